@@ -1,6 +1,7 @@
 package main
 
 import (
+	"database/sql"
 	"fmt"
 	"log"
 	"net/http"
@@ -22,18 +23,47 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/director"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/secrets"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
+	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 )
 
+const (
+	memoryTokenCache   = "memory"
+	postgresTokenCache = "postgres"
+
+	opaqueTokenFormat = "opaque"
+	jwtTokenFormat    = "jwt"
+
+	kubernetesBackend = "kubernetes"
+	fileBackend       = "file"
+	memoryBackend     = "memory"
+	postgresBackend   = "postgres"
+
+	defaultRootCACertificateSecretName = "root-ca"
+
+	connStringf string = "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s"
+)
+
 type config struct {
 	Address               string `envconfig:"default=127.0.0.1:3000"`
 	APIEndpoint           string `envconfig:"default=/graphql"`
 	PlaygroundAPIEndpoint string `envconfig:"default=/graphql"`
+	// InternalAddress serves the API with the administrative operations, it must not be exposed outside the cluster
+	InternalAddress string `envconfig:"default=127.0.0.1:3001"`
+
+	CRLEndpoint             string `envconfig:"default=/crl"`
+	PreviousCRLEndpoint     string `envconfig:"default=/crl/previous"`
+	RevocationCheckEndpoint string `envconfig:"default=/revocation/check"`
 
 	CSRSubject struct {
 		Country            string `envconfig:"default=PL"`
@@ -42,33 +72,80 @@ type config struct {
 		Locality           string `envconfig:"default=Locality"`
 		Province           string `envconfig:"default=State"`
 	}
-	CertificateValidityTime     time.Duration `envconfig:"default=2160h"`
+	CertificateValidityTime time.Duration `envconfig:"default=2160h"`
+	KeyAlgorithms           struct {
+		AllowRSA       bool `envconfig:"default=true"`
+		MinRSAKeySize  int  `envconfig:"default=2048"`
+		AllowECDSAP256 bool `envconfig:"default=true"`
+	}
 	CASecretName                string        `envconfig:"default=namespace/name"`
 	RootCACertificateSecretName string        `envconfig:"optional"`
+	NextCASecretName            string        `envconfig:"optional"`
+	CAOverlapWindow             time.Duration `envconfig:"default=2160h"`
+	RevocationConfigMapName     string        `envconfig:"default=namespace/name"`
+
+	// SecretsBackend selects the source of the CA: kubernetes secrets, PEM files or an ephemeral CA generated in memory
+	SecretsBackend string `envconfig:"default=kubernetes"`
+	CAFiles        struct {
+		Certificate       string `envconfig:"optional"`
+		Key               string `envconfig:"optional"`
+		RootCACertificate string `envconfig:"optional"`
+	}
+	EphemeralCAValidityTime time.Duration `envconfig:"default=8760h"`
+	// RevocationBackend selects the storage of the revocation list: kubernetes config map or memory
+	RevocationBackend string `envconfig:"default=kubernetes"`
+	// InventoryBackend selects the storage of the issued certificates inventory: memory or postgres
+	InventoryBackend string `envconfig:"default=memory"`
+
+	CertificateRenewal struct {
+		Window                    time.Duration `envconfig:"default=720h"`
+		RevokeRenewedCertificates bool          `envconfig:"default=false"`
+	}
 
 	Token struct {
 		Length                int           `envconfig:"default=64"`
 		RuntimeExpiration     time.Duration `envconfig:"default=60m"`
 		ApplicationExpiration time.Duration `envconfig:"default=5m"`
 		CSRExpiration         time.Duration `envconfig:"default=5m"`
+		Cache                 string        `envconfig:"default=memory"`
+		// Format selects random tokens resolved with the cache or JWTs signed with JWTSecret, for which the cache only records used tokens
+		Format    string `envconfig:"default=opaque"`
+		JWTSecret string `envconfig:"optional"`
+	}
+
+	Database struct {
+		User     string `envconfig:"default=postgres,APP_DB_USER"`
+		Password string `envconfig:"default=pgsql@12345,APP_DB_PASSWORD"`
+		Host     string `envconfig:"default=localhost,APP_DB_HOST"`
+		Port     string `envconfig:"default=5432,APP_DB_PORT"`
+		Name     string `envconfig:"default=postgres,APP_DB_NAME"`
+		SSLMode  string `envconfig:"default=disable,APP_DB_SSL"`
 	}
 
 	DirectorURL string `envconfig:"default=127.0.0.1:3003"`
+
+	DirectorClient struct {
+		URL         string        `envconfig:"default=http://127.0.0.1:3000/graphql"`
+		InternalURL string        `envconfig:"default=http://127.0.0.1:3002"`
+		Timeout     time.Duration `envconfig:"default=10s"`
+	}
 }
 
 func (c *config) String() string {
-	return fmt.Sprintf("Address: %s, APIEndpoint: %s, "+
+	return fmt.Sprintf("Address: %s, APIEndpoint: %s, InternalAddress: %s, CRLEndpoint: %s, PreviousCRLEndpoint: %s, RevocationCheckEndpoint: %s, "+
 		"CSRSubjectCountry: %s, CSRSubjectOrganization: %s, CSRSubjectOrganizationalUnit: %s, "+
 		"CSRSubjectLocality: %s, CSRSubjectProvince: %s, "+
-		"CertificateValidityTime: %s, CASecretName: %s, RootCACertificateSecretName: %s, "+
-		"TokenLength: %d, TokenRuntimeExpiration: %s, TokenApplicationExpiration: %s, TokenCSRExpiration: %s, "+
-		"DirectorURL: %s",
-		c.Address, c.APIEndpoint,
+		"CertificateValidityTime: %s, KeyAlgorithmsAllowRSA: %v, KeyAlgorithmsMinRSAKeySize: %d, KeyAlgorithmsAllowECDSAP256: %v, CertificateRenewalWindow: %s, CertificateRenewalRevokeRenewedCertificates: %v, CASecretName: %s, RootCACertificateSecretName: %s, NextCASecretName: %s, CAOverlapWindow: %s, RevocationConfigMapName: %s, "+
+		"SecretsBackend: %s, CAFilesCertificate: %s, CAFilesKey: %s, CAFilesRootCACertificate: %s, EphemeralCAValidityTime: %s, RevocationBackend: %s, InventoryBackend: %s, "+
+		"TokenLength: %d, TokenRuntimeExpiration: %s, TokenApplicationExpiration: %s, TokenCSRExpiration: %s, TokenCache: %s, TokenFormat: %s, "+
+		"DirectorURL: %s, DirectorClientURL: %s, DirectorClientInternalURL: %s, DirectorClientTimeout: %s",
+		c.Address, c.APIEndpoint, c.InternalAddress, c.CRLEndpoint, c.PreviousCRLEndpoint, c.RevocationCheckEndpoint,
 		c.CSRSubject.Country, c.CSRSubject.Organization, c.CSRSubject.OrganizationalUnit,
 		c.CSRSubject.Locality, c.CSRSubject.Province,
-		c.CertificateValidityTime, c.CASecretName, c.RootCACertificateSecretName,
-		c.Token.Length, c.Token.RuntimeExpiration.String(), c.Token.ApplicationExpiration.String(), c.Token.CSRExpiration.String(),
-		c.DirectorURL)
+		c.CertificateValidityTime, c.KeyAlgorithms.AllowRSA, c.KeyAlgorithms.MinRSAKeySize, c.KeyAlgorithms.AllowECDSAP256, c.CertificateRenewal.Window, c.CertificateRenewal.RevokeRenewedCertificates, c.CASecretName, c.RootCACertificateSecretName, c.NextCASecretName, c.CAOverlapWindow, c.RevocationConfigMapName,
+		c.SecretsBackend, c.CAFiles.Certificate, c.CAFiles.Key, c.CAFiles.RootCACertificate, c.EphemeralCAValidityTime, c.RevocationBackend, c.InventoryBackend,
+		c.Token.Length, c.Token.RuntimeExpiration.String(), c.Token.ApplicationExpiration.String(), c.Token.CSRExpiration.String(), c.Token.Cache, c.Token.Format,
+		c.DirectorURL, c.DirectorClient.URL, c.DirectorClient.InternalURL, c.DirectorClient.Timeout)
 }
 
 func main() {
@@ -79,23 +156,19 @@ func main() {
 	log.Println("Starting Connector Service")
 	log.Printf("Config: %s", cfg.String())
 
-	tokenCache := tokens.NewTokenCache(cfg.Token.ApplicationExpiration, cfg.Token.RuntimeExpiration, cfg.Token.CSRExpiration)
-	tokenService := tokens.NewTokenService(tokenCache, tokens.NewTokenGenerator(cfg.Token.Length))
+	var db *sql.DB
+	if cfg.Token.Cache == postgresTokenCache || cfg.InventoryBackend == postgresBackend {
+		db, err = openDatabase(cfg)
+		exitOnError(err, "Failed to initialize database connection")
+	}
 
-	authenticator := authentication.NewAuthenticator(tokenService)
+	tokenService, err := newTokenService(cfg, db)
+	exitOnError(err, "Failed to initialize token service")
 
-	tokenResolver := api.NewTokenResolver(tokenService)
+	directorClient := director.NewClient(cfg.DirectorClient.URL, cfg.DirectorClient.InternalURL, cfg.DirectorClient.Timeout)
+
+	tokenResolver := api.NewTokenResolver(tokenService, directorClient)
 
-	coreClientSet, appErr := newCoreClientSet()
-	exitOnError(appErr, "Failed to initialize Kubernetes client.")
-	secretsRepository := newSecretsRepository(coreClientSet)
-	certificateUtility := certificates.NewCertificateUtility(cfg.CertificateValidityTime)
-	certificateService := certificates.NewCertificateService(
-		secretsRepository,
-		certificateUtility,
-		namespacedname.Parse(cfg.CASecretName),
-		namespacedname.Parse(cfg.RootCACertificateSecretName),
-	)
 	csrSubjectConsts := certificates.CSRSubjectConsts{
 		Country:            cfg.CSRSubject.Country,
 		Organization:       cfg.CSRSubject.Organization,
@@ -104,42 +177,184 @@ func main() {
 		Province:           cfg.CSRSubject.Province,
 	}
 
+	var coreClientSet *kubernetes.Clientset
+	if cfg.SecretsBackend == kubernetesBackend || cfg.RevocationBackend == kubernetesBackend {
+		coreClientSet, err = newCoreClientSet()
+		exitOnError(err, "Failed to initialize Kubernetes client.")
+	}
+
+	caSecretName := namespacedname.Parse(cfg.CASecretName)
+	secretsRepository, rootCACertificateSecretName, err := newSecretsRepository(cfg, coreClientSet, caSecretName, namespacedname.Parse(cfg.RootCACertificateSecretName), csrSubjectConsts)
+	exitOnError(err, "Failed to initialize secrets repository")
+
+	revocationRepository, err := newRevocationRepository(cfg, coreClientSet)
+	exitOnError(err, "Failed to initialize revocation repository")
+
+	keyPolicy := certificates.KeyPolicy{
+		AllowRSA:       cfg.KeyAlgorithms.AllowRSA,
+		MinRSAKeySize:  cfg.KeyAlgorithms.MinRSAKeySize,
+		AllowECDSAP256: cfg.KeyAlgorithms.AllowECDSAP256,
+	}
+	exitOnError(keyPolicy.Validate(), "Invalid key algorithms configuration")
+
+	certificateUtility := certificates.NewCertificateUtility(cfg.CertificateValidityTime, keyPolicy)
+	certificateService := certificates.NewCertificateService(
+		secretsRepository,
+		certificateUtility,
+		caSecretName,
+		rootCACertificateSecretName,
+		newRotationConfig(cfg),
+	)
+	authenticator := authentication.NewAuthenticator(tokenService, certificateService)
+
+	inventoryRepository, err := newInventoryRepository(cfg, db)
+	exitOnError(err, "Failed to initialize issued certificates inventory")
+	inventoryService := inventory.NewInventoryService(inventoryRepository)
+
+	revocationService := revocation.NewRevocationService(revocationRepository, certificateService, inventory.NewClientCertificates(inventoryService))
+
 	certificateResolver := api.NewCertificateResolver(
 		authenticator,
 		tokenService,
 		certificateService,
+		revocationService,
+		directorClient,
+		inventoryService,
 		csrSubjectConsts,
+		keyPolicy,
+		api.RenewalConfig{
+			RenewalWindow:             cfg.CertificateRenewal.Window,
+			RevokeRenewedCertificates: cfg.CertificateRenewal.RevokeRenewedCertificates,
+		},
 		cfg.DirectorURL)
 
-	server := prepareServer(cfg, tokenResolver, certificateResolver)
+	revocationResolver := api.NewRevocationResolver(revocationService, inventoryService, directorClient)
+	inventoryResolver := api.NewInventoryResolver(inventoryService)
+
+	externalServer, internalServer := prepareServers(cfg, tokenResolver, certificateResolver, revocationResolver, inventoryResolver, revocation.NewHandler(revocationService))
+
+	go func() {
+		log.Printf("Internal API listening on %s...", cfg.InternalAddress)
+		if err := internalServer.ListenAndServe(); err != nil {
+			panic(err)
+		}
+	}()
 
 	log.Printf("API listening on %s...", cfg.Address)
-	if err := server.ListenAndServe(); err != nil {
+	if err := externalServer.ListenAndServe(); err != nil {
 		panic(err)
 	}
 }
 
-func prepareServer(cfg config, tokenResolver api.TokenResolver, certResolver api.CertificateResolver) *http.Server {
-	externalResolver := api.Resolver{CertificateResolver: certResolver, TokenResolver: tokenResolver}
+func openDatabase(cfg config) (*sql.DB, error) {
+	connString := fmt.Sprintf(connStringf, cfg.Database.Host, cfg.Database.Port, cfg.Database.User,
+		cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode)
 
-	gqlInternalCfg := gqlschema.Config{
-		Resolvers: &externalResolver,
+	db, err := sql.Open("postgres", connString)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to open database connection")
 	}
 
-	externalExecutableSchema := gqlschema.NewExecutableSchema(gqlInternalCfg)
+	err = db.Ping()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to connect to database")
+	}
 
-	externalRouter := mux.NewRouter()
-	externalRouter.HandleFunc("/", handler.Playground("Dataloader", cfg.PlaygroundAPIEndpoint))
-	externalRouter.HandleFunc(cfg.APIEndpoint, handler.GraphQL(externalExecutableSchema))
+	return db, nil
+}
+
+func newTokenService(cfg config, db *sql.DB) (tokens.Service, error) {
+	switch cfg.Token.Format {
+	case opaqueTokenFormat:
+		tokenCache, err := newTokenCache(cfg, db)
+		if err != nil {
+			return nil, err
+		}
+
+		return tokens.NewTokenService(tokenCache, tokens.NewTokenGenerator(cfg.Token.Length)), nil
+	case jwtTokenFormat:
+		replayStore, err := newReplayStore(cfg, db)
+		if err != nil {
+			return nil, err
+		}
+
+		return tokens.NewJWTService([]byte(cfg.Token.JWTSecret), replayStore, cfg.Token.ApplicationExpiration, cfg.Token.RuntimeExpiration, cfg.Token.CSRExpiration)
+	default:
+		return nil, errors.Errorf("Invalid token format: %s", cfg.Token.Format)
+	}
+}
+
+func newTokenCache(cfg config, db *sql.DB) (tokens.Cache, error) {
+	switch cfg.Token.Cache {
+	case memoryTokenCache:
+		return tokens.NewTokenCache(cfg.Token.ApplicationExpiration, cfg.Token.RuntimeExpiration, cfg.Token.CSRExpiration), nil
+	case postgresTokenCache:
+		return tokens.NewPostgresCache(db, cfg.Token.ApplicationExpiration, cfg.Token.RuntimeExpiration, cfg.Token.CSRExpiration), nil
+	default:
+		return nil, errors.Errorf("Invalid token cache type: %s", cfg.Token.Cache)
+	}
+}
+
+func newReplayStore(cfg config, db *sql.DB) (tokens.ReplayStore, error) {
+	switch cfg.Token.Cache {
+	case memoryTokenCache:
+		return tokens.NewInMemoryReplayStore(), nil
+	case postgresTokenCache:
+		return tokens.NewPostgresReplayStore(db), nil
+	default:
+		return nil, errors.Errorf("Invalid token cache type: %s", cfg.Token.Cache)
+	}
+}
+
+func newInventoryRepository(cfg config, db *sql.DB) (inventory.Repository, error) {
+	switch cfg.InventoryBackend {
+	case postgresBackend:
+		return inventory.NewPostgresRepository(db), nil
+	case memoryBackend:
+		logrus.Warn("Using in-memory issued certificates inventory, the inventory is lost after restart")
+		return inventory.NewInMemoryRepository(), nil
+	default:
+		return nil, errors.Errorf("Invalid inventory backend: %s", cfg.InventoryBackend)
+	}
+}
+
+// prepareServers returns the server of the external API, which is exposed by the Gateway, and the server of the internal API.
+// Both serve the same schema, but the administrative operations are allowed only on the internal one.
+func prepareServers(cfg config, tokenResolver api.TokenResolver, certResolver api.CertificateResolver, revocationResolver api.RevocationResolver, inventoryResolver api.InventoryResolver, revocationHandler revocation.Handler) (*http.Server, *http.Server) {
+	resolver := api.Resolver{CertificateResolver: certResolver, TokenResolver: tokenResolver, RevocationResolver: revocationResolver, InventoryResolver: inventoryResolver}
+
+	gqlCfg := gqlschema.Config{
+		Resolvers: &resolver,
+	}
+
+	executableSchema := gqlschema.NewExecutableSchema(gqlCfg)
 
 	authContextMiddleware := authentication.NewAuthenticationContextMiddleware()
 
+	externalRouter := mux.NewRouter()
+	externalRouter.HandleFunc("/", handler.Playground("Dataloader", cfg.PlaygroundAPIEndpoint))
+	externalRouter.HandleFunc(cfg.APIEndpoint, handler.GraphQL(executableSchema))
+	externalRouter.HandleFunc(cfg.CRLEndpoint, revocationHandler.CRL).Methods(http.MethodGet)
+	externalRouter.HandleFunc(cfg.PreviousCRLEndpoint, revocationHandler.PreviousCRL).Methods(http.MethodGet)
+
 	externalRouter.Use(authContextMiddleware.PropagateAuthentication)
 
-	return &http.Server{
+	internalRouter := mux.NewRouter()
+	internalRouter.HandleFunc(cfg.APIEndpoint, handler.GraphQL(executableSchema))
+	internalRouter.HandleFunc(cfg.RevocationCheckEndpoint, revocationHandler.CheckRevocation).Methods(http.MethodPost)
+
+	internalRouter.Use(authContextMiddleware.PropagateAuthentication, authContextMiddleware.MarkInternalAPI)
+
+	externalServer := &http.Server{
 		Addr:    cfg.Address,
 		Handler: externalRouter,
 	}
+	internalServer := &http.Server{
+		Addr:    cfg.InternalAddress,
+		Handler: internalRouter,
+	}
+
+	return externalServer, internalServer
 }
 
 func exitOnError(err error, context string) {
@@ -170,10 +385,75 @@ func newCoreClientSet() (*kubernetes.Clientset, error) {
 	return coreClientset, nil
 }
 
-func newSecretsRepository(coreClientSet *kubernetes.Clientset) secrets.Repository {
-	core := coreClientSet.CoreV1()
+// newSecretsRepository returns the repository of the CA secrets and the name of the root CA secret, which is set
+// when the root CA certificate file is provided
+func newSecretsRepository(cfg config, coreClientSet *kubernetes.Clientset, caSecretName, rootCACertificateSecretName types.NamespacedName, subjectConsts certificates.CSRSubjectConsts) (secrets.Repository, types.NamespacedName, error) {
+	switch cfg.SecretsBackend {
+	case kubernetesBackend:
+		core := coreClientSet.CoreV1()
+
+		return secrets.NewRepository(func(namespace string) secrets.Manager {
+			return core.Secrets(namespace)
+		}), rootCACertificateSecretName, nil
+	case fileBackend:
+		if cfg.CAFiles.Certificate == "" || cfg.CAFiles.Key == "" {
+			return nil, types.NamespacedName{}, errors.New("CA certificate and key files are required for file secrets backend")
+		}
+
+		secretFiles := map[types.NamespacedName]secrets.SecretFiles{
+			caSecretName: {
+				certificates.CACertificateSecretKey: cfg.CAFiles.Certificate,
+				certificates.CAKeySecretKey:         cfg.CAFiles.Key,
+			},
+		}
+
+		if cfg.CAFiles.RootCACertificate != "" {
+			if rootCACertificateSecretName.Name == "" {
+				rootCACertificateSecretName = namespacedname.Parse(defaultRootCACertificateSecretName)
+			}
+			secretFiles[rootCACertificateSecretName] = secrets.SecretFiles{
+				certificates.RootCACertificateSecretKey: cfg.CAFiles.RootCACertificate,
+			}
+		}
+
+		return secrets.NewFileRepository(secretFiles), rootCACertificateSecretName, nil
+	case memoryBackend:
+		caCertificate, caKey, err := certificates.GenerateCA(subjectConsts, cfg.EphemeralCAValidityTime)
+		if err != nil {
+			return nil, types.NamespacedName{}, errors.Wrap(err, "Failed to generate ephemeral CA")
+		}
+
+		logrus.Warn("Using ephemeral CA, certificates issued by the Connector become invalid after restart")
+
+		return secrets.NewInMemoryRepository(map[types.NamespacedName]map[string][]byte{
+			caSecretName: {
+				certificates.CACertificateSecretKey: caCertificate,
+				certificates.CAKeySecretKey:         caKey,
+			},
+		}), types.NamespacedName{}, nil
+	default:
+		return nil, types.NamespacedName{}, errors.Errorf("Invalid secrets backend: %s", cfg.SecretsBackend)
+	}
+}
+
+func newRotationConfig(cfg config) certificates.RotationConfig {
+	rotationConfig := certificates.RotationConfig{OverlapWindow: cfg.CAOverlapWindow}
+	if cfg.NextCASecretName != "" {
+		rotationConfig.NextCASecretName = namespacedname.Parse(cfg.NextCASecretName)
+	}
 
-	return secrets.NewRepository(func(namespace string) secrets.Manager {
-		return core.Secrets(namespace)
-	})
+	return rotationConfig
+}
+
+func newRevocationRepository(cfg config, coreClientSet *kubernetes.Clientset) (revocation.Repository, error) {
+	switch cfg.RevocationBackend {
+	case kubernetesBackend:
+		name := namespacedname.Parse(cfg.RevocationConfigMapName)
+		return revocation.NewRepository(coreClientSet.CoreV1().ConfigMaps(name.Namespace), name.Name, wait.NeverStop), nil
+	case memoryBackend:
+		logrus.Warn("Using in-memory revocation list, revoked certificates become valid after restart")
+		return revocation.NewInMemoryRepository(), nil
+	default:
+		return nil, errors.Errorf("Invalid revocation backend: %s", cfg.RevocationBackend)
+	}
 }
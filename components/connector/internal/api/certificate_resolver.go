@@ -2,11 +2,16 @@ package api
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/base64"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/director"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/gqlschema"
 	"github.com/pkg/errors"
@@ -17,13 +22,27 @@ type CertificateResolver interface {
 	SignCertificateSigningRequest(ctx context.Context, csr string) (*gqlschema.CertificationResult, error)
 	RevokeCertificate(ctx context.Context) (bool, error)
 	Configuration(ctx context.Context) (*gqlschema.Configuration, error)
+	CaCertificates(ctx context.Context) ([]*gqlschema.CACertificate, error)
+}
+
+// RenewalConfig configures renewal of certificates authenticated with the client certificate
+type RenewalConfig struct {
+	// RenewalWindow is the time before the certificate expiration in which it can be renewed
+	RenewalWindow time.Duration
+	// RevokeRenewedCertificates enables revocation of the certificate used to authenticate the renewal
+	RevokeRenewedCertificates bool
 }
 
 type certificateResolver struct {
 	authenticator       authentication.Authenticator
 	tokenService        tokens.Service
 	certificatesService certificates.Service
+	revocationService   revocation.Service
+	directorClient      director.Client
+	inventoryService    inventory.Service
 	csrSubjectConsts    certificates.CSRSubjectConsts
+	keyAlgorithms       []string
+	renewalConfig       RenewalConfig
 	directorURL         string
 	log                 *logrus.Entry
 }
@@ -32,26 +51,36 @@ func NewCertificateResolver(
 	authenticator authentication.Authenticator,
 	tokenService tokens.Service,
 	certificatesService certificates.Service,
+	revocationService revocation.Service,
+	directorClient director.Client,
+	inventoryService inventory.Service,
 	csrSubjectConsts certificates.CSRSubjectConsts,
+	keyPolicy certificates.KeyPolicy,
+	renewalConfig RenewalConfig,
 	directorURL string) CertificateResolver {
 	return &certificateResolver{
 		authenticator:       authenticator,
 		tokenService:        tokenService,
 		certificatesService: certificatesService,
+		revocationService:   revocationService,
+		directorClient:      directorClient,
+		inventoryService:    inventoryService,
 		csrSubjectConsts:    csrSubjectConsts,
+		keyAlgorithms:       keyPolicy.KeyAlgorithms(),
+		renewalConfig:       renewalConfig,
 		directorURL:         directorURL,
 		log:                 logrus.WithField("Resolver", "Certificate"),
 	}
 }
 
 func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context, csr string) (*gqlschema.CertificationResult, error) {
-	tokenData, err := r.authenticator.AuthenticateToken(ctx)
+	client, err := r.authenticate(ctx)
 	if err != nil {
-		r.log.Errorf(err.Error())
-		return nil, errors.Wrap(err, "Failed to authenticate with token")
+		r.log.Error(err.Error())
+		return nil, err
 	}
 
-	r.log.Infof("Signing Certificate Signing Request for %s client.", tokenData.ClientId)
+	r.log.Infof("Signing Certificate Signing Request for %s client.", client.id)
 
 	rawCSR, err := decodeStringFromBase64(csr)
 	if err != nil {
@@ -59,17 +88,18 @@ func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context,
 		return nil, errors.Wrap(err, "Error while decoding Certificate Signing Request")
 	}
 
-	subject := certificates.CSRSubject{
-		CommonName:       tokenData.ClientId,
-		CSRSubjectConsts: r.csrSubjectConsts,
-	}
-
-	encodedCertificates, err := r.certificatesService.SignCSR(rawCSR, subject)
+	encodedCertificates, err := r.certificatesService.SignCSR(rawCSR, client.subject)
 	if err != nil {
 		r.log.Errorf(err.Error())
 		return nil, errors.Wrap(err, "Error while signing Certificate Signing Request")
 	}
 
+	if client.certificate != nil && r.renewalConfig.RevokeRenewedCertificates {
+		r.revokeRenewedCertificate(client.certificate)
+	}
+
+	r.handleSignedCertificate(client, encodedCertificates)
+
 	certificationResult := certificates.ToCertificationResult(encodedCertificates)
 
 	r.log.Infof("Certificate Signing Request signed.")
@@ -77,36 +107,206 @@ func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context,
 }
 
 func (r *certificateResolver) RevokeCertificate(ctx context.Context) (bool, error) {
-	panic("not implemented")
+	certificate, err := r.authenticator.AuthenticateCertificate(ctx)
+	if err != nil {
+		r.log.Error(err.Error())
+		return false, errors.Wrap(err, "Failed to authenticate with client certificate")
+	}
+
+	r.log.Infof("Revoking certificate with %s serial number for %s client.", revocation.FormatSerialNumber(certificate.SerialNumber), certificate.Subject.CommonName)
+
+	err = r.revocationService.RevokeCertificate(certificate)
+	if err != nil {
+		r.log.Error(err.Error())
+		return false, errors.Wrap(err, "Error while revoking certificate")
+	}
+
+	r.log.Infof("Certificate revoked.")
+
+	reportPairing(r.directorClient, r.log, certificates.TenantFromCertificate(certificate), director.PairingReport{
+		ClientType: director.ClientType(certificates.ClientTypeFromCertificate(certificate)),
+		ClientID:   certificate.Subject.CommonName,
+		Event:      director.PairingEventRevoked,
+	})
+
+	return true, nil
 }
 
 func (r *certificateResolver) Configuration(ctx context.Context) (*gqlschema.Configuration, error) {
-	tokenData, err := r.authenticator.AuthenticateToken(ctx)
+	client, err := r.authenticate(ctx)
 	if err != nil {
-		r.log.Errorf(err.Error())
+		r.log.Error(err.Error())
 		return nil, err
 	}
 
-	r.log.Infof("Fetching configuration for %s client.", tokenData.ClientId)
+	r.log.Infof("Fetching configuration for %s client.", client.id)
 
-	token, err := r.tokenService.CreateToken(tokenData.ClientId, tokens.CSRToken)
-	if err != nil {
-		r.log.Errorf(err.Error())
-		return nil, err
+	var csrToken *gqlschema.Token
+	if client.certificate == nil {
+		token, err := r.tokenService.CreateToken(tokens.TokenData{
+			Type:       tokens.CSRToken,
+			ClientId:   client.id,
+			Tenant:     client.subject.Tenant,
+			ClientType: tokens.TokenType(client.subject.ClientType),
+		})
+		if err != nil {
+			r.log.Errorf(err.Error())
+			return nil, err
+		}
+		csrToken = &gqlschema.Token{Token: token}
 	}
 
 	csrInfo := &gqlschema.CertificateSigningRequestInfo{
-		Subject:      r.csrSubjectConsts.ToString(tokenData.ClientId),
-		KeyAlgorithm: "rsa2048",
+		Subject:              client.subject.String(),
+		KeyAlgorithm:         r.keyAlgorithms[0],
+		AllowedKeyAlgorithms: r.keyAlgorithms,
 	}
 
 	return &gqlschema.Configuration{
-		Token:                         &gqlschema.Token{Token: token},
+		Token:                         csrToken,
 		CertificateSigningRequestInfo: csrInfo,
 		ManagementPlaneInfo:           &gqlschema.ManagementPlaneInfo{DirectorURL: r.directorURL},
 	}, nil
 }
 
+func (r *certificateResolver) CaCertificates(ctx context.Context) ([]*gqlschema.CACertificate, error) {
+	r.log.Info("Fetching CA certificates...")
+
+	caCertificates, err := r.certificatesService.CACertificates()
+	if err != nil {
+		r.log.Error(err.Error())
+		return nil, errors.Wrap(err, "Failed to fetch CA certificates")
+	}
+
+	result := make([]*gqlschema.CACertificate, 0, len(caCertificates))
+	for _, caCertificate := range caCertificates {
+		result = append(result, certificates.ToCACertificate(caCertificate))
+	}
+
+	return result, nil
+}
+
+type authenticatedClient struct {
+	id      string
+	subject certificates.CSRSubject
+	// certificate is set when the client authenticated with the certificate being renewed
+	certificate *x509.Certificate
+}
+
+// authenticate uses the one-time token if it was provided, otherwise the client certificate is used to renew it
+func (r *certificateResolver) authenticate(ctx context.Context) (authenticatedClient, error) {
+	token, _ := authentication.GetStringFromContext(ctx, authentication.ConnectorTokenKey)
+	clientCertificate, _ := authentication.GetStringFromContext(ctx, authentication.ClientCertificateKey)
+
+	if token != "" || clientCertificate == "" {
+		tokenData, err := r.authenticator.AuthenticateToken(ctx)
+		if err != nil {
+			return authenticatedClient{}, errors.Wrap(err, "Failed to authenticate with token")
+		}
+
+		return authenticatedClient{
+			id: tokenData.ClientId,
+			subject: certificates.CSRSubject{
+				CommonName:       tokenData.ClientId,
+				Tenant:           tokenData.Tenant,
+				ClientType:       string(tokenData.ClientType),
+				CSRSubjectConsts: r.csrSubjectConsts,
+			},
+		}, nil
+	}
+
+	certificate, err := r.authenticator.AuthenticateCertificate(ctx)
+	if err != nil {
+		return authenticatedClient{}, errors.Wrap(err, "Failed to authenticate with client certificate")
+	}
+
+	err = r.checkRenewalAllowed(certificate)
+	if err != nil {
+		return authenticatedClient{}, errors.Wrap(err, "Failed to authenticate with client certificate")
+	}
+
+	return authenticatedClient{
+		id:          certificate.Subject.CommonName,
+		subject:     certificates.CSRSubjectFromCertificate(certificate),
+		certificate: certificate,
+	}, nil
+}
+
+func (r *certificateResolver) checkRenewalAllowed(certificate *x509.Certificate) error {
+	validFor := time.Until(certificate.NotAfter)
+	if validFor <= 0 {
+		return apperrors.Forbidden("Certificate expired")
+	}
+
+	if validFor > r.renewalConfig.RenewalWindow {
+		return apperrors.Forbidden("Certificate can be renewed not earlier than %s before its expiration", r.renewalConfig.RenewalWindow)
+	}
+
+	revoked, err := r.revocationService.IsRevoked(certificate)
+	if err != nil {
+		return err
+	}
+
+	if revoked {
+		return apperrors.Forbidden("Certificate has been revoked")
+	}
+
+	return nil
+}
+
+func (r *certificateResolver) revokeRenewedCertificate(certificate *x509.Certificate) {
+	serialNumber := revocation.FormatSerialNumber(certificate.SerialNumber)
+
+	err := r.revocationService.RevokeCertificate(certificate)
+	if err != nil {
+		r.log.Errorf("Failed to revoke renewed certificate with %s serial number: %s", serialNumber, err.Error())
+		return
+	}
+
+	r.log.Infof("Renewed certificate with %s serial number revoked.", serialNumber)
+}
+
+// handleSignedCertificate records the signed certificate in the inventory and reports it to the Director
+// failures are only logged as the certificate has already been signed
+func (r *certificateResolver) handleSignedCertificate(client authenticatedClient, encodedCertificates certificates.EncodedCertificateChain) {
+	signedCertificate, err := certificates.ParseClientCertificate(encodedCertificates)
+	if err != nil {
+		r.log.Errorf("Failed to parse certificate signed for %s client: %s", client.id, err.Error())
+		return
+	}
+
+	r.recordSignedCertificate(signedCertificate)
+	r.reportSignedCertificate(client, signedCertificate)
+}
+
+func (r *certificateResolver) recordSignedCertificate(signedCertificate *x509.Certificate) {
+	serialNumber := revocation.FormatSerialNumber(signedCertificate.SerialNumber)
+
+	err := r.inventoryService.Record(signedCertificate)
+	if err != nil {
+		r.log.Errorf("Failed to record certificate with %s serial number in the inventory: %s", serialNumber, err.Error())
+		return
+	}
+
+	r.log.Infof("Certificate with %s serial number recorded in the inventory.", serialNumber)
+}
+
+// reportSignedCertificate reports the certificate issued with the token or renewed with the client certificate
+func (r *certificateResolver) reportSignedCertificate(client authenticatedClient, signedCertificate *x509.Certificate) {
+	event := director.PairingEventIssued
+	if client.certificate != nil {
+		event = director.PairingEventRenewed
+	}
+
+	reportPairing(r.directorClient, r.log, client.subject.Tenant, director.PairingReport{
+		ClientType:   director.ClientType(client.subject.ClientType),
+		ClientID:     client.id,
+		Event:        event,
+		SerialNumber: revocation.FormatSerialNumber(signedCertificate.SerialNumber),
+		ExpiresAt:    signedCertificate.NotAfter,
+	})
+}
+
 func decodeStringFromBase64(string string) ([]byte, apperrors.AppError) {
 	bytes, err := base64.StdEncoding.DecodeString(string)
 	if err != nil {
@@ -2,16 +2,33 @@ package api
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	authenticationMocks "github.com/kyma-incubator/compass/components/connector/internal/authentication/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/director"
+	directorMocks "github.com/kyma-incubator/compass/components/connector/internal/director/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	inventoryMocks "github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	tokensMocks "github.com/kyma-incubator/compass/components/connector/internal/tokens/mocks"
+	"github.com/kyma-incubator/compass/components/connector/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,6 +37,7 @@ var (
 	decodedCSR, _ = decodeStringFromBase64(CSR)
 	subject       = certificates.CSRSubject{
 		CommonName: "commonname",
+		Tenant:     tenant,
 		CSRSubjectConsts: certificates.CSRSubjectConsts{
 			Country:            "country",
 			Organization:       "organization",
@@ -28,10 +46,18 @@ var (
 			Province:           "province",
 		},
 	}
-	directorURL = "https://compass-gateway.kyma.local/director/graphql"
-	tokenData   = tokens.TokenData{
+	directorURL   = "https://compass-gateway.kyma.local/director/graphql"
+	renewalConfig = RenewalConfig{RenewalWindow: 30 * 24 * time.Hour}
+	keyPolicy     = certificates.KeyPolicy{AllowRSA: true, MinRSAKeySize: 2048, AllowECDSAP256: true}
+	tokenData     = tokens.TokenData{
 		ClientId: subject.CommonName,
 		Type:     "sometype",
+		Tenant:   subject.Tenant,
+	}
+	csrTokenData = tokens.TokenData{
+		ClientId: subject.CommonName,
+		Type:     tokens.CSRToken,
+		Tenant:   subject.Tenant,
 	}
 )
 
@@ -55,7 +81,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, nil, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
 
 		// when
 		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -67,6 +93,111 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		assert.Equal(t, clientCertificate, certificationResult.ClientCertificate)
 	})
 
+	t.Run("should report issued certificate to Director", func(t *testing.T) {
+		// given
+		applicationTokenData := tokens.TokenData{
+			ClientId:   subject.CommonName,
+			Type:       tokens.ApplicationToken,
+			Tenant:     subject.Tenant,
+			ClientType: tokens.ApplicationToken,
+		}
+		applicationSubject := subject
+		applicationSubject.ClientType = string(tokens.ApplicationToken)
+
+		signedCertificate, encodedChain := encodedCertificateChain(t)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateToken", context.TODO()).Return(applicationTokenData, nil)
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", decodedCSR, applicationSubject).Return(encodedChain, nil)
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ReportPairing", tenant, director.PairingReport{
+			ClientType:   director.ApplicationClient,
+			ClientID:     subject.CommonName,
+			Event:        director.PairingEventIssued,
+			SerialNumber: "4d2",
+			ExpiresAt:    signedCertificate.NotAfter,
+		}).Return(nil)
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Record", mock.AnythingOfType("*x509.Certificate")).Return(nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, nil, directorClient, inventoryService, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, encodedChain.ClientCertificate, certificationResult.ClientCertificate)
+		directorClient.AssertExpectations(t)
+	})
+
+	t.Run("should record issued certificate in the inventory", func(t *testing.T) {
+		// given
+		applicationTokenData := tokens.TokenData{
+			ClientId:   subject.CommonName,
+			Type:       tokens.ApplicationToken,
+			Tenant:     subject.Tenant,
+			ClientType: tokens.ApplicationToken,
+		}
+		applicationSubject := subject
+		applicationSubject.ClientType = string(tokens.ApplicationToken)
+
+		signedCertificate, encodedChain := encodedCertificateChain(t)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateToken", context.TODO()).Return(applicationTokenData, nil)
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", decodedCSR, applicationSubject).Return(encodedChain, nil)
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ReportPairing", tenant, mock.Anything).Return(nil)
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Record", mock.MatchedBy(func(certificate *x509.Certificate) bool {
+			return inventory.NewIssuedCertificate(certificate) == inventory.NewIssuedCertificate(signedCertificate)
+		})).Return(nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, nil, directorClient, inventoryService, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
+
+		// then
+		require.NoError(t, err)
+		inventoryService.AssertExpectations(t)
+	})
+
+	t.Run("should return certificate when failed to record it and report to Director", func(t *testing.T) {
+		// given
+		applicationTokenData := tokens.TokenData{
+			ClientId:   subject.CommonName,
+			Type:       tokens.ApplicationToken,
+			Tenant:     subject.Tenant,
+			ClientType: tokens.ApplicationToken,
+		}
+		applicationSubject := subject
+		applicationSubject.ClientType = string(tokens.ApplicationToken)
+
+		_, encodedChain := encodedCertificateChain(t)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateToken", context.TODO()).Return(applicationTokenData, nil)
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", decodedCSR, applicationSubject).Return(encodedChain, nil)
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ReportPairing", tenant, mock.Anything).Return(apperrors.UpstreamServerCallFailed("error"))
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Record", mock.AnythingOfType("*x509.Certificate")).Return(apperrors.Internal("error"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, nil, directorClient, inventoryService, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, encodedChain.ClientCertificate, certificationResult.ClientCertificate)
+	})
+
 	t.Run("should return error when unauthenticated call", func(t *testing.T) {
 		// given
 		certChainBase64 := "certChainBase64"
@@ -86,7 +217,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, nil, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -114,7 +245,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, nil, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), "not base 64 csr")
@@ -132,7 +263,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", decodedCSR, subject).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, nil, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -142,6 +273,117 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 	})
 }
 
+func TestCertificateResolver_SignCertificateSigningRequest_Renewal(t *testing.T) {
+
+	ctx := authentication.PutInContext(context.Background(), authentication.ClientCertificateKey, "Cert=\"certificate\"")
+
+	encodedChain := certificates.EncodedCertificateChain{
+		CertificateChain:  "certChainBase64",
+		CaCertificate:     "caCertificate",
+		ClientCertificate: "clientCertificate",
+	}
+
+	t.Run("should renew client certificate with the same subject", func(t *testing.T) {
+		// given
+		certificate := clientCertificate(subject, 24*time.Hour)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", ctx).Return(certificate, nil)
+		revocationService := &revocationMocks.Service{}
+		revocationService.On("IsRevoked", certificate).Return(false, nil)
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, revocationService, nil, nil, certificates.CSRSubjectConsts{}, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		certificationResult, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, encodedChain.ClientCertificate, certificationResult.ClientCertificate)
+		authenticator.AssertNotCalled(t, "AuthenticateToken", mock.Anything)
+		revocationService.AssertNotCalled(t, "RevokeCertificate", mock.Anything)
+	})
+
+	t.Run("should revoke renewed certificate when enabled", func(t *testing.T) {
+		// given
+		certificate := clientCertificate(subject, 24*time.Hour)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", ctx).Return(certificate, nil)
+		revocationService := &revocationMocks.Service{}
+		revocationService.On("IsRevoked", certificate).Return(false, nil)
+		revocationService.On("RevokeCertificate", certificate).Return(nil)
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
+
+		config := RenewalConfig{RenewalWindow: renewalConfig.RenewalWindow, RevokeRenewedCertificates: true}
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, revocationService, nil, nil, subject.CSRSubjectConsts, keyPolicy, config, directorURL)
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.NoError(t, err)
+		revocationService.AssertExpectations(t)
+	})
+
+	t.Run("should return error when certificate is not within renewal window", func(t *testing.T) {
+		// given
+		certificate := clientCertificate(subject, 90*24*time.Hour)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", ctx).Return(certificate, nil)
+		certService := &certificatesMocks.Service{}
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, &revocationMocks.Service{}, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.Error(t, err)
+		certService.AssertNotCalled(t, "SignCSR", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error when certificate expired", func(t *testing.T) {
+		// given
+		certificate := clientCertificate(subject, -time.Hour)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", ctx).Return(certificate, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, &revocationMocks.Service{}, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should return error when certificate was revoked", func(t *testing.T) {
+		// given
+		certificate := clientCertificate(subject, 24*time.Hour)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", ctx).Return(certificate, nil)
+		revocationService := &revocationMocks.Service{}
+		revocationService.On("IsRevoked", certificate).Return(true, nil)
+		certService := &certificatesMocks.Service{}
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, revocationService, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.Error(t, err)
+		certService.AssertNotCalled(t, "SignCSR", mock.Anything, mock.Anything)
+	})
+}
+
 func TestCertificateResolver_Configuration(t *testing.T) {
 
 	t.Run("should return configuration", func(t *testing.T) {
@@ -149,9 +391,9 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateToken", context.Background()).Return(tokenData, nil)
 		tokenService := &tokensMocks.Service{}
-		tokenService.On("CreateToken", subject.CommonName, tokens.CSRToken).Return(token, nil)
+		tokenService.On("CreateToken", csrTokenData).Return(token, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, nil, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -162,6 +404,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		assert.Equal(t, directorURL, configurationResult.ManagementPlaneInfo.DirectorURL)
 		assert.Equal(t, expectedSubject(subject.CSRSubjectConsts, subject.CommonName), configurationResult.CertificateSigningRequestInfo.Subject)
 		assert.Equal(t, "rsa2048", configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
+		assert.Equal(t, []string{"rsa2048", "ecdsa-p256"}, configurationResult.CertificateSigningRequestInfo.AllowedKeyAlgorithms)
 	})
 
 	t.Run("should return error when failed to generate token", func(t *testing.T) {
@@ -169,9 +412,9 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateToken", context.Background()).Return(tokenData, nil)
 		tokenService := &tokensMocks.Service{}
-		tokenService.On("CreateToken", subject.CommonName, tokens.CSRToken).Return("", apperrors.Internal("error"))
+		tokenService.On("CreateToken", csrTokenData).Return("", apperrors.Internal("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, nil, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -187,7 +430,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		authenticator.On("AuthenticateToken", context.Background()).Return(tokens.TokenData{}, apperrors.Forbidden("Error"))
 		tokenService := &tokensMocks.Service{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, nil, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -199,6 +442,171 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 
 }
 
+func TestCertificateResolver_Configuration_Renewal(t *testing.T) {
+	t.Run("should return configuration with subject of the client certificate", func(t *testing.T) {
+		// given
+		ctx := authentication.PutInContext(context.Background(), authentication.ClientCertificateKey, "Cert=\"certificate\"")
+		certificate := clientCertificate(subject, 24*time.Hour)
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", ctx).Return(certificate, nil)
+		revocationService := &revocationMocks.Service{}
+		revocationService.On("IsRevoked", certificate).Return(false, nil)
+		tokenService := &tokensMocks.Service{}
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, revocationService, nil, nil, certificates.CSRSubjectConsts{}, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		configurationResult, err := certificateResolver.Configuration(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, configurationResult.Token)
+		assert.Equal(t, expectedSubject(subject.CSRSubjectConsts, subject.CommonName), configurationResult.CertificateSigningRequestInfo.Subject)
+		tokenService.AssertNotCalled(t, "CreateToken", mock.Anything)
+	})
+}
+
+func TestCertificateResolver_RevokeCertificate(t *testing.T) {
+
+	certificate := &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: subject.CommonName},
+	}
+
+	t.Run("should revoke client certificate", func(t *testing.T) {
+		// given
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.TODO()).Return(certificate, nil)
+		revocationService := &revocationMocks.Service{}
+		revocationService.On("RevokeCertificate", certificate).Return(nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, revocationService, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		revoked, err := certificateResolver.RevokeCertificate(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		revocationService.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to authenticate with certificate", func(t *testing.T) {
+		// given
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.TODO()).Return(nil, fmt.Errorf("error"))
+		revocationService := &revocationMocks.Service{}
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, revocationService, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		revoked, err := certificateResolver.RevokeCertificate(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.False(t, revoked)
+		revocationService.AssertNotCalled(t, "RevokeCertificate", mock.Anything)
+	})
+
+	t.Run("should return error when failed to revoke certificate", func(t *testing.T) {
+		// given
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.TODO()).Return(certificate, nil)
+		revocationService := &revocationMocks.Service{}
+		revocationService.On("RevokeCertificate", certificate).Return(apperrors.Internal("error"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, revocationService, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		revoked, err := certificateResolver.RevokeCertificate(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.False(t, revoked)
+	})
+}
+
+func TestCertificateResolver_CaCertificates(t *testing.T) {
+
+	t.Run("should return CA certificates", func(t *testing.T) {
+		// given
+		expiresAt := time.Date(2020, 10, 3, 12, 0, 0, 0, time.UTC)
+		caCertificates := []certificates.CACertificateInfo{
+			{Fingerprint: "AA:BB", Subject: "CN=next", Role: certificates.ActiveCA, ExpiresAt: expiresAt},
+			{Fingerprint: "CC:DD", Subject: "CN=current", Role: certificates.PreviousCA, ExpiresAt: expiresAt},
+		}
+
+		certService := &certificatesMocks.Service{}
+		certService.On("CACertificates").Return(caCertificates, nil)
+
+		certificateResolver := NewCertificateResolver(nil, nil, certService, nil, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		result, err := certificateResolver.CaCertificates(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "AA:BB", result[0].Fingerprint)
+		assert.Equal(t, gqlschema.CARoleActive, result[0].Role)
+		assert.Equal(t, "2020-10-03T12:00:00Z", result[0].ExpiresAt)
+		assert.Equal(t, gqlschema.CARolePrevious, result[1].Role)
+	})
+
+	t.Run("should return error when failed to fetch CA certificates", func(t *testing.T) {
+		// given
+		certService := &certificatesMocks.Service{}
+		certService.On("CACertificates").Return(nil, apperrors.Internal("error"))
+
+		certificateResolver := NewCertificateResolver(nil, nil, certService, nil, nil, nil, subject.CSRSubjectConsts, keyPolicy, renewalConfig, directorURL)
+
+		// when
+		result, err := certificateResolver.CaCertificates(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
 func expectedSubject(c certificates.CSRSubjectConsts, commonName string) string {
 	return fmt.Sprintf("O=%s,OU=%s,L=%s,ST=%s,C=%s,CN=%s", c.Organization, c.OrganizationalUnit, c.Locality, c.Province, c.Country, commonName)
 }
+
+func clientCertificate(subject certificates.CSRSubject, validFor time.Duration) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject: pkix.Name{
+			CommonName:         subject.CommonName,
+			Country:            []string{subject.Country},
+			Organization:       []string{subject.Organization},
+			OrganizationalUnit: []string{subject.OrganizationalUnit},
+			Locality:           []string{subject.Locality},
+			Province:           []string{subject.Province},
+		},
+		URIs:      []*url.URL{certificates.TenantURI(subject.Tenant)},
+		NotBefore: time.Now().Add(-time.Hour),
+		NotAfter:  time.Now().Add(validFor),
+	}
+}
+
+func encodedCertificateChain(t *testing.T) (*x509.Certificate, certificates.EncodedCertificateChain) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := clientCertificate(subject, 24*time.Hour)
+	rawCertificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(rawCertificate)
+	require.NoError(t, err)
+
+	encodedCertificate := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rawCertificate}))
+
+	return certificate, certificates.EncodedCertificateChain{
+		CertificateChain:  encodedCertificate,
+		CaCertificate:     encodedCertificate,
+		ClientCertificate: encodedCertificate,
+	}
+}
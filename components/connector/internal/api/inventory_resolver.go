@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/pkg/gqlschema"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type InventoryResolver interface {
+	IssuedCertificates(ctx context.Context, filter *gqlschema.IssuedCertificateFilter) ([]*gqlschema.IssuedCertificate, error)
+}
+
+type inventoryResolver struct {
+	inventoryService inventory.Service
+	log              *logrus.Entry
+}
+
+func NewInventoryResolver(inventoryService inventory.Service) InventoryResolver {
+	return &inventoryResolver{
+		inventoryService: inventoryService,
+		log:              logrus.WithField("Resolver", "Inventory"),
+	}
+}
+
+func (r *inventoryResolver) IssuedCertificates(ctx context.Context, in *gqlschema.IssuedCertificateFilter) ([]*gqlschema.IssuedCertificate, error) {
+	err := requireInternalAPI(ctx, "Listing issued certificates")
+	if err != nil {
+		r.log.Error(err.Error())
+		return nil, errors.Wrap(err, "Failed to fetch issued certificates")
+	}
+
+	r.log.Info("Fetching issued certificates...")
+
+	filter, appErr := inventory.NewFilter(in, time.Now())
+	if appErr != nil {
+		r.log.Error(appErr.Error())
+		return nil, errors.Wrap(appErr, "Invalid issued certificates filter")
+	}
+
+	issuedCertificates, appErr := r.inventoryService.List(filter)
+	if appErr != nil {
+		r.log.Error(appErr.Error())
+		return nil, errors.Wrap(appErr, "Failed to fetch issued certificates")
+	}
+
+	result := make([]*gqlschema.IssuedCertificate, 0, len(issuedCertificates))
+	for _, issuedCertificate := range issuedCertificates {
+		result = append(result, inventory.ToIssuedCertificate(issuedCertificate))
+	}
+
+	return result, nil
+}
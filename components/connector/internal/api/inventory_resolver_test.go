@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	inventoryMocks "github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	"github.com/kyma-incubator/compass/components/connector/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryResolver_IssuedCertificates(t *testing.T) {
+
+	notAfter := time.Date(2019, 12, 30, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should return certificates expiring within given number of days", func(t *testing.T) {
+		// given
+		clientType := gqlschema.ClientTypeRuntime
+		days := 7
+
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("List", mock.MatchedBy(func(filter inventory.Filter) bool {
+			return filter.ClientType == "Runtime" && filter.ExpiresBefore.Sub(filter.ExpiresAfter) == 7*24*time.Hour
+		})).Return([]inventory.IssuedCertificate{{SerialNumber: "4d2", ClientID: "runtime-id", ClientType: "Runtime", NotAfter: notAfter}}, nil)
+
+		resolver := NewInventoryResolver(inventoryService)
+
+		// when
+		result, err := resolver.IssuedCertificates(internalAPIContext(context.Background()), &gqlschema.IssuedCertificateFilter{ClientType: &clientType, ExpiringWithinDays: &days})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "4d2", result[0].SerialNumber)
+		assert.Equal(t, "2019-12-30T12:00:00Z", result[0].NotAfter)
+		inventoryService.AssertExpectations(t)
+	})
+
+	t.Run("should return error when filter is invalid", func(t *testing.T) {
+		// given
+		days := -1
+
+		resolver := NewInventoryResolver(&inventoryMocks.Service{})
+
+		// when
+		_, err := resolver.IssuedCertificates(internalAPIContext(context.Background()), &gqlschema.IssuedCertificateFilter{ExpiringWithinDays: &days})
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should return error when failed to fetch certificates", func(t *testing.T) {
+		// given
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("List", inventory.Filter{}).Return(nil, apperrors.Internal("error"))
+
+		resolver := NewInventoryResolver(inventoryService)
+
+		// when
+		_, err := resolver.IssuedCertificates(internalAPIContext(context.Background()), nil)
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should not return certificates when requested through external API", func(t *testing.T) {
+		// given
+		inventoryService := &inventoryMocks.Service{}
+
+		resolver := NewInventoryResolver(inventoryService)
+
+		// when
+		_, err := resolver.IssuedCertificates(tenantContext(), nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "available only on the internal API")
+		inventoryService.AssertNotCalled(t, "List", mock.Anything)
+	})
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"github.com/kyma-incubator/compass/components/connector/internal/director"
+	"github.com/sirupsen/logrus"
+)
+
+// reportPairing notifies the Director about the change of the client certificate
+// failures are only logged as they must not affect the issued or revoked certificate
+func reportPairing(directorClient director.Client, log *logrus.Entry, tenant string, report director.PairingReport) {
+	if tenant == "" || report.ClientType == "" {
+		log.Warnf("Pairing event %s of %s client not reported to Director: tenant or client type unknown.", report.Event, report.ClientID)
+		return
+	}
+
+	err := directorClient.ReportPairing(tenant, report)
+	if err != nil {
+		log.Errorf("Failed to report pairing event %s of %s client to Director: %s", report.Event, report.ClientID, err.Error())
+		return
+	}
+
+	log.Infof("Pairing event %s of %s client reported to Director.", report.Event, report.ClientID)
+}
@@ -1,12 +1,18 @@
 package api
 
 import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/pkg/gqlschema"
 )
 
 type Resolver struct {
 	CertificateResolver
 	TokenResolver
+	RevocationResolver
+	InventoryResolver
 }
 
 type externalMutationResolver struct {
@@ -23,3 +29,12 @@ func (r *Resolver) Mutation() gqlschema.MutationResolver {
 func (r *Resolver) Query() gqlschema.QueryResolver {
 	return &externalQueryResolver{r}
 }
+
+// requireInternalAPI rejects the administrative operations requested through the external API, which is exposed by the Gateway
+func requireInternalAPI(ctx context.Context, operation string) error {
+	if !authentication.IsInternalAPI(ctx) {
+		return apperrors.Forbidden("%s is available only on the internal API", operation)
+	}
+
+	return nil
+}
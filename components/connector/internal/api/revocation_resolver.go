@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/director"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type RevocationResolver interface {
+	RevokeCertificateBySerialNumber(ctx context.Context, serialNumber string) (bool, error)
+	RevokeApplicationCertificates(ctx context.Context, appID string) (bool, error)
+	RevokeRuntimeCertificates(ctx context.Context, runtimeID string) (bool, error)
+}
+
+type revocationResolver struct {
+	revocationService revocation.Service
+	inventoryService  inventory.Service
+	directorClient    director.Client
+	log               *logrus.Entry
+}
+
+func NewRevocationResolver(revocationService revocation.Service, inventoryService inventory.Service, directorClient director.Client) RevocationResolver {
+	return &revocationResolver{
+		revocationService: revocationService,
+		inventoryService:  inventoryService,
+		directorClient:    directorClient,
+		log:               logrus.WithField("Resolver", "Revocation"),
+	}
+}
+
+func (r *revocationResolver) RevokeCertificateBySerialNumber(ctx context.Context, serialNumber string) (bool, error) {
+	err := requireInternalAPI(ctx, "Revoking certificate by serial number")
+	if err != nil {
+		r.log.Error(err.Error())
+		return false, errors.Wrap(err, "Failed to revoke certificate")
+	}
+
+	r.log.Infof("Revoking certificate with %s serial number...", serialNumber)
+
+	err = r.revocationService.RevokeSerialNumber(serialNumber)
+	if err != nil {
+		r.log.Error(err.Error())
+		return false, errors.Wrap(err, "Failed to revoke certificate")
+	}
+
+	r.log.Infof("Certificate with %s serial number revoked", serialNumber)
+
+	r.reportRevoked(inventory.Filter{SerialNumber: serialNumber}, serialNumber)
+	return true, nil
+}
+
+func (r *revocationResolver) RevokeApplicationCertificates(ctx context.Context, appID string) (bool, error) {
+	err := requireInternalAPI(ctx, "Revoking Application certificates")
+	if err != nil {
+		r.log.Error(err.Error())
+		return false, errors.Wrap(err, "Failed to revoke Application certificates")
+	}
+
+	r.log.Infof("Revoking certificates of %s Application...", appID)
+
+	err = r.revocationService.RevokeClient(appID)
+	if err != nil {
+		r.log.Error(err.Error())
+		return false, errors.Wrap(err, "Failed to revoke Application certificates")
+	}
+
+	r.log.Infof("Certificates of %s Application revoked", appID)
+
+	r.reportRevoked(inventory.Filter{ClientID: appID, ClientType: string(director.ApplicationClient)}, "")
+	return true, nil
+}
+
+func (r *revocationResolver) RevokeRuntimeCertificates(ctx context.Context, runtimeID string) (bool, error) {
+	err := requireInternalAPI(ctx, "Revoking Runtime certificates")
+	if err != nil {
+		r.log.Error(err.Error())
+		return false, errors.Wrap(err, "Failed to revoke Runtime certificates")
+	}
+
+	r.log.Infof("Revoking certificates of %s Runtime...", runtimeID)
+
+	err = r.revocationService.RevokeClient(runtimeID)
+	if err != nil {
+		r.log.Error(err.Error())
+		return false, errors.Wrap(err, "Failed to revoke Runtime certificates")
+	}
+
+	r.log.Infof("Certificates of %s Runtime revoked", runtimeID)
+
+	r.reportRevoked(inventory.Filter{ClientID: runtimeID, ClientType: string(director.RuntimeClient)}, "")
+	return true, nil
+}
+
+// reportRevoked reports the revocation with the tenant of the client taken from the inventory
+// the serial number limits the revocation to a single certificate, all certificates of the client are revoked without it
+func (r *revocationResolver) reportRevoked(filter inventory.Filter, serialNumber string) {
+	issuedCertificates, err := r.inventoryService.List(filter)
+	if err != nil {
+		r.log.Errorf("Revocation not reported to Director: failed to find revoked certificates in the inventory: %s", err.Error())
+		return
+	}
+
+	if len(issuedCertificates) == 0 {
+		r.log.Warnf("Revocation not reported to Director: revoked certificates not found in the inventory.")
+		return
+	}
+
+	certificate := issuedCertificates[len(issuedCertificates)-1]
+
+	reportPairing(r.directorClient, r.log, certificate.Tenant, director.PairingReport{
+		ClientType:   director.ClientType(certificate.ClientType),
+		ClientID:     certificate.ClientID,
+		Event:        director.PairingEventRevoked,
+		SerialNumber: serialNumber,
+	})
+}
@@ -0,0 +1,269 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/kyma-incubator/compass/components/connector/internal/director"
+	directorMocks "github.com/kyma-incubator/compass/components/connector/internal/director/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	inventoryMocks "github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	serialNumber = "4d2"
+)
+
+func TestRevocationResolver_RevokeCertificateBySerialNumber(t *testing.T) {
+	t.Run("should revoke certificate", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeSerialNumber", serialNumber).Return(nil)
+
+		revocationResolver := NewRevocationResolver(revocationSvc, emptyInventory(), nil)
+
+		// when
+		revoked, err := revocationResolver.RevokeCertificateBySerialNumber(internalAPIContext(context.Background()), serialNumber)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should report revoked certificate to Director", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeSerialNumber", serialNumber).Return(nil)
+		inventorySvc := &inventoryMocks.Service{}
+		inventorySvc.On("List", inventory.Filter{SerialNumber: serialNumber}).Return([]inventory.IssuedCertificate{
+			{SerialNumber: serialNumber, ClientID: runtimeId, ClientType: "Runtime", Tenant: tenant},
+		}, nil)
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ReportPairing", tenant, director.PairingReport{
+			ClientType:   director.RuntimeClient,
+			ClientID:     runtimeId,
+			Event:        director.PairingEventRevoked,
+			SerialNumber: serialNumber,
+		}).Return(nil)
+
+		revocationResolver := NewRevocationResolver(revocationSvc, inventorySvc, directorClient)
+
+		// when
+		revoked, err := revocationResolver.RevokeCertificateBySerialNumber(internalAPIContext(context.Background()), serialNumber)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		directorClient.AssertExpectations(t)
+	})
+
+	t.Run("should revoke certificate when failed to find it in the inventory", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeSerialNumber", serialNumber).Return(nil)
+		inventorySvc := &inventoryMocks.Service{}
+		inventorySvc.On("List", inventory.Filter{SerialNumber: serialNumber}).Return(nil, apperrors.Internal("error"))
+		directorClient := &directorMocks.Client{}
+
+		revocationResolver := NewRevocationResolver(revocationSvc, inventorySvc, directorClient)
+
+		// when
+		revoked, err := revocationResolver.RevokeCertificateBySerialNumber(internalAPIContext(context.Background()), serialNumber)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		directorClient.AssertNotCalled(t, "ReportPairing", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error when failed to revoke certificate", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeSerialNumber", serialNumber).Return(apperrors.WrongInput("error"))
+
+		revocationResolver := NewRevocationResolver(revocationSvc, nil, nil)
+
+		// when
+		revoked, err := revocationResolver.RevokeCertificateBySerialNumber(internalAPIContext(context.Background()), serialNumber)
+
+		// then
+		require.Error(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("should not revoke certificate when requested through external API", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+
+		revocationResolver := NewRevocationResolver(revocationSvc, nil, nil)
+
+		// when
+		revoked, err := revocationResolver.RevokeCertificateBySerialNumber(tenantContext(), serialNumber)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "available only on the internal API")
+		assert.False(t, revoked)
+		revocationSvc.AssertNotCalled(t, "RevokeSerialNumber", serialNumber)
+	})
+}
+
+func TestRevocationResolver_RevokeApplicationCertificates(t *testing.T) {
+	t.Run("should revoke Application certificates", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeClient", appId).Return(nil)
+
+		revocationResolver := NewRevocationResolver(revocationSvc, emptyInventory(), nil)
+
+		// when
+		revoked, err := revocationResolver.RevokeApplicationCertificates(internalAPIContext(context.Background()), appId)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should report revoked Application certificates to Director", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeClient", appId).Return(nil)
+		inventorySvc := &inventoryMocks.Service{}
+		inventorySvc.On("List", inventory.Filter{ClientID: appId, ClientType: "Application"}).Return([]inventory.IssuedCertificate{
+			{SerialNumber: "4d1", ClientID: appId, ClientType: "Application", Tenant: tenant},
+			{SerialNumber: serialNumber, ClientID: appId, ClientType: "Application", Tenant: tenant},
+		}, nil)
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ReportPairing", tenant, director.PairingReport{
+			ClientType: director.ApplicationClient,
+			ClientID:   appId,
+			Event:      director.PairingEventRevoked,
+		}).Return(nil)
+
+		revocationResolver := NewRevocationResolver(revocationSvc, inventorySvc, directorClient)
+
+		// when
+		revoked, err := revocationResolver.RevokeApplicationCertificates(internalAPIContext(context.Background()), appId)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		directorClient.AssertExpectations(t)
+	})
+
+	t.Run("should revoke Application certificates when failed to report to Director", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeClient", appId).Return(nil)
+		inventorySvc := &inventoryMocks.Service{}
+		inventorySvc.On("List", mock.Anything).Return([]inventory.IssuedCertificate{
+			{SerialNumber: serialNumber, ClientID: appId, ClientType: "Application", Tenant: tenant},
+		}, nil)
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ReportPairing", tenant, mock.Anything).Return(apperrors.UpstreamServerCallFailed("error"))
+
+		revocationResolver := NewRevocationResolver(revocationSvc, inventorySvc, directorClient)
+
+		// when
+		revoked, err := revocationResolver.RevokeApplicationCertificates(internalAPIContext(context.Background()), appId)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should return error when failed to revoke Application certificates", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeClient", appId).Return(apperrors.Internal("error"))
+
+		revocationResolver := NewRevocationResolver(revocationSvc, nil, nil)
+
+		// when
+		revoked, err := revocationResolver.RevokeApplicationCertificates(internalAPIContext(context.Background()), appId)
+
+		// then
+		require.Error(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("should not revoke Application certificates when requested through external API", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+
+		revocationResolver := NewRevocationResolver(revocationSvc, nil, nil)
+
+		// when
+		revoked, err := revocationResolver.RevokeApplicationCertificates(tenantContext(), appId)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "available only on the internal API")
+		assert.False(t, revoked)
+		revocationSvc.AssertNotCalled(t, "RevokeClient", appId)
+	})
+}
+
+func TestRevocationResolver_RevokeRuntimeCertificates(t *testing.T) {
+	t.Run("should revoke Runtime certificates", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeClient", runtimeId).Return(nil)
+
+		revocationResolver := NewRevocationResolver(revocationSvc, emptyInventory(), nil)
+
+		// when
+		revoked, err := revocationResolver.RevokeRuntimeCertificates(internalAPIContext(context.Background()), runtimeId)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should return error when failed to revoke Runtime certificates", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("RevokeClient", runtimeId).Return(apperrors.Internal("error"))
+
+		revocationResolver := NewRevocationResolver(revocationSvc, nil, nil)
+
+		// when
+		revoked, err := revocationResolver.RevokeRuntimeCertificates(internalAPIContext(context.Background()), runtimeId)
+
+		// then
+		require.Error(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("should not revoke Runtime certificates when requested through external API", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+
+		revocationResolver := NewRevocationResolver(revocationSvc, nil, nil)
+
+		// when
+		revoked, err := revocationResolver.RevokeRuntimeCertificates(tenantContext(), runtimeId)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "available only on the internal API")
+		assert.False(t, revoked)
+		revocationSvc.AssertNotCalled(t, "RevokeClient", runtimeId)
+	})
+}
+
+func emptyInventory() *inventoryMocks.Service {
+	inventorySvc := &inventoryMocks.Service{}
+	inventorySvc.On("List", mock.Anything).Return(nil, nil)
+	return inventorySvc
+}
+
+func internalAPIContext(ctx context.Context) context.Context {
+	return authentication.PutInternalAPIInContext(ctx)
+}
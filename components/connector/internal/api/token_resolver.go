@@ -3,6 +3,9 @@ package api
 import (
 	"context"
 
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/kyma-incubator/compass/components/connector/internal/director"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/gqlschema"
 	"github.com/pkg/errors"
@@ -16,21 +19,41 @@ type TokenResolver interface {
 }
 
 type tokenResolver struct {
-	tokenService tokens.Service
-	log          *logrus.Entry
+	tokenService   tokens.Service
+	directorClient director.Client
+	log            *logrus.Entry
 }
 
-func NewTokenResolver(tokenService tokens.Service) TokenResolver {
+func NewTokenResolver(tokenService tokens.Service, directorClient director.Client) TokenResolver {
 	return &tokenResolver{
-		tokenService: tokenService,
-		log:          logrus.WithField("Resolver", "Token"),
+		tokenService:   tokenService,
+		directorClient: directorClient,
+		log:            logrus.WithField("Resolver", "Token"),
 	}
 }
 
 func (r *tokenResolver) GenerateApplicationToken(ctx context.Context, appID string) (*gqlschema.Token, error) {
 	r.log.Infof("Generating token for %s Application...", appID)
 
-	token, err := r.tokenService.CreateToken(appID, tokens.ApplicationToken)
+	tenant, err := tenantFromContext(ctx)
+	if err != nil {
+		r.log.Error(err.Error())
+		return &gqlschema.Token{}, errors.Wrap(err, "Failed to create Application token")
+	}
+
+	exists, err := r.directorClient.ApplicationExists(tenant, appID)
+	if err != nil {
+		r.log.Error(err.Error())
+		return &gqlschema.Token{}, errors.Wrap(err, "Failed to verify Application in Director")
+	}
+
+	if !exists {
+		err := apperrors.NotFound("Application %s not found in %s tenant", appID, tenant)
+		r.log.Error(err.Error())
+		return &gqlschema.Token{}, errors.Wrap(err, "Failed to create Application token")
+	}
+
+	token, err := r.tokenService.CreateToken(tokens.TokenData{Type: tokens.ApplicationToken, ClientId: appID, Tenant: tenant, ClientType: tokens.ApplicationToken})
 	if err != nil {
 		r.log.Error(err.Error())
 		return &gqlschema.Token{}, errors.Wrap(err, "Failed to create Application token")
@@ -43,7 +66,25 @@ func (r *tokenResolver) GenerateApplicationToken(ctx context.Context, appID stri
 func (r *tokenResolver) GenerateRuntimeToken(ctx context.Context, runtimeID string) (*gqlschema.Token, error) {
 	r.log.Infof("Generating token for %s Runtime...", runtimeID)
 
-	token, err := r.tokenService.CreateToken(runtimeID, tokens.RuntimeToken)
+	tenant, err := tenantFromContext(ctx)
+	if err != nil {
+		r.log.Error(err.Error())
+		return &gqlschema.Token{}, errors.Wrap(err, "Failed to create Runtime token")
+	}
+
+	exists, err := r.directorClient.RuntimeExists(tenant, runtimeID)
+	if err != nil {
+		r.log.Error(err.Error())
+		return &gqlschema.Token{}, errors.Wrap(err, "Failed to verify Runtime in Director")
+	}
+
+	if !exists {
+		err := apperrors.NotFound("Runtime %s not found in %s tenant", runtimeID, tenant)
+		r.log.Error(err.Error())
+		return &gqlschema.Token{}, errors.Wrap(err, "Failed to create Runtime token")
+	}
+
+	token, err := r.tokenService.CreateToken(tokens.TokenData{Type: tokens.RuntimeToken, ClientId: runtimeID, Tenant: tenant, ClientType: tokens.RuntimeToken})
 	if err != nil {
 		r.log.Error(err.Error())
 		return &gqlschema.Token{}, errors.Wrap(err, "Failed to create Runtime token")
@@ -56,3 +97,12 @@ func (r *tokenResolver) GenerateRuntimeToken(ctx context.Context, runtimeID stri
 func (r *tokenResolver) IsHealthy(ctx context.Context) (bool, error) {
 	return true, nil
 }
+
+func tenantFromContext(ctx context.Context) (string, error) {
+	tenant, err := authentication.GetStringFromContext(ctx, authentication.TenantKey)
+	if err != nil || tenant == "" {
+		return "", apperrors.BadRequest("Tenant not provided")
+	}
+
+	return tenant, nil
+}
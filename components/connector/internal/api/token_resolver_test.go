@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	directorMocks "github.com/kyma-incubator/compass/components/connector/internal/director/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens/mocks"
 	"github.com/stretchr/testify/assert"
@@ -15,34 +17,88 @@ const (
 	appId     = "app-id"
 	runtimeId = "runtime-id"
 	token     = "abcd-efgh"
+	tenant    = "tenant"
 )
 
 func TestTokenResolver_GenerateApplicationToken(t *testing.T) {
 
+	tokenData := tokens.TokenData{Type: tokens.ApplicationToken, ClientId: appId, Tenant: tenant, ClientType: tokens.ApplicationToken}
+
 	t.Run("should generate Application token", func(t *testing.T) {
 		// given
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ApplicationExists", tenant, appId).Return(true, nil)
+
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("CreateToken", appId, tokens.ApplicationToken).Return(token, nil)
+		tokenSvc.On("CreateToken", tokenData).Return(token, nil)
 
-		tokenResolver := NewTokenResolver(tokenSvc)
+		tokenResolver := NewTokenResolver(tokenSvc, directorClient)
 
 		// when
-		generatedToken, err := tokenResolver.GenerateApplicationToken(context.Background(), appId)
+		generatedToken, err := tokenResolver.GenerateApplicationToken(tenantContext(), appId)
 
 		// then
 		require.NoError(t, err)
 		assert.Equal(t, token, generatedToken.Token)
 	})
 
+	t.Run("should return error when tenant not provided", func(t *testing.T) {
+		// given
+		tokenResolver := NewTokenResolver(&mocks.Service{}, &directorMocks.Client{})
+
+		// when
+		generatedToken, err := tokenResolver.GenerateApplicationToken(context.Background(), appId)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, generatedToken)
+	})
+
+	t.Run("should return error when Application does not exist", func(t *testing.T) {
+		// given
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ApplicationExists", tenant, appId).Return(false, nil)
+
+		tokenSvc := &mocks.Service{}
+
+		tokenResolver := NewTokenResolver(tokenSvc, directorClient)
+
+		// when
+		generatedToken, err := tokenResolver.GenerateApplicationToken(tenantContext(), appId)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, generatedToken)
+		tokenSvc.AssertNotCalled(t, "CreateToken", tokenData)
+	})
+
+	t.Run("should return error when failed to verify Application", func(t *testing.T) {
+		// given
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ApplicationExists", tenant, appId).Return(false, apperrors.UpstreamServerCallFailed("error"))
+
+		tokenResolver := NewTokenResolver(&mocks.Service{}, directorClient)
+
+		// when
+		generatedToken, err := tokenResolver.GenerateApplicationToken(tenantContext(), appId)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, generatedToken)
+	})
+
 	t.Run("should return error when failed generate Application token", func(t *testing.T) {
 		// given
+		directorClient := &directorMocks.Client{}
+		directorClient.On("ApplicationExists", tenant, appId).Return(true, nil)
+
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("CreateToken", appId, tokens.ApplicationToken).Return("", apperrors.Internal("error"))
+		tokenSvc.On("CreateToken", tokenData).Return("", apperrors.Internal("error"))
 
-		tokenResolver := NewTokenResolver(tokenSvc)
+		tokenResolver := NewTokenResolver(tokenSvc, directorClient)
 
 		// when
-		generatedToken, err := tokenResolver.GenerateApplicationToken(context.Background(), appId)
+		generatedToken, err := tokenResolver.GenerateApplicationToken(tenantContext(), appId)
 
 		// then
 		require.Error(t, err)
@@ -53,33 +109,63 @@ func TestTokenResolver_GenerateApplicationToken(t *testing.T) {
 
 func TestTokenResolver_GenerateRuntimeToken(t *testing.T) {
 
+	tokenData := tokens.TokenData{Type: tokens.RuntimeToken, ClientId: runtimeId, Tenant: tenant, ClientType: tokens.RuntimeToken}
+
 	t.Run("should generate Runtime token", func(t *testing.T) {
 		// given
+		directorClient := &directorMocks.Client{}
+		directorClient.On("RuntimeExists", tenant, runtimeId).Return(true, nil)
+
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("CreateToken", runtimeId, tokens.RuntimeToken).Return(token, nil)
+		tokenSvc.On("CreateToken", tokenData).Return(token, nil)
 
-		tokenResolver := NewTokenResolver(tokenSvc)
+		tokenResolver := NewTokenResolver(tokenSvc, directorClient)
 
 		// when
-		generatedToken, err := tokenResolver.GenerateRuntimeToken(context.Background(), runtimeId)
+		generatedToken, err := tokenResolver.GenerateRuntimeToken(tenantContext(), runtimeId)
 
 		// then
 		require.NoError(t, err)
 		assert.Equal(t, token, generatedToken.Token)
 	})
 
+	t.Run("should return error when Runtime does not exist", func(t *testing.T) {
+		// given
+		directorClient := &directorMocks.Client{}
+		directorClient.On("RuntimeExists", tenant, runtimeId).Return(false, nil)
+
+		tokenSvc := &mocks.Service{}
+
+		tokenResolver := NewTokenResolver(tokenSvc, directorClient)
+
+		// when
+		generatedToken, err := tokenResolver.GenerateRuntimeToken(tenantContext(), runtimeId)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, generatedToken)
+		tokenSvc.AssertNotCalled(t, "CreateToken", tokenData)
+	})
+
 	t.Run("should return error when failed generate Runtime token", func(t *testing.T) {
 		// given
+		directorClient := &directorMocks.Client{}
+		directorClient.On("RuntimeExists", tenant, runtimeId).Return(true, nil)
+
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("CreateToken", runtimeId, tokens.RuntimeToken).Return("", apperrors.Internal("error"))
+		tokenSvc.On("CreateToken", tokenData).Return("", apperrors.Internal("error"))
 
-		tokenResolver := NewTokenResolver(tokenSvc)
+		tokenResolver := NewTokenResolver(tokenSvc, directorClient)
 
 		// when
-		generatedToken, err := tokenResolver.GenerateRuntimeToken(context.Background(), runtimeId)
+		generatedToken, err := tokenResolver.GenerateRuntimeToken(tenantContext(), runtimeId)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, generatedToken)
 	})
 }
+
+func tenantContext() context.Context {
+	return authentication.PutInContext(context.Background(), authentication.TenantKey, tenant)
+}
@@ -2,7 +2,9 @@ package authentication
 
 import (
 	"context"
+	"crypto/x509"
 
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/pkg/errors"
 )
@@ -10,16 +12,19 @@ import (
 //go:generate mockery -name=Authenticator
 type Authenticator interface {
 	AuthenticateToken(context context.Context) (tokens.TokenData, error)
+	AuthenticateCertificate(context context.Context) (*x509.Certificate, error)
 }
 
-func NewAuthenticator(tokenService tokens.Service) Authenticator {
+func NewAuthenticator(tokenService tokens.Service, certificateService certificates.Service) Authenticator {
 	return &authenticator{
-		tokenService: tokenService,
+		tokenService:       tokenService,
+		certificateService: certificateService,
 	}
 }
 
 type authenticator struct {
-	tokenService tokens.Service
+	tokenService       tokens.Service
+	certificateService certificates.Service
 }
 
 func (a *authenticator) AuthenticateToken(context context.Context) (tokens.TokenData, error) {
@@ -28,12 +33,29 @@ func (a *authenticator) AuthenticateToken(context context.Context) (tokens.Token
 		return tokens.TokenData{}, errors.Wrap(err, "Failed to authenticate request, token not provided")
 	}
 
-	tokenData, err := a.tokenService.Resolve(token)
+	tokenData, err := a.tokenService.ResolveAndDelete(token)
 	if err != nil {
 		return tokens.TokenData{}, errors.Wrap(err, "Failed to authenticate request, token is invalid")
 	}
 
-	a.tokenService.Delete(token)
-
 	return tokenData, nil
 }
+
+func (a *authenticator) AuthenticateCertificate(context context.Context) (*x509.Certificate, error) {
+	clientCertificate, err := GetStringFromContext(context, ClientCertificateKey)
+	if err != nil || clientCertificate == "" {
+		return nil, errors.New("Failed to authenticate request, client certificate not provided")
+	}
+
+	certificate, err := ParseClientCertificate(clientCertificate)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to authenticate request, client certificate is invalid")
+	}
+
+	// the header is trusted only as far as the certificate is signed by the Connector CA
+	if appErr := a.certificateService.VerifyClientCertificate(certificate); appErr != nil {
+		return nil, errors.Wrap(appErr, "Failed to authenticate request, client certificate is not trusted")
+	}
+
+	return certificate, nil
+}
@@ -2,6 +2,7 @@ package authentication_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
@@ -14,6 +15,8 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens/mocks"
+
+	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
 )
 
 const (
@@ -31,10 +34,9 @@ func TestAuthenticator_AuthenticateToken(t *testing.T) {
 		ctx := authentication.PutInContext(context.Background(), authentication.ConnectorTokenKey, token)
 
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("Resolve", token).Return(tokenData, nil)
-		tokenSvc.On("Delete", token).Return()
+		tokenSvc.On("ResolveAndDelete", token).Return(tokenData, nil)
 
-		authenticator := authentication.NewAuthenticator(tokenSvc)
+		authenticator := authentication.NewAuthenticator(tokenSvc, nil)
 
 		// when
 		data, err := authenticator.AuthenticateToken(ctx)
@@ -49,10 +51,9 @@ func TestAuthenticator_AuthenticateToken(t *testing.T) {
 		ctx := authentication.PutInContext(context.Background(), authentication.ConnectorTokenKey, token)
 
 		tokenSvc := &mocks.Service{}
-		tokenSvc.On("Resolve", token).Return(tokens.TokenData{}, apperrors.NotFound("error"))
-		tokenSvc.On("Delete", token).Return()
+		tokenSvc.On("ResolveAndDelete", token).Return(tokens.TokenData{}, apperrors.NotFound("error"))
 
-		authenticator := authentication.NewAuthenticator(tokenSvc)
+		authenticator := authentication.NewAuthenticator(tokenSvc, nil)
 
 		// when
 		data, err := authenticator.AuthenticateToken(ctx)
@@ -64,7 +65,7 @@ func TestAuthenticator_AuthenticateToken(t *testing.T) {
 
 	t.Run("should return error if token not found in context", func(t *testing.T) {
 		// given
-		authenticator := authentication.NewAuthenticator(nil)
+		authenticator := authentication.NewAuthenticator(nil, nil)
 
 		// when
 		data, err := authenticator.AuthenticateToken(context.Background())
@@ -75,3 +76,73 @@ func TestAuthenticator_AuthenticateToken(t *testing.T) {
 	})
 
 }
+
+func TestAuthenticator_AuthenticateCertificate(t *testing.T) {
+
+	certificate, encodedCertificate := generateCertificate(t, clientId)
+
+	t.Run("should authenticate with client certificate", func(t *testing.T) {
+		// given
+		header := fmt.Sprintf(`Hash=%s;Cert="%s";Subject="CN=%s"`, certHash, encodedCertificate, clientId)
+		ctx := authentication.PutInContext(context.Background(), authentication.ClientCertificateKey, header)
+
+		certificateService := &certificatesMocks.Service{}
+		certificateService.On("VerifyClientCertificate", certificate).Return(nil)
+
+		authenticator := authentication.NewAuthenticator(nil, certificateService)
+
+		// when
+		authenticated, err := authenticator.AuthenticateCertificate(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, certificate.Raw, authenticated.Raw)
+		certificateService.AssertExpectations(t)
+	})
+
+	t.Run("should return error if client certificate is not trusted", func(t *testing.T) {
+		// given
+		header := fmt.Sprintf(`Hash=%s;Cert="%s";Subject="CN=%s"`, certHash, encodedCertificate, clientId)
+		ctx := authentication.PutInContext(context.Background(), authentication.ClientCertificateKey, header)
+
+		certificateService := &certificatesMocks.Service{}
+		certificateService.On("VerifyClientCertificate", certificate).Return(apperrors.Forbidden("error"))
+
+		authenticator := authentication.NewAuthenticator(nil, certificateService)
+
+		// when
+		authenticated, err := authenticator.AuthenticateCertificate(ctx)
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, authenticated)
+	})
+
+	t.Run("should return error if client certificate not provided", func(t *testing.T) {
+		// given
+		ctx := authentication.PutInContext(context.Background(), authentication.ClientCertificateKey, "")
+
+		authenticator := authentication.NewAuthenticator(nil, nil)
+
+		// when
+		authenticated, err := authenticator.AuthenticateCertificate(ctx)
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, authenticated)
+	})
+
+	t.Run("should return error if client certificate is invalid", func(t *testing.T) {
+		// given
+		ctx := authentication.PutInContext(context.Background(), authentication.ClientCertificateKey, `Cert="invalid"`)
+
+		authenticator := authentication.NewAuthenticator(nil, nil)
+
+		// when
+		authenticated, err := authenticator.AuthenticateCertificate(ctx)
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, authenticated)
+	})
+}
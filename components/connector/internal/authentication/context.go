@@ -9,7 +9,12 @@ import (
 type ContextKey string
 
 const (
-	ConnectorTokenKey ContextKey = "ConnectorToken"
+	ConnectorTokenKey    ContextKey = "ConnectorToken"
+	ClientCertificateKey ContextKey = "ClientCertificate"
+	TenantKey            ContextKey = "Tenant"
+	InternalAPIKey       ContextKey = "InternalAPI"
+
+	internalAPIValue = "true"
 )
 
 func GetStringFromContext(ctx context.Context, key ContextKey) (string, error) {
@@ -26,3 +31,14 @@ func GetStringFromContext(ctx context.Context, key ContextKey) (string, error) {
 func PutInContext(ctx context.Context, key ContextKey, value string) context.Context {
 	return context.WithValue(ctx, key, value)
 }
+
+// PutInternalAPIInContext marks the context of the request received by the internal API
+func PutInternalAPIInContext(ctx context.Context) context.Context {
+	return PutInContext(ctx, InternalAPIKey, internalAPIValue)
+}
+
+// IsInternalAPI reports whether the request was received by the internal API, which is not exposed outside the cluster
+func IsInternalAPI(ctx context.Context) bool {
+	value, err := GetStringFromContext(ctx, InternalAPIKey)
+	return err == nil && value == internalAPIValue
+}
@@ -2,10 +2,14 @@ package authentication
 
 import (
 	"net/http"
+
+	"github.com/kyma-incubator/compass/components/connector/pkg/xfcc"
 )
 
 const (
-	ConnectorTokenHeader string = "Connector-Token"
+	ConnectorTokenHeader    string = "Connector-Token"
+	ClientCertificateHeader string = xfcc.Header
+	TenantHeader            string = "Tenant"
 )
 
 type authContextMiddleware struct {
@@ -18,7 +22,22 @@ func NewAuthenticationContextMiddleware() *authContextMiddleware {
 func (acm *authContextMiddleware) PropagateAuthentication(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		token := r.Header.Get(ConnectorTokenHeader)
-		r = r.WithContext(PutInContext(r.Context(), ConnectorTokenKey, token))
+		clientCertificate := r.Header.Get(ClientCertificateHeader)
+
+		ctx := PutInContext(r.Context(), ConnectorTokenKey, token)
+		ctx = PutInContext(ctx, ClientCertificateKey, clientCertificate)
+		ctx = PutInContext(ctx, TenantKey, r.Header.Get(TenantHeader))
+
+		r = r.WithContext(ctx)
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// MarkInternalAPI marks requests received by the internal API
+func (acm *authContextMiddleware) MarkInternalAPI(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(PutInternalAPIInContext(r.Context()))
 
 		handler.ServeHTTP(w, r)
 	})
@@ -15,6 +15,8 @@ import (
 func TestAuthContextMiddleware_PropagateAuthentication(t *testing.T) {
 
 	connectorToken := "connector-token"
+	clientCertificate := "Hash=qwertyuiop;Subject=\"CN=client\""
+	tenant := "tenant"
 
 	t.Run("should put authentication to context", func(t *testing.T) {
 		// given
@@ -23,6 +25,14 @@ func TestAuthContextMiddleware_PropagateAuthentication(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, connectorToken, token)
 
+			certificate, err := authentication.GetStringFromContext(r.Context(), authentication.ClientCertificateKey)
+			require.NoError(t, err)
+			assert.Equal(t, clientCertificate, certificate)
+
+			tenantValue, err := authentication.GetStringFromContext(r.Context(), authentication.TenantKey)
+			require.NoError(t, err)
+			assert.Equal(t, tenant, tenantValue)
+
 			w.WriteHeader(http.StatusOK)
 		})
 
@@ -30,6 +40,8 @@ func TestAuthContextMiddleware_PropagateAuthentication(t *testing.T) {
 		require.NoError(t, err)
 
 		request.Header.Add(authentication.ConnectorTokenHeader, connectorToken)
+		request.Header.Add(authentication.ClientCertificateHeader, clientCertificate)
+		request.Header.Add(authentication.TenantHeader, tenant)
 		rr := httptest.NewRecorder()
 
 		authContextMiddleware := authentication.NewAuthenticationContextMiddleware()
@@ -39,3 +51,47 @@ func TestAuthContextMiddleware_PropagateAuthentication(t *testing.T) {
 		handlerWithMiddleware.ServeHTTP(rr, request)
 	})
 }
+
+func TestAuthContextMiddleware_MarkInternalAPI(t *testing.T) {
+	t.Run("should mark request as received by internal API", func(t *testing.T) {
+		// given
+		called := false
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			assert.True(t, authentication.IsInternalAPI(r.Context()))
+		})
+
+		request, err := http.NewRequest(http.MethodPost, "", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		authContextMiddleware := authentication.NewAuthenticationContextMiddleware()
+
+		// when
+		authContextMiddleware.MarkInternalAPI(handler).ServeHTTP(rr, request)
+
+		// then
+		assert.True(t, called)
+	})
+
+	t.Run("should not mark request received by external API", func(t *testing.T) {
+		// given
+		called := false
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			assert.False(t, authentication.IsInternalAPI(r.Context()))
+		})
+
+		request, err := http.NewRequest(http.MethodPost, "", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		authContextMiddleware := authentication.NewAuthenticationContextMiddleware()
+
+		// when
+		authContextMiddleware.PropagateAuthentication(handler).ServeHTTP(rr, request)
+
+		// then
+		assert.True(t, called)
+	})
+}
@@ -5,12 +5,36 @@ package mocks
 import context "context"
 import mock "github.com/stretchr/testify/mock"
 import tokens "github.com/kyma-incubator/compass/components/connector/internal/tokens"
+import x509 "crypto/x509"
 
 // Authenticator is an autogenerated mock type for the Authenticator type
 type Authenticator struct {
 	mock.Mock
 }
 
+// AuthenticateCertificate provides a mock function with given fields: _a0
+func (_m *Authenticator) AuthenticateCertificate(_a0 context.Context) (*x509.Certificate, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *x509.Certificate
+	if rf, ok := ret.Get(0).(func(context.Context) *x509.Certificate); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*x509.Certificate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AuthenticateToken provides a mock function with given fields: _a0
 func (_m *Authenticator) AuthenticateToken(_a0 context.Context) (tokens.TokenData, error) {
 	ret := _m.Called(_a0)
@@ -0,0 +1,33 @@
+package authentication
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/kyma-incubator/compass/components/connector/pkg/xfcc"
+	"github.com/pkg/errors"
+)
+
+// ParseClientCertificate extracts the client certificate from the value of X-Forwarded-Client-Cert header.
+func ParseClientCertificate(header string) (*x509.Certificate, error) {
+	pemCertificate, err := xfcc.CertificateFromHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if pemCertificate == "" {
+		return nil, errors.New("client certificate not found in header")
+	}
+
+	pemBlock, _ := pem.Decode([]byte(pemCertificate))
+	if pemBlock == nil {
+		return nil, errors.New("error while decoding client certificate pem block")
+	}
+
+	certificate, err := x509.ParseCertificate(pemBlock.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing client certificate")
+	}
+
+	return certificate, nil
+}
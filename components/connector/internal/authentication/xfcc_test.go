@@ -0,0 +1,95 @@
+package authentication_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClientCertificate(t *testing.T) {
+
+	certificate, encodedCertificate := generateCertificate(t, clientId)
+
+	t.Run("should parse certificate from header", func(t *testing.T) {
+		// given
+		header := fmt.Sprintf(`Hash=%s;Cert="%s";Subject="CN=%s,O=Org";URI=`, certHash, encodedCertificate, clientId)
+
+		// when
+		parsed, err := authentication.ParseClientCertificate(header)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, certificate.SerialNumber, parsed.SerialNumber)
+		assert.Equal(t, clientId, parsed.Subject.CommonName)
+	})
+
+	t.Run("should parse certificate of the original client when header contains multiple elements", func(t *testing.T) {
+		// given
+		header := fmt.Sprintf(`By=spiffe://cluster.local/ns/compass-system/sa/gateway;Hash=%s;Cert="%s";Subject="CN=%s,O=Org",By=spiffe://cluster.local/ns/compass-system/sa/connector;Hash=%s;Subject=""`,
+			certHash, encodedCertificate, clientId, certHash)
+
+		// when
+		parsed, err := authentication.ParseClientCertificate(header)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, certificate.SerialNumber, parsed.SerialNumber)
+	})
+
+	t.Run("should return error when header does not contain certificate", func(t *testing.T) {
+		// given
+		header := fmt.Sprintf(`Hash=%s;Subject="CN=%s,O=Org";URI=`, certHash, clientId)
+
+		// when
+		parsed, err := authentication.ParseClientCertificate(header)
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, parsed)
+	})
+
+	t.Run("should return error when certificate is invalid", func(t *testing.T) {
+		// given
+		header := fmt.Sprintf(`Hash=%s;Cert="not-a-certificate"`, certHash)
+
+		// when
+		parsed, err := authentication.ParseClientCertificate(header)
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, parsed)
+	})
+}
+
+func generateCertificate(t *testing.T, commonName string) (*x509.Certificate, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"Org"}},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	rawCertificate, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(rawCertificate)
+	require.NoError(t, err)
+
+	pemCertificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rawCertificate})
+
+	return certificate, url.PathEscape(string(pemCertificate))
+}
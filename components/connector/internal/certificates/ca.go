@@ -0,0 +1,58 @@
+package certificates
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+const (
+	ephemeralCACommonName = "Compass Connector Ephemeral CA"
+	ephemeralCAKeyBits    = 2048
+)
+
+// GenerateCA creates a self-signed CA certificate and its private key, both PEM encoded
+// it is meant for local development and tests, where no CA is provisioned for the Connector
+func GenerateCA(subjectConsts CSRSubjectConsts, validityTime time.Duration) (certificate []byte, key []byte, appErr apperrors.AppError) {
+	caKey, err := rsa.GenerateKey(rand.Reader, ephemeralCAKeyBits)
+	if err != nil {
+		return nil, nil, apperrors.Internal("Error while generating CA key: %s", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, nil, apperrors.Internal("Error while generating serial number: %s", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName:         ephemeralCACommonName,
+			Country:            []string{subjectConsts.Country},
+			Organization:       []string{subjectConsts.Organization},
+			OrganizationalUnit: []string{subjectConsts.OrganizationalUnit},
+			Locality:           []string{subjectConsts.Locality},
+			Province:           []string{subjectConsts.Province},
+		},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(validityTime),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	caCrtRaw, err := x509.CreateCertificate(rand.Reader, &template, &template, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, apperrors.Internal("Error while creating CA certificate: %s", err)
+	}
+
+	certificate = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCrtRaw})
+	key = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(caKey)})
+
+	return certificate, key, nil
+}
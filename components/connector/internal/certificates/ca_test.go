@@ -0,0 +1,40 @@
+package certificates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCA(t *testing.T) {
+
+	t.Run("should generate CA certificate and key loadable by certificate utility", func(t *testing.T) {
+		// given
+		subjectConsts := CSRSubjectConsts{
+			Country:            "PL",
+			Organization:       "Org",
+			OrganizationalUnit: "OrgUnit",
+			Locality:           "Locality",
+			Province:           "State",
+		}
+		certUtil := NewCertificateUtility(time.Hour, keyPolicy)
+
+		// when
+		encodedCertificate, encodedKey, err := GenerateCA(subjectConsts, 24*time.Hour)
+
+		// then
+		require.NoError(t, err)
+
+		caCrt, err := certUtil.LoadCert(encodedCertificate)
+		require.NoError(t, err)
+		caKey, err := certUtil.LoadKey(encodedKey)
+		require.NoError(t, err)
+
+		assert.True(t, caCrt.IsCA)
+		assert.Equal(t, subjectConsts.Organization, caCrt.Subject.Organization[0])
+		assert.Equal(t, &caKey.PublicKey, caCrt.PublicKey)
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), caCrt.NotAfter, time.Minute)
+	})
+}
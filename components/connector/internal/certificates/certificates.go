@@ -4,8 +4,10 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	"math/big"
+	"net/url"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
@@ -17,17 +19,25 @@ type CertificateUtility interface {
 	LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError)
 	LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError)
 	CheckCSRValues(csr *x509.CertificateRequest, subject CSRSubject) apperrors.AppError
-	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError)
+	CheckCSRKey(csr *x509.CertificateRequest) apperrors.AppError
+	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey, uris []*url.URL) ([]byte, apperrors.AppError)
 	AddCertificateHeaderAndFooter(crtRaw []byte) []byte
+	CreateCRL(caCrt *x509.Certificate, caKey *rsa.PrivateKey, revokedCertificates []pkix.RevokedCertificate) ([]byte, apperrors.AppError)
 }
 
+const crlValidityTime = 24 * time.Hour
+
+var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+
 type certificateUtility struct {
 	certificateValidityTime time.Duration
+	keyPolicy               KeyPolicy
 }
 
-func NewCertificateUtility(certificateValidityTime time.Duration) CertificateUtility {
+func NewCertificateUtility(certificateValidityTime time.Duration, keyPolicy KeyPolicy) CertificateUtility {
 	return &certificateUtility{
 		certificateValidityTime: certificateValidityTime,
+		keyPolicy:               keyPolicy,
 	}
 }
 
@@ -60,7 +70,12 @@ func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 		return nil, apperrors.Internal("Error while parsing private key: %s", err)
 	}
 
-	return caPrivateKey.(*rsa.PrivateKey), nil
+	rsaPrivateKey, ok := caPrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, apperrors.Internal("Error while parsing private key: unsupported key type %T", caPrivateKey)
+	}
+
+	return rsaPrivateKey, nil
 }
 
 func (cu *certificateUtility) LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError) {
@@ -119,8 +134,15 @@ func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subje
 	return nil
 }
 
-func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	clientCRTTemplate := cu.prepareCRTTemplate(csr)
+func (cu *certificateUtility) CheckCSRKey(csr *x509.CertificateRequest) apperrors.AppError {
+	return cu.keyPolicy.CheckKey(csr.PublicKey)
+}
+
+func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey, uris []*url.URL) ([]byte, apperrors.AppError) {
+	clientCRTTemplate, appErr := cu.prepareCRTTemplate(csr, uris)
+	if appErr != nil {
+		return nil, appErr
+	}
 
 	clientCrtRaw, err := x509.CreateCertificate(rand.Reader, &clientCRTTemplate, caCrt, csr.PublicKey, caKey)
 	if err != nil {
@@ -130,17 +152,33 @@ func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	return clientCrtRaw, nil
 }
 
-func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest) x509.Certificate {
-	return x509.Certificate{
-		SignatureAlgorithm: csr.SignatureAlgorithm,
+func (cu *certificateUtility) CreateCRL(caCrt *x509.Certificate, caKey *rsa.PrivateKey, revokedCertificates []pkix.RevokedCertificate) ([]byte, apperrors.AppError) {
+	now := time.Now()
+
+	crlRaw, err := caCrt.CreateCRL(rand.Reader, caKey, revokedCertificates, now, now.Add(crlValidityTime))
+	if err != nil {
+		return nil, apperrors.Internal("Error while creating CRL: %s", err)
+	}
 
-		SerialNumber: big.NewInt(2),
+	return crlRaw, nil
+}
+
+func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest, uris []*url.URL) (x509.Certificate, apperrors.AppError) {
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return x509.Certificate{}, apperrors.Internal("Error while generating serial number: %s", err)
+	}
+
+	// signature algorithm is left to be chosen based on the CA key, as the CSR may use a different key type
+	return x509.Certificate{
+		SerialNumber: serialNumber,
 		Subject:      csr.Subject,
 		NotBefore:    time.Now(),
 		NotAfter:     time.Now().Add(cu.certificateValidityTime),
 		KeyUsage:     x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
-	}
+		URIs:         uris,
+	}, nil
 }
 
 func (cu *certificateUtility) AddCertificateHeaderAndFooter(crtRaw []byte) []byte {
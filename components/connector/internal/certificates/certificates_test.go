@@ -1,10 +1,15 @@
 package certificates
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/pem"
+	"math/big"
 	"testing"
 	"time"
 
@@ -223,13 +228,15 @@ var (
 	encodedCert        = []byte(cert)
 	encodedInvalidCert = []byte(invalidCert)
 	encodedInvalidKey  = []byte(invalidKey)
+
+	keyPolicy = KeyPolicy{AllowRSA: true, MinRSAKeySize: 2048, AllowECDSAP256: true}
 )
 
 func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should load cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedCert)
@@ -248,7 +255,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail decoding cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		crt, err := certificateUtility.LoadCert([]byte("invalid data"))
@@ -261,7 +268,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail parsing cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedInvalidCert)
@@ -277,7 +284,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load RSA key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedRSAKey)
@@ -289,7 +296,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedKey)
@@ -301,7 +308,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should fail decoding key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		crt, err := certificateUtility.LoadKey([]byte("invalid data"))
@@ -314,7 +321,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should fail parsing key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		crt, err := certificateUtility.LoadKey(encodedInvalidKey)
@@ -330,7 +337,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should load CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		key, err := certificateUtility.LoadCSR([]byte(CSR))
@@ -342,7 +349,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail decoding CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte("aW52YWxpZCBkYXRh"))
@@ -355,7 +362,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail parsing CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte(invalidCSR))
@@ -393,7 +400,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -414,7 +421,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -438,7 +445,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -462,7 +469,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -487,7 +494,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -511,7 +518,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -535,7 +542,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -559,7 +566,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -575,11 +582,11 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 
 	t.Run("should sign client certificate", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
 
 		// when
-		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, nil)
 
 		//then
 		require.NoError(t, apperr)
@@ -592,16 +599,83 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 		assert.Equal(t, validityTime, certificateValidityTime)
 	})
 
+	t.Run("should issue certificates with unique serial numbers", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
+		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
+
+		// when
+		firstRawCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, nil)
+		require.NoError(t, apperr)
+		secondRawCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, nil)
+		require.NoError(t, apperr)
+
+		//then
+		firstCrt, err := x509.ParseCertificate(firstRawCRT)
+		require.NoError(t, err)
+		secondCrt, err := x509.ParseCertificate(secondRawCRT)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, firstCrt.SerialNumber, secondCrt.SerialNumber)
+	})
+
+	t.Run("should include tenant and client type in client certificate", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
+		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
+		subject := CSRSubject{Tenant: "tenant", ClientType: "Runtime"}
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, subject.URIs())
+
+		//then
+		require.NoError(t, apperr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+
+		require.Len(t, decodedCrt.URIs, 2)
+		assert.Equal(t, "urn:compass:tenant:tenant", decodedCrt.URIs[0].String())
+		assert.Equal(t, "urn:compass:client-type:Runtime", decodedCrt.URIs[1].String())
+		assert.Equal(t, "tenant", TenantFromCertificate(decodedCrt))
+		assert.Equal(t, "Runtime", ClientTypeFromCertificate(decodedCrt))
+	})
+
+	t.Run("should sign ECDSA CSR with RSA CA", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
+		caCrt, _, key := prepareCrtAndKey(certificateUtility)
+
+		clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		rawCSR, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: commonName}}, clientKey)
+		require.NoError(t, err)
+		csr, appErr := certificateUtility.LoadCSR(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: rawCSR}))
+		require.NoError(t, appErr)
+		require.NoError(t, certificateUtility.CheckCSRKey(csr))
+
+		// when
+		rawClientCRT, appErr := certificateUtility.SignCSR(caCrt, csr, key, nil)
+
+		//then
+		require.NoError(t, appErr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		assert.Equal(t, &clientKey.PublicKey, decodedCrt.PublicKey)
+		require.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+	})
+
 	t.Run("should return when failed to create certificate", func(t *testing.T) {
 		// given
 		caCrt := &x509.Certificate{}
 		csr := &x509.CertificateRequest{}
 		key := &rsa.PrivateKey{}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 
 		// when
-		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key, nil)
 
 		// then
 		require.Error(t, err)
@@ -611,11 +685,49 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 
 }
 
+func TestCertificateUtility_CreateCRL(t *testing.T) {
+
+	t.Run("should create CRL signed by CA", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
+		caCrt, _, key := prepareCrtAndKey(certificateUtility)
+
+		revoked := []pkix.RevokedCertificate{
+			{SerialNumber: big.NewInt(1234), RevocationTime: time.Now()},
+		}
+
+		// when
+		rawCRL, apperr := certificateUtility.CreateCRL(caCrt, key, revoked)
+
+		// then
+		require.NoError(t, apperr)
+
+		crl, err := x509.ParseCRL(rawCRL)
+		require.NoError(t, err)
+		require.NoError(t, caCrt.CheckCRLSignature(crl))
+		require.Len(t, crl.TBSCertList.RevokedCertificates, 1)
+		assert.Equal(t, big.NewInt(1234), crl.TBSCertList.RevokedCertificates[0].SerialNumber)
+	})
+
+	t.Run("should return error when failed to create CRL", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
+
+		// when
+		rawCRL, err := certificateUtility.CreateCRL(&x509.Certificate{}, &rsa.PrivateKey{}, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		assert.Nil(t, rawCRL)
+	})
+}
+
 func TestCertificateUtility_AddCertificateHeaderAndFooter(t *testing.T) {
 
 	t.Run("should add certificate header and footer", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
 		certificate, apperr := certificateUtility.LoadCert([]byte(cert))
 		require.NoError(t, apperr)
 
@@ -657,3 +769,55 @@ func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate,
 	}
 	return caCrt, csr, key
 }
+
+func TestParseClientCertificate(t *testing.T) {
+
+	t.Run("should parse client certificate from encoded chain", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime, keyPolicy)
+		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
+
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, nil)
+		require.NoError(t, apperr)
+
+		encodedChain := EncodedCertificateChain{
+			ClientCertificate: base64.StdEncoding.EncodeToString(certificateUtility.AddCertificateHeaderAndFooter(rawClientCRT)),
+		}
+
+		// when
+		certificate, apperr := ParseClientCertificate(encodedChain)
+
+		// then
+		require.NoError(t, apperr)
+		assert.Equal(t, rawClientCRT, certificate.Raw)
+	})
+
+	t.Run("should return error when client certificate is not PEM encoded", func(t *testing.T) {
+		// given
+		encodedChain := EncodedCertificateChain{
+			ClientCertificate: base64.StdEncoding.EncodeToString([]byte("invalid")),
+		}
+
+		// when
+		certificate, apperr := ParseClientCertificate(encodedChain)
+
+		// then
+		require.Error(t, apperr)
+		assert.Equal(t, apperrors.CodeInternal, apperr.Code())
+		assert.Nil(t, certificate)
+	})
+}
+
+func TestFingerprint(t *testing.T) {
+
+	t.Run("should return colon separated SHA-256 fingerprint", func(t *testing.T) {
+		// given
+		certificate := &x509.Certificate{Raw: []byte("abc")}
+
+		// when
+		fingerprint := Fingerprint(certificate)
+
+		// then
+		assert.Equal(t, "BA:78:16:BF:8F:01:CF:EA:41:41:40:DE:5D:AE:22:23:B0:03:61:A3:96:17:7A:9C:B4:10:FF:61:F2:00:15:AD", fingerprint)
+	})
+}
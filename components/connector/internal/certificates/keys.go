@@ -0,0 +1,82 @@
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MinimalRSAKeySize is the smallest RSA key size that can be configured as allowed
+	MinimalRSAKeySize = 2048
+
+	rsaKeyAlgorithmFormat = "rsa%d"
+	ecdsaP256KeyAlgorithm = "ecdsa-p256"
+)
+
+// KeyPolicy defines the public keys accepted in the Certificate Signing Requests
+type KeyPolicy struct {
+	AllowRSA       bool
+	MinRSAKeySize  int
+	AllowECDSAP256 bool
+}
+
+// Validate checks that the policy allows at least one key algorithm and does not allow weak RSA keys
+func (p KeyPolicy) Validate() error {
+	if !p.AllowRSA && !p.AllowECDSAP256 {
+		return errors.New("at least one key algorithm has to be allowed")
+	}
+
+	if p.AllowRSA && p.MinRSAKeySize < MinimalRSAKeySize {
+		return errors.Errorf("minimal RSA key size %d is smaller than %d bits", p.MinRSAKeySize, MinimalRSAKeySize)
+	}
+
+	return nil
+}
+
+// KeyAlgorithms returns the names of the allowed key algorithms in the order of preference, eg.: rsa2048, ecdsa-p256
+func (p KeyPolicy) KeyAlgorithms() []string {
+	var algorithms []string
+	if p.AllowRSA {
+		algorithms = append(algorithms, fmt.Sprintf(rsaKeyAlgorithmFormat, p.MinRSAKeySize))
+	}
+	if p.AllowECDSAP256 {
+		algorithms = append(algorithms, ecdsaP256KeyAlgorithm)
+	}
+
+	return algorithms
+}
+
+// CheckKey returns Wrong Input error when the public key of the CSR is not allowed by the policy
+func (p KeyPolicy) CheckKey(publicKey interface{}) apperrors.AppError {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if !p.AllowRSA {
+			return apperrors.WrongInput("CSR: RSA keys are not allowed, allowed key algorithms: %v.", p.KeyAlgorithms())
+		}
+
+		if size := key.N.BitLen(); size < p.MinRSAKeySize {
+			return apperrors.WrongInput("CSR: RSA key size %d is smaller than required %d bits.", size, p.MinRSAKeySize)
+		}
+
+		if key.E < 3 || key.E%2 == 0 {
+			return apperrors.WrongInput("CSR: RSA public exponent %d is insecure.", key.E)
+		}
+	case *ecdsa.PublicKey:
+		if !p.AllowECDSAP256 {
+			return apperrors.WrongInput("CSR: ECDSA keys are not allowed, allowed key algorithms: %v.", p.KeyAlgorithms())
+		}
+
+		if key.Curve != elliptic.P256() {
+			return apperrors.WrongInput("CSR: ECDSA curve %s is not allowed, only P-256 is supported.", key.Curve.Params().Name)
+		}
+	default:
+		return apperrors.WrongInput("CSR: Unsupported public key type %T, allowed key algorithms: %v.", publicKey, p.KeyAlgorithms())
+	}
+
+	return nil
+}
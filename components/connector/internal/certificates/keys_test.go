@@ -0,0 +1,159 @@
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyPolicy_CheckKey(t *testing.T) {
+
+	policy := KeyPolicy{AllowRSA: true, MinRSAKeySize: 2048, AllowECDSAP256: true}
+
+	t.Run("should accept RSA key of required size", func(t *testing.T) {
+		// given
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		// when
+		appErr := policy.CheckKey(&key.PublicKey)
+
+		// then
+		require.NoError(t, appErr)
+	})
+
+	t.Run("should accept ECDSA P-256 key", func(t *testing.T) {
+		// given
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		// when
+		appErr := policy.CheckKey(&key.PublicKey)
+
+		// then
+		require.NoError(t, appErr)
+	})
+
+	t.Run("should reject RSA key smaller than required", func(t *testing.T) {
+		// given
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+
+		// when
+		appErr := policy.CheckKey(&key.PublicKey)
+
+		// then
+		require.Error(t, appErr)
+		assert.Equal(t, apperrors.CodeWrongInput, appErr.Code())
+		assert.Contains(t, appErr.Error(), "RSA key size 1024 is smaller than required 2048 bits")
+	})
+
+	t.Run("should reject RSA key with insecure exponent", func(t *testing.T) {
+		// given
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		publicKey := key.PublicKey
+		publicKey.E = 1
+
+		// when
+		appErr := policy.CheckKey(&publicKey)
+
+		// then
+		require.Error(t, appErr)
+		assert.Equal(t, apperrors.CodeWrongInput, appErr.Code())
+	})
+
+	t.Run("should reject ECDSA key on other curve", func(t *testing.T) {
+		// given
+		key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+
+		// when
+		appErr := policy.CheckKey(&key.PublicKey)
+
+		// then
+		require.Error(t, appErr)
+		assert.Equal(t, apperrors.CodeWrongInput, appErr.Code())
+		assert.Contains(t, appErr.Error(), "P-384")
+	})
+
+	t.Run("should reject key algorithm which is not allowed", func(t *testing.T) {
+		// given
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		rsaOnlyPolicy := KeyPolicy{AllowRSA: true, MinRSAKeySize: 2048}
+
+		// when
+		appErr := rsaOnlyPolicy.CheckKey(&key.PublicKey)
+
+		// then
+		require.Error(t, appErr)
+		assert.Equal(t, apperrors.CodeWrongInput, appErr.Code())
+	})
+
+	t.Run("should reject unsupported key type", func(t *testing.T) {
+		// when
+		appErr := policy.CheckKey("key")
+
+		// then
+		require.Error(t, appErr)
+		assert.Equal(t, apperrors.CodeWrongInput, appErr.Code())
+	})
+}
+
+func TestKeyPolicy_KeyAlgorithms(t *testing.T) {
+
+	t.Run("should return allowed key algorithms", func(t *testing.T) {
+		// given
+		policy := KeyPolicy{AllowRSA: true, MinRSAKeySize: 4096, AllowECDSAP256: true}
+
+		// when
+		algorithms := policy.KeyAlgorithms()
+
+		// then
+		assert.Equal(t, []string{"rsa4096", "ecdsa-p256"}, algorithms)
+	})
+}
+
+func TestKeyPolicy_Validate(t *testing.T) {
+
+	t.Run("should accept valid policy", func(t *testing.T) {
+		// given
+		policy := KeyPolicy{AllowRSA: true, MinRSAKeySize: 2048}
+
+		// when
+		err := policy.Validate()
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail when no algorithm allowed", func(t *testing.T) {
+		// given
+		policy := KeyPolicy{MinRSAKeySize: 2048}
+
+		// when
+		err := policy.Validate()
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should fail when minimal RSA key size is too small", func(t *testing.T) {
+		// given
+		policy := KeyPolicy{AllowRSA: true, MinRSAKeySize: 1024}
+
+		// when
+		err := policy.Validate()
+
+		// then
+		require.Error(t, err)
+	})
+}
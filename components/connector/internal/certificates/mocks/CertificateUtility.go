@@ -6,6 +6,8 @@ import apperrors "github.com/kyma-incubator/compass/components/connector/interna
 import certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
 import mock "github.com/stretchr/testify/mock"
 import rsa "crypto/rsa"
+import url "net/url"
+import pkix "crypto/x509/pkix"
 import x509 "crypto/x509"
 
 // CertificateUtility is an autogenerated mock type for the CertificateUtility type
@@ -29,6 +31,22 @@ func (_m *CertificateUtility) AddCertificateHeaderAndFooter(crtRaw []byte) []byt
 	return r0
 }
 
+// CheckCSRKey provides a mock function with given fields: csr
+func (_m *CertificateUtility) CheckCSRKey(csr *x509.CertificateRequest) apperrors.AppError {
+	ret := _m.Called(csr)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(*x509.CertificateRequest) apperrors.AppError); ok {
+		r0 = rf(csr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // CheckCSRValues provides a mock function with given fields: csr, subject
 func (_m *CertificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subject certificates.CSRSubject) apperrors.AppError {
 	ret := _m.Called(csr, subject)
@@ -45,6 +63,31 @@ func (_m *CertificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subje
 	return r0
 }
 
+// CreateCRL provides a mock function with given fields: caCrt, caKey, revokedCertificates
+func (_m *CertificateUtility) CreateCRL(caCrt *x509.Certificate, caKey *rsa.PrivateKey, revokedCertificates []pkix.RevokedCertificate) ([]byte, apperrors.AppError) {
+	ret := _m.Called(caCrt, caKey, revokedCertificates)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(*x509.Certificate, *rsa.PrivateKey, []pkix.RevokedCertificate) []byte); ok {
+		r0 = rf(caCrt, caKey, revokedCertificates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(*x509.Certificate, *rsa.PrivateKey, []pkix.RevokedCertificate) apperrors.AppError); ok {
+		r1 = rf(caCrt, caKey, revokedCertificates)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // LoadCSR provides a mock function with given fields: encodedData
 func (_m *CertificateUtility) LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError) {
 	ret := _m.Called(encodedData)
@@ -120,13 +163,13 @@ func (_m *CertificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 	return r0, r1
 }
 
-// SignCSR provides a mock function with given fields: caCrt, csr, caKey
-func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	ret := _m.Called(caCrt, csr, caKey)
+// SignCSR provides a mock function with given fields: caCrt, csr, caKey, uris
+func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey, uris []*url.URL) ([]byte, apperrors.AppError) {
+	ret := _m.Called(caCrt, csr, caKey, uris)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) []byte); ok {
-		r0 = rf(caCrt, csr, caKey)
+	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey, []*url.URL) []byte); ok {
+		r0 = rf(caCrt, csr, caKey, uris)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -134,8 +177,8 @@ func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) apperrors.AppError); ok {
-		r1 = rf(caCrt, csr, caKey)
+	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey, []*url.URL) apperrors.AppError); ok {
+		r1 = rf(caCrt, csr, caKey, uris)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -5,12 +5,89 @@ package mocks
 import apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 import certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
 import mock "github.com/stretchr/testify/mock"
+import pkix "crypto/x509/pkix"
+import x509 "crypto/x509"
 
 // Service is an autogenerated mock type for the Service type
 type Service struct {
 	mock.Mock
 }
 
+// CACertificates provides a mock function with given fields:
+func (_m *Service) CACertificates() ([]certificates.CACertificateInfo, apperrors.AppError) {
+	ret := _m.Called()
+
+	var r0 []certificates.CACertificateInfo
+	if rf, ok := ret.Get(0).(func() []certificates.CACertificateInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]certificates.CACertificateInfo)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func() apperrors.AppError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateCRL provides a mock function with given fields: revokedCertificates
+func (_m *Service) CreateCRL(revokedCertificates []pkix.RevokedCertificate) ([]byte, apperrors.AppError) {
+	ret := _m.Called(revokedCertificates)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]pkix.RevokedCertificate) []byte); ok {
+		r0 = rf(revokedCertificates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func([]pkix.RevokedCertificate) apperrors.AppError); ok {
+		r1 = rf(revokedCertificates)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreatePreviousCRL provides a mock function with given fields: revokedCertificates
+func (_m *Service) CreatePreviousCRL(revokedCertificates []pkix.RevokedCertificate) ([]byte, apperrors.AppError) {
+	ret := _m.Called(revokedCertificates)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]pkix.RevokedCertificate) []byte); ok {
+		r0 = rf(revokedCertificates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func([]pkix.RevokedCertificate) apperrors.AppError); ok {
+		r1 = rf(revokedCertificates)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // SignCSR provides a mock function with given fields: encodedCSR, subject
 func (_m *Service) SignCSR(encodedCSR []byte, subject certificates.CSRSubject) (certificates.EncodedCertificateChain, apperrors.AppError) {
 	ret := _m.Called(encodedCSR, subject)
@@ -33,3 +110,19 @@ func (_m *Service) SignCSR(encodedCSR []byte, subject certificates.CSRSubject) (
 
 	return r0, r1
 }
+
+// VerifyClientCertificate provides a mock function with given fields: certificate
+func (_m *Service) VerifyClientCertificate(certificate *x509.Certificate) apperrors.AppError {
+	ret := _m.Called(certificate)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(*x509.Certificate) apperrors.AppError); ok {
+		r0 = rf(certificate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
@@ -1,16 +1,98 @@
 package certificates
 
 import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/pkg/gqlschema"
 )
 
+const (
+	tenantURIPrefix     = "compass:tenant:"
+	clientTypeURIPrefix = "compass:client-type:"
+)
+
 type CSRSubject struct {
 	CommonName string
+	// Tenant is not a part of the subject, it is included in the certificate as the URI Subject Alternative Name
+	Tenant string
+	// ClientType is not a part of the subject, it is included in the certificate as the URI Subject Alternative Name
+	ClientType string
 	CSRSubjectConsts
 }
 
+func (s CSRSubject) String() string {
+	return s.ToString(s.CommonName)
+}
+
+// URIs returns URI Subject Alternative Names included in the certificates issued for the subject
+func (s CSRSubject) URIs() []*url.URL {
+	var uris []*url.URL
+	if s.Tenant != "" {
+		uris = append(uris, TenantURI(s.Tenant))
+	}
+	if s.ClientType != "" {
+		uris = append(uris, ClientTypeURI(s.ClientType))
+	}
+
+	return uris
+}
+
+// CSRSubjectFromCertificate returns subject of the existing certificate, which is expected in the CSR when renewing it
+func CSRSubjectFromCertificate(certificate *x509.Certificate) CSRSubject {
+	subject := certificate.Subject
+
+	return CSRSubject{
+		CommonName: subject.CommonName,
+		Tenant:     TenantFromCertificate(certificate),
+		ClientType: ClientTypeFromCertificate(certificate),
+		CSRSubjectConsts: CSRSubjectConsts{
+			Country:            first(subject.Country),
+			Organization:       first(subject.Organization),
+			OrganizationalUnit: first(subject.OrganizationalUnit),
+			Locality:           first(subject.Locality),
+			Province:           first(subject.Province),
+		},
+	}
+}
+
+// TenantURI returns URI identifying the tenant in the issued certificates, e.g. urn:compass:tenant:<tenant>
+func TenantURI(tenant string) *url.URL {
+	return &url.URL{Scheme: "urn", Opaque: tenantURIPrefix + tenant}
+}
+
+// TenantFromCertificate returns the tenant from the certificate URI Subject Alternative Name or empty string if it is not present
+func TenantFromCertificate(certificate *x509.Certificate) string {
+	return valueFromURIs(certificate, tenantURIPrefix)
+}
+
+// ClientTypeURI returns URI identifying the type of the client in the issued certificates, e.g. urn:compass:client-type:Application
+func ClientTypeURI(clientType string) *url.URL {
+	return &url.URL{Scheme: "urn", Opaque: clientTypeURIPrefix + clientType}
+}
+
+// ClientTypeFromCertificate returns the client type from the certificate URI Subject Alternative Name or empty string if it is not present
+func ClientTypeFromCertificate(certificate *x509.Certificate) string {
+	return valueFromURIs(certificate, clientTypeURIPrefix)
+}
+
+func valueFromURIs(certificate *x509.Certificate, prefix string) string {
+	for _, uri := range certificate.URIs {
+		if uri.Scheme == "urn" && strings.HasPrefix(uri.Opaque, prefix) {
+			return strings.TrimPrefix(uri.Opaque, prefix)
+		}
+	}
+
+	return ""
+}
+
 type CSRSubjectConsts struct {
 	Country            string
 	Organization       string
@@ -29,6 +111,75 @@ type EncodedCertificateChain struct {
 	CaCertificate     string
 }
 
+// ParseClientCertificate decodes the client certificate from the encoded certificate chain
+func ParseClientCertificate(encodedChain EncodedCertificateChain) (*x509.Certificate, apperrors.AppError) {
+	pemCertificate, err := base64.StdEncoding.DecodeString(encodedChain.ClientCertificate)
+	if err != nil {
+		return nil, apperrors.Internal("Error while decoding client certificate: %s", err)
+	}
+
+	block, _ := pem.Decode(pemCertificate)
+	if block == nil {
+		return nil, apperrors.Internal("Error while decoding client certificate: no PEM data found")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, apperrors.Internal("Error while parsing client certificate: %s", err)
+	}
+
+	return certificate, nil
+}
+
+// CARole describes the purpose of the CA certificate
+type CARole string
+
+const (
+	// ActiveCA signs the new client certificates
+	ActiveCA CARole = "ACTIVE"
+	// PreviousCA signed the client certificates before the rotation, it is published until the overlap window ends
+	PreviousCA CARole = "PREVIOUS"
+	// RootCA is attached to the certificate chain
+	RootCA CARole = "ROOT"
+)
+
+type CACertificateInfo struct {
+	Fingerprint string
+	Subject     string
+	Role        CARole
+	ExpiresAt   time.Time
+}
+
+func NewCACertificateInfo(certificate *x509.Certificate, role CARole) CACertificateInfo {
+	return CACertificateInfo{
+		Fingerprint: Fingerprint(certificate),
+		Subject:     certificate.Subject.String(),
+		Role:        role,
+		ExpiresAt:   certificate.NotAfter,
+	}
+}
+
+// Fingerprint returns SHA-256 fingerprint of the DER encoded certificate as colon separated hex bytes
+func Fingerprint(certificate *x509.Certificate) string {
+	sum := sha256.Sum256(certificate.Raw)
+
+	hexBytes := make([]string, len(sum))
+	for i, b := range sum {
+		hexBytes[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return strings.Join(hexBytes, ":")
+}
+
+func ToCACertificate(info CACertificateInfo) *gqlschema.CACertificate {
+	return &gqlschema.CACertificate{
+		Fingerprint: info.Fingerprint,
+		Subject:     info.Subject,
+		Role:        gqlschema.CARole(info.Role),
+		ExpiresAt:   info.ExpiresAt.UTC().Format(time.RFC3339),
+	}
+}
+
 func ToCertificationResult(encodedChain EncodedCertificateChain) gqlschema.CertificationResult {
 	return gqlschema.CertificationResult{
 		CertificateChain:  encodedChain.CertificateChain,
@@ -36,3 +187,11 @@ func ToCertificationResult(encodedChain EncodedCertificateChain) gqlschema.Certi
 		CaCertificate:     encodedChain.CaCertificate,
 	}
 }
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -1,8 +1,12 @@
 package certificates
 
 import (
+	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
+	"net/url"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/secrets"
@@ -10,17 +14,38 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// Keys of the CA secret and the root CA secret data
 const (
-	caCertificateSecretKey     = "ca.crt"
-	caKeySecretKey             = "ca.key"
-	rootCACertificateSecretKey = "cacert"
+	CACertificateSecretKey     = "ca.crt"
+	CAKeySecretKey             = "ca.key"
+	RootCACertificateSecretKey = "cacert"
 )
 
 //go:generate mockery -name=Service
 type Service interface {
 	// SignCSR takes encoded CSR, validates subject and generates Certificate based on CA stored in secret
+	// the tenant and the client type of the subject are included in the Certificate as the URI Subject Alternative Names
 	// returns base64 encoded certificate chain
 	SignCSR(encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError)
+	// CreateCRL generates DER encoded Certificate Revocation List signed with CA stored in secret
+	CreateCRL(revokedCertificates []pkix.RevokedCertificate) ([]byte, apperrors.AppError)
+	// CreatePreviousCRL generates DER encoded Certificate Revocation List signed with the previous CA, so that the
+	// certificates it issued can be checked during the rotation overlap window, returns NotFound error without the previous CA
+	CreatePreviousCRL(revokedCertificates []pkix.RevokedCertificate) ([]byte, apperrors.AppError)
+	// CACertificates returns the CA certificates in use: the active CA signing the certificates, the previous CA
+	// published during the rotation overlap window and the root CA
+	CACertificates() ([]CACertificateInfo, apperrors.AppError)
+	// VerifyClientCertificate checks that the client certificate is valid for the client authentication
+	// and is signed by the active or the previous CA
+	VerifyClientCertificate(certificate *x509.Certificate) apperrors.AppError
+}
+
+// RotationConfig configures rotation of the CA
+type RotationConfig struct {
+	// NextCASecretName is the secret of the CA replacing the current one, the rotation starts once the secret exists
+	NextCASecretName types.NamespacedName
+	// OverlapWindow is the time since the next CA becomes valid during which the current CA is still published
+	OverlapWindow time.Duration
 }
 
 type certificateService struct {
@@ -28,17 +53,24 @@ type certificateService struct {
 	certUtil                    CertificateUtility
 	caSecretName                types.NamespacedName
 	rootCACertificateSecretName types.NamespacedName
+	rotationConfig              RotationConfig
 }
 
-func NewCertificateService(secretRepository secrets.Repository, certUtil CertificateUtility, caSecretName, rootCACertificateSecretName types.NamespacedName) Service {
+func NewCertificateService(secretRepository secrets.Repository, certUtil CertificateUtility, caSecretName, rootCACertificateSecretName types.NamespacedName, rotationConfig RotationConfig) Service {
 	return &certificateService{
 		secretsRepository:           secretRepository,
 		certUtil:                    certUtil,
 		caSecretName:                caSecretName,
 		rootCACertificateSecretName: rootCACertificateSecretName,
+		rotationConfig:              rotationConfig,
 	}
 }
 
+type certificateAuthority struct {
+	certificate *x509.Certificate
+	key         *rsa.PrivateKey
+}
+
 func (svc *certificateService) SignCSR(encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError) {
 	csr, err := svc.certUtil.LoadCSR(encodedCSR)
 	if err != nil {
@@ -50,67 +82,187 @@ func (svc *certificateService) SignCSR(encodedCSR []byte, subject CSRSubject) (E
 		return EncodedCertificateChain{}, err
 	}
 
-	return svc.signCSR(csr)
+	return svc.signCSR(csr, subject.URIs())
 }
 
-func (svc *certificateService) signCSR(csr *x509.CertificateRequest) (EncodedCertificateChain, apperrors.AppError) {
-	secretData, err := svc.secretsRepository.Get(svc.caSecretName)
+func (svc *certificateService) CreateCRL(revokedCertificates []pkix.RevokedCertificate) ([]byte, apperrors.AppError) {
+	activeCA, _, err := svc.loadCAs()
 	if err != nil {
-		return EncodedCertificateChain{}, err
+		return nil, err
 	}
 
-	caCrt, err := svc.certUtil.LoadCert(secretData[caCertificateSecretKey])
+	return svc.certUtil.CreateCRL(activeCA.certificate, activeCA.key, revokedCertificates)
+}
+
+func (svc *certificateService) CreatePreviousCRL(revokedCertificates []pkix.RevokedCertificate) ([]byte, apperrors.AppError) {
+	_, previousCACrt, err := svc.loadCAs()
 	if err != nil {
-		return EncodedCertificateChain{}, err
+		return nil, err
 	}
 
-	caKey, err := svc.certUtil.LoadKey(secretData[caKeySecretKey])
+	if previousCACrt == nil || time.Now().After(previousCACrt.NotAfter) {
+		return nil, apperrors.NotFound("Previous CA is not in use")
+	}
+
+	previousCA, err := svc.loadCA(svc.caSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.certUtil.CreateCRL(previousCA.certificate, previousCA.key, revokedCertificates)
+}
+
+func (svc *certificateService) CACertificates() ([]CACertificateInfo, apperrors.AppError) {
+	activeCA, previousCACrt, err := svc.loadCAs()
+	if err != nil {
+		return nil, err
+	}
+
+	caCertificates := []CACertificateInfo{NewCACertificateInfo(activeCA.certificate, ActiveCA)}
+	if previousCACrt != nil {
+		caCertificates = append(caCertificates, NewCACertificateInfo(previousCACrt, PreviousCA))
+	}
+
+	if svc.rootCACertificateSecretName.Name != "" {
+		rootCACrt, err := svc.loadCACertificate(svc.rootCACertificateSecretName, RootCACertificateSecretKey)
+		if err != nil {
+			return nil, err
+		}
+
+		caCertificates = append(caCertificates, NewCACertificateInfo(rootCACrt, RootCA))
+	}
+
+	return caCertificates, nil
+}
+
+func (svc *certificateService) VerifyClientCertificate(certificate *x509.Certificate) apperrors.AppError {
+	activeCA, previousCACrt, appErr := svc.loadCAs()
+	if appErr != nil {
+		return appErr
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(activeCA.certificate)
+	if previousCACrt != nil {
+		roots.AddCert(previousCACrt)
+	}
+
+	_, err := certificate.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return apperrors.Forbidden("Client certificate is not trusted: %s", err)
+	}
+
+	return nil
+}
+
+func (svc *certificateService) signCSR(csr *x509.CertificateRequest, uris []*url.URL) (EncodedCertificateChain, apperrors.AppError) {
+	activeCA, previousCACrt, err := svc.loadCAs()
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
 
-	signedCrt, err := svc.certUtil.SignCSR(caCrt, csr, caKey)
+	signedCrt, err := svc.certUtil.SignCSR(activeCA.certificate, csr, activeCA.key, uris)
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
 
-	return svc.encodeCertificates(caCrt.Raw, signedCrt)
+	rawCaCertificates := [][]byte{activeCA.certificate.Raw}
+	if previousCACrt != nil {
+		rawCaCertificates = append(rawCaCertificates, previousCACrt.Raw)
+	}
+
+	return svc.encodeCertificates(rawCaCertificates, signedCrt)
 }
 
-func (svc *certificateService) encodeCertificates(rawCaCertificate, rawClientCertificate []byte) (EncodedCertificateChain, apperrors.AppError) {
-	caCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(rawCaCertificate)
-	signedCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(rawClientCertificate)
+// loadCAs returns the CA signing the certificates and the certificate of the previous CA, which is set only
+// during the overlap window of the CA rotation
+func (svc *certificateService) loadCAs() (certificateAuthority, *x509.Certificate, apperrors.AppError) {
+	if svc.rotationConfig.NextCASecretName.Name == "" {
+		currentCA, err := svc.loadCA(svc.caSecretName)
+		return currentCA, nil, err
+	}
 
-	if svc.rootCACertificateSecretName.Name != "" {
-		rootCABytes, err := svc.loadRootCACert()
-		if err != nil {
-			return EncodedCertificateChain{}, err
+	nextCA, err := svc.loadCA(svc.rotationConfig.NextCASecretName)
+	if err != nil {
+		if err.Code() != apperrors.CodeNotFound {
+			return certificateAuthority{}, nil, err
 		}
 
-		caCrtBytes = svc.createCertChain(rootCABytes, caCrtBytes)
+		currentCA, err := svc.loadCA(svc.caSecretName)
+		return currentCA, nil, err
 	}
 
-	certChain := svc.createCertChain(signedCrtBytes, caCrtBytes)
+	if time.Now().After(nextCA.certificate.NotBefore.Add(svc.rotationConfig.OverlapWindow)) {
+		return nextCA, nil, nil
+	}
 
-	return encodeCertificateBase64(certChain, signedCrtBytes, caCrtBytes), nil
+	currentCACrt, err := svc.loadCACertificate(svc.caSecretName, CACertificateSecretKey)
+	if err != nil {
+		return certificateAuthority{}, nil, err
+	}
+
+	return nextCA, currentCACrt, nil
 }
 
-func (svc *certificateService) loadRootCACert() ([]byte, apperrors.AppError) {
-	secretData, err := svc.secretsRepository.Get(svc.rootCACertificateSecretName)
+func (svc *certificateService) loadCA(secretName types.NamespacedName) (certificateAuthority, apperrors.AppError) {
+	secretData, err := svc.secretsRepository.Get(secretName)
 	if err != nil {
-		return nil, err
+		return certificateAuthority{}, err
+	}
+
+	caCrt, err := svc.certUtil.LoadCert(secretData[CACertificateSecretKey])
+	if err != nil {
+		return certificateAuthority{}, err
 	}
 
-	rootCACrt, err := svc.certUtil.LoadCert(secretData[rootCACertificateSecretKey])
+	caKey, err := svc.certUtil.LoadKey(secretData[CAKeySecretKey])
+	if err != nil {
+		return certificateAuthority{}, err
+	}
+
+	return certificateAuthority{certificate: caCrt, key: caKey}, nil
+}
+
+func (svc *certificateService) loadCACertificate(secretName types.NamespacedName, key string) (*x509.Certificate, apperrors.AppError) {
+	secretData, err := svc.secretsRepository.Get(secretName)
 	if err != nil {
 		return nil, err
 	}
 
-	return svc.certUtil.AddCertificateHeaderAndFooter(rootCACrt.Raw), nil
+	return svc.certUtil.LoadCert(secretData[key])
+}
+
+func (svc *certificateService) encodeCertificates(rawCaCertificates [][]byte, rawClientCertificate []byte) (EncodedCertificateChain, apperrors.AppError) {
+	var caCrtBytes []byte
+	for _, rawCaCertificate := range rawCaCertificates {
+		caCrtBytes = append(caCrtBytes, svc.certUtil.AddCertificateHeaderAndFooter(rawCaCertificate)...)
+	}
+	signedCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(rawClientCertificate)
+
+	if svc.rootCACertificateSecretName.Name != "" {
+		rootCACrt, err := svc.loadCACertificate(svc.rootCACertificateSecretName, RootCACertificateSecretKey)
+		if err != nil {
+			return EncodedCertificateChain{}, err
+		}
+
+		caCrtBytes = svc.createCertChain(svc.certUtil.AddCertificateHeaderAndFooter(rootCACrt.Raw), caCrtBytes)
+	}
+
+	certChain := svc.createCertChain(signedCrtBytes, caCrtBytes)
+
+	return encodeCertificateBase64(certChain, signedCrtBytes, caCrtBytes), nil
 }
 
 func (svc *certificateService) checkCSR(csr *x509.CertificateRequest, expectedSubject CSRSubject) apperrors.AppError {
-	return svc.certUtil.CheckCSRValues(csr, expectedSubject)
+	err := svc.certUtil.CheckCSRValues(csr, expectedSubject)
+	if err != nil {
+		return err
+	}
+
+	return svc.certUtil.CheckCSRKey(csr)
 }
 
 func (svc *certificateService) createCertChain(clientCrt, caCrt []byte) []byte {
@@ -1,10 +1,14 @@
 package certificates_test
 
 import (
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
@@ -14,15 +18,19 @@ import (
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
 	secretsMock "github.com/kyma-incubator/compass/components/connector/internal/secrets/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 const (
 	authSecretName   = "nginx-auth-ca"
 	rootCASecretName = "rootCA-secret"
+	nextCASecretName = "next-ca-secret"
 	namespace        = "kyma-integration"
 
 	appName            = "appName"
+	tenant             = "tenant"
+	clientType         = "Application"
 	country            = "country"
 	organization       = "organization"
 	organizationalUnit = "organizationalUnit"
@@ -60,6 +68,8 @@ var (
 
 	subjectValues = certificates.CSRSubject{
 		CommonName: appName,
+		Tenant:     tenant,
+		ClientType: clientType,
 		CSRSubjectConsts: certificates.CSRSubjectConsts{
 			Country:            country,
 			Organization:       organization,
@@ -78,6 +88,25 @@ var (
 		Namespace: namespace,
 		Name:      rootCASecretName,
 	}
+
+	nextCANamespacedName = types.NamespacedName{
+		Namespace: namespace,
+		Name:      nextCASecretName,
+	}
+
+	nextCaCrtEncoded = []byte("nextCaCrtEncoded")
+	nextCaKeyEncoded = []byte("nextCaKeyEncoded")
+
+	nextCertsSecretData = map[string][]byte{
+		"ca.crt": nextCaCrtEncoded,
+		"ca.key": nextCaKeyEncoded,
+	}
+
+	nextCaKey         = &rsa.PrivateKey{D: big.NewInt(1)}
+	nextCaCRTBytes    = []byte("nextCaCRTBytes")
+	overlapWindow     = 24 * time.Hour
+	rotationConfig    = certificates.RotationConfig{NextCASecretName: nextCANamespacedName, OverlapWindow: overlapWindow}
+	notFoundSecretErr = apperrors.NotFound("secret not found")
 )
 
 func TestCertificateService_SignCSR(t *testing.T) {
@@ -92,11 +121,12 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, subjectValues.URIs()).Return(clientCRT, nil)
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
-		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{})
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
 
 		// when
 		encodedCertChain, apperr := certificatesService.SignCSR(rawCSR, subjectValues)
@@ -131,12 +161,13 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, subjectValues.URIs()).Return(clientCRT, nil)
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes).Once().
 			On("AddCertificateHeaderAndFooter", rootCACrt.Raw).Return(rootCACrtBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
-		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, rootCANamespacedName)
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, rootCANamespacedName, certificates.RotationConfig{})
 
 		// when
 		encodedCertChain, apperr := certificatesService.SignCSR(rawCSR, subjectValues)
@@ -165,8 +196,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
 
-		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{})
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(rawCSR, subjectValues)
@@ -190,11 +222,12 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, subjectValues.URIs()).Return(clientCRT, nil)
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
-		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, rootCANamespacedName)
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, rootCANamespacedName, certificates.RotationConfig{})
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(rawCSR, subjectValues)
@@ -214,7 +247,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(nil, apperrors.Internal("error"))
 
-		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{})
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(rawCSR, subjectValues)
@@ -235,7 +268,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(apperrors.Forbidden("error"))
 
-		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{})
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(rawCSR, subjectValues)
@@ -248,6 +281,28 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.AssertExpectations(t)
 	})
 
+	t.Run("should return error when CSR key is not allowed", func(t *testing.T) {
+		// given
+		secretsRepository := &secretsMock.Repository{}
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRKey", csr).Return(apperrors.WrongInput("error"))
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
+
+		// when
+		encodedChain, err := certificatesService.SignCSR(rawCSR, subjectValues)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, encodedChain)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+		secretsRepository.AssertExpectations(t)
+		certUtils.AssertExpectations(t)
+	})
+
 	t.Run("should return error when couldn't load cert", func(t *testing.T) {
 		// given
 		secretsRepository := &secretsMock.Repository{}
@@ -256,9 +311,10 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
 		certUtils.On("LoadCert", caCrtEncoded).Return(nil, apperrors.Internal("error"))
 
-		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{})
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(rawCSR, subjectValues)
@@ -279,10 +335,11 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(nil, apperrors.Internal("error"))
 
-		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{})
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(rawCSR, subjectValues)
@@ -305,20 +362,539 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(nil, apperrors.Internal("error"))
+		certUtils.On("CheckCSRKey", csr).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, subjectValues.URIs()).Return(nil, apperrors.Internal("error"))
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
+
+		// when
+		encodedChain, err := certificatesService.SignCSR(rawCSR, subjectValues)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, encodedChain)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		secretsRepository.AssertExpectations(t)
+		certUtils.AssertExpectations(t)
+	})
+}
+
+func TestCertificateService_SignCSR_Rotation(t *testing.T) {
+
+	t.Run("should sign with next CA and publish both CAs during overlap window", func(t *testing.T) {
+		// given
+		nextCaCrt := nextCACertificate(time.Now().Add(-time.Hour))
+
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nextCertsSecretData, nil).
+			On("Get", authNamespacedName).Return(certsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", nextCaCrtEncoded).Return(nextCaCrt, nil).
+			On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", nextCaKeyEncoded).Return(nextCaKey, nil)
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
+		certUtils.On("SignCSR", nextCaCrt, csr, nextCaKey, subjectValues.URIs()).Return(clientCRT, nil)
+		certUtils.On("AddCertificateHeaderAndFooter", nextCaCrt.Raw).Return(nextCaCRTBytes).
+			On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes).
+			On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, rotationConfig)
+
+		// when
+		encodedCertChain, apperr := certificatesService.SignCSR(rawCSR, subjectValues)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedCaCRT, err := decodeBase64(encodedCertChain.CaCertificate)
+		require.NoError(t, err)
+		assert.Equal(t, append(append([]byte{}, nextCaCRTBytes...), caCRTBytes...), decodedCaCRT)
+
+		decodedChain, err := decodeBase64(encodedCertChain.CertificateChain)
+		require.NoError(t, err)
+		assert.Equal(t, append(append(append([]byte{}, clientCRTBytes...), nextCaCRTBytes...), caCRTBytes...), decodedChain)
+
+		certUtils.AssertExpectations(t)
+		certUtils.AssertNotCalled(t, "LoadKey", caKeyEncoded)
+	})
+
+	t.Run("should publish only next CA after overlap window", func(t *testing.T) {
+		// given
+		nextCaCrt := nextCACertificate(time.Now().Add(-2 * overlapWindow))
+
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nextCertsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", nextCaCrtEncoded).Return(nextCaCrt, nil)
+		certUtils.On("LoadKey", nextCaKeyEncoded).Return(nextCaKey, nil)
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
+		certUtils.On("SignCSR", nextCaCrt, csr, nextCaKey, subjectValues.URIs()).Return(clientCRT, nil)
+		certUtils.On("AddCertificateHeaderAndFooter", nextCaCrt.Raw).Return(nextCaCRTBytes).
+			On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, rotationConfig)
+
+		// when
+		encodedCertChain, apperr := certificatesService.SignCSR(rawCSR, subjectValues)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedCaCRT, err := decodeBase64(encodedCertChain.CaCertificate)
+		require.NoError(t, err)
+		assert.Equal(t, nextCaCRTBytes, decodedCaCRT)
+
+		secretsRepository.AssertNotCalled(t, "Get", authNamespacedName)
+	})
+
+	t.Run("should sign with current CA when next CA secret not found", func(t *testing.T) {
+		// given
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nil, notFoundSecretErr).
+			On("Get", authNamespacedName).Return(certsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, subjectValues.URIs()).Return(clientCRT, nil)
+		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes).
+			On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
-		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{})
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, rotationConfig)
+
+		// when
+		encodedCertChain, apperr := certificatesService.SignCSR(rawCSR, subjectValues)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedCaCRT, err := decodeBase64(encodedCertChain.CaCertificate)
+		require.NoError(t, err)
+		assert.Equal(t, caCRTBytes, decodedCaCRT)
+
+		secretsRepository.AssertExpectations(t)
+		certUtils.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to read next CA secret", func(t *testing.T) {
+		// given
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nil, apperrors.Internal("error"))
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRKey", csr).Return(nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, rotationConfig)
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(rawCSR, subjectValues)
 
 		// then
 		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		assert.Empty(t, encodedChain)
+		secretsRepository.AssertNotCalled(t, "Get", authNamespacedName)
+	})
+}
+
+func TestCertificateService_CACertificates(t *testing.T) {
+
+	t.Run("should return active, previous and root CA certificates", func(t *testing.T) {
+		// given
+		nextCaCrt := nextCACertificate(time.Now().Add(-time.Hour))
+		currentCaCrt := &x509.Certificate{Raw: []byte("currentCaCrt"), Subject: pkix.Name{CommonName: "current"}, NotAfter: time.Now().Add(time.Hour)}
+		rootCaCrt := &x509.Certificate{Raw: []byte("rootCaCrt"), Subject: pkix.Name{CommonName: "root"}}
+
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nextCertsSecretData, nil).
+			On("Get", authNamespacedName).Return(certsSecretData, nil).
+			On("Get", rootCANamespacedName).Return(rootCASecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", nextCaCrtEncoded).Return(nextCaCrt, nil).
+			On("LoadCert", caCrtEncoded).Return(currentCaCrt, nil).
+			On("LoadCert", rootCaEncoded).Return(rootCaCrt, nil)
+		certUtils.On("LoadKey", nextCaKeyEncoded).Return(nextCaKey, nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, rootCANamespacedName, rotationConfig)
+
+		// when
+		caCertificates, err := certificatesService.CACertificates()
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, caCertificates, 3)
+		assert.Equal(t, certificates.NewCACertificateInfo(nextCaCrt, certificates.ActiveCA), caCertificates[0])
+		assert.Equal(t, certificates.NewCACertificateInfo(currentCaCrt, certificates.PreviousCA), caCertificates[1])
+		assert.Equal(t, certificates.NewCACertificateInfo(rootCaCrt, certificates.RootCA), caCertificates[2])
+		assert.Equal(t, "CN=next", caCertificates[0].Subject)
+	})
+
+	t.Run("should return only active CA certificate when rotation not configured", func(t *testing.T) {
+		// given
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", authNamespacedName).Return(certsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
+
+		// when
+		caCertificates, err := certificatesService.CACertificates()
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, caCertificates, 1)
+		assert.Equal(t, certificates.ActiveCA, caCertificates[0].Role)
+	})
+
+	t.Run("should return error when failed to read CA secret", func(t *testing.T) {
+		// given
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", authNamespacedName).Return(nil, apperrors.Internal("error"))
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, &certificatesMocks.CertificateUtility{}, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
+
+		// when
+		_, err := certificatesService.CACertificates()
+
+		// then
+		require.Error(t, err)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func TestCertificateService_CreateCRL(t *testing.T) {
+
+	revoked := []pkix.RevokedCertificate{
+		{SerialNumber: big.NewInt(1234)},
+	}
+
+	t.Run("should create CRL", func(t *testing.T) {
+		// given
+		rawCRL := []byte("crl")
+
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", authNamespacedName).Return(certsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("CreateCRL", caCrt, caKey, revoked).Return(rawCRL, nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
+
+		// when
+		crl, err := certificatesService.CreateCRL(revoked)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, rawCRL, crl)
 		secretsRepository.AssertExpectations(t)
 		certUtils.AssertExpectations(t)
 	})
+
+	t.Run("should return error when failed to read CA secret", func(t *testing.T) {
+		// given
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", authNamespacedName).Return(nil, apperrors.NotFound("error"))
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, &certificatesMocks.CertificateUtility{}, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
+
+		// when
+		crl, err := certificatesService.CreateCRL(revoked)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		assert.Nil(t, crl)
+	})
+}
+
+func TestCertificateService_CreatePreviousCRL(t *testing.T) {
+
+	revoked := []pkix.RevokedCertificate{
+		{SerialNumber: big.NewInt(1234)},
+	}
+
+	previousCaCrt := &x509.Certificate{
+		Raw:      []byte("previousCaCrt"),
+		NotAfter: time.Now().Add(time.Hour),
+	}
+
+	t.Run("should create CRL signed with previous CA during overlap window", func(t *testing.T) {
+		// given
+		rawCRL := []byte("crl")
+
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nextCertsSecretData, nil).
+			On("Get", authNamespacedName).Return(certsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", nextCaCrtEncoded).Return(nextCACertificate(time.Now().Add(-time.Hour)), nil).
+			On("LoadCert", caCrtEncoded).Return(previousCaCrt, nil)
+		certUtils.On("LoadKey", nextCaKeyEncoded).Return(nextCaKey, nil).
+			On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("CreateCRL", previousCaCrt, caKey, revoked).Return(rawCRL, nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, rotationConfig)
+
+		// when
+		crl, err := certificatesService.CreatePreviousCRL(revoked)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, rawCRL, crl)
+		certUtils.AssertExpectations(t)
+	})
+
+	t.Run("should return not found error after overlap window", func(t *testing.T) {
+		// given
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nextCertsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", nextCaCrtEncoded).Return(nextCACertificate(time.Now().Add(-2*overlapWindow)), nil)
+		certUtils.On("LoadKey", nextCaKeyEncoded).Return(nextCaKey, nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, rotationConfig)
+
+		// when
+		_, err := certificatesService.CreatePreviousCRL(revoked)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		certUtils.AssertNotCalled(t, "CreateCRL", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return not found error when previous CA expired", func(t *testing.T) {
+		// given
+		expiredCaCrt := &x509.Certificate{NotAfter: time.Now().Add(-time.Minute)}
+
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nextCertsSecretData, nil).
+			On("Get", authNamespacedName).Return(certsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", nextCaCrtEncoded).Return(nextCACertificate(time.Now().Add(-time.Hour)), nil).
+			On("LoadCert", caCrtEncoded).Return(expiredCaCrt, nil)
+		certUtils.On("LoadKey", nextCaKeyEncoded).Return(nextCaKey, nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, rotationConfig)
+
+		// when
+		_, err := certificatesService.CreatePreviousCRL(revoked)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should return not found error without rotation", func(t *testing.T) {
+		// given
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", authNamespacedName).Return(certsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
+
+		// when
+		_, err := certificatesService.CreatePreviousCRL(revoked)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+}
+
+func TestCertificateService_VerifyClientCertificate(t *testing.T) {
+
+	activeCACrt, activeCAKey := generateCACertificate(t, "active", time.Now().Add(-time.Hour))
+
+	newCertificateService := func(caCrt *x509.Certificate) certificates.Service {
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", authNamespacedName).Return(certsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+
+		return certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
+	}
+
+	t.Run("should accept certificate signed by active CA", func(t *testing.T) {
+		// given
+		clientCrt := generateClientCertificate(t, activeCACrt, activeCAKey, x509.ExtKeyUsageClientAuth)
+
+		// when
+		err := newCertificateService(activeCACrt).VerifyClientCertificate(clientCrt)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject self-signed certificate", func(t *testing.T) {
+		// given
+		clientCrt := generateClientCertificate(t, nil, nil, x509.ExtKeyUsageClientAuth)
+
+		// when
+		err := newCertificateService(activeCACrt).VerifyClientCertificate(clientCrt)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+	})
+
+	t.Run("should reject certificate not valid for client authentication", func(t *testing.T) {
+		// given
+		clientCrt := generateClientCertificate(t, activeCACrt, activeCAKey, x509.ExtKeyUsageServerAuth)
+
+		// when
+		err := newCertificateService(activeCACrt).VerifyClientCertificate(clientCrt)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+	})
+
+	t.Run("should accept certificate signed by previous CA during overlap window", func(t *testing.T) {
+		// given
+		nextCaCrt, _ := generateCACertificate(t, "next", time.Now().Add(-time.Hour))
+		clientCrt := generateClientCertificate(t, activeCACrt, activeCAKey, x509.ExtKeyUsageClientAuth)
+
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nextCertsSecretData, nil).
+			On("Get", authNamespacedName).Return(certsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", nextCaCrtEncoded).Return(nextCaCrt, nil).
+			On("LoadCert", caCrtEncoded).Return(activeCACrt, nil)
+		certUtils.On("LoadKey", nextCaKeyEncoded).Return(nextCaKey, nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, rotationConfig)
+
+		// when
+		err := certificatesService.VerifyClientCertificate(clientCrt)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject certificate signed by previous CA after overlap window", func(t *testing.T) {
+		// given
+		nextCaCrt, _ := generateCACertificate(t, "next", time.Now().Add(-2*overlapWindow))
+		clientCrt := generateClientCertificate(t, activeCACrt, activeCAKey, x509.ExtKeyUsageClientAuth)
+
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", nextCANamespacedName).Return(nextCertsSecretData, nil)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", nextCaCrtEncoded).Return(nextCaCrt, nil)
+		certUtils.On("LoadKey", nextCaKeyEncoded).Return(nextCaKey, nil)
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, certUtils, authNamespacedName, types.NamespacedName{}, rotationConfig)
+
+		// when
+		err := certificatesService.VerifyClientCertificate(clientCrt)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+	})
+
+	t.Run("should return error when failed to read CA secret", func(t *testing.T) {
+		// given
+		clientCrt := generateClientCertificate(t, activeCACrt, activeCAKey, x509.ExtKeyUsageClientAuth)
+
+		secretsRepository := &secretsMock.Repository{}
+		secretsRepository.On("Get", authNamespacedName).Return(nil, apperrors.Internal("error"))
+
+		certificatesService := certificates.NewCertificateService(secretsRepository, &certificatesMocks.CertificateUtility{}, authNamespacedName, types.NamespacedName{}, certificates.RotationConfig{})
+
+		// when
+		err := certificatesService.VerifyClientCertificate(clientCrt)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func generateCACertificate(t *testing.T, commonName string, notBefore time.Time) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(365 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	rawCertificate, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(rawCertificate)
+	require.NoError(t, err)
+
+	return certificate, key
+}
+
+// generateClientCertificate signs the client certificate with the CA or creates self-signed one if the CA is not provided
+func generateClientCertificate(t *testing.T, caCrt *x509.Certificate, caKey *rsa.PrivateKey, extKeyUsage x509.ExtKeyUsage) *x509.Certificate {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: appName},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{extKeyUsage},
+	}
+
+	parent, signingKey := template, key
+	if caCrt != nil {
+		parent, signingKey = caCrt, caKey
+	}
+
+	rawCertificate, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), signingKey)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(rawCertificate)
+	require.NoError(t, err)
+
+	return certificate
+}
+
+func nextCACertificate(notBefore time.Time) *x509.Certificate {
+	return &x509.Certificate{
+		Raw:       []byte("nextCaCrt"),
+		Subject:   pkix.Name{CommonName: "next"},
+		NotBefore: notBefore,
+		NotAfter:  notBefore.Add(365 * 24 * time.Hour),
+	}
 }
 
 func decodeBase64(base64CrtChain string) ([]byte, error) {
@@ -0,0 +1,194 @@
+package director
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+const TenantHeader = "Tenant"
+
+const (
+	applicationsLookupPath = "/applications/"
+	runtimesLookupPath     = "/runtimes/"
+
+	reportApplicationPairingMutation = `mutation($id: ID!, $in: PairingReportInput!) { result: reportApplicationPairing(id: $id, in: $in) { id } }`
+	reportRuntimePairingMutation     = `mutation($id: ID!, $in: PairingReportInput!) { result: reportRuntimePairing(id: $id, in: $in) { id } }`
+)
+
+const (
+	ApplicationClient ClientType = "Application"
+	RuntimeClient     ClientType = "Runtime"
+)
+
+const (
+	PairingEventIssued  PairingEvent = "ISSUED"
+	PairingEventRenewed PairingEvent = "RENEWED"
+	PairingEventRevoked PairingEvent = "REVOKED"
+)
+
+// ClientType identifies the kind of Director entity the certificate was issued for
+type ClientType string
+
+// PairingEvent is the certificate lifecycle event reported to the Director
+type PairingEvent string
+
+// PairingReport describes the change of the client certificate of an Application or a Runtime
+type PairingReport struct {
+	ClientType   ClientType
+	ClientID     string
+	Event        PairingEvent
+	SerialNumber string
+	ExpiresAt    time.Time
+}
+
+//go:generate mockery -name=Client
+type Client interface {
+	ApplicationExists(tenant, id string) (bool, apperrors.AppError)
+	RuntimeExists(tenant, id string) (bool, apperrors.AppError)
+	ReportPairing(tenant string, report PairingReport) apperrors.AppError
+}
+
+type client struct {
+	url         string
+	internalURL string
+	httpClient  *http.Client
+}
+
+// NewClient creates client of the Director GraphQL API available under the given URL.
+// Applications and Runtimes are looked up with the internal API of the Director available under the internal URL.
+func NewClient(url, internalURL string, timeout time.Duration) Client {
+	return &client{
+		url:         url,
+		internalURL: strings.TrimSuffix(internalURL, "/"),
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type graphQLResponse struct {
+	Data struct {
+		Result *struct {
+			ID string `json:"id"`
+		} `json:"result"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (c *client) ApplicationExists(tenant, id string) (bool, apperrors.AppError) {
+	return c.exists(applicationsLookupPath, tenant, id)
+}
+
+func (c *client) RuntimeExists(tenant, id string) (bool, apperrors.AppError) {
+	return c.exists(runtimesLookupPath, tenant, id)
+}
+
+func (c *client) ReportPairing(tenant string, report PairingReport) apperrors.AppError {
+	var mutation string
+	switch report.ClientType {
+	case ApplicationClient:
+		mutation = reportApplicationPairingMutation
+	case RuntimeClient:
+		mutation = reportRuntimePairingMutation
+	default:
+		return apperrors.Internal("Unknown client type %s", report.ClientType)
+	}
+
+	input := map[string]interface{}{"event": report.Event}
+	if report.SerialNumber != "" {
+		input["serialNumber"] = report.SerialNumber
+	}
+	if report.Event != PairingEventRevoked {
+		input["expiresAt"] = report.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	response, appErr := c.do(mutation, tenant, map[string]interface{}{"id": report.ClientID, "in": input})
+	if appErr != nil {
+		return appErr
+	}
+
+	return responseError(response)
+}
+
+// exists looks up the object with the internal API, which responds with the 404 status code if the object
+// does not exist in the tenant
+func (c *client) exists(lookupPath, tenant, id string) (bool, apperrors.AppError) {
+	request, err := http.NewRequest(http.MethodGet, c.internalURL+lookupPath+url.PathEscape(id), nil)
+	if err != nil {
+		return false, apperrors.Internal("Failed to create Director request: %s", err.Error())
+	}
+	request.Header.Set(TenantHeader, tenant)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return false, apperrors.UpstreamServerCallFailed("Failed to call Director: %s", err.Error())
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, apperrors.UpstreamServerCallFailed("Director responded with unexpected status %d", response.StatusCode)
+	}
+}
+
+func responseError(response graphQLResponse) apperrors.AppError {
+	if len(response.Errors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(response.Errors))
+	for _, e := range response.Errors {
+		messages = append(messages, e.Message)
+	}
+
+	return apperrors.UpstreamServerCallFailed("Director returned errors: %s", strings.Join(messages, "; "))
+}
+
+func (c *client) do(query, tenant string, variables map[string]interface{}) (graphQLResponse, apperrors.AppError) {
+	body, err := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return graphQLResponse{}, apperrors.Internal("Failed to marshal Director request: %s", err.Error())
+	}
+
+	request, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return graphQLResponse{}, apperrors.Internal("Failed to create Director request: %s", err.Error())
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(TenantHeader, tenant)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return graphQLResponse{}, apperrors.UpstreamServerCallFailed("Failed to call Director: %s", err.Error())
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return graphQLResponse{}, apperrors.UpstreamServerCallFailed("Director responded with unexpected status %d", response.StatusCode)
+	}
+
+	var graphQLResp graphQLResponse
+	err = json.NewDecoder(response.Body).Decode(&graphQLResp)
+	if err != nil {
+		return graphQLResponse{}, apperrors.UpstreamServerCallFailed("Failed to decode Director response: %s", err.Error())
+	}
+
+	return graphQLResp, nil
+}
@@ -0,0 +1,242 @@
+package director_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/director"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tenant = "tenant"
+	id     = "id"
+)
+
+func TestClient_ApplicationExists(t *testing.T) {
+
+	t.Run("should return true when application exists", func(t *testing.T) {
+		// given
+		server := newLookupServer(t, "/applications/"+id, http.StatusOK)
+		defer server.Close()
+
+		client := director.NewClient("http://director", server.URL, time.Second)
+
+		// when
+		exists, err := client.ApplicationExists(tenant, id)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("should return false when application does not exist", func(t *testing.T) {
+		// given
+		server := newLookupServer(t, "/applications/"+id, http.StatusNotFound)
+		defer server.Close()
+
+		client := director.NewClient("http://director", server.URL, time.Second)
+
+		// when
+		exists, err := client.ApplicationExists(tenant, id)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("should return error when Director responded with unexpected status", func(t *testing.T) {
+		// given
+		server := newLookupServer(t, "/applications/"+id, http.StatusInternalServerError)
+		defer server.Close()
+
+		client := director.NewClient("http://director", server.URL, time.Second)
+
+		// when
+		_, err := client.ApplicationExists(tenant, id)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeUpstreamServerCallFailed, err.Code())
+	})
+}
+
+func TestClient_RuntimeExists(t *testing.T) {
+
+	t.Run("should return true when runtime exists", func(t *testing.T) {
+		// given
+		server := newLookupServer(t, "/runtimes/"+id, http.StatusOK)
+		defer server.Close()
+
+		client := director.NewClient("http://director", server.URL, time.Second)
+
+		// when
+		exists, err := client.RuntimeExists(tenant, id)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("should return false when runtime does not exist", func(t *testing.T) {
+		// given
+		server := newLookupServer(t, "/runtimes/"+id, http.StatusNotFound)
+		defer server.Close()
+
+		client := director.NewClient("http://director", server.URL, time.Second)
+
+		// when
+		exists, err := client.RuntimeExists(tenant, id)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestClient_ReportPairing(t *testing.T) {
+
+	expiresAt := time.Date(2019, 10, 3, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should report issued application certificate", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, `{"data":{"result":{"id":"id"}}}`, http.StatusOK)
+		defer server.Close()
+
+		client := director.NewClient(server.URL, "http://director", time.Second)
+
+		report := director.PairingReport{
+			ClientType:   director.ApplicationClient,
+			ClientID:     id,
+			Event:        director.PairingEventIssued,
+			SerialNumber: "1234",
+			ExpiresAt:    expiresAt,
+		}
+
+		// when
+		err := client.ReportPairing(tenant, report)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should report revoked runtime certificate", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, `{"data":{"result":{"id":"id"}}}`, http.StatusOK)
+		defer server.Close()
+
+		client := director.NewClient(server.URL, "http://director", time.Second)
+
+		report := director.PairingReport{
+			ClientType: director.RuntimeClient,
+			ClientID:   id,
+			Event:      director.PairingEventRevoked,
+		}
+
+		// when
+		err := client.ReportPairing(tenant, report)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should report serial number of revoked certificate", func(t *testing.T) {
+		// given
+		var input map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var request struct {
+				Variables struct {
+					In map[string]interface{} `json:"in"`
+				} `json:"variables"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&request)
+			require.NoError(t, err)
+			input = request.Variables.In
+
+			_, err = w.Write([]byte(`{"data":{"result":{"id":"id"}}}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		client := director.NewClient(server.URL, "http://director", time.Second)
+
+		report := director.PairingReport{
+			ClientType:   director.RuntimeClient,
+			ClientID:     id,
+			Event:        director.PairingEventRevoked,
+			SerialNumber: "1234",
+		}
+
+		// when
+		err := client.ReportPairing(tenant, report)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"event": "REVOKED", "serialNumber": "1234"}, input)
+	})
+
+	t.Run("should return error when Director returned errors", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, `{"data":{"result":null},"errors":[{"message":"error"}]}`, http.StatusOK)
+		defer server.Close()
+
+		client := director.NewClient(server.URL, "http://director", time.Second)
+
+		report := director.PairingReport{
+			ClientType: director.RuntimeClient,
+			ClientID:   id,
+			Event:      director.PairingEventRevoked,
+		}
+
+		// when
+		err := client.ReportPairing(tenant, report)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeUpstreamServerCallFailed, err.Code())
+	})
+
+	t.Run("should return error when client type is unknown", func(t *testing.T) {
+		// given
+		client := director.NewClient("http://director", "http://director", time.Second)
+
+		// when
+		err := client.ReportPairing(tenant, director.PairingReport{ClientID: id, Event: director.PairingEventRevoked})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func newDirectorServer(t *testing.T, response string, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, tenant, r.Header.Get(director.TenantHeader))
+
+		var request struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+		assert.Equal(t, id, request.Variables["id"])
+
+		w.WriteHeader(status)
+		_, err = w.Write([]byte(response))
+		require.NoError(t, err)
+	}))
+}
+
+func newLookupServer(t *testing.T, expectedPath string, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, expectedPath, r.URL.Path)
+		assert.Equal(t, tenant, r.Header.Get(director.TenantHeader))
+
+		w.WriteHeader(status)
+	}))
+}
@@ -0,0 +1,35 @@
+package inventory
+
+import (
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+)
+
+type clientCertificates struct {
+	inventoryService Service
+}
+
+// NewClientCertificates lists the certificates issued for the client from the inventory,
+// so that the revocation of the client is published in the Certificate Revocation List
+func NewClientCertificates(inventoryService Service) revocation.ClientCertificates {
+	return &clientCertificates{
+		inventoryService: inventoryService,
+	}
+}
+
+func (c *clientCertificates) List(clientID string) ([]revocation.IssuedCertificate, apperrors.AppError) {
+	issuedCertificates, err := c.inventoryService.List(Filter{ClientID: clientID})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]revocation.IssuedCertificate, 0, len(issuedCertificates))
+	for _, issuedCertificate := range issuedCertificates {
+		result = append(result, revocation.IssuedCertificate{
+			SerialNumber: issuedCertificate.SerialNumber,
+			NotBefore:    issuedCertificate.NotBefore,
+		})
+	}
+
+	return result, nil
+}
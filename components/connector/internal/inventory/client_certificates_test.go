@@ -0,0 +1,46 @@
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCertificates_List(t *testing.T) {
+
+	t.Run("should list certificates issued for client", func(t *testing.T) {
+		// given
+		inventoryService := &mocks.Service{}
+		inventoryService.On("List", inventory.Filter{ClientID: "app-id"}).Return([]inventory.IssuedCertificate{
+			{SerialNumber: "4d2", ClientID: "app-id", NotBefore: notBefore, NotAfter: notAfter},
+		}, nil)
+
+		clientCertificates := inventory.NewClientCertificates(inventoryService)
+
+		// when
+		issued, err := clientCertificates.List("app-id")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []revocation.IssuedCertificate{{SerialNumber: "4d2", NotBefore: notBefore}}, issued)
+	})
+
+	t.Run("should return error when failed to list issued certificates", func(t *testing.T) {
+		// given
+		inventoryService := &mocks.Service{}
+		inventoryService.On("List", inventory.Filter{ClientID: "app-id"}).Return(nil, apperrors.Internal("error"))
+
+		clientCertificates := inventory.NewClientCertificates(inventoryService)
+
+		// when
+		_, err := clientCertificates.List("app-id")
+
+		// then
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+package inventory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+type inMemoryRepository struct {
+	mutex        sync.RWMutex
+	certificates map[string]IssuedCertificate
+}
+
+// NewInMemoryRepository creates a new issued certificates repository keeping entries in memory
+// the inventory is lost on restart, so it is meant for local development and tests only
+func NewInMemoryRepository() Repository {
+	return &inMemoryRepository{
+		certificates: map[string]IssuedCertificate{},
+	}
+}
+
+// Insert adds the certificate to the inventory, keeping the existing entry if the serial number is already there
+func (r *inMemoryRepository) Insert(certificate IssuedCertificate) apperrors.AppError {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.certificates[certificate.SerialNumber]; !exists {
+		r.certificates[certificate.SerialNumber] = certificate
+	}
+
+	return nil
+}
+
+// List returns the certificates matching the filter ordered by the expiration time
+func (r *inMemoryRepository) List(filter Filter) ([]IssuedCertificate, apperrors.AppError) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	result := make([]IssuedCertificate, 0)
+	for _, certificate := range r.certificates {
+		if filter.Matches(certificate) {
+			result = append(result, certificate)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].NotAfter.Equal(result[j].NotAfter) {
+			return result[i].SerialNumber < result[j].SerialNumber
+		}
+		return result[i].NotAfter.Before(result[j].NotAfter)
+	})
+
+	return result, nil
+}
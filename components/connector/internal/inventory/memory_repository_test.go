@@ -0,0 +1,52 @@
+package inventory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryRepository(t *testing.T) {
+
+	t.Run("should list matching certificates ordered by expiration time", func(t *testing.T) {
+		// given
+		repository := inventory.NewInMemoryRepository()
+
+		later := inventory.IssuedCertificate{SerialNumber: "1", ClientID: "runtime-id", NotAfter: notAfter}
+		sooner := inventory.IssuedCertificate{SerialNumber: "2", ClientID: "runtime-id", NotAfter: now.Add(time.Hour)}
+		other := inventory.IssuedCertificate{SerialNumber: "3", ClientID: "app-id", NotAfter: now.Add(time.Hour)}
+
+		for _, certificate := range []inventory.IssuedCertificate{later, sooner, other} {
+			err := repository.Insert(certificate)
+			require.NoError(t, err)
+		}
+
+		// when
+		result, err := repository.List(inventory.Filter{ClientID: "runtime-id"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []inventory.IssuedCertificate{sooner, later}, result)
+	})
+
+	t.Run("should keep existing certificate with the same serial number", func(t *testing.T) {
+		// given
+		repository := inventory.NewInMemoryRepository()
+
+		err := repository.Insert(inventory.IssuedCertificate{SerialNumber: "4d2", ClientID: "runtime-id"})
+		require.NoError(t, err)
+
+		// when
+		err = repository.Insert(inventory.IssuedCertificate{SerialNumber: "4d2", ClientID: "app-id"})
+
+		// then
+		require.NoError(t, err)
+		result, err := repository.List(inventory.Filter{})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "runtime-id", result[0].ClientID)
+	})
+}
@@ -0,0 +1,146 @@
+package inventory
+
+import (
+	"crypto/x509"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/kyma-incubator/compass/components/connector/pkg/gqlschema"
+)
+
+const (
+	applicationClientType = "Application"
+	runtimeClientType     = "Runtime"
+
+	day = 24 * time.Hour
+)
+
+// IssuedCertificate describes the client certificate signed by the Connector
+type IssuedCertificate struct {
+	// SerialNumber is hex encoded, the same way as in the revocation list
+	SerialNumber string
+	Subject      string
+	ClientID     string
+	// ClientType is Application or Runtime, empty if the certificate does not carry the client type
+	ClientType string
+	Tenant     string
+	NotBefore  time.Time
+	NotAfter   time.Time
+}
+
+func NewIssuedCertificate(certificate *x509.Certificate) IssuedCertificate {
+	return IssuedCertificate{
+		SerialNumber: revocation.FormatSerialNumber(certificate.SerialNumber),
+		Subject:      certificate.Subject.String(),
+		ClientID:     certificate.Subject.CommonName,
+		ClientType:   certificates.ClientTypeFromCertificate(certificate),
+		Tenant:       certificates.TenantFromCertificate(certificate),
+		NotBefore:    certificate.NotBefore.UTC(),
+		NotAfter:     certificate.NotAfter.UTC(),
+	}
+}
+
+// Filter selects the issued certificates, empty fields match all certificates
+type Filter struct {
+	SerialNumber string
+	ClientID     string
+	ClientType   string
+	Tenant       string
+	// ExpiresAfter and ExpiresBefore limit the expiration time of the certificates, zero values are not applied
+	ExpiresAfter  time.Time
+	ExpiresBefore time.Time
+}
+
+// Matches checks if the certificate meets all the filter conditions
+func (f Filter) Matches(certificate IssuedCertificate) bool {
+	if f.SerialNumber != "" && f.SerialNumber != certificate.SerialNumber {
+		return false
+	}
+
+	if f.ClientID != "" && f.ClientID != certificate.ClientID {
+		return false
+	}
+
+	if f.ClientType != "" && f.ClientType != certificate.ClientType {
+		return false
+	}
+
+	if f.Tenant != "" && f.Tenant != certificate.Tenant {
+		return false
+	}
+
+	if !f.ExpiresAfter.IsZero() && !certificate.NotAfter.After(f.ExpiresAfter) {
+		return false
+	}
+
+	if !f.ExpiresBefore.IsZero() && certificate.NotAfter.After(f.ExpiresBefore) {
+		return false
+	}
+
+	return true
+}
+
+// NewFilter converts the GraphQL filter, the certificates expiring within the given number of days
+// are the ones which are still valid at the time passed as now
+func NewFilter(in *gqlschema.IssuedCertificateFilter, now time.Time) (Filter, apperrors.AppError) {
+	filter := Filter{}
+	if in == nil {
+		return filter, nil
+	}
+
+	if in.ClientID != nil {
+		filter.ClientID = *in.ClientID
+	}
+
+	if in.ClientType != nil {
+		switch *in.ClientType {
+		case gqlschema.ClientTypeApplication:
+			filter.ClientType = applicationClientType
+		case gqlschema.ClientTypeRuntime:
+			filter.ClientType = runtimeClientType
+		}
+	}
+
+	if in.Tenant != nil {
+		filter.Tenant = *in.Tenant
+	}
+
+	if in.ExpiringWithinDays != nil {
+		if *in.ExpiringWithinDays < 0 {
+			return Filter{}, apperrors.WrongInput("Number of days cannot be negative")
+		}
+
+		filter.ExpiresAfter = now.UTC()
+		filter.ExpiresBefore = now.UTC().Add(time.Duration(*in.ExpiringWithinDays) * day)
+	}
+
+	return filter, nil
+}
+
+func ToIssuedCertificate(certificate IssuedCertificate) *gqlschema.IssuedCertificate {
+	result := &gqlschema.IssuedCertificate{
+		SerialNumber: certificate.SerialNumber,
+		Subject:      certificate.Subject,
+		ClientID:     certificate.ClientID,
+		NotBefore:    certificate.NotBefore.UTC().Format(time.RFC3339),
+		NotAfter:     certificate.NotAfter.UTC().Format(time.RFC3339),
+	}
+
+	switch certificate.ClientType {
+	case applicationClientType:
+		clientType := gqlschema.ClientTypeApplication
+		result.ClientType = &clientType
+	case runtimeClientType:
+		clientType := gqlschema.ClientTypeRuntime
+		result.ClientType = &clientType
+	}
+
+	if certificate.Tenant != "" {
+		tenant := certificate.Tenant
+		result.Tenant = &tenant
+	}
+
+	return result
+}
@@ -0,0 +1,163 @@
+package inventory_test
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	now       = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	notBefore = now.Add(-time.Hour)
+	notAfter  = now.Add(90 * 24 * time.Hour)
+)
+
+func TestNewIssuedCertificate(t *testing.T) {
+	// given
+	certificate := &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: "runtime-id", Organization: []string{"Org"}},
+		URIs:         []*url.URL{certificates.TenantURI("tenant"), certificates.ClientTypeURI("Runtime")},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	// when
+	issuedCertificate := inventory.NewIssuedCertificate(certificate)
+
+	// then
+	assert.Equal(t, inventory.IssuedCertificate{
+		SerialNumber: "4d2",
+		Subject:      "CN=runtime-id,O=Org",
+		ClientID:     "runtime-id",
+		ClientType:   "Runtime",
+		Tenant:       "tenant",
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}, issuedCertificate)
+}
+
+func TestFilter_Matches(t *testing.T) {
+
+	certificate := inventory.IssuedCertificate{
+		SerialNumber: "4d2",
+		ClientID:     "runtime-id",
+		ClientType:   "Runtime",
+		Tenant:       "tenant",
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	for _, testCase := range []struct {
+		description string
+		filter      inventory.Filter
+		matches     bool
+	}{
+		{description: "empty filter", filter: inventory.Filter{}, matches: true},
+		{description: "all fields", filter: inventory.Filter{SerialNumber: "4d2", ClientID: "runtime-id", ClientType: "Runtime", Tenant: "tenant", ExpiresAfter: now, ExpiresBefore: notAfter}, matches: true},
+		{description: "other serial number", filter: inventory.Filter{SerialNumber: "4d3"}, matches: false},
+		{description: "other client", filter: inventory.Filter{ClientID: "app-id"}, matches: false},
+		{description: "other client type", filter: inventory.Filter{ClientType: "Application"}, matches: false},
+		{description: "other tenant", filter: inventory.Filter{Tenant: "other"}, matches: false},
+		{description: "expired before", filter: inventory.Filter{ExpiresAfter: notAfter}, matches: false},
+		{description: "expires later", filter: inventory.Filter{ExpiresBefore: notAfter.Add(-time.Second)}, matches: false},
+	} {
+		t.Run("should check "+testCase.description, func(t *testing.T) {
+			assert.Equal(t, testCase.matches, testCase.filter.Matches(certificate))
+		})
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+
+	t.Run("should convert filter", func(t *testing.T) {
+		// given
+		clientID := "app-id"
+		clientType := gqlschema.ClientTypeApplication
+		tenant := "tenant"
+		days := 7
+
+		// when
+		filter, err := inventory.NewFilter(&gqlschema.IssuedCertificateFilter{
+			ClientID:           &clientID,
+			ClientType:         &clientType,
+			Tenant:             &tenant,
+			ExpiringWithinDays: &days,
+		}, now)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, inventory.Filter{
+			ClientID:      "app-id",
+			ClientType:    "Application",
+			Tenant:        "tenant",
+			ExpiresAfter:  now,
+			ExpiresBefore: now.Add(7 * 24 * time.Hour),
+		}, filter)
+	})
+
+	t.Run("should return empty filter when filter not provided", func(t *testing.T) {
+		// when
+		filter, err := inventory.NewFilter(nil, now)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, inventory.Filter{}, filter)
+	})
+
+	t.Run("should return error when number of days is negative", func(t *testing.T) {
+		// given
+		days := -1
+
+		// when
+		_, err := inventory.NewFilter(&gqlschema.IssuedCertificateFilter{ExpiringWithinDays: &days}, now)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+	})
+}
+
+func TestToIssuedCertificate(t *testing.T) {
+
+	t.Run("should convert certificate", func(t *testing.T) {
+		// when
+		result := inventory.ToIssuedCertificate(inventory.IssuedCertificate{
+			SerialNumber: "4d2",
+			Subject:      "CN=runtime-id",
+			ClientID:     "runtime-id",
+			ClientType:   "Runtime",
+			Tenant:       "tenant",
+			NotBefore:    notBefore,
+			NotAfter:     notAfter,
+		})
+
+		// then
+		require.NotNil(t, result.ClientType)
+		assert.Equal(t, gqlschema.ClientTypeRuntime, *result.ClientType)
+		require.NotNil(t, result.Tenant)
+		assert.Equal(t, "tenant", *result.Tenant)
+		assert.Equal(t, "4d2", result.SerialNumber)
+		assert.Equal(t, "2019-10-01T11:00:00Z", result.NotBefore)
+		assert.Equal(t, "2019-12-30T12:00:00Z", result.NotAfter)
+	})
+
+	t.Run("should leave unknown client type and tenant empty", func(t *testing.T) {
+		// when
+		result := inventory.ToIssuedCertificate(inventory.IssuedCertificate{SerialNumber: "4d2", ClientID: "app-id"})
+
+		// then
+		assert.Nil(t, result.ClientType)
+		assert.Nil(t, result.Tenant)
+	})
+}
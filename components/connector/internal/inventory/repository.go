@@ -0,0 +1,105 @@
+package inventory
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+const (
+	insertCertificateQuery  = `INSERT INTO connector_issued_certificates (serial_number, subject, client_id, client_type, tenant, not_before, not_after) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (serial_number) DO NOTHING`
+	selectCertificatesQuery = `SELECT serial_number, subject, client_id, client_type, tenant, not_before, not_after FROM connector_issued_certificates`
+	orderCertificatesClause = ` ORDER BY not_after, serial_number`
+)
+
+//go:generate mockery -name=Repository
+type Repository interface {
+	Insert(certificate IssuedCertificate) apperrors.AppError
+	List(filter Filter) ([]IssuedCertificate, apperrors.AppError)
+}
+
+type postgresRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresRepository creates a new issued certificates repository persisting entries in the connector_issued_certificates table
+func NewPostgresRepository(db *sql.DB) Repository {
+	return &postgresRepository{db: db}
+}
+
+func (r *postgresRepository) Insert(certificate IssuedCertificate) apperrors.AppError {
+	_, err := r.db.Exec(insertCertificateQuery, certificate.SerialNumber, certificate.Subject, certificate.ClientID,
+		certificate.ClientType, certificate.Tenant, certificate.NotBefore.UTC(), certificate.NotAfter.UTC())
+	if err != nil {
+		return apperrors.Internal("Failed to store issued certificate: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) List(filter Filter) ([]IssuedCertificate, apperrors.AppError) {
+	query, args := listQuery(filter)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, apperrors.Internal("Failed to list issued certificates: %s", err.Error())
+	}
+	defer rows.Close()
+
+	result := make([]IssuedCertificate, 0)
+	for rows.Next() {
+		var certificate IssuedCertificate
+
+		err := rows.Scan(&certificate.SerialNumber, &certificate.Subject, &certificate.ClientID, &certificate.ClientType,
+			&certificate.Tenant, &certificate.NotBefore, &certificate.NotAfter)
+		if err != nil {
+			return nil, apperrors.Internal("Failed to read issued certificate: %s", err.Error())
+		}
+
+		result = append(result, certificate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.Internal("Failed to list issued certificates: %s", err.Error())
+	}
+
+	return result, nil
+}
+
+func listQuery(filter Filter) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.SerialNumber != "" {
+		addCondition("serial_number = $%d", filter.SerialNumber)
+	}
+	if filter.ClientID != "" {
+		addCondition("client_id = $%d", filter.ClientID)
+	}
+	if filter.ClientType != "" {
+		addCondition("client_type = $%d", filter.ClientType)
+	}
+	if filter.Tenant != "" {
+		addCondition("tenant = $%d", filter.Tenant)
+	}
+	if !filter.ExpiresAfter.IsZero() {
+		addCondition("not_after > $%d", filter.ExpiresAfter.UTC())
+	}
+	if !filter.ExpiresBefore.IsZero() {
+		addCondition("not_after <= $%d", filter.ExpiresBefore.UTC())
+	}
+
+	query := selectCertificatesQuery
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	return query + orderCertificatesClause, args
+}
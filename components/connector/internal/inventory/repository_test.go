@@ -0,0 +1,169 @@
+package inventory
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testNotBefore = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	testNotAfter  = testNotBefore.Add(90 * 24 * time.Hour)
+
+	columns = []string{"serial_number", "subject", "client_id", "client_type", "tenant", "not_before", "not_after"}
+)
+
+func TestPostgresRepository_Insert(t *testing.T) {
+
+	t.Run("should store certificate", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(insertCertificateQuery)).
+			WithArgs("4d2", "CN=runtime-id", "runtime-id", "Runtime", "tenant", testNotBefore, testNotAfter).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.Insert(IssuedCertificate{
+			SerialNumber: "4d2",
+			Subject:      "CN=runtime-id",
+			ClientID:     "runtime-id",
+			ClientType:   "Runtime",
+			Tenant:       "tenant",
+			NotBefore:    testNotBefore,
+			NotAfter:     testNotAfter,
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when failed to store certificate", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(insertCertificateQuery)).
+			WillReturnError(errors.New("error"))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.Insert(IssuedCertificate{SerialNumber: "4d2"})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func TestPostgresRepository_List(t *testing.T) {
+
+	t.Run("should list certificates matching filter", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		expiresBefore := testNotBefore.Add(7 * 24 * time.Hour)
+		query := selectCertificatesQuery + " WHERE client_type = $1 AND tenant = $2 AND not_after > $3 AND not_after <= $4" + orderCertificatesClause
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs("Runtime", "tenant", testNotBefore, expiresBefore).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("4d2", "CN=runtime-id", "runtime-id", "Runtime", "tenant", testNotBefore, testNotAfter))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		result, err := repository.List(Filter{ClientType: "Runtime", Tenant: "tenant", ExpiresAfter: testNotBefore, ExpiresBefore: expiresBefore})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []IssuedCertificate{{
+			SerialNumber: "4d2",
+			Subject:      "CN=runtime-id",
+			ClientID:     "runtime-id",
+			ClientType:   "Runtime",
+			Tenant:       "tenant",
+			NotBefore:    testNotBefore,
+			NotAfter:     testNotAfter,
+		}}, result)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("should list certificate with serial number", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		query := selectCertificatesQuery + " WHERE serial_number = $1" + orderCertificatesClause
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs("4d2").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("4d2", "CN=runtime-id", "runtime-id", "Runtime", "tenant", testNotBefore, testNotAfter))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		result, err := repository.List(Filter{SerialNumber: "4d2"})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "runtime-id", result[0].ClientID)
+	})
+
+	t.Run("should list all certificates when filter is empty", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(selectCertificatesQuery + orderCertificatesClause)).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		result, err := repository.List(Filter{})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when failed to list certificates", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(selectCertificatesQuery)).
+			WillReturnError(errors.New("error"))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		_, err := repository.List(Filter{ClientID: "runtime-id"})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func newDBMock(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	return db, dbMock
+}
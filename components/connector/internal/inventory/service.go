@@ -0,0 +1,37 @@
+package inventory
+
+import (
+	"crypto/x509"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+//go:generate mockery -name=Service
+type Service interface {
+	// Record adds the client certificate signed by the Connector to the inventory
+	Record(certificate *x509.Certificate) apperrors.AppError
+	// List returns the issued certificates matching the filter, ordered by the expiration time
+	List(filter Filter) ([]IssuedCertificate, apperrors.AppError)
+}
+
+type inventoryService struct {
+	repository Repository
+}
+
+func NewInventoryService(repository Repository) Service {
+	return &inventoryService{
+		repository: repository,
+	}
+}
+
+func (svc *inventoryService) Record(certificate *x509.Certificate) apperrors.AppError {
+	if certificate == nil || certificate.SerialNumber == nil {
+		return apperrors.BadRequest("Certificate without serial number cannot be recorded")
+	}
+
+	return svc.repository.Insert(NewIssuedCertificate(certificate))
+}
+
+func (svc *inventoryService) List(filter Filter) ([]IssuedCertificate, apperrors.AppError) {
+	return svc.repository.List(filter)
+}
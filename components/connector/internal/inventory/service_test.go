@@ -0,0 +1,73 @@
+package inventory_test
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryService_Record(t *testing.T) {
+
+	t.Run("should record certificate", func(t *testing.T) {
+		// given
+		certificate := &x509.Certificate{
+			SerialNumber: big.NewInt(1234),
+			Subject:      pkix.Name{CommonName: "app-id"},
+			NotBefore:    notBefore,
+			NotAfter:     notAfter,
+		}
+
+		repository := &mocks.Repository{}
+		repository.On("Insert", inventory.NewIssuedCertificate(certificate)).Return(nil)
+
+		service := inventory.NewInventoryService(repository)
+
+		// when
+		err := service.Record(certificate)
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when certificate has no serial number", func(t *testing.T) {
+		// given
+		service := inventory.NewInventoryService(&mocks.Repository{})
+
+		// when
+		err := service.Record(&x509.Certificate{})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+}
+
+func TestInventoryService_List(t *testing.T) {
+
+	t.Run("should list certificates", func(t *testing.T) {
+		// given
+		filter := inventory.Filter{ClientID: "app-id"}
+		issuedCertificates := []inventory.IssuedCertificate{{SerialNumber: "4d2", ClientID: "app-id"}}
+
+		repository := &mocks.Repository{}
+		repository.On("List", filter).Return(issuedCertificates, nil)
+
+		service := inventory.NewInventoryService(repository)
+
+		// when
+		result, err := service.List(filter)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, issuedCertificates, result)
+		repository.AssertExpectations(t)
+	})
+}
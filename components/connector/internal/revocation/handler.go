@@ -0,0 +1,133 @@
+package revocation
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/sirupsen/logrus"
+)
+
+const crlContentType = "application/pkix-crl"
+
+// CheckRequest contains PEM encoded certificate which revocation status should be checked
+type CheckRequest struct {
+	Certificate string `json:"certificate"`
+}
+
+// CheckResponse contains revocation status of the certificate
+type CheckResponse struct {
+	Revoked bool `json:"revoked"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type Handler interface {
+	CRL(w http.ResponseWriter, r *http.Request)
+	PreviousCRL(w http.ResponseWriter, r *http.Request)
+	CheckRevocation(w http.ResponseWriter, r *http.Request)
+}
+
+type handler struct {
+	revocationService Service
+	log               *logrus.Entry
+}
+
+func NewHandler(revocationService Service) Handler {
+	return &handler{
+		revocationService: revocationService,
+		log:               logrus.WithField("Handler", "Revocation"),
+	}
+}
+
+// CRL serves DER encoded Certificate Revocation List
+func (h *handler) CRL(w http.ResponseWriter, r *http.Request) {
+	h.writeCRL(w, h.revocationService.CRL)
+}
+
+// PreviousCRL serves DER encoded Certificate Revocation List signed with the previous CA during the rotation overlap window
+func (h *handler) PreviousCRL(w http.ResponseWriter, r *http.Request) {
+	h.writeCRL(w, h.revocationService.PreviousCRL)
+}
+
+// CheckRevocation responds whether the certificate provided in the request body was revoked
+func (h *handler) CheckRevocation(w http.ResponseWriter, r *http.Request) {
+	var request CheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		h.writeError(w, apperrors.BadRequest("Failed to decode request body: %s", err))
+		return
+	}
+
+	certificate, appErr := parseCertificate(request.Certificate)
+	if appErr != nil {
+		h.writeError(w, appErr)
+		return
+	}
+
+	revoked, appErr := h.revocationService.IsRevoked(certificate)
+	if appErr != nil {
+		h.log.Errorf("Failed to check certificate revocation: %s", appErr.Error())
+		h.writeError(w, appErr)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, CheckResponse{Revoked: revoked})
+}
+
+func (h *handler) writeCRL(w http.ResponseWriter, createCRL func() ([]byte, apperrors.AppError)) {
+	crl, err := createCRL()
+	if err != nil {
+		if err.Code() != apperrors.CodeNotFound {
+			h.log.Errorf("Failed to create CRL: %s", err.Error())
+		}
+		h.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", crlContentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(crl); err != nil {
+		h.log.Errorf("Failed to write CRL: %s", err.Error())
+	}
+}
+
+func (h *handler) writeError(w http.ResponseWriter, appErr apperrors.AppError) {
+	h.writeJSON(w, httpStatus(appErr), errorResponse{Error: appErr.Error()})
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		h.log.Errorf("Failed to write response: %s", err.Error())
+	}
+}
+
+func httpStatus(appErr apperrors.AppError) int {
+	switch appErr.Code() {
+	case apperrors.CodeBadRequest, apperrors.CodeWrongInput:
+		return http.StatusBadRequest
+	case apperrors.CodeNotFound:
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func parseCertificate(encodedCertificate string) (*x509.Certificate, apperrors.AppError) {
+	pemBlock, _ := pem.Decode([]byte(encodedCertificate))
+	if pemBlock == nil {
+		return nil, apperrors.BadRequest("Error while decoding certificate pem block")
+	}
+
+	certificate, err := x509.ParseCertificate(pemBlock.Bytes)
+	if err != nil {
+		return nil, apperrors.BadRequest("Error while parsing certificate: %s", err)
+	}
+
+	return certificate, nil
+}
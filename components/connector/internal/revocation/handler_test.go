@@ -0,0 +1,184 @@
+package revocation_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_CRL(t *testing.T) {
+	t.Run("should serve CRL", func(t *testing.T) {
+		// given
+		crl := []byte("crl")
+
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("CRL").Return(crl, nil)
+
+		handler := revocation.NewHandler(revocationSvc)
+
+		req := httptest.NewRequest(http.MethodGet, "/crl", nil)
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.CRL(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/pkix-crl", rr.Header().Get("Content-Type"))
+		assert.Equal(t, crl, rr.Body.Bytes())
+	})
+
+	t.Run("should return error when failed to create CRL", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("CRL").Return(nil, apperrors.Internal("error"))
+
+		handler := revocation.NewHandler(revocationSvc)
+
+		req := httptest.NewRequest(http.MethodGet, "/crl", nil)
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.CRL(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestHandler_PreviousCRL(t *testing.T) {
+	t.Run("should serve CRL signed with previous CA", func(t *testing.T) {
+		// given
+		crl := []byte("crl")
+
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("PreviousCRL").Return(crl, nil)
+
+		handler := revocation.NewHandler(revocationSvc)
+
+		req := httptest.NewRequest(http.MethodGet, "/crl/previous", nil)
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.PreviousCRL(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/pkix-crl", rr.Header().Get("Content-Type"))
+		assert.Equal(t, crl, rr.Body.Bytes())
+	})
+
+	t.Run("should return Not Found when previous CA is not in use", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("PreviousCRL").Return(nil, apperrors.NotFound("error"))
+
+		handler := revocation.NewHandler(revocationSvc)
+
+		req := httptest.NewRequest(http.MethodGet, "/crl/previous", nil)
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.PreviousCRL(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestHandler_CheckRevocation(t *testing.T) {
+
+	pemCertificate := generateCertificate(t)
+
+	t.Run("should return revocation status", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("IsRevoked", mock.AnythingOfType("*x509.Certificate")).Return(true, nil)
+
+		handler := revocation.NewHandler(revocationSvc)
+
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.CheckRevocation(rr, checkRequest(t, pemCertificate))
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response revocation.CheckResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		assert.True(t, response.Revoked)
+	})
+
+	t.Run("should return Bad Request when certificate is invalid", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+
+		handler := revocation.NewHandler(revocationSvc)
+
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.CheckRevocation(rr, checkRequest(t, "invalid"))
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		revocationSvc.AssertNotCalled(t, "IsRevoked", mock.Anything)
+	})
+
+	t.Run("should return error when failed to check revocation", func(t *testing.T) {
+		// given
+		revocationSvc := &mocks.Service{}
+		revocationSvc.On("IsRevoked", mock.AnythingOfType("*x509.Certificate")).Return(false, apperrors.Internal("error"))
+
+		handler := revocation.NewHandler(revocationSvc)
+
+		rr := httptest.NewRecorder()
+
+		// when
+		handler.CheckRevocation(rr, checkRequest(t, pemCertificate))
+
+		// then
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func checkRequest(t *testing.T, certificate string) *http.Request {
+	body, err := json.Marshal(revocation.CheckRequest{Certificate: certificate})
+	require.NoError(t, err)
+
+	return httptest.NewRequest(http.MethodPost, "/revocation/check", bytes.NewReader(body))
+}
+
+func generateCertificate(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: "app-id"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	rawCertificate, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rawCertificate}))
+}
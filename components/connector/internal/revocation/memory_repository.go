@@ -0,0 +1,65 @@
+package revocation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+type inMemoryRepository struct {
+	mutex         sync.RWMutex
+	serialNumbers map[string]time.Time
+	clients       map[string]time.Time
+}
+
+// NewInMemoryRepository creates a new revocation list repository keeping entries in memory
+// the list is lost on restart, so it is meant for local development and tests only
+func NewInMemoryRepository() Repository {
+	return &inMemoryRepository{
+		serialNumbers: map[string]time.Time{},
+		clients:       map[string]time.Time{},
+	}
+}
+
+func (r *inMemoryRepository) Get() (List, apperrors.AppError) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return List{
+		SerialNumbers: copyEntries(r.serialNumbers),
+		Clients:       copyEntries(r.clients),
+	}, nil
+}
+
+// InsertSerialNumber adds serial number to the revocation list, keeping the original revocation time if it was already revoked
+func (r *inMemoryRepository) InsertSerialNumber(serialNumber string, revokedAt time.Time) apperrors.AppError {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.serialNumbers[serialNumber]; !exists {
+		r.serialNumbers[serialNumber] = revokedAt.UTC()
+	}
+
+	return nil
+}
+
+// InsertClient adds client to the revocation list, overriding the previous revocation time so that certificates
+// issued since then are revoked as well
+func (r *inMemoryRepository) InsertClient(clientID string, revokedAt time.Time) apperrors.AppError {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.clients[clientID] = revokedAt.UTC()
+
+	return nil
+}
+
+func copyEntries(entries map[string]time.Time) map[string]time.Time {
+	result := make(map[string]time.Time, len(entries))
+	for key, value := range entries {
+		result[key] = value
+	}
+
+	return result
+}
@@ -0,0 +1,47 @@
+package revocation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryRepository(t *testing.T) {
+
+	revokedAt := time.Date(2019, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should keep original revocation time of serial number", func(t *testing.T) {
+		// given
+		repository := revocation.NewInMemoryRepository()
+
+		// when
+		err := repository.InsertSerialNumber("4d2", revokedAt)
+		require.NoError(t, err)
+		err = repository.InsertSerialNumber("4d2", revokedAt.Add(time.Hour))
+		require.NoError(t, err)
+
+		// then
+		list, err := repository.Get()
+		require.NoError(t, err)
+		assert.Equal(t, revokedAt, list.SerialNumbers["4d2"])
+	})
+
+	t.Run("should override revocation time of client", func(t *testing.T) {
+		// given
+		repository := revocation.NewInMemoryRepository()
+
+		// when
+		err := repository.InsertClient("app-id", revokedAt)
+		require.NoError(t, err)
+		err = repository.InsertClient("app-id", revokedAt.Add(time.Hour))
+		require.NoError(t, err)
+
+		// then
+		list, err := repository.Get()
+		require.NoError(t, err)
+		assert.Equal(t, revokedAt.Add(time.Hour), list.Clients["app-id"])
+	})
+}
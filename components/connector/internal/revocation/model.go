@@ -0,0 +1,108 @@
+package revocation
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+// List contains revoked certificates serial numbers and clients which certificates were revoked,
+// both mapped to the time of revocation
+type List struct {
+	SerialNumbers map[string]time.Time
+	Clients       map[string]time.Time
+}
+
+// IsRevoked checks if the certificate serial number was revoked or if the certificate was issued
+// for the client before all its certificates were revoked
+func (l List) IsRevoked(certificate *x509.Certificate) bool {
+	if _, revoked := l.SerialNumbers[FormatSerialNumber(certificate.SerialNumber)]; revoked {
+		return true
+	}
+
+	revokedAt, revoked := l.Clients[certificate.Subject.CommonName]
+	if !revoked {
+		return false
+	}
+
+	return !certificate.NotBefore.After(revokedAt)
+}
+
+// IssuedCertificate identifies the certificate issued for the client
+type IssuedCertificate struct {
+	SerialNumber string
+	NotBefore    time.Time
+}
+
+// RevokedCertificates returns the entries of Certificate Revocation List sorted by revocation time and serial number. The certificates
+// issued for the revoked clients before their revocation are listed with the time of the client revocation
+func (l List) RevokedCertificates(clientCertificates map[string][]IssuedCertificate) []pkix.RevokedCertificate {
+	revokedAtBySerialNumber := make(map[string]time.Time, len(l.SerialNumbers))
+	for serialNumber, revokedAt := range l.SerialNumbers {
+		revokedAtBySerialNumber[serialNumber] = revokedAt
+	}
+
+	for clientID, revokedAt := range l.Clients {
+		for _, certificate := range clientCertificates[clientID] {
+			if certificate.NotBefore.After(revokedAt) {
+				continue
+			}
+
+			if serialRevokedAt, revoked := revokedAtBySerialNumber[certificate.SerialNumber]; revoked && serialRevokedAt.Before(revokedAt) {
+				continue
+			}
+
+			revokedAtBySerialNumber[certificate.SerialNumber] = revokedAt
+		}
+	}
+
+	revokedCertificates := make([]pkix.RevokedCertificate, 0, len(revokedAtBySerialNumber))
+
+	for serialNumber, revokedAt := range revokedAtBySerialNumber {
+		parsed, err := ParseSerialNumber(serialNumber)
+		if err != nil {
+			continue
+		}
+
+		revokedCertificates = append(revokedCertificates, pkix.RevokedCertificate{
+			SerialNumber:   parsed,
+			RevocationTime: revokedAt,
+		})
+	}
+
+	sort.Slice(revokedCertificates, func(i, j int) bool {
+		if !revokedCertificates[i].RevocationTime.Equal(revokedCertificates[j].RevocationTime) {
+			return revokedCertificates[i].RevocationTime.Before(revokedCertificates[j].RevocationTime)
+		}
+
+		return revokedCertificates[i].SerialNumber.Cmp(revokedCertificates[j].SerialNumber) < 0
+	})
+
+	return revokedCertificates
+}
+
+// FormatSerialNumber returns serial number as lower case hex string
+func FormatSerialNumber(serialNumber *big.Int) string {
+	if serialNumber == nil {
+		return ""
+	}
+
+	return serialNumber.Text(16)
+}
+
+// ParseSerialNumber parses hex encoded serial number, optionally separated with colons
+func ParseSerialNumber(serialNumber string) (*big.Int, apperrors.AppError) {
+	normalized := strings.ToLower(strings.Replace(serialNumber, ":", "", -1))
+
+	parsed, ok := new(big.Int).SetString(normalized, 16)
+	if !ok || parsed.Sign() <= 0 {
+		return nil, apperrors.WrongInput("Invalid serial number %s provided, expected hex encoded positive number", serialNumber)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,140 @@
+package revocation
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_IsRevoked(t *testing.T) {
+
+	revokedAt := time.Now()
+
+	list := List{
+		SerialNumbers: map[string]time.Time{"4d2": revokedAt},
+		Clients:       map[string]time.Time{"app-id": revokedAt},
+	}
+
+	t.Run("should return true when serial number was revoked", func(t *testing.T) {
+		// given
+		certificate := &x509.Certificate{SerialNumber: big.NewInt(1234)}
+
+		// then
+		assert.True(t, list.IsRevoked(certificate))
+	})
+
+	t.Run("should return true when certificate was issued before client revocation", func(t *testing.T) {
+		// given
+		certificate := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "app-id"},
+			NotBefore:    revokedAt.Add(-time.Hour),
+		}
+
+		// then
+		assert.True(t, list.IsRevoked(certificate))
+	})
+
+	t.Run("should return false when certificate was issued after client revocation", func(t *testing.T) {
+		// given
+		certificate := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "app-id"},
+			NotBefore:    revokedAt.Add(time.Hour),
+		}
+
+		// then
+		assert.False(t, list.IsRevoked(certificate))
+	})
+
+	t.Run("should return false when certificate was not revoked", func(t *testing.T) {
+		// given
+		certificate := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "runtime-id"},
+		}
+
+		// then
+		assert.False(t, list.IsRevoked(certificate))
+	})
+}
+
+func TestList_RevokedCertificates(t *testing.T) {
+	t.Run("should return revoked serial numbers sorted by revocation time", func(t *testing.T) {
+		// given
+		revokedAt := time.Now()
+
+		list := List{
+			SerialNumbers: map[string]time.Time{
+				"4d2": revokedAt,
+				"1f":  revokedAt.Add(-time.Hour),
+			},
+			Clients: map[string]time.Time{"app-id": revokedAt},
+		}
+
+		// when
+		revokedCertificates := list.RevokedCertificates(nil)
+
+		// then
+		require.Len(t, revokedCertificates, 2)
+		assert.Equal(t, big.NewInt(31), revokedCertificates[0].SerialNumber)
+		assert.Equal(t, big.NewInt(1234), revokedCertificates[1].SerialNumber)
+	})
+
+	t.Run("should return certificates issued for revoked clients before their revocation", func(t *testing.T) {
+		// given
+		revokedAt := time.Now()
+
+		list := List{
+			SerialNumbers: map[string]time.Time{
+				"4d2": revokedAt.Add(-2 * time.Hour),
+				"1f":  revokedAt.Add(time.Hour),
+			},
+			Clients: map[string]time.Time{"app-id": revokedAt},
+		}
+		clientCertificates := map[string][]IssuedCertificate{
+			"app-id": {
+				{SerialNumber: "4d2", NotBefore: revokedAt.Add(-3 * time.Hour)},
+				{SerialNumber: "1f", NotBefore: revokedAt.Add(-3 * time.Hour)},
+				{SerialNumber: "a", NotBefore: revokedAt.Add(-time.Minute)},
+				{SerialNumber: "b", NotBefore: revokedAt.Add(time.Minute)},
+			},
+		}
+
+		// when
+		revokedCertificates := list.RevokedCertificates(clientCertificates)
+
+		// then
+		assert.Equal(t, []pkix.RevokedCertificate{
+			{SerialNumber: big.NewInt(1234), RevocationTime: revokedAt.Add(-2 * time.Hour)},
+			{SerialNumber: big.NewInt(10), RevocationTime: revokedAt},
+			{SerialNumber: big.NewInt(31), RevocationTime: revokedAt},
+		}, revokedCertificates)
+	})
+}
+
+func TestParseSerialNumber(t *testing.T) {
+	t.Run("should parse hex encoded serial number", func(t *testing.T) {
+		// when
+		serialNumber, err := ParseSerialNumber("04:D2")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1234), serialNumber)
+		assert.Equal(t, "4d2", FormatSerialNumber(serialNumber))
+	})
+
+	t.Run("should return error when serial number is invalid", func(t *testing.T) {
+		// when
+		serialNumber, err := ParseSerialNumber("not-a-number")
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, serialNumber)
+	})
+}
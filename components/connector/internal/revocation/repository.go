@@ -0,0 +1,211 @@
+package revocation
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	serialNumberKeyPrefix = "serial."
+	clientKeyPrefix       = "client."
+
+	maxUpdateAttempts = 5
+
+	watchRetryInterval = 5 * time.Second
+)
+
+//go:generate mockery -name=Manager
+type Manager interface {
+	Get(name string, options metav1.GetOptions) (*v1.ConfigMap, error)
+	Update(configMap *v1.ConfigMap) (*v1.ConfigMap, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+}
+
+//go:generate mockery -name=Repository
+type Repository interface {
+	Get() (List, apperrors.AppError)
+	InsertSerialNumber(serialNumber string, revokedAt time.Time) apperrors.AppError
+	InsertClient(clientID string, revokedAt time.Time) apperrors.AppError
+}
+
+type repository struct {
+	configMapManager Manager
+	configMapName    string
+	log              *logrus.Entry
+
+	cacheLock sync.RWMutex
+	cached    *v1.ConfigMap
+}
+
+// NewRepository creates a new revocation list repository persisting entries in the Config Map.
+// The Config Map is cached and kept up to date by watching it until the stop channel is closed,
+// while the watch is not established the Config Map is read on each request.
+func NewRepository(configMapManager Manager, configMapName string, stop <-chan struct{}) Repository {
+	r := &repository{
+		configMapManager: configMapManager,
+		configMapName:    configMapName,
+		log:              logrus.WithField("Repository", "Revocation"),
+	}
+
+	go r.watch(stop)
+
+	return r
+}
+
+func (r *repository) Get() (List, apperrors.AppError) {
+	configMap, appErr := r.getCachedConfigMap()
+	if appErr != nil {
+		return List{}, appErr
+	}
+
+	list := List{
+		SerialNumbers: map[string]time.Time{},
+		Clients:       map[string]time.Time{},
+	}
+
+	for key, value := range configMap.Data {
+		revokedAt, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return List{}, apperrors.Internal("failed to parse revocation time of %s entry, %s", key, err)
+		}
+
+		switch {
+		case strings.HasPrefix(key, serialNumberKeyPrefix):
+			list.SerialNumbers[strings.TrimPrefix(key, serialNumberKeyPrefix)] = revokedAt
+		case strings.HasPrefix(key, clientKeyPrefix):
+			list.Clients[strings.TrimPrefix(key, clientKeyPrefix)] = revokedAt
+		}
+	}
+
+	return list, nil
+}
+
+// InsertSerialNumber adds serial number to the revocation list, keeping the original revocation time if it was already revoked
+func (r *repository) InsertSerialNumber(serialNumber string, revokedAt time.Time) apperrors.AppError {
+	return r.insert(serialNumberKeyPrefix+serialNumber, revokedAt, false)
+}
+
+// InsertClient adds client to the revocation list, overriding the previous revocation time so that certificates
+// issued since then are revoked as well
+func (r *repository) InsertClient(clientID string, revokedAt time.Time) apperrors.AppError {
+	return r.insert(clientKeyPrefix+clientID, revokedAt, true)
+}
+
+func (r *repository) insert(key string, revokedAt time.Time, override bool) apperrors.AppError {
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		configMap, appErr := r.getConfigMap()
+		if appErr != nil {
+			return appErr
+		}
+
+		if _, exists := configMap.Data[key]; exists && !override {
+			return nil
+		}
+
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[key] = revokedAt.UTC().Format(time.RFC3339)
+
+		updated, err := r.configMapManager.Update(configMap)
+		if err == nil {
+			r.setCached(updated)
+			return nil
+		}
+
+		if !k8serrors.IsConflict(err) {
+			return apperrors.Internal("failed to update %s config map, %s", r.configMapName, err)
+		}
+	}
+
+	return apperrors.Internal("failed to update %s config map, too many conflicting updates", r.configMapName)
+}
+
+func (r *repository) getConfigMap() (*v1.ConfigMap, apperrors.AppError) {
+	configMap, err := r.configMapManager.Get(r.configMapName, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, apperrors.NotFound("config map %s not found", r.configMapName)
+		}
+		return nil, apperrors.Internal("failed to get %s config map, %s", r.configMapName, err)
+	}
+
+	return configMap, nil
+}
+
+func (r *repository) getCachedConfigMap() (*v1.ConfigMap, apperrors.AppError) {
+	r.cacheLock.RLock()
+	cached := r.cached
+	r.cacheLock.RUnlock()
+
+	if cached != nil {
+		return cached, nil
+	}
+
+	return r.getConfigMap()
+}
+
+func (r *repository) setCached(configMap *v1.ConfigMap) {
+	r.cacheLock.Lock()
+	defer r.cacheLock.Unlock()
+
+	r.cached = configMap
+}
+
+func (r *repository) watch(stop <-chan struct{}) {
+	for {
+		r.watchUntilClosed(stop)
+
+		// changes are not tracked until the watch is established again
+		r.setCached(nil)
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(watchRetryInterval):
+		}
+	}
+}
+
+func (r *repository) watchUntilClosed(stop <-chan struct{}) {
+	watcher, err := r.configMapManager.Watch(metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", r.configMapName).String(),
+	})
+	if err != nil {
+		r.log.Errorf("Failed to watch %s config map: %s", r.configMapName, err.Error())
+		return
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				if configMap, ok := event.Object.(*v1.ConfigMap); ok {
+					r.setCached(configMap)
+				}
+			case watch.Deleted:
+				r.setCached(nil)
+			case watch.Error:
+				r.log.Errorf("Error while watching %s config map: %v", r.configMapName, event.Object)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package revocation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	configMapName = "revocations-config"
+)
+
+func TestRepository_Get(t *testing.T) {
+
+	revokedAt := time.Date(2019, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should get revocation list", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{
+			"serial.4d2":    revokedAt.Format(time.RFC3339),
+			"client.app-id": revokedAt.Format(time.RFC3339),
+		}}
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, metav1.GetOptions{}).Return(configMap, nil)
+
+		repository, stop := newWatchedRepository(configMapManager, watch.NewFake())
+		defer stop()
+
+		// when
+		list, err := repository.Get()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, revokedAt, list.SerialNumbers["4d2"])
+		assert.Equal(t, revokedAt, list.Clients["app-id"])
+	})
+
+	t.Run("should return Not Found error when config map not found", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, metav1.GetOptions{}).Return(nil, k8serrors.NewNotFound(schema.GroupResource{}, configMapName))
+
+		repository, stop := newWatchedRepository(configMapManager, watch.NewFake())
+		defer stop()
+
+		// when
+		_, err := repository.Get()
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should return error when revocation time is invalid", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"serial.4d2": "invalid"}}
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, metav1.GetOptions{}).Return(configMap, nil)
+
+		repository, stop := newWatchedRepository(configMapManager, watch.NewFake())
+		defer stop()
+
+		// when
+		_, err := repository.Get()
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+
+	t.Run("should get revocation list from watched config map", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"serial.4d2": revokedAt.Format(time.RFC3339)}}
+
+		configMapManager := &mocks.Manager{}
+		watcher := watch.NewFake()
+
+		repository, stop := newWatchedRepository(configMapManager, watcher)
+		defer stop()
+
+		watcher.Add(&v1.ConfigMap{})
+		// second event is received after the first one has been processed
+		watcher.Modify(configMap)
+
+		// when
+		list, err := repository.Get()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, revokedAt, list.SerialNumbers["4d2"])
+		configMapManager.AssertNotCalled(t, "Get", configMapName, metav1.GetOptions{})
+	})
+}
+
+func TestRepository_InsertSerialNumber(t *testing.T) {
+
+	revokedAt := time.Date(2019, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should insert serial number", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, metav1.GetOptions{}).Return(&v1.ConfigMap{}, nil)
+		configMapManager.On("Update", &v1.ConfigMap{Data: map[string]string{
+			"serial.4d2": revokedAt.Format(time.RFC3339),
+		}}).Return(&v1.ConfigMap{}, nil)
+
+		repository, stop := newWatchedRepository(configMapManager, watch.NewFake())
+		defer stop()
+
+		// when
+		err := repository.InsertSerialNumber("4d2", revokedAt)
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should not override revocation time of already revoked serial number", func(t *testing.T) {
+		// given
+		configMap := &v1.ConfigMap{Data: map[string]string{"serial.4d2": revokedAt.Format(time.RFC3339)}}
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, metav1.GetOptions{}).Return(configMap, nil)
+
+		repository, stop := newWatchedRepository(configMapManager, watch.NewFake())
+		defer stop()
+
+		// when
+		err := repository.InsertSerialNumber("4d2", revokedAt.Add(time.Hour))
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("should retry on conflict", func(t *testing.T) {
+		// given
+		conflictErr := k8serrors.NewConflict(schema.GroupResource{}, configMapName, nil)
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, metav1.GetOptions{}).Return(func(string, metav1.GetOptions) *v1.ConfigMap {
+			return &v1.ConfigMap{}
+		}, nil)
+		configMapManager.On("Update", mock.AnythingOfType("*v1.ConfigMap")).Return(nil, conflictErr).Once()
+		configMapManager.On("Update", mock.AnythingOfType("*v1.ConfigMap")).Return(&v1.ConfigMap{}, nil).Once()
+
+		repository, stop := newWatchedRepository(configMapManager, watch.NewFake())
+		defer stop()
+
+		// when
+		err := repository.InsertSerialNumber("4d2", revokedAt)
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertNumberOfCalls(t, "Update", 2)
+	})
+
+	t.Run("should return error when failed to update config map", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, metav1.GetOptions{}).Return(&v1.ConfigMap{}, nil)
+		configMapManager.On("Update", mock.AnythingOfType("*v1.ConfigMap")).Return(nil, k8serrors.NewBadRequest("error"))
+
+		repository, stop := newWatchedRepository(configMapManager, watch.NewFake())
+		defer stop()
+
+		// when
+		err := repository.InsertSerialNumber("4d2", revokedAt)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func TestRepository_InsertClient(t *testing.T) {
+	t.Run("should override revocation time of already revoked client", func(t *testing.T) {
+		// given
+		revokedAt := time.Date(2019, 9, 1, 12, 0, 0, 0, time.UTC)
+		configMap := &v1.ConfigMap{Data: map[string]string{"client.app-id": revokedAt.Format(time.RFC3339)}}
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, metav1.GetOptions{}).Return(configMap, nil)
+		configMapManager.On("Update", &v1.ConfigMap{Data: map[string]string{
+			"client.app-id": revokedAt.Add(time.Hour).Format(time.RFC3339),
+		}}).Return(&v1.ConfigMap{}, nil)
+
+		repository, stop := newWatchedRepository(configMapManager, watch.NewFake())
+		defer stop()
+
+		// when
+		err := repository.InsertClient("app-id", revokedAt.Add(time.Hour))
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+}
+
+func newWatchedRepository(configMapManager *mocks.Manager, watcher watch.Interface) (revocation.Repository, func()) {
+	watching := make(chan struct{})
+	listOptions := metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("metadata.name", configMapName).String()}
+	configMapManager.On("Watch", listOptions).Return(watcher, nil).Run(func(mock.Arguments) {
+		close(watching)
+	}).Once()
+
+	stop := make(chan struct{})
+	repository := revocation.NewRepository(configMapManager, configMapName, stop)
+	<-watching
+
+	return repository, func() {
+		close(stop)
+	}
+}
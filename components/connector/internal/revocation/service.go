@@ -0,0 +1,120 @@
+package revocation
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+)
+
+//go:generate mockery -name=Service
+type Service interface {
+	// RevokeCertificate adds serial number of the certificate to the revocation list
+	RevokeCertificate(certificate *x509.Certificate) apperrors.AppError
+	// RevokeSerialNumber adds hex encoded serial number to the revocation list
+	RevokeSerialNumber(serialNumber string) apperrors.AppError
+	// RevokeClient revokes all certificates issued for the Application or Runtime until now
+	RevokeClient(clientID string) apperrors.AppError
+	// IsRevoked checks if the certificate was revoked
+	IsRevoked(certificate *x509.Certificate) (bool, apperrors.AppError)
+	// CRL returns DER encoded Certificate Revocation List containing revoked serial numbers
+	// and the serial numbers of the certificates revoked together with their clients
+	CRL() ([]byte, apperrors.AppError)
+	// PreviousCRL returns the same Certificate Revocation List signed with the previous CA during the rotation overlap window
+	PreviousCRL() ([]byte, apperrors.AppError)
+}
+
+// ClientCertificates lists the certificates issued for the client, so that the certificates revoked
+// together with the client are published in the Certificate Revocation List
+//
+//go:generate mockery -name=ClientCertificates
+type ClientCertificates interface {
+	List(clientID string) ([]IssuedCertificate, apperrors.AppError)
+}
+
+type revocationService struct {
+	repository          Repository
+	certificatesService certificates.Service
+	clientCertificates  ClientCertificates
+}
+
+func NewRevocationService(repository Repository, certificatesService certificates.Service, clientCertificates ClientCertificates) Service {
+	return &revocationService{
+		repository:          repository,
+		certificatesService: certificatesService,
+		clientCertificates:  clientCertificates,
+	}
+}
+
+func (svc *revocationService) RevokeCertificate(certificate *x509.Certificate) apperrors.AppError {
+	if certificate == nil || certificate.SerialNumber == nil {
+		return apperrors.BadRequest("Certificate without serial number cannot be revoked")
+	}
+
+	return svc.repository.InsertSerialNumber(FormatSerialNumber(certificate.SerialNumber), time.Now())
+}
+
+func (svc *revocationService) RevokeSerialNumber(serialNumber string) apperrors.AppError {
+	parsed, err := ParseSerialNumber(serialNumber)
+	if err != nil {
+		return err
+	}
+
+	return svc.repository.InsertSerialNumber(FormatSerialNumber(parsed), time.Now())
+}
+
+func (svc *revocationService) RevokeClient(clientID string) apperrors.AppError {
+	if clientID == "" {
+		return apperrors.WrongInput("Client ID cannot be empty")
+	}
+
+	return svc.repository.InsertClient(clientID, time.Now())
+}
+
+func (svc *revocationService) IsRevoked(certificate *x509.Certificate) (bool, apperrors.AppError) {
+	list, err := svc.repository.Get()
+	if err != nil {
+		return false, err
+	}
+
+	return list.IsRevoked(certificate), nil
+}
+
+func (svc *revocationService) CRL() ([]byte, apperrors.AppError) {
+	revokedCertificates, err := svc.revokedCertificates()
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.certificatesService.CreateCRL(revokedCertificates)
+}
+
+func (svc *revocationService) PreviousCRL() ([]byte, apperrors.AppError) {
+	revokedCertificates, err := svc.revokedCertificates()
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.certificatesService.CreatePreviousCRL(revokedCertificates)
+}
+
+func (svc *revocationService) revokedCertificates() ([]pkix.RevokedCertificate, apperrors.AppError) {
+	list, err := svc.repository.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	clientCertificates := make(map[string][]IssuedCertificate, len(list.Clients))
+	for clientID := range list.Clients {
+		issued, err := svc.clientCertificates.List(clientID)
+		if err != nil {
+			return nil, err.Append("Failed to list certificates issued for %s client", clientID)
+		}
+
+		clientCertificates[clientID] = issued
+	}
+
+	return list.RevokedCertificates(clientCertificates), nil
+}
@@ -0,0 +1,259 @@
+package revocation_test
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevocationService_RevokeCertificate(t *testing.T) {
+	t.Run("should revoke certificate serial number", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("InsertSerialNumber", "4d2", mock.AnythingOfType("time.Time")).Return(nil)
+
+		service := revocation.NewRevocationService(repository, nil, nil)
+
+		// when
+		err := service.RevokeCertificate(&x509.Certificate{SerialNumber: big.NewInt(1234)})
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when certificate has no serial number", func(t *testing.T) {
+		// given
+		service := revocation.NewRevocationService(&mocks.Repository{}, nil, nil)
+
+		// when
+		err := service.RevokeCertificate(&x509.Certificate{})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+}
+
+func TestRevocationService_RevokeSerialNumber(t *testing.T) {
+	t.Run("should revoke normalized serial number", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("InsertSerialNumber", "4d2", mock.AnythingOfType("time.Time")).Return(nil)
+
+		service := revocation.NewRevocationService(repository, nil, nil)
+
+		// when
+		err := service.RevokeSerialNumber("04:D2")
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when serial number is invalid", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+
+		service := revocation.NewRevocationService(repository, nil, nil)
+
+		// when
+		err := service.RevokeSerialNumber("invalid")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+		repository.AssertNotCalled(t, "InsertSerialNumber", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRevocationService_RevokeClient(t *testing.T) {
+	t.Run("should revoke client certificates", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("InsertClient", "app-id", mock.AnythingOfType("time.Time")).Return(nil)
+
+		service := revocation.NewRevocationService(repository, nil, nil)
+
+		// when
+		err := service.RevokeClient("app-id")
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when client ID is empty", func(t *testing.T) {
+		// given
+		service := revocation.NewRevocationService(&mocks.Repository{}, nil, nil)
+
+		// when
+		err := service.RevokeClient("")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+	})
+}
+
+func TestRevocationService_IsRevoked(t *testing.T) {
+
+	list := revocation.List{
+		SerialNumbers: map[string]time.Time{"4d2": time.Now()},
+	}
+
+	t.Run("should return true when certificate is revoked", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get").Return(list, nil)
+
+		service := revocation.NewRevocationService(repository, nil, nil)
+
+		// when
+		revoked, err := service.IsRevoked(&x509.Certificate{SerialNumber: big.NewInt(1234)})
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should return error when failed to get revocation list", func(t *testing.T) {
+		// given
+		repository := &mocks.Repository{}
+		repository.On("Get").Return(revocation.List{}, apperrors.Internal("error"))
+
+		service := revocation.NewRevocationService(repository, nil, nil)
+
+		// when
+		_, err := service.IsRevoked(&x509.Certificate{SerialNumber: big.NewInt(1234)})
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestRevocationService_CRL(t *testing.T) {
+	t.Run("should create CRL with revoked serial numbers", func(t *testing.T) {
+		// given
+		revokedAt := time.Now()
+		list := revocation.List{
+			SerialNumbers: map[string]time.Time{"4d2": revokedAt},
+		}
+		crl := []byte("crl")
+
+		repository := &mocks.Repository{}
+		repository.On("Get").Return(list, nil)
+
+		certificatesService := &certificatesMocks.Service{}
+		certificatesService.On("CreateCRL", []pkix.RevokedCertificate{
+			{SerialNumber: big.NewInt(1234), RevocationTime: revokedAt},
+		}).Return(crl, nil)
+
+		service := revocation.NewRevocationService(repository, certificatesService, nil)
+
+		// when
+		result, err := service.CRL()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, crl, result)
+	})
+
+	t.Run("should create CRL with certificates issued for revoked clients", func(t *testing.T) {
+		// given
+		revokedAt := time.Now()
+		list := revocation.List{
+			SerialNumbers: map[string]time.Time{"4d2": revokedAt.Add(-time.Hour)},
+			Clients:       map[string]time.Time{"app-id": revokedAt},
+		}
+		crl := []byte("crl")
+
+		repository := &mocks.Repository{}
+		repository.On("Get").Return(list, nil)
+
+		clientCertificates := &mocks.ClientCertificates{}
+		clientCertificates.On("List", "app-id").Return([]revocation.IssuedCertificate{
+			{SerialNumber: "1f", NotBefore: revokedAt.Add(-24 * time.Hour)},
+			{SerialNumber: "20", NotBefore: revokedAt.Add(time.Minute)},
+		}, nil)
+
+		certificatesService := &certificatesMocks.Service{}
+		certificatesService.On("CreateCRL", []pkix.RevokedCertificate{
+			{SerialNumber: big.NewInt(1234), RevocationTime: revokedAt.Add(-time.Hour)},
+			{SerialNumber: big.NewInt(31), RevocationTime: revokedAt},
+		}).Return(crl, nil)
+
+		service := revocation.NewRevocationService(repository, certificatesService, clientCertificates)
+
+		// when
+		result, err := service.CRL()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, crl, result)
+		clientCertificates.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to list certificates issued for revoked client", func(t *testing.T) {
+		// given
+		list := revocation.List{
+			Clients: map[string]time.Time{"app-id": time.Now()},
+		}
+
+		repository := &mocks.Repository{}
+		repository.On("Get").Return(list, nil)
+
+		clientCertificates := &mocks.ClientCertificates{}
+		clientCertificates.On("List", "app-id").Return(nil, apperrors.Internal("error"))
+
+		certificatesService := &certificatesMocks.Service{}
+
+		service := revocation.NewRevocationService(repository, certificatesService, clientCertificates)
+
+		// when
+		_, err := service.CRL()
+
+		// then
+		require.Error(t, err)
+		certificatesService.AssertNotCalled(t, "CreateCRL", mock.Anything)
+	})
+}
+
+func TestRevocationService_PreviousCRL(t *testing.T) {
+	t.Run("should create CRL signed with previous CA", func(t *testing.T) {
+		// given
+		revokedAt := time.Now()
+		list := revocation.List{
+			SerialNumbers: map[string]time.Time{"4d2": revokedAt},
+		}
+		crl := []byte("crl")
+
+		repository := &mocks.Repository{}
+		repository.On("Get").Return(list, nil)
+
+		certificatesService := &certificatesMocks.Service{}
+		certificatesService.On("CreatePreviousCRL", []pkix.RevokedCertificate{
+			{SerialNumber: big.NewInt(1234), RevocationTime: revokedAt},
+		}).Return(crl, nil)
+
+		service := revocation.NewRevocationService(repository, certificatesService, nil)
+
+		// when
+		result, err := service.PreviousCRL()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, crl, result)
+		certificatesService.AssertNotCalled(t, "CreateCRL", mock.Anything)
+	})
+}
@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"io/ioutil"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SecretFiles maps keys of the secret data to the paths of the files containing the values
+type SecretFiles map[string]string
+
+type fileRepository struct {
+	secrets map[types.NamespacedName]SecretFiles
+}
+
+// NewFileRepository creates a secrets repository reading the secret data from files
+// the files are read on every call, so they can be replaced without restarting the Connector
+func NewFileRepository(secrets map[types.NamespacedName]SecretFiles) Repository {
+	return &fileRepository{
+		secrets: secrets,
+	}
+}
+
+func (r *fileRepository) Get(name types.NamespacedName) (secretData map[string][]byte, appError apperrors.AppError) {
+	files, found := r.secrets[name]
+	if !found {
+		return nil, apperrors.NotFound("secret %s not found", name)
+	}
+
+	secretData = make(map[string][]byte, len(files))
+	for key, path := range files {
+		value, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, apperrors.Internal("failed to read %s key of %s secret from %s file, %s", key, name, path, err)
+		}
+
+		secretData[key] = value
+	}
+
+	return secretData, nil
+}
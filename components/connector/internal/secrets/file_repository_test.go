@@ -0,0 +1,68 @@
+package secrets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestFileRepository_Get(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	caCrtPath := filepath.Join(dir, "ca.crt")
+	caKeyPath := filepath.Join(dir, "ca.key")
+	require.NoError(t, ioutil.WriteFile(caCrtPath, expectedCaCrt, 0600))
+	require.NoError(t, ioutil.WriteFile(caKeyPath, expectedCaKey, 0600))
+
+	t.Run("should read secret data from files", func(t *testing.T) {
+		// given
+		repository := NewFileRepository(map[types.NamespacedName]SecretFiles{
+			namespacedName: {"ca.crt": caCrtPath, "ca.key": caKeyPath},
+		})
+
+		// when
+		secretData, err := repository.Get(namespacedName)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expectedCaCrt, secretData["ca.crt"])
+		assert.Equal(t, expectedCaKey, secretData["ca.key"])
+	})
+
+	t.Run("should fail in case secret not configured", func(t *testing.T) {
+		// given
+		repository := NewFileRepository(map[types.NamespacedName]SecretFiles{})
+
+		// when
+		secretData, err := repository.Get(namespacedName)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		assert.Nil(t, secretData)
+	})
+
+	t.Run("should fail if couldn't read file", func(t *testing.T) {
+		// given
+		repository := NewFileRepository(map[types.NamespacedName]SecretFiles{
+			namespacedName: {"ca.crt": filepath.Join(dir, "missing.crt")},
+		})
+
+		// when
+		secretData, err := repository.Get(namespacedName)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		assert.Nil(t, secretData)
+	})
+}
@@ -0,0 +1,31 @@
+package secrets
+
+import (
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type inMemoryRepository struct {
+	secrets map[types.NamespacedName]map[string][]byte
+}
+
+// NewInMemoryRepository creates a secrets repository serving the secret data provided at startup
+func NewInMemoryRepository(secrets map[types.NamespacedName]map[string][]byte) Repository {
+	return &inMemoryRepository{
+		secrets: secrets,
+	}
+}
+
+func (r *inMemoryRepository) Get(name types.NamespacedName) (secretData map[string][]byte, appError apperrors.AppError) {
+	data, found := r.secrets[name]
+	if !found {
+		return nil, apperrors.NotFound("secret %s not found", name)
+	}
+
+	secretData = make(map[string][]byte, len(data))
+	for key, value := range data {
+		secretData[key] = value
+	}
+
+	return secretData, nil
+}
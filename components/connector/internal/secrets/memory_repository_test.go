@@ -0,0 +1,41 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestInMemoryRepository_Get(t *testing.T) {
+
+	t.Run("should get secret", func(t *testing.T) {
+		// given
+		repository := NewInMemoryRepository(map[types.NamespacedName]map[string][]byte{
+			namespacedName: {"ca.crt": expectedCaCrt, "ca.key": expectedCaKey},
+		})
+
+		// when
+		secretData, err := repository.Get(namespacedName)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expectedCaCrt, secretData["ca.crt"])
+		assert.Equal(t, expectedCaKey, secretData["ca.key"])
+	})
+
+	t.Run("should fail in case secret not found", func(t *testing.T) {
+		// given
+		repository := NewInMemoryRepository(map[types.NamespacedName]map[string][]byte{})
+
+		// when
+		secretData, err := repository.Get(namespacedName)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		assert.Nil(t, secretData)
+	})
+}
@@ -1,6 +1,9 @@
 package tokens
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
@@ -13,45 +16,90 @@ const (
 	defaultCleanupInterval = 1 * time.Minute
 )
 
+// Cache stores one-time tokens. Implementations keep only the hash of the token, never the token itself.
 type Cache interface {
-	Put(token string, data TokenData)
+	Put(token string, data TokenData) apperrors.AppError
 	Get(token string) (TokenData, apperrors.AppError)
-	Delete(token string)
+	GetAndDelete(token string) (TokenData, apperrors.AppError)
+	Delete(token string) apperrors.AppError
 }
 
-type tokenCache struct {
-	tokenCache          *cache.Cache
+type tokenTTLs struct {
 	applicationTokenTTL time.Duration
 	runtimeTokenTTL     time.Duration
 	csrTokenTTL         time.Duration
 }
 
+func (t tokenTTLs) forType(tokenType TokenType) time.Duration {
+	switch tokenType {
+	case RuntimeToken:
+		return t.runtimeTokenTTL
+	case ApplicationToken:
+		return t.applicationTokenTTL
+	case CSRToken:
+		return t.csrTokenTTL
+	}
+
+	return defaultTTLMinutes
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+type tokenCache struct {
+	tokenCache *cache.Cache
+	ttls       tokenTTLs
+	mutex      sync.Mutex
+}
+
 func NewTokenCache(applicationTokenTTL, runtimeTokenTTL, csrTokenTTL time.Duration) Cache {
 	return &tokenCache{
-		tokenCache:          cache.New(defaultTTLMinutes, defaultCleanupInterval),
-		applicationTokenTTL: applicationTokenTTL,
-		runtimeTokenTTL:     runtimeTokenTTL,
-		csrTokenTTL:         csrTokenTTL,
+		tokenCache: cache.New(defaultTTLMinutes, defaultCleanupInterval),
+		ttls: tokenTTLs{
+			applicationTokenTTL: applicationTokenTTL,
+			runtimeTokenTTL:     runtimeTokenTTL,
+			csrTokenTTL:         csrTokenTTL,
+		},
 	}
 }
 
-func (c *tokenCache) Put(token string, data TokenData) {
-	var tokenTTL time.Duration
+func (c *tokenCache) Put(token string, data TokenData) apperrors.AppError {
+	c.tokenCache.Set(hashToken(token), data, c.ttls.forType(data.Type))
+	return nil
+}
 
-	switch data.Type {
-	case RuntimeToken:
-		tokenTTL = c.runtimeTokenTTL
-	case ApplicationToken:
-		tokenTTL = c.applicationTokenTTL
-	case CSRToken:
-		tokenTTL = c.csrTokenTTL
+func (c *tokenCache) Get(token string) (TokenData, apperrors.AppError) {
+	return c.get(hashToken(token))
+}
+
+func (c *tokenCache) GetAndDelete(token string) (TokenData, apperrors.AppError) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	tokenHash := hashToken(token)
+
+	tokenData, err := c.get(tokenHash)
+	if err != nil {
+		return TokenData{}, err
 	}
 
-	c.tokenCache.Set(token, data, tokenTTL)
+	c.tokenCache.Delete(tokenHash)
+
+	return tokenData, nil
 }
 
-func (c *tokenCache) Get(token string) (TokenData, apperrors.AppError) {
-	data, found := c.tokenCache.Get(token)
+func (c *tokenCache) Delete(token string) apperrors.AppError {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.tokenCache.Delete(hashToken(token))
+	return nil
+}
+
+func (c *tokenCache) get(tokenHash string) (TokenData, apperrors.AppError) {
+	data, found := c.tokenCache.Get(tokenHash)
 	if !found {
 		return TokenData{}, apperrors.NotFound("Token not found in the cache.")
 	}
@@ -63,7 +111,3 @@ func (c *tokenCache) Get(token string) (TokenData, apperrors.AppError) {
 
 	return tokenData, nil
 }
-
-func (c *tokenCache) Delete(token string) {
-	c.tokenCache.Delete(token)
-}
@@ -0,0 +1,131 @@
+package tokens
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+const (
+	jwtAlgorithm = "HS256"
+	jwtType      = "JWT"
+
+	// MinimalJWTSecretLength is the minimal length in bytes of the secret used to sign the tokens, equal to the HS256 hash size
+	MinimalJWTSecretLength = sha256.Size
+
+	tokenIDLength = 16
+)
+
+var jwtEncoding = base64.RawURLEncoding
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+}
+
+// JWTClaims are the claims of the signed one-time token
+type JWTClaims struct {
+	ID         string    `json:"jti"`
+	ClientId   string    `json:"sub"`
+	Type       TokenType `json:"tokenType"`
+	ClientType TokenType `json:"clientType,omitempty"`
+	Tenant     string    `json:"tenant,omitempty"`
+	IssuedAt   int64     `json:"iat"`
+	ExpiresAt  int64     `json:"exp"`
+}
+
+// TokenData returns the data the token was issued with
+func (c JWTClaims) TokenData() TokenData {
+	return TokenData{
+		Type:       c.Type,
+		ClientId:   c.ClientId,
+		ClientType: c.ClientType,
+		Tenant:     c.Tenant,
+	}
+}
+
+// Expiration returns the time after which the token is no longer valid
+func (c JWTClaims) Expiration() time.Time {
+	return time.Unix(c.ExpiresAt, 0).UTC()
+}
+
+// SignJWT encodes the claims as a JWT signed with HMAC SHA-256
+func SignJWT(claims JWTClaims, secret []byte) (string, apperrors.AppError) {
+	header, err := json.Marshal(jwtHeader{Algorithm: jwtAlgorithm, Type: jwtType})
+	if err != nil {
+		return "", apperrors.Internal("Failed to encode token header: %s", err)
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", apperrors.Internal("Failed to encode token claims: %s", err)
+	}
+
+	signingInput := jwtEncoding.EncodeToString(header) + "." + jwtEncoding.EncodeToString(payload)
+
+	return signingInput + "." + jwtEncoding.EncodeToString(signature(signingInput, secret)), nil
+}
+
+// ParseJWT verifies the signature and the expiration time of the token and returns its claims
+// it does not check whether the token has already been used, so it can be used to inspect tokens offline
+func ParseJWT(token string, secret []byte, now time.Time) (JWTClaims, apperrors.AppError) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return JWTClaims{}, apperrors.Forbidden("Token is not a valid JWT")
+	}
+
+	var header jwtHeader
+	err := decodeJWTPart(parts[0], &header)
+	if err != nil {
+		return JWTClaims{}, apperrors.Forbidden("Failed to decode token header: %s", err)
+	}
+
+	if header.Algorithm != jwtAlgorithm {
+		return JWTClaims{}, apperrors.Forbidden("Token signing algorithm %s is not supported", header.Algorithm)
+	}
+
+	tokenSignature, err := jwtEncoding.DecodeString(parts[2])
+	if err != nil {
+		return JWTClaims{}, apperrors.Forbidden("Failed to decode token signature: %s", err)
+	}
+
+	if !hmac.Equal(tokenSignature, signature(parts[0]+"."+parts[1], secret)) {
+		return JWTClaims{}, apperrors.Forbidden("Token signature is invalid")
+	}
+
+	var claims JWTClaims
+	err = decodeJWTPart(parts[1], &claims)
+	if err != nil {
+		return JWTClaims{}, apperrors.Forbidden("Failed to decode token claims: %s", err)
+	}
+
+	if claims.ID == "" || claims.ClientId == "" {
+		return JWTClaims{}, apperrors.Forbidden("Token does not contain ID or client ID")
+	}
+
+	if !now.Before(claims.Expiration()) {
+		return JWTClaims{}, apperrors.Forbidden("Token expired")
+	}
+
+	return claims, nil
+}
+
+func decodeJWTPart(part string, target interface{}) error {
+	decoded, err := jwtEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(decoded, target)
+}
+
+func signature(signingInput string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,114 @@
+package tokens
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+type jwtService struct {
+	secret      []byte
+	replayStore ReplayStore
+	ttls        tokenTTLs
+	now         func() time.Time
+}
+
+// NewJWTService creates token service issuing self-contained tokens signed with the secret, so that they can be
+// validated without the shared token cache. The replay store records the IDs of the used tokens, so that
+// each token can be used only once.
+func NewJWTService(secret []byte, replayStore ReplayStore, applicationTokenTTL, runtimeTokenTTL, csrTokenTTL time.Duration) (Service, apperrors.AppError) {
+	if len(secret) < MinimalJWTSecretLength {
+		return nil, apperrors.WrongInput("Token signing secret must be at least %d bytes long", MinimalJWTSecretLength)
+	}
+
+	return &jwtService{
+		secret:      secret,
+		replayStore: replayStore,
+		ttls: tokenTTLs{
+			applicationTokenTTL: applicationTokenTTL,
+			runtimeTokenTTL:     runtimeTokenTTL,
+			csrTokenTTL:         csrTokenTTL,
+		},
+		now: time.Now,
+	}, nil
+}
+
+func (svc *jwtService) CreateToken(tokenData TokenData) (string, apperrors.AppError) {
+	tokenID, err := generateRandomString(tokenIDLength)
+	if err != nil {
+		return "", err
+	}
+
+	issuedAt := svc.now()
+
+	return SignJWT(JWTClaims{
+		ID:         tokenID,
+		ClientId:   tokenData.ClientId,
+		Type:       tokenData.Type,
+		ClientType: tokenData.ClientType,
+		Tenant:     tokenData.Tenant,
+		IssuedAt:   issuedAt.Unix(),
+		ExpiresAt:  issuedAt.Add(svc.ttls.forType(tokenData.Type)).Unix(),
+	}, svc.secret)
+}
+
+func (svc *jwtService) Resolve(token string) (TokenData, apperrors.AppError) {
+	claims, err := ParseJWT(token, svc.secret, svc.now())
+	if err != nil {
+		return TokenData{}, err.Append("Failed to resolve token")
+	}
+
+	used, err := svc.replayStore.IsUsed(claims.ID)
+	if err != nil {
+		return TokenData{}, err.Append("Failed to resolve token")
+	}
+
+	if used {
+		return TokenData{}, apperrors.Forbidden("Failed to resolve token, token has already been used")
+	}
+
+	return claims.TokenData(), nil
+}
+
+// ResolveAndDelete resolves the token and records it as used in one step, so that the token can be used only once.
+func (svc *jwtService) ResolveAndDelete(token string) (TokenData, apperrors.AppError) {
+	claims, err := ParseJWT(token, svc.secret, svc.now())
+	if err != nil {
+		return TokenData{}, err.Append("Failed to resolve token")
+	}
+
+	err = svc.use(claims)
+	if err != nil {
+		return TokenData{}, err.Append("Failed to resolve token")
+	}
+
+	return claims.TokenData(), nil
+}
+
+// Delete invalidates the token by recording it as used, expired and invalid tokens are ignored
+func (svc *jwtService) Delete(token string) apperrors.AppError {
+	claims, err := ParseJWT(token, svc.secret, svc.now())
+	if err != nil {
+		return nil
+	}
+
+	err = svc.use(claims)
+	if err != nil && err.Code() != apperrors.CodeForbidden {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *jwtService) use(claims JWTClaims) apperrors.AppError {
+	firstUse, err := svc.replayStore.Use(claims.ID, claims.Expiration())
+	if err != nil {
+		return err
+	}
+
+	if !firstUse {
+		return apperrors.Forbidden("Token has already been used")
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJWTService(t *testing.T) {
+
+	t.Run("should return error when secret is too short", func(t *testing.T) {
+		// when
+		_, err := NewJWTService([]byte("secret"), NewInMemoryReplayStore(), appTTL, otherTTL, otherTTL)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+	})
+}
+
+func TestJWTService(t *testing.T) {
+
+	tokenData := TokenData{Type: ApplicationToken, ClientId: clientId, ClientType: ApplicationToken, Tenant: tenant}
+
+	t.Run("should create token which can be resolved offline", func(t *testing.T) {
+		// given
+		tokenService := newJWTService(t)
+
+		// when
+		token, err := tokenService.CreateToken(tokenData)
+
+		// then
+		require.NoError(t, err)
+
+		claims, err := ParseJWT(token, jwtSecret, now)
+		require.NoError(t, err)
+		assert.Equal(t, tokenData, claims.TokenData())
+		assert.Equal(t, now.Add(appTTL), claims.Expiration())
+	})
+
+	t.Run("should resolve token only once", func(t *testing.T) {
+		// given
+		tokenService := newJWTService(t)
+
+		token, err := tokenService.CreateToken(tokenData)
+		require.NoError(t, err)
+
+		// when
+		resolvedTokenData, err := tokenService.Resolve(token)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, tokenData, resolvedTokenData)
+
+		// when
+		resolvedTokenData, err = tokenService.ResolveAndDelete(token)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, tokenData, resolvedTokenData)
+
+		// when
+		_, err = tokenService.ResolveAndDelete(token)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+
+		_, err = tokenService.Resolve(token)
+		require.Error(t, err)
+	})
+
+	t.Run("should not resolve deleted token", func(t *testing.T) {
+		// given
+		tokenService := newJWTService(t)
+
+		token, err := tokenService.CreateToken(tokenData)
+		require.NoError(t, err)
+
+		// when
+		err = tokenService.Delete(token)
+
+		// then
+		require.NoError(t, err)
+
+		_, err = tokenService.ResolveAndDelete(token)
+		require.Error(t, err)
+	})
+
+	t.Run("should not resolve expired token", func(t *testing.T) {
+		// given
+		tokenService := newJWTService(t)
+
+		token, err := tokenService.CreateToken(TokenData{Type: RuntimeToken, ClientId: clientId})
+		require.NoError(t, err)
+
+		tokenService.(*jwtService).now = func() time.Time {
+			return now.Add(otherTTL)
+		}
+
+		// when
+		_, err = tokenService.ResolveAndDelete(token)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+	})
+}
+
+func newJWTService(t *testing.T) Service {
+	tokenService, err := NewJWTService(jwtSecret, NewInMemoryReplayStore(), appTTL, otherTTL, otherTTL)
+	require.NoError(t, err)
+
+	tokenService.(*jwtService).now = func() time.Time {
+		return now
+	}
+
+	return tokenService
+}
@@ -0,0 +1,96 @@
+package tokens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var jwtSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func TestParseJWT(t *testing.T) {
+
+	claims := JWTClaims{
+		ID:         "token-id",
+		ClientId:   clientId,
+		Type:       CSRToken,
+		ClientType: RuntimeToken,
+		Tenant:     tenant,
+		IssuedAt:   now.Unix(),
+		ExpiresAt:  now.Add(time.Minute).Unix(),
+	}
+
+	t.Run("should parse signed token", func(t *testing.T) {
+		// given
+		token, err := SignJWT(claims, jwtSecret)
+		require.NoError(t, err)
+
+		// when
+		parsedClaims, err := ParseJWT(token, jwtSecret, now)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, claims, parsedClaims)
+		assert.Equal(t, TokenData{Type: CSRToken, ClientId: clientId, ClientType: RuntimeToken, Tenant: tenant}, parsedClaims.TokenData())
+	})
+
+	t.Run("should return error when token expired", func(t *testing.T) {
+		// given
+		token, err := SignJWT(claims, jwtSecret)
+		require.NoError(t, err)
+
+		// when
+		_, err = ParseJWT(token, jwtSecret, now.Add(time.Minute))
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+	})
+
+	t.Run("should return error when token signed with other secret", func(t *testing.T) {
+		// given
+		token, err := SignJWT(claims, []byte("other-secret-other-secret-other-secret"))
+		require.NoError(t, err)
+
+		// when
+		_, err = ParseJWT(token, jwtSecret, now)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+	})
+
+	t.Run("should return error when claims were modified", func(t *testing.T) {
+		// given
+		token, err := SignJWT(claims, jwtSecret)
+		require.NoError(t, err)
+
+		otherClaims := claims
+		otherClaims.ClientId = "other-client"
+		otherToken, err := SignJWT(otherClaims, jwtSecret)
+		require.NoError(t, err)
+
+		parts := strings.Split(token, ".")
+		otherParts := strings.Split(otherToken, ".")
+
+		// when
+		_, err = ParseJWT(parts[0]+"."+otherParts[1]+"."+parts[2], jwtSecret, now)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+	})
+
+	t.Run("should return error when token is not JWT", func(t *testing.T) {
+		// when
+		_, err := ParseJWT("token", jwtSecret, now)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+	})
+}
@@ -11,20 +11,20 @@ type Service struct {
 	mock.Mock
 }
 
-// CreateToken provides a mock function with given fields: clientId, tokenType
-func (_m *Service) CreateToken(clientId string, tokenType tokens.TokenType) (string, apperrors.AppError) {
-	ret := _m.Called(clientId, tokenType)
+// CreateToken provides a mock function with given fields: tokenData
+func (_m *Service) CreateToken(tokenData tokens.TokenData) (string, apperrors.AppError) {
+	ret := _m.Called(tokenData)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, tokens.TokenType) string); ok {
-		r0 = rf(clientId, tokenType)
+	if rf, ok := ret.Get(0).(func(tokens.TokenData) string); ok {
+		r0 = rf(tokenData)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(string, tokens.TokenType) apperrors.AppError); ok {
-		r1 = rf(clientId, tokenType)
+	if rf, ok := ret.Get(1).(func(tokens.TokenData) apperrors.AppError); ok {
+		r1 = rf(tokenData)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -35,8 +35,19 @@ func (_m *Service) CreateToken(clientId string, tokenType tokens.TokenType) (str
 }
 
 // Delete provides a mock function with given fields: token
-func (_m *Service) Delete(token string) {
-	_m.Called(token)
+func (_m *Service) Delete(token string) apperrors.AppError {
+	ret := _m.Called(token)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
 }
 
 // Resolve provides a mock function with given fields: token
@@ -61,3 +72,26 @@ func (_m *Service) Resolve(token string) (tokens.TokenData, apperrors.AppError)
 
 	return r0, r1
 }
+
+// ResolveAndDelete provides a mock function with given fields: token
+func (_m *Service) ResolveAndDelete(token string) (tokens.TokenData, apperrors.AppError) {
+	ret := _m.Called(token)
+
+	var r0 tokens.TokenData
+	if rf, ok := ret.Get(0).(func(string) tokens.TokenData); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(tokens.TokenData)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
@@ -11,4 +11,7 @@ const (
 type TokenData struct {
 	Type     TokenType
 	ClientId string
+	// ClientType is the type of the client the token was issued for, either ApplicationToken or RuntimeToken
+	ClientType TokenType
+	Tenant     string
 }
@@ -0,0 +1,89 @@
+package tokens
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+const (
+	insertTokenQuery         = `INSERT INTO connector_tokens (token_hash, client_id, token_type, client_type, tenant, expires_at) VALUES ($1, $2, $3, $4, $5, $6)`
+	selectTokenQuery         = `SELECT client_id, token_type, client_type, tenant FROM connector_tokens WHERE token_hash = $1 AND expires_at > $2`
+	deleteTokenQuery         = `DELETE FROM connector_tokens WHERE token_hash = $1`
+	consumeTokenQuery        = `DELETE FROM connector_tokens WHERE token_hash = $1 AND expires_at > $2 RETURNING client_id, token_type, client_type, tenant`
+	deleteExpiredTokensQuery = `DELETE FROM connector_tokens WHERE expires_at <= $1`
+)
+
+type postgresCache struct {
+	db   *sql.DB
+	ttls tokenTTLs
+	now  func() time.Time
+}
+
+// NewPostgresCache creates token cache backed by the connector_tokens table, so that tokens are shared between Connector replicas.
+func NewPostgresCache(db *sql.DB, applicationTokenTTL, runtimeTokenTTL, csrTokenTTL time.Duration) Cache {
+	return &postgresCache{
+		db: db,
+		ttls: tokenTTLs{
+			applicationTokenTTL: applicationTokenTTL,
+			runtimeTokenTTL:     runtimeTokenTTL,
+			csrTokenTTL:         csrTokenTTL,
+		},
+		now: time.Now,
+	}
+}
+
+func (c *postgresCache) Put(token string, data TokenData) apperrors.AppError {
+	now := c.now().UTC()
+
+	_, err := c.db.Exec(deleteExpiredTokensQuery, now)
+	if err != nil {
+		return apperrors.Internal("Failed to delete expired tokens: %s", err.Error())
+	}
+
+	_, err = c.db.Exec(insertTokenQuery, hashToken(token), data.ClientId, string(data.Type), string(data.ClientType), data.Tenant, now.Add(c.ttls.forType(data.Type)))
+	if err != nil {
+		return apperrors.Internal("Failed to store token: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (c *postgresCache) Get(token string) (TokenData, apperrors.AppError) {
+	row := c.db.QueryRow(selectTokenQuery, hashToken(token), c.now().UTC())
+	return scanTokenData(row)
+}
+
+func (c *postgresCache) GetAndDelete(token string) (TokenData, apperrors.AppError) {
+	row := c.db.QueryRow(consumeTokenQuery, hashToken(token), c.now().UTC())
+	return scanTokenData(row)
+}
+
+func (c *postgresCache) Delete(token string) apperrors.AppError {
+	_, err := c.db.Exec(deleteTokenQuery, hashToken(token))
+	if err != nil {
+		return apperrors.Internal("Failed to delete token: %s", err.Error())
+	}
+
+	return nil
+}
+
+func scanTokenData(row *sql.Row) (TokenData, apperrors.AppError) {
+	var clientId, tokenType, clientType, tenant string
+
+	err := row.Scan(&clientId, &tokenType, &clientType, &tenant)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TokenData{}, apperrors.NotFound("Token not found in the cache.")
+		}
+		return TokenData{}, apperrors.Internal("Failed to get token from cache: %s", err.Error())
+	}
+
+	return TokenData{
+		Type:       TokenType(tokenType),
+		ClientId:   clientId,
+		ClientType: TokenType(clientType),
+		Tenant:     tenant,
+	}, nil
+}
@@ -0,0 +1,166 @@
+package tokens
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token    = "token"
+	appTTL   = 5 * time.Minute
+	otherTTL = 1 * time.Minute
+)
+
+var now = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func TestPostgresCache_Put(t *testing.T) {
+
+	t.Run("should store hashed token with expiration time", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(deleteExpiredTokensQuery)).
+			WithArgs(now).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		dbMock.ExpectExec(regexp.QuoteMeta(insertTokenQuery)).
+			WithArgs(hashToken(token), clientId, string(ApplicationToken), string(ApplicationToken), tenant, now.Add(appTTL)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		cache := newPostgresCache(db)
+
+		// when
+		err := cache.Put(token, TokenData{Type: ApplicationToken, ClientId: clientId, ClientType: ApplicationToken, Tenant: tenant})
+
+		// then
+		require.NoError(t, err)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when failed to store token", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(deleteExpiredTokensQuery)).
+			WithArgs(now).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		dbMock.ExpectExec(regexp.QuoteMeta(insertTokenQuery)).
+			WillReturnError(errors.New("error"))
+
+		cache := newPostgresCache(db)
+
+		// when
+		err := cache.Put(token, TokenData{Type: RuntimeToken, ClientId: clientId})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func TestPostgresCache_GetAndDelete(t *testing.T) {
+
+	t.Run("should resolve and delete token", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(consumeTokenQuery)).
+			WithArgs(hashToken(token), now).
+			WillReturnRows(sqlmock.NewRows([]string{"client_id", "token_type", "client_type", "tenant"}).AddRow(clientId, string(CSRToken), string(RuntimeToken), tenant))
+
+		cache := newPostgresCache(db)
+
+		// when
+		tokenData, err := cache.GetAndDelete(token)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, TokenData{Type: CSRToken, ClientId: clientId, ClientType: RuntimeToken, Tenant: tenant}, tokenData)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found error when token does not exist or expired", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(consumeTokenQuery)).
+			WithArgs(hashToken(token), now).
+			WillReturnRows(sqlmock.NewRows([]string{"client_id", "token_type", "client_type", "tenant"}))
+
+		cache := newPostgresCache(db)
+
+		// when
+		_, err := cache.GetAndDelete(token)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should return internal error when query failed", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(consumeTokenQuery)).
+			WillReturnError(errors.New("error"))
+
+		cache := newPostgresCache(db)
+
+		// when
+		_, err := cache.GetAndDelete(token)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func TestPostgresCache_Delete(t *testing.T) {
+
+	t.Run("should delete token", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(deleteTokenQuery)).
+			WithArgs(hashToken(token)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		cache := newPostgresCache(db)
+
+		// when
+		err := cache.Delete(token)
+
+		// then
+		require.NoError(t, err)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+}
+
+func newDBMock(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	return db, dbMock
+}
+
+func newPostgresCache(db *sql.DB) Cache {
+	cache := NewPostgresCache(db, appTTL, otherTTL, otherTTL).(*postgresCache)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	return cache
+}
@@ -0,0 +1,61 @@
+package tokens
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+const (
+	insertUsedTokenQuery         = `INSERT INTO connector_used_tokens (token_id, expires_at) VALUES ($1, $2) ON CONFLICT (token_id) DO NOTHING`
+	selectUsedTokenQuery         = `SELECT 1 FROM connector_used_tokens WHERE token_id = $1`
+	deleteExpiredUsedTokensQuery = `DELETE FROM connector_used_tokens WHERE expires_at <= $1`
+)
+
+type postgresReplayStore struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+// NewPostgresReplayStore creates replay store backed by the connector_used_tokens table, so that a token
+// used with one Connector replica is rejected by the others
+func NewPostgresReplayStore(db *sql.DB) ReplayStore {
+	return &postgresReplayStore{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+func (s *postgresReplayStore) Use(tokenID string, expiresAt time.Time) (bool, apperrors.AppError) {
+	_, err := s.db.Exec(deleteExpiredUsedTokensQuery, s.now().UTC())
+	if err != nil {
+		return false, apperrors.Internal("Failed to delete expired used tokens: %s", err.Error())
+	}
+
+	result, err := s.db.Exec(insertUsedTokenQuery, tokenID, expiresAt.UTC())
+	if err != nil {
+		return false, apperrors.Internal("Failed to record used token: %s", err.Error())
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, apperrors.Internal("Failed to record used token: %s", err.Error())
+	}
+
+	return inserted == 1, nil
+}
+
+func (s *postgresReplayStore) IsUsed(tokenID string) (bool, apperrors.AppError) {
+	var found int
+
+	err := s.db.QueryRow(selectUsedTokenQuery, tokenID).Scan(&found)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, apperrors.Internal("Failed to check used token: %s", err.Error())
+	}
+
+	return true, nil
+}
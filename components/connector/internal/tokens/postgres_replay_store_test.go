@@ -0,0 +1,135 @@
+package tokens
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresReplayStore_Use(t *testing.T) {
+
+	expiresAt := now.Add(time.Minute)
+
+	t.Run("should record token as used", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(deleteExpiredUsedTokensQuery)).
+			WithArgs(now).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		dbMock.ExpectExec(regexp.QuoteMeta(insertUsedTokenQuery)).
+			WithArgs("token-id", expiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		store := newPostgresReplayStore(db)
+
+		// when
+		firstUse, err := store.Use("token-id", expiresAt)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, firstUse)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("should detect token used before", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(deleteExpiredUsedTokensQuery)).
+			WithArgs(now).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		dbMock.ExpectExec(regexp.QuoteMeta(insertUsedTokenQuery)).
+			WithArgs("token-id", expiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		store := newPostgresReplayStore(db)
+
+		// when
+		firstUse, err := store.Use("token-id", expiresAt)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, firstUse)
+	})
+
+	t.Run("should return error when failed to record token", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(deleteExpiredUsedTokensQuery)).
+			WithArgs(now).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		dbMock.ExpectExec(regexp.QuoteMeta(insertUsedTokenQuery)).
+			WillReturnError(errors.New("error"))
+
+		store := newPostgresReplayStore(db)
+
+		// when
+		_, err := store.Use("token-id", expiresAt)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func TestPostgresReplayStore_IsUsed(t *testing.T) {
+
+	t.Run("should return true when token was used", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(selectUsedTokenQuery)).
+			WithArgs("token-id").
+			WillReturnRows(sqlmock.NewRows([]string{"?column?"}).AddRow(1))
+
+		store := newPostgresReplayStore(db)
+
+		// when
+		used, err := store.IsUsed("token-id")
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, used)
+	})
+
+	t.Run("should return false when token was not used", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(selectUsedTokenQuery)).
+			WithArgs("token-id").
+			WillReturnRows(sqlmock.NewRows([]string{"?column?"}))
+
+		store := newPostgresReplayStore(db)
+
+		// when
+		used, err := store.IsUsed("token-id")
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, used)
+	})
+}
+
+func newPostgresReplayStore(db *sql.DB) ReplayStore {
+	store := NewPostgresReplayStore(db).(*postgresReplayStore)
+	store.now = func() time.Time {
+		return now
+	}
+
+	return store
+}
@@ -0,0 +1,55 @@
+package tokens
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+
+	"github.com/patrickmn/go-cache"
+)
+
+// ReplayStore records the IDs of the used signed tokens until the tokens expire
+type ReplayStore interface {
+	// Use records the token ID as used and returns false if it had already been used
+	Use(tokenID string, expiresAt time.Time) (bool, apperrors.AppError)
+	IsUsed(tokenID string) (bool, apperrors.AppError)
+}
+
+type inMemoryReplayStore struct {
+	usedTokens *cache.Cache
+	mutex      sync.Mutex
+	now        func() time.Time
+}
+
+// NewInMemoryReplayStore creates replay store keeping the used token IDs in memory of a single Connector instance
+func NewInMemoryReplayStore() ReplayStore {
+	return &inMemoryReplayStore{
+		usedTokens: cache.New(defaultTTLMinutes, defaultCleanupInterval),
+		now:        time.Now,
+	}
+}
+
+func (s *inMemoryReplayStore) Use(tokenID string, expiresAt time.Time) (bool, apperrors.AppError) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, used := s.usedTokens.Get(tokenID); used {
+		return false, nil
+	}
+
+	ttl := expiresAt.Sub(s.now())
+	if ttl <= 0 {
+		// the token has already expired, so it is rejected regardless of the store
+		ttl = cache.DefaultExpiration
+	}
+
+	s.usedTokens.Set(tokenID, true, ttl)
+
+	return true, nil
+}
+
+func (s *inMemoryReplayStore) IsUsed(tokenID string) (bool, apperrors.AppError) {
+	_, used := s.usedTokens.Get(tokenID)
+	return used, nil
+}
@@ -6,9 +6,10 @@ import (
 
 //go:generate mockery -name=Service
 type Service interface {
-	CreateToken(clientId string, tokenType TokenType) (string, apperrors.AppError)
+	CreateToken(tokenData TokenData) (string, apperrors.AppError)
 	Resolve(token string) (TokenData, apperrors.AppError)
-	Delete(token string)
+	ResolveAndDelete(token string) (TokenData, apperrors.AppError)
+	Delete(token string) apperrors.AppError
 }
 
 type tokenService struct {
@@ -23,19 +24,17 @@ func NewTokenService(store Cache, generator TokenGenerator) *tokenService {
 	}
 }
 
-func (svc *tokenService) CreateToken(clientId string, tokenType TokenType) (string, apperrors.AppError) {
+func (svc *tokenService) CreateToken(tokenData TokenData) (string, apperrors.AppError) {
 	token, err := svc.generator.NewToken()
 	if err != nil {
 		return "", err
 	}
 
-	tokenData := TokenData{
-		Type:     tokenType,
-		ClientId: clientId,
+	err = svc.store.Put(token, tokenData)
+	if err != nil {
+		return "", err.Append("Failed to store token")
 	}
 
-	svc.store.Put(token, tokenData)
-
 	return token, nil
 }
 
@@ -48,6 +47,16 @@ func (svc *tokenService) Resolve(token string) (TokenData, apperrors.AppError) {
 	return tokenData, nil
 }
 
-func (svc *tokenService) Delete(token string) {
-	svc.store.Delete(token)
+// ResolveAndDelete resolves the token and removes it in one step, so that the token can be used only once.
+func (svc *tokenService) ResolveAndDelete(token string) (TokenData, apperrors.AppError) {
+	tokenData, err := svc.store.GetAndDelete(token)
+	if err != nil {
+		return TokenData{}, err.Append("Failed to resolve token")
+	}
+
+	return tokenData, nil
+}
+
+func (svc *tokenService) Delete(token string) apperrors.AppError {
+	return svc.store.Delete(token)
 }
@@ -12,6 +12,7 @@ import (
 
 const (
 	clientId = "client-id"
+	tenant   = "tenant"
 )
 
 func TestTokenService(t *testing.T) {
@@ -26,6 +27,7 @@ func TestTokenService(t *testing.T) {
 			expectedTokenData: TokenData{
 				Type:     ApplicationToken,
 				ClientId: clientId,
+				Tenant:   tenant,
 			},
 		},
 		{
@@ -34,6 +36,7 @@ func TestTokenService(t *testing.T) {
 			expectedTokenData: TokenData{
 				Type:     RuntimeToken,
 				ClientId: clientId,
+				Tenant:   tenant,
 			},
 		},
 		{
@@ -42,6 +45,7 @@ func TestTokenService(t *testing.T) {
 			expectedTokenData: TokenData{
 				Type:     CSRToken,
 				ClientId: clientId,
+				Tenant:   tenant,
 			},
 		},
 	} {
@@ -50,7 +54,7 @@ func TestTokenService(t *testing.T) {
 			tokenService := newTokenService()
 
 			// when
-			token, err := tokenService.CreateToken(clientId, testCase.tokenType)
+			token, err := tokenService.CreateToken(testCase.expectedTokenData)
 
 			// then
 			require.NoError(t, err)
@@ -91,6 +95,34 @@ func TestTokenService_Resolve(t *testing.T) {
 	})
 }
 
+func TestTokenService_ResolveAndDelete(t *testing.T) {
+
+	t.Run("should resolve token only once", func(t *testing.T) {
+		// given
+		tokenService := newTokenService()
+
+		tokenData := TokenData{Type: ApplicationToken, ClientId: clientId, Tenant: tenant}
+
+		token, err := tokenService.CreateToken(tokenData)
+		require.NoError(t, err)
+
+		// when
+		resolvedTokenData, err := tokenService.ResolveAndDelete(token)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, tokenData, resolvedTokenData)
+
+		// when
+		resolvedTokenData, err = tokenService.ResolveAndDelete(token)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		assert.Empty(t, resolvedTokenData)
+	})
+}
+
 func newTokenService() Service {
 	tokenStore := NewTokenCache(1*time.Minute, 1*time.Minute, 1*time.Minute)
 	generator := NewTokenGenerator(10)
@@ -2,9 +2,23 @@
 
 package gqlschema
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type CACertificate struct {
+	Fingerprint string `json:"fingerprint"`
+	Subject     string `json:"subject"`
+	Role        CARole `json:"role"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
 type CertificateSigningRequestInfo struct {
-	Subject      string `json:"subject"`
-	KeyAlgorithm string `json:"keyAlgorithm"`
+	Subject              string   `json:"subject"`
+	KeyAlgorithm         string   `json:"keyAlgorithm"`
+	AllowedKeyAlgorithms []string `json:"allowedKeyAlgorithms"`
 }
 
 type CertificationResult struct {
@@ -19,6 +33,23 @@ type Configuration struct {
 	ManagementPlaneInfo           *ManagementPlaneInfo           `json:"managementPlaneInfo"`
 }
 
+type IssuedCertificate struct {
+	SerialNumber string      `json:"serialNumber"`
+	Subject      string      `json:"subject"`
+	ClientID     string      `json:"clientID"`
+	ClientType   *ClientType `json:"clientType"`
+	Tenant       *string     `json:"tenant"`
+	NotBefore    string      `json:"notBefore"`
+	NotAfter     string      `json:"notAfter"`
+}
+
+type IssuedCertificateFilter struct {
+	ClientID           *string     `json:"clientID"`
+	ClientType         *ClientType `json:"clientType"`
+	Tenant             *string     `json:"tenant"`
+	ExpiringWithinDays *int        `json:"expiringWithinDays"`
+}
+
 type ManagementPlaneInfo struct {
 	DirectorURL string `json:"directorURL"`
 }
@@ -26,3 +57,87 @@ type ManagementPlaneInfo struct {
 type Token struct {
 	Token string `json:"token"`
 }
+
+type CARole string
+
+const (
+	CARoleActive   CARole = "ACTIVE"
+	CARolePrevious CARole = "PREVIOUS"
+	CARoleRoot     CARole = "ROOT"
+)
+
+var AllCARole = []CARole{
+	CARoleActive,
+	CARolePrevious,
+	CARoleRoot,
+}
+
+func (e CARole) IsValid() bool {
+	switch e {
+	case CARoleActive, CARolePrevious, CARoleRoot:
+		return true
+	}
+	return false
+}
+
+func (e CARole) String() string {
+	return string(e)
+}
+
+func (e *CARole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CARole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CARole", str)
+	}
+	return nil
+}
+
+func (e CARole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ClientType string
+
+const (
+	ClientTypeApplication ClientType = "APPLICATION"
+	ClientTypeRuntime     ClientType = "RUNTIME"
+)
+
+var AllClientType = []ClientType{
+	ClientTypeApplication,
+	ClientTypeRuntime,
+}
+
+func (e ClientType) IsValid() bool {
+	switch e {
+	case ClientTypeApplication, ClientTypeRuntime:
+		return true
+	}
+	return false
+}
+
+func (e ClientType) String() string {
+	return string(e)
+}
+
+func (e *ClientType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ClientType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ClientType", str)
+	}
+	return nil
+}
+
+func (e ClientType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
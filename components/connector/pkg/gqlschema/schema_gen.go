@@ -42,9 +42,17 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	CACertificate struct {
+		ExpiresAt   func(childComplexity int) int
+		Fingerprint func(childComplexity int) int
+		Role        func(childComplexity int) int
+		Subject     func(childComplexity int) int
+	}
+
 	CertificateSigningRequestInfo struct {
-		KeyAlgorithm func(childComplexity int) int
-		Subject      func(childComplexity int) int
+		AllowedKeyAlgorithms func(childComplexity int) int
+		KeyAlgorithm         func(childComplexity int) int
+		Subject              func(childComplexity int) int
 	}
 
 	CertificationResult struct {
@@ -59,19 +67,34 @@ type ComplexityRoot struct {
 		Token                         func(childComplexity int) int
 	}
 
+	IssuedCertificate struct {
+		ClientID     func(childComplexity int) int
+		ClientType   func(childComplexity int) int
+		NotAfter     func(childComplexity int) int
+		NotBefore    func(childComplexity int) int
+		SerialNumber func(childComplexity int) int
+		Subject      func(childComplexity int) int
+		Tenant       func(childComplexity int) int
+	}
+
 	ManagementPlaneInfo struct {
 		DirectorURL func(childComplexity int) int
 	}
 
 	Mutation struct {
-		GenerateApplicationToken      func(childComplexity int, appID string) int
-		GenerateRuntimeToken          func(childComplexity int, runtimeID string) int
-		RevokeCertificate             func(childComplexity int) int
-		SignCertificateSigningRequest func(childComplexity int, csr string) int
+		GenerateApplicationToken        func(childComplexity int, appID string) int
+		GenerateRuntimeToken            func(childComplexity int, runtimeID string) int
+		RevokeApplicationCertificates   func(childComplexity int, appID string) int
+		RevokeCertificate               func(childComplexity int) int
+		RevokeCertificateBySerialNumber func(childComplexity int, serialNumber string) int
+		RevokeRuntimeCertificates       func(childComplexity int, runtimeID string) int
+		SignCertificateSigningRequest   func(childComplexity int, csr string) int
 	}
 
 	Query struct {
-		Configuration func(childComplexity int) int
+		CaCertificates     func(childComplexity int) int
+		Configuration      func(childComplexity int) int
+		IssuedCertificates func(childComplexity int, filter *IssuedCertificateFilter) int
 	}
 
 	Token struct {
@@ -84,9 +107,14 @@ type MutationResolver interface {
 	GenerateRuntimeToken(ctx context.Context, runtimeID string) (*Token, error)
 	SignCertificateSigningRequest(ctx context.Context, csr string) (*CertificationResult, error)
 	RevokeCertificate(ctx context.Context) (bool, error)
+	RevokeCertificateBySerialNumber(ctx context.Context, serialNumber string) (bool, error)
+	RevokeApplicationCertificates(ctx context.Context, appID string) (bool, error)
+	RevokeRuntimeCertificates(ctx context.Context, runtimeID string) (bool, error)
 }
 type QueryResolver interface {
 	Configuration(ctx context.Context) (*Configuration, error)
+	CaCertificates(ctx context.Context) ([]*CACertificate, error)
+	IssuedCertificates(ctx context.Context, filter *IssuedCertificateFilter) ([]*IssuedCertificate, error)
 }
 
 type executableSchema struct {
@@ -104,6 +132,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "CACertificate.expiresAt":
+		if e.complexity.CACertificate.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.CACertificate.ExpiresAt(childComplexity), true
+
+	case "CACertificate.fingerprint":
+		if e.complexity.CACertificate.Fingerprint == nil {
+			break
+		}
+
+		return e.complexity.CACertificate.Fingerprint(childComplexity), true
+
+	case "CACertificate.role":
+		if e.complexity.CACertificate.Role == nil {
+			break
+		}
+
+		return e.complexity.CACertificate.Role(childComplexity), true
+
+	case "CACertificate.subject":
+		if e.complexity.CACertificate.Subject == nil {
+			break
+		}
+
+		return e.complexity.CACertificate.Subject(childComplexity), true
+
+	case "CertificateSigningRequestInfo.allowedKeyAlgorithms":
+		if e.complexity.CertificateSigningRequestInfo.AllowedKeyAlgorithms == nil {
+			break
+		}
+
+		return e.complexity.CertificateSigningRequestInfo.AllowedKeyAlgorithms(childComplexity), true
+
 	case "CertificateSigningRequestInfo.keyAlgorithm":
 		if e.complexity.CertificateSigningRequestInfo.KeyAlgorithm == nil {
 			break
@@ -160,6 +223,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Configuration.Token(childComplexity), true
 
+	case "IssuedCertificate.clientID":
+		if e.complexity.IssuedCertificate.ClientID == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.ClientID(childComplexity), true
+
+	case "IssuedCertificate.clientType":
+		if e.complexity.IssuedCertificate.ClientType == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.ClientType(childComplexity), true
+
+	case "IssuedCertificate.notAfter":
+		if e.complexity.IssuedCertificate.NotAfter == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.NotAfter(childComplexity), true
+
+	case "IssuedCertificate.notBefore":
+		if e.complexity.IssuedCertificate.NotBefore == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.NotBefore(childComplexity), true
+
+	case "IssuedCertificate.serialNumber":
+		if e.complexity.IssuedCertificate.SerialNumber == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.SerialNumber(childComplexity), true
+
+	case "IssuedCertificate.subject":
+		if e.complexity.IssuedCertificate.Subject == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.Subject(childComplexity), true
+
+	case "IssuedCertificate.tenant":
+		if e.complexity.IssuedCertificate.Tenant == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.Tenant(childComplexity), true
+
 	case "ManagementPlaneInfo.directorURL":
 		if e.complexity.ManagementPlaneInfo.DirectorURL == nil {
 			break
@@ -191,6 +303,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.GenerateRuntimeToken(childComplexity, args["runtimeID"].(string)), true
 
+	case "Mutation.revokeApplicationCertificates":
+		if e.complexity.Mutation.RevokeApplicationCertificates == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeApplicationCertificates_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeApplicationCertificates(childComplexity, args["appID"].(string)), true
+
 	case "Mutation.revokeCertificate":
 		if e.complexity.Mutation.RevokeCertificate == nil {
 			break
@@ -198,6 +322,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.RevokeCertificate(childComplexity), true
 
+	case "Mutation.revokeCertificateBySerialNumber":
+		if e.complexity.Mutation.RevokeCertificateBySerialNumber == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeCertificateBySerialNumber_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeCertificateBySerialNumber(childComplexity, args["serialNumber"].(string)), true
+
+	case "Mutation.revokeRuntimeCertificates":
+		if e.complexity.Mutation.RevokeRuntimeCertificates == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeRuntimeCertificates_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeRuntimeCertificates(childComplexity, args["runtimeID"].(string)), true
+
 	case "Mutation.signCertificateSigningRequest":
 		if e.complexity.Mutation.SignCertificateSigningRequest == nil {
 			break
@@ -210,6 +358,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.SignCertificateSigningRequest(childComplexity, args["csr"].(string)), true
 
+	case "Query.caCertificates":
+		if e.complexity.Query.CaCertificates == nil {
+			break
+		}
+
+		return e.complexity.Query.CaCertificates(childComplexity), true
+
 	case "Query.configuration":
 		if e.complexity.Query.Configuration == nil {
 			break
@@ -217,6 +372,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Configuration(childComplexity), true
 
+	case "Query.issuedCertificates":
+		if e.complexity.Query.IssuedCertificates == nil {
+			break
+		}
+
+		args, err := ec.field_Query_issuedCertificates_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IssuedCertificates(childComplexity, args["filter"].(*IssuedCertificateFilter)), true
+
 	case "Token.token":
 		if e.complexity.Token.Token == nil {
 			break
@@ -327,7 +494,49 @@ type Configuration {
 # CSRInfo
 type CertificateSigningRequestInfo {
     subject: String! # eg.: "OU=Test,O=Test,L=Blacksburg,ST=Virginia,C=US,CN={ID}"
-    keyAlgorithm: String! # eg.: rsa2048
+    keyAlgorithm: String! # eg.: rsa2048, the preferred algorithm, kept for compatibility
+    allowedKeyAlgorithms: [String!]! # eg.: ["rsa2048", "ecdsa-p256"]
+}
+
+# CACertificate
+type CACertificate {
+    fingerprint: String! # SHA-256 fingerprint of the DER encoded certificate, eg.: "5E:0B:...:9C"
+    subject: String!
+    role: CARole!
+    expiresAt: String! # eg.: "2020-10-03T12:00:00Z"
+}
+
+enum CARole {
+    """signs the new client certificates"""
+    ACTIVE
+    """signed the client certificates before the rotation, published until the overlap window ends"""
+    PREVIOUS
+    """root CA attached to the certificate chain"""
+    ROOT
+}
+
+# IssuedCertificate
+type IssuedCertificate {
+    serialNumber: String! # hex encoded, eg.: "1a2b3c"
+    subject: String! # eg.: "OU=Test,O=Test,L=Blacksburg,ST=Virginia,C=US,CN={ID}"
+    clientID: ID!
+    clientType: ClientType # not set for certificates issued for clients of unknown type
+    tenant: String
+    notBefore: String! # eg.: "2019-10-03T12:00:00Z"
+    notAfter: String! # eg.: "2020-01-01T12:00:00Z"
+}
+
+enum ClientType {
+    APPLICATION
+    RUNTIME
+}
+
+input IssuedCertificateFilter {
+    clientID: ID
+    clientType: ClientType
+    tenant: String
+    """returns only the certificates which are still valid and expire within the given number of days"""
+    expiringWithinDays: Int
 }
 
 type Query {
@@ -335,6 +544,16 @@ type Query {
 
     """returns configuration information like subject that should be placed in the signing request or Director URL"""
     configuration: Configuration!
+
+    # CA
+
+    """returns the CA certificates currently used by the Connector"""
+    caCertificates: [CACertificate!]!
+
+    # Issued certificates
+
+    """returns the certificates issued by the Connector matching the filter, available only on the internal API"""
+    issuedCertificates(filter: IssuedCertificateFilter): [IssuedCertificate!]!
 }
 
 type Mutation {
@@ -347,6 +566,13 @@ type Mutation {
 
     """revokes certificate with which the request was issued"""
     revokeCertificate: Boolean!
+
+    """revokes certificate with the given hex encoded serial number"""
+    revokeCertificateBySerialNumber(serialNumber: String!): Boolean!
+    """revokes all certificates issued until now for the Application"""
+    revokeApplicationCertificates(appID: ID!): Boolean!
+    """revokes all certificates issued until now for the Runtime"""
+    revokeRuntimeCertificates(runtimeID: ID!): Boolean!
 }
 `},
 )
@@ -383,6 +609,48 @@ func (ec *executionContext) field_Mutation_generateRuntimeToken_args(ctx context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_revokeApplicationCertificates_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["appID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["appID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_revokeCertificateBySerialNumber_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["serialNumber"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["serialNumber"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_revokeRuntimeCertificates_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["runtimeID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["runtimeID"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_signCertificateSigningRequest_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -411,6 +679,20 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_issuedCertificates_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *IssuedCertificateFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		arg0, err = ec.unmarshalOIssuedCertificateFilter2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐIssuedCertificateFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -435,19 +717,337 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _CACertificate_fingerprint(ctx context.Context, field graphql.CollectedField, obj *CACertificate) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CACertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fingerprint, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CACertificate_subject(ctx context.Context, field graphql.CollectedField, obj *CACertificate) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CACertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Subject, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CACertificate_role(ctx context.Context, field graphql.CollectedField, obj *CACertificate) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CACertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Role, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(CARole)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNCARole2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCARole(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CACertificate_expiresAt(ctx context.Context, field graphql.CollectedField, obj *CACertificate) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CACertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpiresAt, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateSigningRequestInfo_subject(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificateSigningRequestInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Subject, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateSigningRequestInfo_keyAlgorithm(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificateSigningRequestInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.KeyAlgorithm, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateSigningRequestInfo_allowedKeyAlgorithms(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificateSigningRequestInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AllowedKeyAlgorithms, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificationResult_certificateChain(ctx context.Context, field graphql.CollectedField, obj *CertificationResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificationResult",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CertificateChain, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificationResult_caCertificate(ctx context.Context, field graphql.CollectedField, obj *CertificationResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificationResult",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CaCertificate, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificationResult_clientCertificate(ctx context.Context, field graphql.CollectedField, obj *CertificationResult) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificationResult",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ClientCertificate, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Configuration_token(ctx context.Context, field graphql.CollectedField, obj *Configuration) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Configuration",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Token, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Token)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOToken2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐToken(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Configuration_certificateSigningRequestInfo(ctx context.Context, field graphql.CollectedField, obj *Configuration) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Configuration",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CertificateSigningRequestInfo, nil
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*CertificateSigningRequestInfo)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalOCertificateSigningRequestInfo2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCertificateSigningRequestInfo(ctx, field.Selections, res)
 }
 
-// endregion ***************************** args.gotpl *****************************
-
-// region    **************************** field.gotpl *****************************
-
-func (ec *executionContext) _CertificateSigningRequestInfo_subject(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) graphql.Marshaler {
+func (ec *executionContext) _Configuration_managementPlaneInfo(ctx context.Context, field graphql.CollectedField, obj *Configuration) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "CertificateSigningRequestInfo",
+		Object:   "Configuration",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -456,25 +1056,22 @@ func (ec *executionContext) _CertificateSigningRequestInfo_subject(ctx context.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Subject, nil
+		return obj.ManagementPlaneInfo, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*ManagementPlaneInfo)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOManagementPlaneInfo2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐManagementPlaneInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CertificateSigningRequestInfo_keyAlgorithm(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) graphql.Marshaler {
+func (ec *executionContext) _IssuedCertificate_serialNumber(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "CertificateSigningRequestInfo",
+		Object:   "IssuedCertificate",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -483,7 +1080,7 @@ func (ec *executionContext) _CertificateSigningRequestInfo_keyAlgorithm(ctx cont
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.KeyAlgorithm, nil
+		return obj.SerialNumber, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -497,11 +1094,11 @@ func (ec *executionContext) _CertificateSigningRequestInfo_keyAlgorithm(ctx cont
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CertificationResult_certificateChain(ctx context.Context, field graphql.CollectedField, obj *CertificationResult) graphql.Marshaler {
+func (ec *executionContext) _IssuedCertificate_subject(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "CertificationResult",
+		Object:   "IssuedCertificate",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -510,7 +1107,7 @@ func (ec *executionContext) _CertificationResult_certificateChain(ctx context.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CertificateChain, nil
+		return obj.Subject, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -524,11 +1121,11 @@ func (ec *executionContext) _CertificationResult_certificateChain(ctx context.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CertificationResult_caCertificate(ctx context.Context, field graphql.CollectedField, obj *CertificationResult) graphql.Marshaler {
+func (ec *executionContext) _IssuedCertificate_clientID(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "CertificationResult",
+		Object:   "IssuedCertificate",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -537,7 +1134,7 @@ func (ec *executionContext) _CertificationResult_caCertificate(ctx context.Conte
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CaCertificate, nil
+		return obj.ClientID, nil
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -548,14 +1145,14 @@ func (ec *executionContext) _CertificationResult_caCertificate(ctx context.Conte
 	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _CertificationResult_clientCertificate(ctx context.Context, field graphql.CollectedField, obj *CertificationResult) graphql.Marshaler {
+func (ec *executionContext) _IssuedCertificate_clientType(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "CertificationResult",
+		Object:   "IssuedCertificate",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -564,25 +1161,22 @@ func (ec *executionContext) _CertificationResult_clientCertificate(ctx context.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ClientCertificate, nil
+		return obj.ClientType, nil
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*ClientType)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOClientType2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐClientType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Configuration_token(ctx context.Context, field graphql.CollectedField, obj *Configuration) graphql.Marshaler {
+func (ec *executionContext) _IssuedCertificate_tenant(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Configuration",
+		Object:   "IssuedCertificate",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -591,22 +1185,22 @@ func (ec *executionContext) _Configuration_token(ctx context.Context, field grap
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Token, nil
+		return obj.Tenant, nil
 	})
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*Token)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOToken2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐToken(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Configuration_certificateSigningRequestInfo(ctx context.Context, field graphql.CollectedField, obj *Configuration) graphql.Marshaler {
+func (ec *executionContext) _IssuedCertificate_notBefore(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Configuration",
+		Object:   "IssuedCertificate",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -615,22 +1209,25 @@ func (ec *executionContext) _Configuration_certificateSigningRequestInfo(ctx con
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CertificateSigningRequestInfo, nil
+		return obj.NotBefore, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*CertificateSigningRequestInfo)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOCertificateSigningRequestInfo2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCertificateSigningRequestInfo(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Configuration_managementPlaneInfo(ctx context.Context, field graphql.CollectedField, obj *Configuration) graphql.Marshaler {
+func (ec *executionContext) _IssuedCertificate_notAfter(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
-		Object:   "Configuration",
+		Object:   "IssuedCertificate",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -639,15 +1236,18 @@ func (ec *executionContext) _Configuration_managementPlaneInfo(ctx context.Conte
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ManagementPlaneInfo, nil
+		return obj.NotAfter, nil
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*ManagementPlaneInfo)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return ec.marshalOManagementPlaneInfo2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐManagementPlaneInfo(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _ManagementPlaneInfo_directorURL(ctx context.Context, field graphql.CollectedField, obj *ManagementPlaneInfo) graphql.Marshaler {
@@ -806,6 +1406,108 @@ func (ec *executionContext) _Mutation_revokeCertificate(ctx context.Context, fie
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Mutation_revokeCertificateBySerialNumber(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_revokeCertificateBySerialNumber_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeCertificateBySerialNumber(rctx, args["serialNumber"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_revokeApplicationCertificates(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_revokeApplicationCertificates_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeApplicationCertificates(rctx, args["appID"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_revokeRuntimeCertificates(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_revokeRuntimeCertificates_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeRuntimeCertificates(rctx, args["runtimeID"].(string))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Query_configuration(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
@@ -833,6 +1535,67 @@ func (ec *executionContext) _Query_configuration(ctx context.Context, field grap
 	return ec.marshalNConfiguration2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐConfiguration(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Query_caCertificates(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CaCertificates(rctx)
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*CACertificate)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNCACertificate2ᚕᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCACertificate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_issuedCertificates(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_issuedCertificates_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().IssuedCertificates(rctx, args["filter"].(*IssuedCertificateFilter))
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*IssuedCertificate)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNIssuedCertificate2ᚕᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐIssuedCertificate(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
@@ -1746,6 +2509,42 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputIssuedCertificateFilter(ctx context.Context, v interface{}) (IssuedCertificateFilter, error) {
+	var it IssuedCertificateFilter
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "clientID":
+			var err error
+			it.ClientID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "clientType":
+			var err error
+			it.ClientType, err = ec.unmarshalOClientType2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐClientType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "tenant":
+			var err error
+			it.Tenant, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "expiringWithinDays":
+			var err error
+			it.ExpiringWithinDays, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -1754,6 +2553,48 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 
 // region    **************************** object.gotpl ****************************
 
+var cACertificateImplementors = []string{"CACertificate"}
+
+func (ec *executionContext) _CACertificate(ctx context.Context, sel ast.SelectionSet, obj *CACertificate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, cACertificateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CACertificate")
+		case "fingerprint":
+			out.Values[i] = ec._CACertificate_fingerprint(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "subject":
+			out.Values[i] = ec._CACertificate_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "role":
+			out.Values[i] = ec._CACertificate_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._CACertificate_expiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var certificateSigningRequestInfoImplementors = []string{"CertificateSigningRequestInfo"}
 
 func (ec *executionContext) _CertificateSigningRequestInfo(ctx context.Context, sel ast.SelectionSet, obj *CertificateSigningRequestInfo) graphql.Marshaler {
@@ -1775,6 +2616,11 @@ func (ec *executionContext) _CertificateSigningRequestInfo(ctx context.Context,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "allowedKeyAlgorithms":
+			out.Values[i] = ec._CertificateSigningRequestInfo_allowedKeyAlgorithms(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1851,6 +2697,57 @@ func (ec *executionContext) _Configuration(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var issuedCertificateImplementors = []string{"IssuedCertificate"}
+
+func (ec *executionContext) _IssuedCertificate(ctx context.Context, sel ast.SelectionSet, obj *IssuedCertificate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.RequestContext, sel, issuedCertificateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("IssuedCertificate")
+		case "serialNumber":
+			out.Values[i] = ec._IssuedCertificate_serialNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "subject":
+			out.Values[i] = ec._IssuedCertificate_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "clientID":
+			out.Values[i] = ec._IssuedCertificate_clientID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "clientType":
+			out.Values[i] = ec._IssuedCertificate_clientType(ctx, field, obj)
+		case "tenant":
+			out.Values[i] = ec._IssuedCertificate_tenant(ctx, field, obj)
+		case "notBefore":
+			out.Values[i] = ec._IssuedCertificate_notBefore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "notAfter":
+			out.Values[i] = ec._IssuedCertificate_notAfter(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var managementPlaneInfoImplementors = []string{"ManagementPlaneInfo"}
 
 func (ec *executionContext) _ManagementPlaneInfo(ctx context.Context, sel ast.SelectionSet, obj *ManagementPlaneInfo) graphql.Marshaler {
@@ -1913,6 +2810,21 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "revokeCertificateBySerialNumber":
+			out.Values[i] = ec._Mutation_revokeCertificateBySerialNumber(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "revokeApplicationCertificates":
+			out.Values[i] = ec._Mutation_revokeApplicationCertificates(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "revokeRuntimeCertificates":
+			out.Values[i] = ec._Mutation_revokeRuntimeCertificates(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1953,6 +2865,34 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				}
 				return res
 			})
+		case "caCertificates":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_caCertificates(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "issuedCertificates":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_issuedCertificates(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -2254,6 +3194,66 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNCACertificate2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCACertificate(ctx context.Context, sel ast.SelectionSet, v CACertificate) graphql.Marshaler {
+	return ec._CACertificate(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCACertificate2ᚕᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCACertificate(ctx context.Context, sel ast.SelectionSet, v []*CACertificate) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCACertificate2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCACertificate(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNCACertificate2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCACertificate(ctx context.Context, sel ast.SelectionSet, v *CACertificate) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CACertificate(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCARole2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCARole(ctx context.Context, v interface{}) (CARole, error) {
+	var res CARole
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNCARole2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCARole(ctx context.Context, sel ast.SelectionSet, v CARole) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNCertificationResult2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐCertificationResult(ctx context.Context, sel ast.SelectionSet, v CertificationResult) graphql.Marshaler {
 	return ec._CertificationResult(ctx, sel, &v)
 }
@@ -2296,6 +3296,57 @@ func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.Selec
 	return res
 }
 
+func (ec *executionContext) marshalNIssuedCertificate2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐIssuedCertificate(ctx context.Context, sel ast.SelectionSet, v IssuedCertificate) graphql.Marshaler {
+	return ec._IssuedCertificate(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNIssuedCertificate2ᚕᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐIssuedCertificate(ctx context.Context, sel ast.SelectionSet, v []*IssuedCertificate) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNIssuedCertificate2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐIssuedCertificate(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNIssuedCertificate2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐIssuedCertificate(ctx context.Context, sel ast.SelectionSet, v *IssuedCertificate) graphql.Marshaler {
+	if v == nil {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._IssuedCertificate(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -2310,6 +3361,43 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) marshalNString2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) marshalNToken2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐToken(ctx context.Context, sel ast.SelectionSet, v Token) graphql.Marshaler {
 	return ec._Token(ctx, sel, &v)
 }
@@ -2584,6 +3672,88 @@ func (ec *executionContext) marshalOCertificateSigningRequestInfo2ᚖgithubᚗco
 	return ec._CertificateSigningRequestInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOClientType2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐClientType(ctx context.Context, v interface{}) (ClientType, error) {
+	var res ClientType
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOClientType2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐClientType(ctx context.Context, sel ast.SelectionSet, v ClientType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOClientType2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐClientType(ctx context.Context, v interface{}) (*ClientType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOClientType2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐClientType(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOClientType2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐClientType(ctx context.Context, sel ast.SelectionSet, v *ClientType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOID2string(ctx context.Context, v interface{}) (string, error) {
+	return graphql.UnmarshalID(v)
+}
+
+func (ec *executionContext) marshalOID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	return graphql.MarshalID(v)
+}
+
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOID2string(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.marshalOID2string(ctx, sel, *v)
+}
+
+func (ec *executionContext) unmarshalOInt2int(ctx context.Context, v interface{}) (int, error) {
+	return graphql.UnmarshalInt(v)
+}
+
+func (ec *executionContext) marshalOInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	return graphql.MarshalInt(v)
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOInt2int(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.marshalOInt2int(ctx, sel, *v)
+}
+
+func (ec *executionContext) unmarshalOIssuedCertificateFilter2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐIssuedCertificateFilter(ctx context.Context, v interface{}) (IssuedCertificateFilter, error) {
+	return ec.unmarshalInputIssuedCertificateFilter(ctx, v)
+}
+
+func (ec *executionContext) unmarshalOIssuedCertificateFilter2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐIssuedCertificateFilter(ctx context.Context, v interface{}) (*IssuedCertificateFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOIssuedCertificateFilter2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐIssuedCertificateFilter(ctx, v)
+	return &res, err
+}
+
 func (ec *executionContext) marshalOManagementPlaneInfo2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgqlschemaᚐManagementPlaneInfo(ctx context.Context, sel ast.SelectionSet, v ManagementPlaneInfo) graphql.Marshaler {
 	return ec._ManagementPlaneInfo(ctx, sel, &v)
 }
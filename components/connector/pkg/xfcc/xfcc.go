@@ -0,0 +1,73 @@
+// Package xfcc reads the certificate of the client from the X-Forwarded-Client-Cert header set by the Envoy proxy.
+// It is shared by the Connector and the Gateway.
+package xfcc
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	Header = "X-Forwarded-Client-Cert"
+
+	certificateField = "cert"
+)
+
+// CertificateFromHeader returns PEM encoded certificate of the original client from X-Forwarded-Client-Cert header value.
+// The header may contain multiple comma separated elements appended by subsequent proxies,
+// the first element with the certificate describes the original client.
+// Empty string is returned when the header does not contain any certificate.
+func CertificateFromHeader(header string) (string, error) {
+	for _, element := range splitOutsideQuotes(header, ',') {
+		for _, pair := range splitOutsideQuotes(element, ';') {
+			keyValue := strings.SplitN(pair, "=", 2)
+			if len(keyValue) != 2 || strings.ToLower(strings.TrimSpace(keyValue[0])) != certificateField {
+				continue
+			}
+
+			certificate, err := url.PathUnescape(unquote(strings.TrimSpace(keyValue[1])))
+			if err != nil {
+				return "", errors.Wrap(err, "while unescaping client certificate")
+			}
+
+			return certificate, nil
+		}
+	}
+
+	return "", nil
+}
+
+func splitOutsideQuotes(value string, separator rune) []string {
+	var parts []string
+	var current strings.Builder
+
+	quoted := false
+	escaped := false
+	for _, char := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case char == '\\' && quoted:
+			escaped = true
+		case char == '"':
+			quoted = !quoted
+		case char == separator && !quoted:
+			parts = append(parts, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(char)
+	}
+
+	return append(parts, current.String())
+}
+
+func unquote(value string) string {
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return value
+	}
+
+	return strings.Replace(value[1:len(value)-1], `\"`, `"`, -1)
+}
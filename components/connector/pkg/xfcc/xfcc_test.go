@@ -0,0 +1,76 @@
+package xfcc_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/pkg/xfcc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	pemCertificate = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	hash           = "f4cf22fb633d4df500e371daf703d4b4d14a0ea9d69cd631f95f9e6ba840f8ad"
+)
+
+func TestCertificateFromHeader(t *testing.T) {
+	encodedCertificate := url.PathEscape(pemCertificate)
+
+	testCases := []struct {
+		Name                string
+		Header              string
+		ExpectedCertificate string
+	}{
+		{
+			Name:                "Single element",
+			Header:              `Hash=` + hash + `;Cert="` + encodedCertificate + `";Subject="CN=app-id,O=Org";URI=`,
+			ExpectedCertificate: pemCertificate,
+		},
+		{
+			Name:                "Unquoted certificate",
+			Header:              `Hash=` + hash + `;Cert=` + encodedCertificate,
+			ExpectedCertificate: pemCertificate,
+		},
+		{
+			Name: "Certificate of the original client in the first of multiple elements",
+			Header: `By=spiffe://cluster.local/ns/compass-system/sa/gateway;Hash=` + hash + `;Cert="` + encodedCertificate + `";Subject="CN=app-id,O=Org"` +
+				`,By=spiffe://cluster.local/ns/compass-system/sa/connector;Hash=` + hash + `;Subject=""`,
+			ExpectedCertificate: pemCertificate,
+		},
+		{
+			Name:                "Separators within quoted subject",
+			Header:              `Hash=` + hash + `;Subject="CN=app-id,O=Org;Unit";Cert="` + encodedCertificate + `"`,
+			ExpectedCertificate: pemCertificate,
+		},
+		{
+			Name:                "No certificate",
+			Header:              `Hash=` + hash + `;Subject="CN=app-id,O=Org";URI=`,
+			ExpectedCertificate: "",
+		},
+		{
+			Name:                "Empty header",
+			Header:              "",
+			ExpectedCertificate: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			certificate, err := xfcc.CertificateFromHeader(testCase.Header)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedCertificate, certificate)
+		})
+	}
+
+	t.Run("should return error when certificate is not properly escaped", func(t *testing.T) {
+		// when
+		_, err := xfcc.CertificateFromHeader(`Hash=` + hash + `;Cert="%zz"`)
+
+		// then
+		require.Error(t, err)
+	})
+}
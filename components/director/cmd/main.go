@@ -16,13 +16,17 @@ import (
 	"github.com/99designs/gqlgen/handler"
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/vrischmann/envconfig"
 )
 
 const connStringf string = "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s"
 
 type config struct {
-	Address  string `envconfig:"default=127.0.0.1:3000"`
+	Address string `envconfig:"default=127.0.0.1:3000"`
+	// InternalAddress serves the API used by other Compass components, it is not exposed by the Gateway
+	InternalAddress string `envconfig:"default=127.0.0.1:3002"`
+
 	Database struct {
 		User     string `envconfig:"default=postgres,APP_DB_USER"`
 		Password string `envconfig:"default=pgsql@12345,APP_DB_PASSWORD"`
@@ -56,8 +60,9 @@ func main() {
 		}
 	}()
 
+	rootResolver := domain.NewRootResolver(transact)
 	gqlCfg := graphql.Config{
-		Resolvers: domain.NewRootResolver(transact),
+		Resolvers: rootResolver,
 	}
 	executableSchema := graphql.NewExecutableSchema(gqlCfg)
 
@@ -66,10 +71,20 @@ func main() {
 
 	router.Use(tenant.RequireAndPassContext)
 	router.HandleFunc("/", handler.Playground("Dataloader", cfg.PlaygroundAPIEndpoint))
-	router.HandleFunc(cfg.APIEndpoint, handler.GraphQL(executableSchema))
+	router.HandleFunc(cfg.APIEndpoint, handler.GraphQL(executableSchema, handler.ResolverMiddleware(pagination.TotalCountMiddleware)))
 
 	http.Handle("/", router)
 
+	internalRouter := mux.NewRouter()
+	rootResolver.ClientLookupHandler().Register(internalRouter)
+
+	go func() {
+		log.Infof("Internal API listening on %s...", cfg.InternalAddress)
+		if err := http.ListenAndServe(cfg.InternalAddress, internalRouter); err != nil {
+			panic(err)
+		}
+	}()
+
 	log.Infof("Listening on %s...", cfg.Address)
 	if err := http.ListenAndServe(cfg.Address, nil); err != nil {
 		panic(err)
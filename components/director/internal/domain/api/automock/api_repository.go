@@ -5,6 +5,7 @@ package automock
 import context "context"
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import orderby "github.com/kyma-incubator/compass/components/director/internal/orderby"
 
 // APIRepository is an autogenerated mock type for the APIRepository type
 type APIRepository struct {
@@ -111,13 +112,13 @@ func (_m *APIRepository) GetByID(id string) (*model.APIDefinition, error) {
 	return r0, r1
 }
 
-// ListByApplicationID provides a mock function with given fields: applicationID, pageSize, cursor
-func (_m *APIRepository) ListByApplicationID(applicationID string, pageSize *int, cursor *string) (*model.APIDefinitionPage, error) {
-	ret := _m.Called(applicationID, pageSize, cursor)
+// ListByApplicationID provides a mock function with given fields: applicationID, pageSize, cursor, orderBy
+func (_m *APIRepository) ListByApplicationID(applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.APIDefinitionPage, error) {
+	ret := _m.Called(applicationID, pageSize, cursor, orderBy)
 
 	var r0 *model.APIDefinitionPage
-	if rf, ok := ret.Get(0).(func(string, *int, *string) *model.APIDefinitionPage); ok {
-		r0 = rf(applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(string, *int, *string, *orderby.OrderBy) *model.APIDefinitionPage); ok {
+		r0 = rf(applicationID, pageSize, cursor, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.APIDefinitionPage)
@@ -125,8 +126,8 @@ func (_m *APIRepository) ListByApplicationID(applicationID string, pageSize *int
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, *int, *string) error); ok {
-		r1 = rf(applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(string, *int, *string, *orderby.OrderBy) error); ok {
+		r1 = rf(applicationID, pageSize, cursor, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,9 +3,11 @@ package api
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
@@ -62,7 +64,7 @@ func (r *inMemoryRepository) List(filter []*labelfilter.LabelFilter, pageSize *i
 	}, nil
 }
 
-func (r *inMemoryRepository) ListByApplicationID(applicationID string, pageSize *int, cursor *string) (*model.APIDefinitionPage, error) {
+func (r *inMemoryRepository) ListByApplicationID(applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.APIDefinitionPage, error) {
 	var items []*model.APIDefinition
 	for _, a := range r.store {
 		if a.ApplicationID == applicationID {
@@ -70,6 +72,10 @@ func (r *inMemoryRepository) ListByApplicationID(applicationID string, pageSize
 		}
 	}
 
+	if err := sortAPIDefinitions(items, orderBy); err != nil {
+		return nil, err
+	}
+
 	return &model.APIDefinitionPage{
 		Data:       items,
 		TotalCount: len(items),
@@ -81,6 +87,25 @@ func (r *inMemoryRepository) ListByApplicationID(applicationID string, pageSize
 	}, nil
 }
 
+// sortAPIDefinitions orders the APIDefinitions the same way as the database does, as they are not stored there yet
+// TODO: remove this function after migrating to Database
+func sortAPIDefinitions(items []*model.APIDefinition, orderBy *orderby.OrderBy) error {
+	var value func(item *model.APIDefinition) string
+	switch orderBy.FieldOrID() {
+	case orderby.ID:
+		value = func(item *model.APIDefinition) string { return item.ID }
+	case orderby.Name:
+		value = func(item *model.APIDefinition) string { return item.Name }
+	default:
+		return errors.Errorf("ordering by %s is not supported", orderBy.FieldOrID())
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return orderBy.Less(orderby.Key{Value: value(items[i]), ID: items[i].ID}, orderby.Key{Value: value(items[j]), ID: items[j].ID})
+	})
+	return nil
+}
+
 func (r *inMemoryRepository) Create(item *model.APIDefinition) error {
 	if item == nil {
 		return errors.New("item can not be nil")
@@ -144,6 +169,9 @@ var apiDefColumns = []string{"id", "tenant_id", "app_id", "name", "description",
 
 var idColumns = []string{"id"}
 
+// apiDefOrderColumns are the indexed columns the API Definitions can be ordered by
+var apiDefOrderColumns = map[orderby.Field]string{orderby.Name: "name"}
+
 var updatableColumns = []string{"name", "description", "group_name", "target_url", "spec_data",
 	"spec_format", "spec_type", "default_auth", "version_value", "version_deprecated",
 	"version_deprecated_since", "version_for_removal"}
@@ -167,7 +195,7 @@ type pgRepository struct {
 func NewPostgresRepository(conv APIDefinitionConverter) *pgRepository {
 	return &pgRepository{
 		SingleGetter:    repo.NewSingleGetter(apiDefTable, tenantColumn, apiDefColumns),
-		PageableQuerier: repo.NewPageableQuerier(apiDefTable, tenantColumn, apiDefColumns),
+		PageableQuerier: repo.NewPageableQuerier(apiDefTable, tenantColumn, "id", apiDefColumns),
 		Creator:         repo.NewCreator(apiDefTable, apiDefColumns),
 		Updater:         repo.NewUpdater(apiDefTable, updatableColumns, tenantColumn, idColumns),
 		Deleter:         repo.NewDeleter(apiDefTable, tenantColumn),
@@ -182,10 +210,15 @@ func (r APIDefCollection) Len() int {
 	return len(r)
 }
 
-func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
+func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.APIDefinitionPage, error) {
+	paginationOrderBy, err := orderBy.ToPagination("id", apiDefOrderColumns)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting order")
+	}
+
 	appCond := fmt.Sprintf("%s = '%s'", "app_id", applicationID)
 	var apiDefCollection APIDefCollection
-	page, totalCount, err := r.PageableQuerier.List(ctx, tenantID, pageSize, cursor, "id", &apiDefCollection, appCond)
+	page, totalCount, err := r.PageableQuerier.List(ctx, tenantID, pageSize, cursor, paginationOrderBy, &apiDefCollection, appCond)
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +73,7 @@ func TestPgRepository_GetByID(t *testing.T) {
 
 func TestPgRepository_ListByApplicationID(t *testing.T) {
 	// GIVEN
-	ExpectedLimit := 3
-	ExpectedOffset := 0
+	ExpectedLimit := 4
 
 	inputPageSize := 3
 	inputCursor := ""
@@ -86,7 +85,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 
 	selectQuery := fmt.Sprintf(`^SELECT (.+) FROM "public"."api_definitions" 
 		WHERE tenant_id=\$1 AND app_id = '%s' 
-		ORDER BY id LIMIT %d OFFSET %d`, appID, ExpectedLimit, ExpectedOffset)
+		ORDER BY id LIMIT %d`, appID, ExpectedLimit)
 
 	rawCountQuery := fmt.Sprintf(`SELECT COUNT(*) FROM "public"."api_definitions" 
 		WHERE tenant_id=$1 AND app_id = '%s'`, appID)
@@ -112,7 +111,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		convMock.On("FromEntity", secondApiDefEntity).Return(model.APIDefinition{ID: secondApiDefID}, nil)
 		pgRepository := api.NewPostgresRepository(convMock)
 		// WHEN
-		modelAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
+		modelAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor, nil)
 		//THEN
 		require.NoError(t, err)
 		require.Len(t, modelAPIDef.Data, 2)
@@ -143,7 +142,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		convMock.On("FromEntity", firstApiDefEntity).Return(model.APIDefinition{}, testErr).Once()
 		pgRepository := api.NewPostgresRepository(convMock)
 		//WHEN
-		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
+		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor, nil)
 		//THEN
 		require.Error(t, err)
 		require.Contains(t, err.Error(), testErr.Error())
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/pkg/errors"
@@ -16,7 +17,7 @@ import (
 type APIRepository interface {
 	GetByID(id string) (*model.APIDefinition, error)
 	Exists(ctx context.Context, tenant, id string) (bool, error)
-	ListByApplicationID(applicationID string, pageSize *int, cursor *string) (*model.APIDefinitionPage, error)
+	ListByApplicationID(applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.APIDefinitionPage, error)
 	CreateMany(item []*model.APIDefinition) error
 	Create(item *model.APIDefinition) error
 	Update(item *model.APIDefinition) error
@@ -51,8 +52,8 @@ func NewService(repo APIRepository, fetchRequestRepo FetchRequestRepository, uid
 	}
 }
 
-func (s *service) List(ctx context.Context, applicationID string, pageSize *int, cursor *string) (*model.APIDefinitionPage, error) {
-	return s.repo.ListByApplicationID(applicationID, pageSize, cursor)
+func (s *service) List(ctx context.Context, applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.APIDefinitionPage, error) {
+	return s.repo.ListByApplicationID(applicationID, pageSize, cursor, orderBy)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.APIDefinition, error) {
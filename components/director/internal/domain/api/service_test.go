@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/stretchr/testify/mock"
@@ -114,6 +115,7 @@ func TestService_List(t *testing.T) {
 
 	first := 2
 	after := "test"
+	orderBy := orderby.New(orderby.Name, pagination.DescOrderDirection)
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, "tenant")
@@ -130,7 +132,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
-				repo.On("ListByApplicationID", applicationID, &first, &after).Return(apiDefinitionPage, nil).Once()
+				repo.On("ListByApplicationID", applicationID, &first, &after, orderBy).Return(apiDefinitionPage, nil).Once()
 				return repo
 			},
 			InputPageSize:      &first,
@@ -142,7 +144,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when APIDefinition listing failed",
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
-				repo.On("ListByApplicationID", applicationID, &first, &after).Return(nil, testErr).Once()
+				repo.On("ListByApplicationID", applicationID, &first, &after, orderBy).Return(nil, testErr).Once()
 				return repo
 			},
 			InputPageSize:      &first,
@@ -159,7 +161,7 @@ func TestService_List(t *testing.T) {
 			svc := api.NewService(repo, nil, nil)
 
 			// when
-			docs, err := svc.List(ctx, applicationID, testCase.InputPageSize, testCase.InputCursor)
+			docs, err := svc.List(ctx, applicationID, testCase.InputPageSize, testCase.InputCursor, orderBy)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -41,6 +41,20 @@ func (_m *ApplicationConverter) MultipleToGraphQL(in []*model.Application) []*gr
 	return r0
 }
 
+// PairingReportFromGraphQL provides a mock function with given fields: in
+func (_m *ApplicationConverter) PairingReportFromGraphQL(in graphql.PairingReportInput) model.PairingReport {
+	ret := _m.Called(in)
+
+	var r0 model.PairingReport
+	if rf, ok := ret.Get(0).(func(graphql.PairingReportInput) model.PairingReport); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.PairingReport)
+	}
+
+	return r0
+}
+
 // ToGraphQL provides a mock function with given fields: in
 func (_m *ApplicationConverter) ToGraphQL(in *model.Application) *graphql.Application {
 	ret := _m.Called(in)
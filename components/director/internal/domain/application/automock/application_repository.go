@@ -7,6 +7,8 @@ import labelfilter "github.com/kyma-incubator/compass/components/director/intern
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/kyma-incubator/compass/components/director/internal/model"
 import uuid "github.com/google/uuid"
+import orderby "github.com/kyma-incubator/compass/components/director/internal/orderby"
+import search "github.com/kyma-incubator/compass/components/director/internal/search"
 
 // ApplicationRepository is an autogenerated mock type for the ApplicationRepository type
 type ApplicationRepository struct {
@@ -85,13 +87,13 @@ func (_m *ApplicationRepository) GetByID(ctx context.Context, tenant string, id
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
-func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize *int, cursor *string) (*model.ApplicationPage, error) {
-	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, tenant, filter, query, pageSize, cursor, orderBy
+func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, query search.Query, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, tenant, filter, query, pageSize, cursor, orderBy)
 
 	var r0 *model.ApplicationPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, *int, *string) *model.ApplicationPage); ok {
-		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, search.Query, *int, *string, *orderby.OrderBy) *model.ApplicationPage); ok {
+		r0 = rf(ctx, tenant, filter, query, pageSize, cursor, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.ApplicationPage)
@@ -99,8 +101,8 @@ func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, *int, *string) error); ok {
-		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, search.Query, *int, *string, *orderby.OrderBy) error); ok {
+		r1 = rf(ctx, tenant, filter, query, pageSize, cursor, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -131,6 +133,29 @@ func (_m *ApplicationRepository) ListByScenarios(ctx context.Context, tenantID u
 	return r0, r1
 }
 
+// ListIDs provides a mock function with given fields: ctx, tenant, filter
+func (_m *ApplicationRepository) ListIDs(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]string, error) {
+	ret := _m.Called(ctx, tenant, filter)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter) []string); ok {
+		r0 = rf(ctx, tenant, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter) error); ok {
+		r1 = rf(ctx, tenant, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *ApplicationRepository) Update(ctx context.Context, item *model.Application) error {
 	ret := _m.Called(ctx, item)
@@ -7,6 +7,8 @@ import labelfilter "github.com/kyma-incubator/compass/components/director/intern
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/kyma-incubator/compass/components/director/internal/model"
 import uuid "github.com/google/uuid"
+import orderby "github.com/kyma-incubator/compass/components/director/internal/orderby"
+import search "github.com/kyma-incubator/compass/components/director/internal/search"
 
 // ApplicationService is an autogenerated mock type for the ApplicationService type
 type ApplicationService struct {
@@ -62,6 +64,29 @@ func (_m *ApplicationService) DeleteLabel(ctx context.Context, applicationID str
 	return r0
 }
 
+// DeleteLabelForMatching provides a mock function with given fields: ctx, filter, key, dryRun
+func (_m *ApplicationService) DeleteLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, dryRun bool) ([]string, error) {
+	ret := _m.Called(ctx, filter, key, dryRun)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, string, bool) []string); ok {
+		r0 = rf(ctx, filter, key, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, string, bool) error); ok {
+		r1 = rf(ctx, filter, key, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *ApplicationService) Get(ctx context.Context, id string) (*model.Application, error) {
 	ret := _m.Called(ctx, id)
@@ -108,13 +133,13 @@ func (_m *ApplicationService) GetLabel(ctx context.Context, applicationID string
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, filter, pageSize, cursor
-func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize *int, cursor *string) (*model.ApplicationPage, error) {
-	ret := _m.Called(ctx, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, filter, query, pageSize, cursor, orderBy
+func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.LabelFilter, query search.Query, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, filter, query, pageSize, cursor, orderBy)
 
 	var r0 *model.ApplicationPage
-	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, *int, *string) *model.ApplicationPage); ok {
-		r0 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, search.Query, *int, *string, *orderby.OrderBy) *model.ApplicationPage); ok {
+		r0 = rf(ctx, filter, query, pageSize, cursor, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.ApplicationPage)
@@ -122,8 +147,8 @@ func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.La
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, *int, *string) error); ok {
-		r1 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, search.Query, *int, *string, *orderby.OrderBy) error); ok {
+		r1 = rf(ctx, filter, query, pageSize, cursor, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -177,6 +202,20 @@ func (_m *ApplicationService) ListLabels(ctx context.Context, applicationID stri
 	return r0, r1
 }
 
+// ReportPairing provides a mock function with given fields: ctx, id, report
+func (_m *ApplicationService) ReportPairing(ctx context.Context, id string, report model.PairingReport) error {
+	ret := _m.Called(ctx, id, report)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PairingReport) error); ok {
+		r0 = rf(ctx, id, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetLabel provides a mock function with given fields: ctx, label
 func (_m *ApplicationService) SetLabel(ctx context.Context, label *model.LabelInput) error {
 	ret := _m.Called(ctx, label)
@@ -191,6 +230,29 @@ func (_m *ApplicationService) SetLabel(ctx context.Context, label *model.LabelIn
 	return r0
 }
 
+// SetLabelForMatching provides a mock function with given fields: ctx, filter, key, value, dryRun
+func (_m *ApplicationService) SetLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, value interface{}, dryRun bool) ([]string, error) {
+	ret := _m.Called(ctx, filter, key, value, dryRun)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, string, interface{}, bool) []string); ok {
+		r0 = rf(ctx, filter, key, value, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, string, interface{}, bool) error); ok {
+		r1 = rf(ctx, filter, key, value, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *ApplicationService) Update(ctx context.Context, id string, in model.ApplicationInput) error {
 	ret := _m.Called(ctx, id, in)
@@ -5,19 +5,20 @@ package automock
 import context "context"
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import orderby "github.com/kyma-incubator/compass/components/director/internal/orderby"
 
 // DocumentService is an autogenerated mock type for the DocumentService type
 type DocumentService struct {
 	mock.Mock
 }
 
-// List provides a mock function with given fields: ctx, applicationID, pageSize, cursor
-func (_m *DocumentService) List(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error) {
-	ret := _m.Called(ctx, applicationID, pageSize, cursor)
+// List provides a mock function with given fields: ctx, applicationID, pageSize, cursor, orderBy
+func (_m *DocumentService) List(ctx context.Context, applicationID string, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.DocumentPage, error) {
+	ret := _m.Called(ctx, applicationID, pageSize, cursor, orderBy)
 
 	var r0 *model.DocumentPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.DocumentPage); ok {
-		r0 = rf(ctx, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string, *orderby.OrderBy) *model.DocumentPage); ok {
+		r0 = rf(ctx, applicationID, pageSize, cursor, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.DocumentPage)
@@ -25,8 +26,8 @@ func (_m *DocumentService) List(ctx context.Context, applicationID string, pageS
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
-		r1 = rf(ctx, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string, *orderby.OrderBy) error); ok {
+		r1 = rf(ctx, applicationID, pageSize, cursor, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5,6 +5,7 @@ package automock
 import context "context"
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import orderby "github.com/kyma-incubator/compass/components/director/internal/orderby"
 
 // EventAPIService is an autogenerated mock type for the EventAPIService type
 type EventAPIService struct {
@@ -46,13 +47,13 @@ func (_m *EventAPIService) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
-// List provides a mock function with given fields: ctx, applicationID, pageSize, cursor
-func (_m *EventAPIService) List(ctx context.Context, applicationID string, pageSize *int, cursor *string) (*model.EventAPIDefinitionPage, error) {
-	ret := _m.Called(ctx, applicationID, pageSize, cursor)
+// List provides a mock function with given fields: ctx, applicationID, pageSize, cursor, orderBy
+func (_m *EventAPIService) List(ctx context.Context, applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.EventAPIDefinitionPage, error) {
+	ret := _m.Called(ctx, applicationID, pageSize, cursor, orderBy)
 
 	var r0 *model.EventAPIDefinitionPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, *int, *string) *model.EventAPIDefinitionPage); ok {
-		r0 = rf(ctx, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int, *string, *orderby.OrderBy) *model.EventAPIDefinitionPage); ok {
+		r0 = rf(ctx, applicationID, pageSize, cursor, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.EventAPIDefinitionPage)
@@ -60,8 +61,8 @@ func (_m *EventAPIService) List(ctx context.Context, applicationID string, pageS
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, *int, *string) error); ok {
-		r1 = rf(ctx, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *int, *string, *orderby.OrderBy) error); ok {
+		r1 = rf(ctx, applicationID, pageSize, cursor, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
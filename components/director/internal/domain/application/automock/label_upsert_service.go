@@ -38,3 +38,17 @@ func (_m *LabelUpsertService) UpsertMultipleLabels(ctx context.Context, tenant s
 
 	return r0
 }
+
+// ValidateLabel provides a mock function with given fields: ctx, tenant, labelInput
+func (_m *LabelUpsertService) ValidateLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error {
+	ret := _m.Called(ctx, tenant, labelInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.LabelInput) error); ok {
+		r0 = rf(ctx, tenant, labelInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
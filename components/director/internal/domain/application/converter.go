@@ -1,6 +1,8 @@
 package application
 
 import (
+	"time"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
@@ -27,6 +29,7 @@ func (c *converter) ToGraphQL(in *model.Application) *graphql.Application {
 		Name:           in.Name,
 		Description:    in.Description,
 		HealthCheckURL: in.HealthCheckURL,
+		Certificate:    c.certificateToGraphQL(in.Certificate),
 	}
 }
 
@@ -86,3 +89,28 @@ func (c *converter) statusToGraphQL(in *model.ApplicationStatus) *graphql.Applic
 		Timestamp: graphql.Timestamp(in.Timestamp),
 	}
 }
+
+func (c *converter) PairingReportFromGraphQL(in graphql.PairingReportInput) model.PairingReport {
+	report := model.PairingReport{
+		Event: model.PairingEvent(in.Event),
+	}
+	if in.SerialNumber != nil {
+		report.SerialNumber = *in.SerialNumber
+	}
+	if in.ExpiresAt != nil {
+		report.ExpiresAt = time.Time(*in.ExpiresAt)
+	}
+
+	return report
+}
+
+func (c *converter) certificateToGraphQL(in *model.ClientCertificate) *graphql.ClientCertificate {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.ClientCertificate{
+		SerialNumber: in.SerialNumber,
+		ExpiresAt:    graphql.Timestamp(in.ExpiresAt),
+	}
+}
@@ -2,6 +2,7 @@ package application_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
@@ -158,3 +159,50 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_PairingReportFromGraphQL(t *testing.T) {
+	// given
+	serialNumber := "123"
+	expiresAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	gqlExpiresAt := graphql.Timestamp(expiresAt)
+
+	testCases := []struct {
+		Name     string
+		Input    graphql.PairingReportInput
+		Expected model.PairingReport
+	}{
+		{
+			Name: "All properties given",
+			Input: graphql.PairingReportInput{
+				Event:        graphql.PairingEventIssued,
+				SerialNumber: &serialNumber,
+				ExpiresAt:    &gqlExpiresAt,
+			},
+			Expected: model.PairingReport{
+				Event:        model.PairingEventIssued,
+				SerialNumber: serialNumber,
+				ExpiresAt:    expiresAt,
+			},
+		},
+		{
+			Name: "Only event given",
+			Input: graphql.PairingReportInput{
+				Event: graphql.PairingEventRevoked,
+			},
+			Expected: model.PairingReport{
+				Event: model.PairingEventRevoked,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			converter := application.NewConverter(nil, nil, nil, nil)
+			res := converter.PairingReportFromGraphQL(testCase.Input)
+
+			// then
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
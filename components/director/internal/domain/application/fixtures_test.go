@@ -71,6 +71,10 @@ func fixDetailedModelApplication(t *testing.T, id, name, description string) *mo
 		Description:    &description,
 		Tenant:         "tenant",
 		HealthCheckURL: &url,
+		Certificate: &model.ClientCertificate{
+			SerialNumber: "123",
+			ExpiresAt:    time,
+		},
 	}
 }
 
@@ -88,6 +92,10 @@ func fixDetailedGQLApplication(t *testing.T, id, name, description string) *grap
 		Name:           name,
 		Description:    &description,
 		HealthCheckURL: &url,
+		Certificate: &graphql.ClientCertificate{
+			SerialNumber: "123",
+			ExpiresAt:    graphql.Timestamp(time),
+		},
 	}
 }
 
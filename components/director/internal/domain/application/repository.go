@@ -3,13 +3,19 @@ package application
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -25,7 +31,7 @@ func (r *inMemoryRepository) GetByID(ctx context.Context, tenant, id string) (*m
 	application := r.store[id]
 
 	if application == nil || application.Tenant != tenant {
-		return nil, errors.New("application not found")
+		return nil, repo.NewNotFoundError()
 	}
 
 	return application, nil
@@ -42,15 +48,24 @@ func (r *inMemoryRepository) Exists(ctx context.Context, tenant, id string) (boo
 	return true, nil
 }
 
-// TODO: Make filtering and paging
-func (r *inMemoryRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize *int, cursor *string) (*model.ApplicationPage, error) {
+// TODO: Make paging
+func (r *inMemoryRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, query search.Query, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.ApplicationPage, error) {
 	var items []*model.Application
 	for _, item := range r.store {
-		if item.Tenant == tenant {
+		if item.Tenant == tenant && query.Matches(&item.Name, item.Description) {
 			items = append(items, item)
 		}
 	}
 
+	items, err := r.filterByLabels(ctx, tenant, items, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sortApplications(items, orderBy); err != nil {
+		return nil, err
+	}
+
 	return &model.ApplicationPage{
 		Data:       items,
 		TotalCount: len(items),
@@ -62,29 +77,47 @@ func (r *inMemoryRepository) List(ctx context.Context, tenant string, filter []*
 	}, nil
 }
 
-// TODO: add pagination when PR-181 is merged
-func (r *inMemoryRepository) ListByScenarios(ctx context.Context, tenantUUID uuid.UUID, scenarios []string, pageSize *int, cursor *string) (*model.ApplicationPage, error) {
-	persist, err := persistence.FromCtx(ctx)
+// ListIDs returns the IDs of all applications matching the label filter, ordered by ID
+func (r *inMemoryRepository) ListIDs(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]string, error) {
+	var items []*model.Application
+	for _, item := range r.store {
+		if item.Tenant == tenant {
+			items = append(items, item)
+		}
+	}
+
+	items, err := r.filterByLabels(ctx, tenant, items, filter)
 	if err != nil {
-		return nil, errors.Wrap(err, "while fetching DB from context")
+		return nil, err
+	}
+
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
 	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
 
+// TODO: add pagination when PR-181 is merged
+func (r *inMemoryRepository) ListByScenarios(ctx context.Context, tenantUUID uuid.UUID, scenarios []string, pageSize *int, cursor *string) (*model.ApplicationPage, error) {
 	var scenariosFilers []*labelfilter.LabelFilter
 
 	for _, scenarioValue := range scenarios {
 		query := fmt.Sprintf(`$[*] ? (@ == "%s")`, scenarioValue)
-		scenariosFilers = append(scenariosFilers, &labelfilter.LabelFilter{Key: model.ScenariosKey, Query: &query})
+		scenariosFilers = append(scenariosFilers, labelfilter.NewForKeyWithQuery(model.ScenariosKey, query))
 	}
 
-	stmt, err := label.FilterQuery(model.ApplicationLabelableObject, label.UnionSet, tenantUUID, scenariosFilers)
-	if err != nil {
-		return nil, errors.Wrap(err, "while creating filter query")
+	tenant := tenantUUID.String()
+	var items []*model.Application
+	for _, item := range r.store {
+		if item.Tenant == tenant {
+			items = append(items, item)
+		}
 	}
 
-	var apps []string
-
-	err = persist.Select(&apps, stmt)
-
+	items, err := r.filterByLabels(ctx, tenant, items, []*labelfilter.LabelFilter{labelfilter.NewOr(scenariosFilers...)})
 	if err != nil {
 		return &model.ApplicationPage{
 			Data:       []*model.Application{},
@@ -96,15 +129,6 @@ func (r *inMemoryRepository) ListByScenarios(ctx context.Context, tenantUUID uui
 			}}, nil
 	}
 
-	var items []*model.Application
-
-	for _, id := range apps {
-		app, found := r.store[id]
-		if found {
-			items = append(items, app)
-		}
-	}
-
 	return &model.ApplicationPage{
 		Data:       items,
 		TotalCount: len(items),
@@ -116,6 +140,66 @@ func (r *inMemoryRepository) ListByScenarios(ctx context.Context, tenantUUID uui
 	}, nil
 }
 
+// sortApplications orders the applications the same way as the database does, as they are not stored there yet
+// TODO: remove this function after migrating to Database
+func sortApplications(items []*model.Application, orderBy *orderby.OrderBy) error {
+	var value func(item *model.Application) string
+	switch orderBy.FieldOrID() {
+	case orderby.ID:
+		value = func(item *model.Application) string { return item.ID }
+	case orderby.Name:
+		value = func(item *model.Application) string { return item.Name }
+	default:
+		return errors.Errorf("ordering by %s is not supported", orderBy.FieldOrID())
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return orderBy.Less(orderby.Key{Value: value(items[i]), ID: items[i].ID}, orderby.Key{Value: value(items[j]), ID: items[j].ID})
+	})
+	return nil
+}
+
+// filterByLabels returns the applications matching the label filter. Applications are kept in memory,
+// so their IDs are passed to the single statement evaluating the filter against the labels stored in the database.
+// TODO: remove this function after migrating to Database
+func (r *inMemoryRepository) filterByLabels(ctx context.Context, tenant string, items []*model.Application, filter []*labelfilter.LabelFilter) ([]*model.Application, error) {
+	if len(filter) == 0 || len(items) == 0 {
+		return items, nil
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching DB from context")
+	}
+
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	args := jsonpath.NewArgs(tenant, pq.Array(ids))
+	condition, err := label.FilterCondition(model.ApplicationLabelableObject, `"id"`, filter, args)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building filter query")
+	}
+
+	var matchingIDs []string
+	stmt := fmt.Sprintf(`SELECT "id" FROM unnest($2::uuid[]) AS applications("id") WHERE %s`, condition)
+	err = persist.Select(&matchingIDs, stmt, args.Values()...)
+	if err != nil {
+		return nil, errors.Wrap(err, "while filtering applications by labels")
+	}
+
+	var matching []*model.Application
+	for _, id := range matchingIDs {
+		if app, found := r.store[id]; found {
+			matching = append(matching, app)
+		}
+	}
+
+	return matching, nil
+}
+
 func (r *inMemoryRepository) Create(ctx context.Context, item *model.Application) error {
 	if item == nil {
 		return errors.New("item can not be empty")
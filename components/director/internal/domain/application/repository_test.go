@@ -2,11 +2,13 @@ package application
 
 import (
 	"context"
-	"fmt"
-	"regexp"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -21,34 +23,31 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 	tenantID := uuid.New()
 	pageSize := 5
 	cursor := ""
+	javaAppID := uuid.New().String()
+	goAppID := uuid.New().String()
 
 	runtimeScenarios := []string{"Java", "Go", "Elixir"}
-	scenarioQuery := fmt.Sprintf(`SELECT "app_id" FROM public.labels 
-					WHERE "app_id" IS NOT NULL AND "tenant_id" = '%s' 
-					AND "key" = 'scenarios' AND "value" @> '["Java"]' 
-						UNION SELECT "app_id" FROM public.labels 
-							WHERE "app_id" IS NOT NULL AND "tenant_id" = '%s' 
-							AND "key" = 'scenarios' AND "value" @> '["Go"]' 
-						UNION SELECT "app_id" FROM public.labels 
-							WHERE "app_id" IS NOT NULL AND "tenant_id" = '%s' 
-							AND "key" = 'scenarios' AND "value" @> '["Elixir"]'`, tenantID, tenantID, tenantID)
-	applicationScenarioQuery := regexp.QuoteMeta(scenarioQuery)
+	scenarioQuery := `SELECT "id" FROM unnest\(\$2::uuid\[\]\) AS applications\("id"\)
+					WHERE \("id" IN \(SELECT "app_id" FROM public\.labels
+						WHERE "app_id" IS NOT NULL AND "tenant_id" = \$1 AND "key" = \$3 AND (.+)\)
+					OR "id" IN \(SELECT "app_id" FROM public\.labels
+						WHERE "app_id" IS NOT NULL AND "tenant_id" = \$1 AND "key" = \$5 AND (.+)\)
+					OR "id" IN \(SELECT "app_id" FROM public\.labels
+						WHERE "app_id" IS NOT NULL AND "tenant_id" = \$1 AND "key" = \$7 AND (.+)\)\)`
 
 	testCases := []struct {
-		Name                        string
-		ExpectedRuntimeScenarioRows *sqlmock.Rows
-		ExpectedApplicationRows     *sqlmock.Rows
-		ExpectedError               error
+		Name                    string
+		ExpectedApplicationRows *sqlmock.Rows
+		ExpectedApplicationIDs  []string
 	}{
 		{
 			Name:                    "Success",
-			ExpectedApplicationRows: sqlmock.NewRows([]string{"app_id"}).AddRow(uuid.New()).AddRow(uuid.New()),
-			ExpectedError:           nil,
+			ExpectedApplicationRows: sqlmock.NewRows([]string{"id"}).AddRow(javaAppID).AddRow(goAppID),
+			ExpectedApplicationIDs:  []string{javaAppID, goAppID},
 		},
 		{
 			Name:                    "Return empty page when no application match",
-			ExpectedApplicationRows: sqlmock.NewRows([]string{"App_id"}),
-			ExpectedError:           nil,
+			ExpectedApplicationRows: sqlmock.NewRows([]string{"id"}),
 		},
 	}
 
@@ -56,27 +55,187 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			//GIVEN
 			sqlxDB, sqlMock := testdb.MockDatabase(t)
-			if testCase.ExpectedApplicationRows != nil {
-				sqlMock.ExpectQuery(applicationScenarioQuery).
-					WithArgs().
-					WillReturnRows(testCase.ExpectedApplicationRows)
-			}
+			sqlMock.ExpectQuery(scenarioQuery).
+				WithArgs(tenantID.String(), sqlmock.AnyArg(), "scenarios", "Java", "scenarios", "Go", "scenarios", "Elixir").
+				WillReturnRows(testCase.ExpectedApplicationRows)
 			repository := NewRepository()
 
 			ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+			for _, id := range []string{javaAppID, goAppID, uuid.New().String()} {
+				require.NoError(t, repository.Create(ctx, &model.Application{ID: id, Tenant: tenantID.String(), Name: id}))
+			}
 
 			//WHEN
 			page, err := repository.ListByScenarios(ctx, tenantID, runtimeScenarios, &pageSize, &cursor)
 
 			//THEN
-			if testCase.ExpectedError != nil {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
-			} else {
-				require.NoError(t, err)
-				assert.NotNil(t, page)
+			require.NoError(t, err)
+			require.NotNil(t, page)
+			var ids []string
+			for _, app := range page.Data {
+				ids = append(ids, app.ID)
 			}
+			assert.Equal(t, testCase.ExpectedApplicationIDs, ids)
 			assert.NoError(t, sqlMock.ExpectationsWereMet())
 		})
 	}
 }
+
+func TestPgRepository_List_WithFilter(t *testing.T) {
+	// given
+	tenantID := uuid.New().String()
+	prodAppID := uuid.New().String()
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	repository := NewRepository()
+	for _, app := range []*model.Application{
+		{ID: prodAppID, Tenant: tenantID, Name: "prod"},
+		{ID: uuid.New().String(), Tenant: tenantID, Name: "deprecated"},
+		{ID: uuid.New().String(), Tenant: uuid.New().String(), Name: "other-tenant"},
+	} {
+		require.NoError(t, repository.Create(ctx, app))
+	}
+
+	query := `SELECT "id" FROM unnest\(\$2::uuid\[\]\) AS applications\("id"\)
+					WHERE \("id" IN \(SELECT "app_id" FROM public\.labels
+						WHERE "app_id" IS NOT NULL AND "tenant_id" = \$1 AND "key" = \$3 AND (.+)\)
+					AND NOT "id" IN \(SELECT "app_id" FROM public\.labels
+						WHERE "app_id" IS NOT NULL AND "tenant_id" = \$1 AND "key" = \$5\)\)`
+	sqlMock.ExpectQuery(query).
+		WithArgs(tenantID, sqlmock.AnyArg(), "env", "prod", "deprecated").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(prodAppID))
+
+	filter := []*labelfilter.LabelFilter{
+		labelfilter.NewForKeyWithQuery("env", `$ ? (@ == "prod")`),
+		labelfilter.NewNot(labelfilter.NewForKey("deprecated")),
+	}
+
+	// when
+	page, err := repository.List(ctx, tenantID, filter, "", nil, nil, nil)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, page.Data, 1)
+	assert.Equal(t, prodAppID, page.Data[0].ID)
+	assert.Equal(t, 1, page.TotalCount)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestInMemoryRepository_List_WithOrderBy(t *testing.T) {
+	// given
+	tenantID := uuid.New().String()
+	ctx := context.TODO()
+
+	repository := NewRepository()
+	for _, app := range []*model.Application{
+		{ID: "3", Tenant: tenantID, Name: "baz"},
+		{ID: "1", Tenant: tenantID, Name: "bar"},
+		{ID: "2", Tenant: tenantID, Name: "foo"},
+	} {
+		require.NoError(t, repository.Create(ctx, app))
+	}
+
+	testCases := []struct {
+		Name        string
+		OrderBy     *orderby.OrderBy
+		ExpectedIDs []string
+	}{
+		{
+			Name:        "Default order",
+			OrderBy:     nil,
+			ExpectedIDs: []string{"1", "2", "3"},
+		},
+		{
+			Name:        "Order by name in descending order",
+			OrderBy:     orderby.New(orderby.Name, pagination.DescOrderDirection),
+			ExpectedIDs: []string{"2", "3", "1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			page, err := repository.List(ctx, tenantID, nil, "", nil, nil, testCase.OrderBy)
+
+			// then
+			require.NoError(t, err)
+			var ids []string
+			for _, app := range page.Data {
+				ids = append(ids, app.ID)
+			}
+			assert.Equal(t, testCase.ExpectedIDs, ids)
+		})
+	}
+
+	t.Run("Returns error when ordering by unsupported field", func(t *testing.T) {
+		// when
+		_, err := repository.List(ctx, tenantID, nil, "", nil, nil, orderby.New(orderby.Title, pagination.AscOrderDirection))
+
+		// then
+		require.EqualError(t, err, "ordering by TITLE is not supported")
+	})
+}
+
+func TestInMemoryRepository_List_WithSearch(t *testing.T) {
+	// given
+	tenantID := uuid.New().String()
+	ctx := context.TODO()
+	description := "Handles the billing of orders"
+
+	repository := NewRepository()
+	for _, app := range []*model.Application{
+		{ID: "1", Tenant: tenantID, Name: "Billing"},
+		{ID: "2", Tenant: tenantID, Name: "orders", Description: &description},
+		{ID: "3", Tenant: tenantID, Name: "shipping"},
+		{ID: "4", Tenant: uuid.New().String(), Name: "billing"},
+	} {
+		require.NoError(t, repository.Create(ctx, app))
+	}
+
+	// when
+	page, err := repository.List(ctx, tenantID, nil, "billing", nil, nil, nil)
+
+	// then
+	require.NoError(t, err)
+	var ids []string
+	for _, app := range page.Data {
+		ids = append(ids, app.ID)
+	}
+	assert.Equal(t, []string{"1", "2"}, ids)
+	assert.Equal(t, 2, page.TotalCount)
+}
+
+func TestInMemoryRepository_ListIDs(t *testing.T) {
+	// given
+	tenantID := uuid.New().String()
+	firstAppID := "aaaaaaaa-0000-0000-0000-000000000000"
+	secondAppID := "bbbbbbbb-0000-0000-0000-000000000000"
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	repository := NewRepository()
+	for _, app := range []*model.Application{
+		{ID: secondAppID, Tenant: tenantID, Name: "second"},
+		{ID: firstAppID, Tenant: tenantID, Name: "first"},
+		{ID: uuid.New().String(), Tenant: tenantID, Name: "unlabeled"},
+		{ID: uuid.New().String(), Tenant: uuid.New().String(), Name: "other-tenant"},
+	} {
+		require.NoError(t, repository.Create(ctx, app))
+	}
+
+	query := `SELECT "id" FROM unnest\(\$2::uuid\[\]\) AS applications\("id"\)
+					WHERE "id" IN \(SELECT "app_id" FROM public\.labels
+						WHERE "app_id" IS NOT NULL AND "tenant_id" = \$1 AND "key" = \$3\)`
+	sqlMock.ExpectQuery(query).
+		WithArgs(tenantID, sqlmock.AnyArg(), "env").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(secondAppID).AddRow(firstAppID))
+
+	// when
+	ids, err := repository.ListIDs(ctx, tenantID, []*labelfilter.LabelFilter{labelfilter.NewForKey("env")})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{firstAppID, secondAppID}, ids)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/pkg/errors"
 )
@@ -18,14 +20,17 @@ import (
 type ApplicationService interface {
 	Create(ctx context.Context, in model.ApplicationInput) (string, error)
 	Update(ctx context.Context, id string, in model.ApplicationInput) error
+	ReportPairing(ctx context.Context, id string, report model.PairingReport) error
 	Get(ctx context.Context, id string) (*model.Application, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize *int, cursor *string) (*model.ApplicationPage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, query search.Query, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.ApplicationPage, error)
 	ListByRuntimeID(ctx context.Context, runtimeUUID uuid.UUID, pageSize *int, cursor *string) (*model.ApplicationPage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, applicationID string, key string) (*model.Label, error)
 	ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error)
 	DeleteLabel(ctx context.Context, applicationID string, key string) error
+	SetLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, value interface{}, dryRun bool) ([]string, error)
+	DeleteLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, dryRun bool) ([]string, error)
 }
 
 //go:generate mockery -name=ApplicationConverter -output=automock -outpkg=automock -case=underscore
@@ -33,11 +38,12 @@ type ApplicationConverter interface {
 	ToGraphQL(in *model.Application) *graphql.Application
 	MultipleToGraphQL(in []*model.Application) []*graphql.Application
 	InputFromGraphQL(in graphql.ApplicationInput) model.ApplicationInput
+	PairingReportFromGraphQL(in graphql.PairingReportInput) model.PairingReport
 }
 
 //go:generate mockery -name=APIService -output=automock -outpkg=automock -case=underscore
 type APIService interface {
-	List(ctx context.Context, applicationID string, pageSize *int, cursor *string) (*model.APIDefinitionPage, error)
+	List(ctx context.Context, applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.APIDefinitionPage, error)
 	Create(ctx context.Context, applicationID string, in model.APIDefinitionInput) (string, error)
 	Update(ctx context.Context, id string, in model.APIDefinitionInput) error
 	Delete(ctx context.Context, id string) error
@@ -53,7 +59,7 @@ type APIConverter interface {
 
 //go:generate mockery -name=EventAPIService -output=automock -outpkg=automock -case=underscore
 type EventAPIService interface {
-	List(ctx context.Context, applicationID string, pageSize *int, cursor *string) (*model.EventAPIDefinitionPage, error)
+	List(ctx context.Context, applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.EventAPIDefinitionPage, error)
 	Create(ctx context.Context, applicationID string, in model.EventAPIDefinitionInput) (string, error)
 	Update(ctx context.Context, id string, in model.EventAPIDefinitionInput) error
 	Delete(ctx context.Context, id string) error
@@ -69,7 +75,7 @@ type EventAPIConverter interface {
 
 //go:generate mockery -name=DocumentService -output=automock -outpkg=automock -case=underscore
 type DocumentService interface {
-	List(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error)
+	List(ctx context.Context, applicationID string, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.DocumentPage, error)
 }
 
 //go:generate mockery -name=WebhookService -output=automock -outpkg=automock -case=underscore
@@ -128,7 +134,7 @@ func NewResolver(transact persistence.Transactioner, svc ApplicationService, api
 	}
 }
 
-func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, searchQuery *string, first *int, after *graphql.PageCursor, orderBy *graphql.ApplicationOrderBy) (*graphql.ApplicationPage, error) {
 	labelFilter := labelfilter.MultipleFromGraphQL(filter)
 
 	var cursor string
@@ -136,7 +142,7 @@ func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilt
 		cursor = string(*after)
 	}
 
-	appPage, err := r.appSvc.List(ctx, labelFilter, first, &cursor)
+	appPage, err := r.appSvc.List(ctx, labelFilter, search.FromGraphQL(searchQuery), first, &cursor, orderby.FromApplicationGraphQL(orderBy))
 	if err != nil {
 		return nil, err
 	}
@@ -266,6 +272,37 @@ func (r *Resolver) UpdateApplication(ctx context.Context, id string, in graphql.
 
 	return gqlApp, nil
 }
+
+func (r *Resolver) ReportApplicationPairing(ctx context.Context, id string, in graphql.PairingReportInput) (*graphql.Application, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	convertedIn := r.appConverter.PairingReportFromGraphQL(in)
+	err = r.appSvc.ReportPairing(ctx, id, convertedIn)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := r.appSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	gqlApp := r.appConverter.ToGraphQL(app)
+
+	return gqlApp, nil
+}
+
 func (r *Resolver) DeleteApplication(ctx context.Context, id string) (*graphql.Application, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -354,13 +391,69 @@ func (r *Resolver) DeleteApplicationLabel(ctx context.Context, applicationID str
 	}, nil
 }
 
-func (r *Resolver) Apis(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor) (*graphql.APIDefinitionPage, error) {
+func (r *Resolver) SetLabelForApplications(ctx context.Context, filter []*graphql.LabelFilter, key string, value interface{}, dryRun *bool) (*graphql.BulkLabelResult, error) {
+	isDryRun := dryRun != nil && *dryRun
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	ids, err := r.appSvc.SetLabelForMatching(ctx, labelfilter.MultipleFromGraphQL(filter), key, value, isDryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.BulkLabelResult{
+		Key:         key,
+		AffectedIDs: ids,
+		DryRun:      isDryRun,
+	}, nil
+}
+
+func (r *Resolver) DeleteLabelForApplications(ctx context.Context, filter []*graphql.LabelFilter, key string, dryRun *bool) (*graphql.BulkLabelResult, error) {
+	isDryRun := dryRun != nil && *dryRun
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	ids, err := r.appSvc.DeleteLabelForMatching(ctx, labelfilter.MultipleFromGraphQL(filter), key, isDryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.BulkLabelResult{
+		Key:         key,
+		AffectedIDs: ids,
+		DryRun:      isDryRun,
+	}, nil
+}
+
+func (r *Resolver) Apis(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor, orderBy *graphql.APIDefinitionOrderBy) (*graphql.APIDefinitionPage, error) {
 	var cursor string
 	if after != nil {
 		cursor = string(*after)
 	}
 
-	apisPage, err := r.apiSvc.List(ctx, obj.ID, first, &cursor)
+	apisPage, err := r.apiSvc.List(ctx, obj.ID, first, &cursor, orderby.FromAPIDefinitionGraphQL(orderBy))
 	if err != nil {
 		return nil, err
 	}
@@ -378,13 +471,13 @@ func (r *Resolver) Apis(ctx context.Context, obj *graphql.Application, group *st
 		},
 	}, nil
 }
-func (r *Resolver) EventAPIs(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor) (*graphql.EventAPIDefinitionPage, error) {
+func (r *Resolver) EventAPIs(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor, orderBy *graphql.EventAPIDefinitionOrderBy) (*graphql.EventAPIDefinitionPage, error) {
 	var cursor string
 	if after != nil {
 		cursor = string(*after)
 	}
 
-	eventAPIPage, err := r.eventAPISvc.List(ctx, obj.ID, first, &cursor)
+	eventAPIPage, err := r.eventAPISvc.List(ctx, obj.ID, first, &cursor, orderby.FromEventAPIDefinitionGraphQL(orderBy))
 	if err != nil {
 		return nil, err
 	}
@@ -404,7 +497,7 @@ func (r *Resolver) EventAPIs(ctx context.Context, obj *graphql.Application, grou
 }
 
 // TODO: Proper error handling
-func (r *Resolver) Documents(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.DocumentPage, error) {
+func (r *Resolver) Documents(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor, orderBy *graphql.DocumentOrderBy) (*graphql.DocumentPage, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -422,7 +515,7 @@ func (r *Resolver) Documents(ctx context.Context, obj *graphql.Application, firs
 		return nil, errors.New("missing required parameter 'first'")
 	}
 
-	documentsPage, err := r.documentSvc.List(ctx, obj.ID, *first, cursor)
+	documentsPage, err := r.documentSvc.List(ctx, obj.ID, *first, cursor, orderby.FromDocumentGraphQL(orderBy))
 	if err != nil {
 		return nil, err
 	}
@@ -12,9 +12,13 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -294,6 +298,81 @@ func TestResolver_UpdateApplication(t *testing.T) {
 	}
 }
 
+func TestResolver_ReportApplicationPairing(t *testing.T) {
+	// given
+	modelApplication := fixModelApplication("foo", "Foo", "Lorem ipsum")
+	gqlApplication := fixGQLApplication("foo", "Foo", "Lorem ipsum")
+	testErr := errors.New("Test error")
+
+	gqlInput := graphql.PairingReportInput{
+		Event: graphql.PairingEventRevoked,
+	}
+	modelInput := model.PairingReport{
+		Event: model.PairingEventRevoked,
+	}
+	applicationID := "foo"
+
+	testCases := []struct {
+		Name                string
+		PersistenceFn       func() *persistenceautomock.PersistenceTx
+		ServiceFn           func() *automock.ApplicationService
+		ExpectedApplication *graphql.Application
+		ExpectedErr         error
+	}{
+		{
+			Name:          "Success",
+			PersistenceFn: txtest.PersistenceContextThatExpectsCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("ReportPairing", contextParam, applicationID, modelInput).Return(nil).Once()
+				svc.On("Get", contextParam, applicationID).Return(modelApplication, nil).Once()
+				return svc
+			},
+			ExpectedApplication: gqlApplication,
+			ExpectedErr:         nil,
+		},
+		{
+			Name:          "Returns error when reporting pairing failed",
+			PersistenceFn: txtest.PersistenceContextThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("ReportPairing", contextParam, applicationID, modelInput).Return(testErr).Once()
+				return svc
+			},
+			ExpectedApplication: nil,
+			ExpectedErr:         testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx := testCase.PersistenceFn()
+			transactioner := txtest.TransactionerThatSucceeds(persistTx)
+			svc := testCase.ServiceFn()
+			converter := &automock.ApplicationConverter{}
+			converter.On("PairingReportFromGraphQL", gqlInput).Return(modelInput).Once()
+			if testCase.ExpectedApplication != nil {
+				converter.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
+			}
+
+			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver.SetConverter(converter)
+
+			// when
+			result, err := resolver.ReportApplicationPairing(context.TODO(), applicationID, gqlInput)
+
+			// then
+			assert.Equal(t, testCase.ExpectedApplication, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+			transactioner.AssertExpectations(t)
+			persistTx.AssertExpectations(t)
+		})
+	}
+}
+
 func TestResolver_DeleteApplication(t *testing.T) {
 	// given
 	modelApplication := fixModelApplication("foo", "Foo", "Bar")
@@ -501,12 +580,17 @@ func TestResolver_Applications(t *testing.T) {
 	first := 2
 	gqlAfter := graphql.PageCursor("test")
 	after := "test"
+	gqlDesc := graphql.OrderDirectionDesc
+	gqlOrderBy := &graphql.ApplicationOrderBy{Field: graphql.ApplicationOrderFieldName, Direction: &gqlDesc}
+	orderBy := orderby.New(orderby.Name, pagination.DescOrderDirection)
+	gqlSearch := "billing"
+	searchQuery := search.Query("billing")
 	query := "foo"
 	filter := []*labelfilter.LabelFilter{
 		{Key: "", Query: &query},
 	}
 	gqlFilter := []*graphql.LabelFilter{
-		{Key: "", Query: &query},
+		{Query: &query},
 	}
 	testErr := errors.New("Test error")
 
@@ -524,7 +608,7 @@ func TestResolver_Applications(t *testing.T) {
 			Name: "Success",
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("List", context.TODO(), filter, &first, &after).Return(fixApplicationPage(modelApplications), nil).Once()
+				svc.On("List", context.TODO(), filter, searchQuery, &first, &after, orderBy).Return(fixApplicationPage(modelApplications), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.ApplicationConverter {
@@ -542,7 +626,7 @@ func TestResolver_Applications(t *testing.T) {
 			Name: "Returns error when application listing failed",
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("List", context.TODO(), filter, &first, &after).Return(nil, testErr).Once()
+				svc.On("List", context.TODO(), filter, searchQuery, &first, &after, orderBy).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.ApplicationConverter {
@@ -566,7 +650,7 @@ func TestResolver_Applications(t *testing.T) {
 			resolver.SetConverter(converter)
 
 			// when
-			result, err := resolver.Applications(context.TODO(), testCase.InputLabelFilters, testCase.InputFirst, testCase.InputAfter)
+			result, err := resolver.Applications(context.TODO(), testCase.InputLabelFilters, &gqlSearch, testCase.InputFirst, testCase.InputAfter, gqlOrderBy)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -962,6 +1046,8 @@ func TestResolver_Documents(t *testing.T) {
 	first := 2
 	gqlAfter := graphql.PageCursor("test")
 	after := "test"
+	gqlOrderBy := &graphql.DocumentOrderBy{Field: graphql.DocumentOrderFieldTitle}
+	orderBy := orderby.New(orderby.Title, pagination.AscOrderDirection)
 	testErr := errors.New("Test error")
 
 	testCases := []struct {
@@ -989,7 +1075,7 @@ func TestResolver_Documents(t *testing.T) {
 			},
 			ServiceFn: func() *automock.DocumentService {
 				svc := &automock.DocumentService{}
-				svc.On("List", contextParam, applicationID, first, after).Return(fixModelDocumentPage(modelDocuments), nil).Once()
+				svc.On("List", contextParam, applicationID, first, after, orderBy).Return(fixModelDocumentPage(modelDocuments), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.DocumentConverter {
@@ -1015,7 +1101,7 @@ func TestResolver_Documents(t *testing.T) {
 			},
 			ServiceFn: func() *automock.DocumentService {
 				svc := &automock.DocumentService{}
-				svc.On("List", contextParam, applicationID, first, after).Return(nil, testErr).Once()
+				svc.On("List", contextParam, applicationID, first, after, orderBy).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.DocumentConverter {
@@ -1037,7 +1123,7 @@ func TestResolver_Documents(t *testing.T) {
 			resolver := application.NewResolver(transact, nil, nil, nil, svc, nil, nil, converter, nil, nil, nil)
 
 			// when
-			result, err := resolver.Documents(context.TODO(), app, &first, &gqlAfter)
+			result, err := resolver.Documents(context.TODO(), app, &first, &gqlAfter, gqlOrderBy)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1186,6 +1272,9 @@ func TestResolver_Apis(t *testing.T) {
 	first := 2
 	gqlAfter := graphql.PageCursor("test")
 	after := "test"
+	gqlDesc := graphql.OrderDirectionDesc
+	gqlOrderBy := &graphql.APIDefinitionOrderBy{Field: graphql.APIDefinitionOrderFieldName, Direction: &gqlDesc}
+	orderBy := orderby.New(orderby.Name, pagination.DescOrderDirection)
 	testErr := errors.New("Test error")
 
 	testCases := []struct {
@@ -1201,7 +1290,7 @@ func TestResolver_Apis(t *testing.T) {
 			Name: "Success",
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("List", context.TODO(), applicationID, &first, &after).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
+				svc.On("List", context.TODO(), applicationID, &first, &after, orderBy).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.APIConverter {
@@ -1218,7 +1307,7 @@ func TestResolver_Apis(t *testing.T) {
 			Name: "Returns error when APIS listing failed",
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("List", context.TODO(), applicationID, &first, &after).Return(nil, testErr).Once()
+				svc.On("List", context.TODO(), applicationID, &first, &after, orderBy).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.APIConverter {
@@ -1240,7 +1329,7 @@ func TestResolver_Apis(t *testing.T) {
 
 			resolver := application.NewResolver(nil, nil, svc, nil, nil, nil, nil, nil, nil, converter, nil)
 			// when
-			result, err := resolver.Apis(context.TODO(), app, &group, testCase.InputFirst, testCase.InputAfter)
+			result, err := resolver.Apis(context.TODO(), app, &group, testCase.InputFirst, testCase.InputAfter, gqlOrderBy)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1286,7 +1375,7 @@ func TestResolver_EventAPIs(t *testing.T) {
 			Name: "Success",
 			ServiceFn: func() *automock.EventAPIService {
 				svc := &automock.EventAPIService{}
-				svc.On("List", context.TODO(), applicationID, &first, &after).Return(fixEventAPIDefinitionPage(modelEventAPIDefinitions), nil).Once()
+				svc.On("List", context.TODO(), applicationID, &first, &after, (*orderby.OrderBy)(nil)).Return(fixEventAPIDefinitionPage(modelEventAPIDefinitions), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.EventAPIConverter {
@@ -1303,7 +1392,7 @@ func TestResolver_EventAPIs(t *testing.T) {
 			Name: "Returns error when APIS listing failed",
 			ServiceFn: func() *automock.EventAPIService {
 				svc := &automock.EventAPIService{}
-				svc.On("List", context.TODO(), applicationID, &first, &after).Return(nil, testErr).Once()
+				svc.On("List", context.TODO(), applicationID, &first, &after, (*orderby.OrderBy)(nil)).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.EventAPIConverter {
@@ -1325,7 +1414,7 @@ func TestResolver_EventAPIs(t *testing.T) {
 
 			resolver := application.NewResolver(nil, nil, nil, svc, nil, nil, nil, nil, nil, nil, converter)
 			// when
-			result, err := resolver.EventAPIs(context.TODO(), app, &group, testCase.InputFirst, testCase.InputAfter)
+			result, err := resolver.EventAPIs(context.TODO(), app, &group, testCase.InputFirst, testCase.InputAfter, nil)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1449,3 +1538,115 @@ func TestResolver_Labels(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_SetLabelForApplications(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	envKey := "env"
+	gqlFilter := []*graphql.LabelFilter{{Key: &envKey}}
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKey("env")}
+	ids := []string{"foo", "bar"}
+	dryRun := true
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		svc := &automock.ApplicationService{}
+		svc.On("SetLabelForMatching", contextParam, filter, "key", "value", false).Return(ids, nil).Once()
+		resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := resolver.SetLabelForApplications(context.TODO(), gqlFilter, "key", "value", nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.BulkLabelResult{Key: "key", AffectedIDs: ids, DryRun: false}, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+
+	t.Run("Success in dry run mode", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		svc := &automock.ApplicationService{}
+		svc.On("SetLabelForMatching", contextParam, filter, "key", "value", true).Return(ids, nil).Once()
+		resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := resolver.SetLabelForApplications(context.TODO(), gqlFilter, "key", "value", &dryRun)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.BulkLabelResult{Key: "key", AffectedIDs: ids, DryRun: true}, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+
+	t.Run("Returns error when setting labels failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		svc := &automock.ApplicationService{}
+		svc.On("SetLabelForMatching", contextParam, filter, "key", "value", false).Return(nil, testErr).Once()
+		resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := resolver.SetLabelForApplications(context.TODO(), gqlFilter, "key", "value", nil)
+
+		// then
+		assert.Equal(t, testErr, err)
+		assert.Nil(t, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+}
+
+func TestResolver_DeleteLabelForApplications(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	envKey := "env"
+	gqlFilter := []*graphql.LabelFilter{{Key: &envKey}}
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKey("env")}
+	ids := []string{"foo", "bar"}
+	dryRun := true
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		svc := &automock.ApplicationService{}
+		svc.On("DeleteLabelForMatching", contextParam, filter, "key", false).Return(ids, nil).Once()
+		resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := resolver.DeleteLabelForApplications(context.TODO(), gqlFilter, "key", nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.BulkLabelResult{Key: "key", AffectedIDs: ids, DryRun: false}, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+
+	t.Run("Success in dry run mode", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		svc := &automock.ApplicationService{}
+		svc.On("DeleteLabelForMatching", contextParam, filter, "key", true).Return(ids, nil).Once()
+		resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := resolver.DeleteLabelForApplications(context.TODO(), gqlFilter, "key", &dryRun)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.BulkLabelResult{Key: "key", AffectedIDs: ids, DryRun: true}, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+
+	t.Run("Returns error when deleting labels failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		svc := &automock.ApplicationService{}
+		svc.On("DeleteLabelForMatching", contextParam, filter, "key", false).Return(nil, testErr).Once()
+		resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := resolver.DeleteLabelForApplications(context.TODO(), gqlFilter, "key", nil)
+
+		// then
+		assert.Equal(t, testErr, err)
+		assert.Nil(t, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
@@ -18,11 +20,12 @@ import (
 type ApplicationRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Application, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize *int, cursor *string) (*model.ApplicationPage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, query search.Query, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.ApplicationPage, error)
 	ListByScenarios(ctx context.Context, tenantID uuid.UUID, scenarios []string, pageSize *int, cursor *string) (*model.ApplicationPage, error)
 	Create(ctx context.Context, item *model.Application) error
 	Update(ctx context.Context, item *model.Application) error
 	Delete(ctx context.Context, item *model.Application) error
+	ListIDs(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]string, error)
 }
 
 //go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
@@ -48,14 +51,14 @@ type WebhookRepository interface {
 
 //go:generate mockery -name=APIRepository -output=automock -outpkg=automock -case=underscore
 type APIRepository interface {
-	ListByApplicationID(applicationID string, pageSize *int, cursor *string) (*model.APIDefinitionPage, error)
+	ListByApplicationID(applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.APIDefinitionPage, error)
 	CreateMany(items []*model.APIDefinition) error
 	DeleteAllByApplicationID(id string) error
 }
 
 //go:generate mockery -name=EventAPIRepository -output=automock -outpkg=automock -case=underscore
 type EventAPIRepository interface {
-	ListByApplicationID(applicationID string, pageSize *int, cursor *string) (*model.EventAPIDefinitionPage, error)
+	ListByApplicationID(applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.EventAPIDefinitionPage, error)
 	CreateMany(items []*model.EventAPIDefinition) error
 	DeleteAllByApplicationID(id string) error
 }
@@ -74,6 +77,7 @@ type FetchRequestRepository interface {
 type LabelUpsertService interface {
 	UpsertMultipleLabels(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, labels map[string]interface{}) error
 	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
+	ValidateLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
 }
 
 //go:generate mockery -name=ScenariosService -output=automock -outpkg=automock -case=underscore
@@ -119,13 +123,13 @@ func NewService(app ApplicationRepository, webhook WebhookRepository, api APIRep
 	}
 }
 
-func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize *int, cursor *string) (*model.ApplicationPage, error) {
+func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, query search.Query, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.ApplicationPage, error) {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	return s.appRepo.List(ctx, appTenant, filter, pageSize, cursor)
+	return s.appRepo.List(ctx, appTenant, filter, query, pageSize, cursor, orderBy)
 }
 
 func (s *service) ListByRuntimeID(ctx context.Context, runtimeID uuid.UUID, pageSize *int, cursor *string) (*model.ApplicationPage, error) {
@@ -268,7 +272,12 @@ func (s *service) Update(ctx context.Context, id string, in model.ApplicationInp
 	if err != nil {
 		return errors.Wrap(err, "while getting Application")
 	}
+	currentStatus := app.Status
+	currentCertificate := app.Certificate
+
 	app = in.ToApplication(app.ID, app.Tenant)
+	app.Status = currentStatus
+	app.Certificate = currentCertificate
 
 	err = s.appRepo.Update(ctx, app)
 	if err != nil {
@@ -298,6 +307,42 @@ func (s *service) Update(ctx context.Context, id string, in model.ApplicationInp
 	return nil
 }
 
+// ReportPairing updates the Application status and client certificate metadata after the Connector issued, renewed or revoked its certificate.
+// Applications are kept in memory, so unlike for Runtimes the metadata is lost when the Director restarts.
+func (s *service) ReportPairing(ctx context.Context, id string, report model.PairingReport) error {
+	err := report.Validate()
+	if err != nil {
+		return errors.Wrap(err, "while validating pairing report")
+	}
+
+	app, err := s.Get(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "while getting Application")
+	}
+
+	if !report.Affects(app.Certificate) {
+		return nil
+	}
+
+	condition := model.ApplicationStatusConditionReady
+	if report.Event == model.PairingEventRevoked {
+		condition = model.ApplicationStatusConditionInitial
+	}
+
+	app.Status = &model.ApplicationStatus{
+		Condition: condition,
+		Timestamp: s.timestampGen(),
+	}
+	app.Certificate = report.Certificate()
+
+	err = s.appRepo.Update(ctx, app)
+	if err != nil {
+		return errors.Wrap(err, "while updating Application")
+	}
+
+	return nil
+}
+
 func (s *service) Delete(ctx context.Context, id string) error {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -422,6 +467,88 @@ func (s *service) DeleteLabel(ctx context.Context, applicationID string, key str
 	return nil
 }
 
+// SetLabelForMatching sets the label on every Application matching the label filter and returns their IDs.
+// In the dry run mode the label value is only validated and the IDs of Applications which would be labelled are returned.
+func (s *service) SetLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, value interface{}, dryRun bool) ([]string, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if len(filter) == 0 {
+		return nil, errors.New("label filter cannot be empty")
+	}
+
+	ids, err := s.appRepo.ListIDs(ctx, appTenant, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Applications matching label filter")
+	}
+
+	if dryRun {
+		err = s.labelUpsertService.ValidateLabel(ctx, appTenant, &model.LabelInput{
+			Key:        key,
+			Value:      value,
+			ObjectType: model.ApplicationLabelableObject,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "while validating label for Applications")
+		}
+
+		return ids, nil
+	}
+
+	for _, id := range ids {
+		err = s.labelUpsertService.UpsertLabel(ctx, appTenant, &model.LabelInput{
+			Key:        key,
+			Value:      value,
+			ObjectType: model.ApplicationLabelableObject,
+			ObjectID:   id,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "while creating label for Application %s", id)
+		}
+	}
+
+	return ids, nil
+}
+
+// DeleteLabelForMatching deletes the label from every Application matching the label filter which has it and returns their IDs.
+// In the dry run mode nothing is deleted and the IDs of Applications which would be affected are returned.
+func (s *service) DeleteLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, dryRun bool) ([]string, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if key == model.ScenariosKey {
+		return nil, fmt.Errorf("%s label can not be deleted from application", model.ScenariosKey)
+	}
+
+	if len(filter) == 0 {
+		return nil, errors.New("label filter cannot be empty")
+	}
+
+	// only the Applications which have the label are affected
+	filterWithKey := append([]*labelfilter.LabelFilter{labelfilter.NewForKey(key)}, filter...)
+	ids, err := s.appRepo.ListIDs(ctx, appTenant, filterWithKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Applications matching label filter")
+	}
+
+	if dryRun {
+		return ids, nil
+	}
+
+	for _, id := range ids {
+		err = s.labelRepo.Delete(ctx, appTenant, model.ApplicationLabelableObject, id, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while deleting label from Application %s", id)
+		}
+	}
+
+	return ids, nil
+}
+
 func (s *service) createRelatedResources(ctx context.Context, in model.ApplicationInput, tenant string, applicationID string) error {
 	var err error
 	var webhooks []*model.Webhook
@@ -12,6 +12,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/stretchr/testify/assert"
@@ -698,6 +700,161 @@ func TestService_UpdateWithInvalidNames(t *testing.T) {
 	}
 }
 
+func TestService_ReportPairing(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	tnt := "tenant"
+	timestamp := time.Now()
+	expiresAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+
+	fixApplication := func() *model.Application {
+		return &model.Application{
+			ID:     id,
+			Tenant: tnt,
+			Name:   "foo",
+			Status: &model.ApplicationStatus{
+				Condition: model.ApplicationStatusConditionInitial,
+			},
+			Certificate: &model.ClientCertificate{
+				SerialNumber: "old",
+				ExpiresAt:    expiresAt,
+			},
+		}
+	}
+
+	renewedReport := model.PairingReport{Event: model.PairingEventRenewed, SerialNumber: "123", ExpiresAt: expiresAt}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.ApplicationRepository
+		Input              model.PairingReport
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success for renewed certificate",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixApplication(), nil).Once()
+				repo.On("Update", ctx, &model.Application{
+					ID:     id,
+					Tenant: tnt,
+					Name:   "foo",
+					Status: &model.ApplicationStatus{
+						Condition: model.ApplicationStatusConditionReady,
+						Timestamp: timestamp,
+					},
+					Certificate: &model.ClientCertificate{
+						SerialNumber: "123",
+						ExpiresAt:    expiresAt,
+					},
+				}).Return(nil).Once()
+				return repo
+			},
+			Input: renewedReport,
+		},
+		{
+			Name: "Success for revoked certificate",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixApplication(), nil).Once()
+				repo.On("Update", ctx, &model.Application{
+					ID:     id,
+					Tenant: tnt,
+					Name:   "foo",
+					Status: &model.ApplicationStatus{
+						Condition: model.ApplicationStatusConditionInitial,
+						Timestamp: timestamp,
+					},
+				}).Return(nil).Once()
+				return repo
+			},
+			Input: model.PairingReport{Event: model.PairingEventRevoked},
+		},
+		{
+			Name: "Success for revoked current certificate",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixApplication(), nil).Once()
+				repo.On("Update", ctx, &model.Application{
+					ID:     id,
+					Tenant: tnt,
+					Name:   "foo",
+					Status: &model.ApplicationStatus{
+						Condition: model.ApplicationStatusConditionInitial,
+						Timestamp: timestamp,
+					},
+				}).Return(nil).Once()
+				return repo
+			},
+			Input: model.PairingReport{Event: model.PairingEventRevoked, SerialNumber: "old"},
+		},
+		{
+			Name: "Ignores revocation of other than current certificate",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixApplication(), nil).Once()
+				return repo
+			},
+			Input: model.PairingReport{Event: model.PairingEventRevoked, SerialNumber: "other"},
+		},
+		{
+			Name: "Returns error when report is invalid",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				return &automock.ApplicationRepository{}
+			},
+			Input:              model.PairingReport{Event: model.PairingEventIssued},
+			ExpectedErrMessage: "while validating pairing report",
+		},
+		{
+			Name: "Returns error when application retrieval failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(nil, testErr).Once()
+				return repo
+			},
+			Input:              renewedReport,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when application update failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixApplication(), nil).Once()
+				repo.On("Update", ctx, mock.Anything).Return(testErr).Once()
+				return repo
+			},
+			Input:              renewedReport,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := application.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			// when
+			err := svc.ReportPairing(ctx, id, testCase.Input)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestService_Delete(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -1003,6 +1160,8 @@ func TestService_List(t *testing.T) {
 
 	first := 2
 	after := "test"
+	orderBy := orderby.New(orderby.Name, pagination.AscOrderDirection)
+	searchQuery := search.Query("billing")
 	filter := []*labelfilter.LabelFilter{{Key: ""}}
 
 	tnt := "tenant"
@@ -1022,7 +1181,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.ApplicationRepository {
 				repo := &automock.ApplicationRepository{}
-				repo.On("List", ctx, tnt, filter, &first, &after).Return(applicationPage, nil).Once()
+				repo.On("List", ctx, tnt, filter, searchQuery, &first, &after, orderBy).Return(applicationPage, nil).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -1035,7 +1194,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when application listing failed",
 			RepositoryFn: func() *automock.ApplicationRepository {
 				repo := &automock.ApplicationRepository{}
-				repo.On("List", ctx, tnt, filter, &first, &after).Return(nil, testErr).Once()
+				repo.On("List", ctx, tnt, filter, searchQuery, &first, &after, orderBy).Return(nil, testErr).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -1053,7 +1212,7 @@ func TestService_List(t *testing.T) {
 			svc := application.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
-			app, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, testCase.InputCursor)
+			app, err := svc.List(ctx, testCase.InputLabelFilters, searchQuery, testCase.InputPageSize, testCase.InputCursor, orderBy)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -1854,3 +2013,282 @@ func contextThatHasTenant(expectedTenant string) interface{} {
 		return actualTenant == expectedTenant
 	})
 }
+
+func TestService_SetLabelForMatching(t *testing.T) {
+	// given
+	tnt := "tenant"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+
+	testErr := errors.New("Test error")
+
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKey("env")}
+	ids := []string{"foo", "bar"}
+	value := []interface{}{"value1"}
+
+	labelInput := func(id string) *model.LabelInput {
+		return &model.LabelInput{
+			Key:        "key",
+			Value:      value,
+			ObjectID:   id,
+			ObjectType: model.ApplicationLabelableObject,
+		}
+	}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.ApplicationRepository
+		LabelServiceFn     func() *automock.LabelUpsertService
+		InputFilter        []*labelfilter.LabelFilter
+		InputDryRun        bool
+		ExpectedIDs        []string
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(ids, nil).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, labelInput("foo")).Return(nil).Once()
+				svc.On("UpsertLabel", ctx, tnt, labelInput("bar")).Return(nil).Once()
+				return svc
+			},
+			InputFilter:        filter,
+			InputDryRun:        false,
+			ExpectedIDs:        ids,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Success in dry run mode",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(ids, nil).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("ValidateLabel", ctx, tnt, labelInput("")).Return(nil).Once()
+				return svc
+			},
+			InputFilter:        filter,
+			InputDryRun:        true,
+			ExpectedIDs:        ids,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when label value is invalid in dry run mode",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(ids, nil).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("ValidateLabel", ctx, tnt, labelInput("")).Return(testErr).Once()
+				return svc
+			},
+			InputFilter:        filter,
+			InputDryRun:        true,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when label set failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(ids, nil).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, labelInput("foo")).Return(testErr).Once()
+				return svc
+			},
+			InputFilter:        filter,
+			InputDryRun:        false,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing Applications failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				return svc
+			},
+			InputFilter:        filter,
+			InputDryRun:        false,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when filter is empty",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				return svc
+			},
+			InputFilter:        nil,
+			InputDryRun:        false,
+			ExpectedErrMessage: "label filter cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelSvc := testCase.LabelServiceFn()
+			svc := application.NewService(repo, nil, nil, nil, nil, nil, nil, nil, labelSvc, nil, nil)
+
+			// when
+			result, err := svc.SetLabelForMatching(ctx, testCase.InputFilter, "key", value, testCase.InputDryRun)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedIDs, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+			labelSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_DeleteLabelForMatching(t *testing.T) {
+	// given
+	tnt := "tenant"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+
+	testErr := errors.New("Test error")
+
+	labelKey := "key"
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKey("env")}
+	filterWithKey := []*labelfilter.LabelFilter{labelfilter.NewForKey(labelKey), labelfilter.NewForKey("env")}
+	ids := []string{"foo", "bar"}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.ApplicationRepository
+		LabelRepositoryFn  func() *automock.LabelRepository
+		InputKey           string
+		InputDryRun        bool
+		ExpectedIDs        []string
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, filterWithKey).Return(ids, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("Delete", ctx, tnt, model.ApplicationLabelableObject, "foo", labelKey).Return(nil).Once()
+				repo.On("Delete", ctx, tnt, model.ApplicationLabelableObject, "bar", labelKey).Return(nil).Once()
+				return repo
+			},
+			InputKey:           labelKey,
+			InputDryRun:        false,
+			ExpectedIDs:        ids,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Success in dry run mode",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, filterWithKey).Return(ids, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				return repo
+			},
+			InputKey:           labelKey,
+			InputDryRun:        true,
+			ExpectedIDs:        ids,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when label delete failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, filterWithKey).Return(ids, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("Delete", ctx, tnt, model.ApplicationLabelableObject, "foo", labelKey).Return(testErr).Once()
+				return repo
+			},
+			InputKey:           labelKey,
+			InputDryRun:        false,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing Applications failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, filterWithKey).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				return repo
+			},
+			InputKey:           labelKey,
+			InputDryRun:        false,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when trying to delete scenarios label",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				return repo
+			},
+			InputKey:           model.ScenariosKey,
+			InputDryRun:        false,
+			ExpectedErrMessage: "can not be deleted from application",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelRepo := testCase.LabelRepositoryFn()
+			svc := application.NewService(repo, nil, nil, nil, nil, nil, labelRepo, nil, nil, nil, nil)
+
+			// when
+			result, err := svc.DeleteLabelForMatching(ctx, filter, testCase.InputKey, testCase.InputDryRun)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedIDs, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
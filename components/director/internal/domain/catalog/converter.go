@@ -0,0 +1,56 @@
+package catalog
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.CatalogSearchResult) *graphql.CatalogSearchResult {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.CatalogSearchResult{
+		Type:          graphql.CatalogSearchResultType(in.Type),
+		ID:            in.ID,
+		ApplicationID: in.ApplicationID,
+		Name:          in.Name,
+		Snippet:       in.Snippet,
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.CatalogSearchResult) []*graphql.CatalogSearchResult {
+	var results []*graphql.CatalogSearchResult
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+		results = append(results, c.ToGraphQL(r))
+	}
+
+	return results
+}
+
+func (c *converter) FromEntity(in Entity) (model.CatalogSearchResult, error) {
+	resultType := model.CatalogSearchResultType(in.Type)
+	switch resultType {
+	case model.CatalogSearchResultTypeAPI, model.CatalogSearchResultTypeEventAPI, model.CatalogSearchResultTypeDocument:
+	default:
+		return model.CatalogSearchResult{}, errors.Errorf("unknown search result type %s", in.Type)
+	}
+
+	return model.CatalogSearchResult{
+		Type:          resultType,
+		ID:            in.ID,
+		ApplicationID: in.AppID,
+		Name:          in.Name,
+		Snippet:       in.Snippet,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package catalog_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalog"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// given
+	input := []*model.CatalogSearchResult{
+		fixModelResult(model.CatalogSearchResultTypeAPI, "1", "Billing"),
+		nil,
+		fixModelResult(model.CatalogSearchResultTypeDocument, "2", "Invoices"),
+	}
+	expected := []*graphql.CatalogSearchResult{
+		fixGQLResult(graphql.CatalogSearchResultTypeAPI, "1", "Billing"),
+		fixGQLResult(graphql.CatalogSearchResultTypeDocument, "2", "Invoices"),
+	}
+	converter := catalog.NewConverter()
+
+	// when
+	result := converter.MultipleToGraphQL(input)
+
+	// then
+	assert.Equal(t, expected, result)
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	converter := catalog.NewConverter()
+
+	t.Run("Success", func(t *testing.T) {
+		// when
+		result, err := converter.FromEntity(fixEntity("EVENT_API", "1", "Orders", 0.5))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, *fixModelResult(model.CatalogSearchResultTypeEventAPI, "1", "Orders"), result)
+	})
+
+	t.Run("Error when type is unknown", func(t *testing.T) {
+		// when
+		_, err := converter.FromEntity(fixEntity("SERVICE", "1", "Orders", 0.5))
+
+		// then
+		require.EqualError(t, err, "unknown search result type SERVICE")
+	})
+}
@@ -0,0 +1,16 @@
+package catalog
+
+type Entity struct {
+	Type    string  `db:"type"`
+	ID      string  `db:"id"`
+	AppID   string  `db:"app_id"`
+	Name    string  `db:"name"`
+	Snippet string  `db:"snippet"`
+	Rank    float64 `db:"rank"`
+}
+
+type Collection []Entity
+
+func (c Collection) Len() int {
+	return len(c)
+}
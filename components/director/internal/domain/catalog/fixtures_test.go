@@ -0,0 +1,85 @@
+package catalog_test
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalog"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+func fixModelResult(resultType model.CatalogSearchResultType, id, name string) *model.CatalogSearchResult {
+	return &model.CatalogSearchResult{
+		Type:          resultType,
+		ID:            id,
+		ApplicationID: "app",
+		Name:          name,
+		Snippet:       "Pays the <b>invoices</b>",
+	}
+}
+
+func fixGQLResult(resultType graphql.CatalogSearchResultType, id, name string) *graphql.CatalogSearchResult {
+	return &graphql.CatalogSearchResult{
+		Type:          resultType,
+		ID:            id,
+		ApplicationID: "app",
+		Name:          name,
+		Snippet:       "Pays the <b>invoices</b>",
+	}
+}
+
+func fixEntity(resultType, id, name string, rank float64) catalog.Entity {
+	return catalog.Entity{
+		Type:    resultType,
+		ID:      id,
+		AppID:   "app",
+		Name:    name,
+		Snippet: "Pays the <b>invoices</b>",
+		Rank:    rank,
+	}
+}
+
+func fixModelPage(results []*model.CatalogSearchResult) *model.CatalogSearchResultPage {
+	return &model.CatalogSearchResultPage{
+		Data:       results,
+		TotalCount: len(results),
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+}
+
+func fixGQLPage(results []*graphql.CatalogSearchResult) *graphql.CatalogSearchResultPage {
+	return &graphql.CatalogSearchResultPage{
+		Data:       results,
+		TotalCount: len(results),
+		PageInfo: &graphql.PageInfo{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+}
+
+func fixAPI(id, appID string) *model.APIDefinition {
+	return &model.APIDefinition{
+		ID:            id,
+		ApplicationID: appID,
+		Name:          "Billing",
+		Description:   str("Pays the invoices"),
+		Spec:          &model.APISpec{Data: str("openapi: 3.0.0")},
+	}
+}
+
+func fixEventAPI(id, appID string) *model.EventAPIDefinition {
+	return &model.EventAPIDefinition{
+		ID:            id,
+		ApplicationID: appID,
+		Name:          "Orders",
+	}
+}
+
+func str(s string) *string {
+	return &s
+}
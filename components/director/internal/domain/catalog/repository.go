@@ -0,0 +1,153 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// searchQuery is the full-text search query built from the searched text, which is bound as $2 by the repository
+const searchQuery = `websearch_to_tsquery('english', $2)`
+
+// searchedTables are the tables included in the catalog, with the searched text of their rows.
+// The text expressions must match the ones of the full-text search indexes of the tables.
+// APIs and Event APIs are not stored in the database yet, so their rows are bound to the query as arrays of the column values.
+// TODO: Search the api_definitions and event_api_definitions tables once APIs and Event APIs are stored in the database
+var searchedTables = []struct {
+	resultType model.CatalogSearchResultType
+	table      string
+	name       string
+	text       string
+}{
+	{
+		resultType: model.CatalogSearchResultTypeAPI,
+		table:      inMemoryTable("api_definitions", 3),
+		name:       "name",
+		text:       "text",
+	},
+	{
+		resultType: model.CatalogSearchResultTypeEventAPI,
+		table:      inMemoryTable("event_api_definitions", 8),
+		name:       "name",
+		text:       "text",
+	},
+	{
+		resultType: model.CatalogSearchResultTypeDocument,
+		table:      "public.documents",
+		name:       "title",
+		text:       `title || ' ' || display_name || ' ' || description || ' ' || coalesce(data, '')`,
+	},
+}
+
+var catalogColumns = []string{"type", "id", "app_id", "name", fmt.Sprintf(`ts_headline('english', text, %s, 'StartSel=<b>, StopSel=</b>') AS snippet`, searchQuery), "rank"}
+
+//go:generate mockery -name=Converter -output=automock -outpkg=automock -case=underscore
+type Converter interface {
+	FromEntity(in Entity) (model.CatalogSearchResult, error)
+}
+
+type pgRepository struct {
+	*repo.PageableQuerier
+
+	conv Converter
+}
+
+func NewRepository(conv Converter) *pgRepository {
+	return &pgRepository{
+		PageableQuerier: repo.NewPageableQuerier(catalogTable(), "tenant_id", "id", catalogColumns),
+		conv:            conv,
+	}
+}
+
+// Search returns the APIs, Event APIs and Documents matching the query, the best matching first.
+// The APIs and Event APIs are searched among the given ones, which must belong to the tenant.
+func (r *pgRepository) Search(ctx context.Context, tenant string, query string, apis []*model.APIDefinition, eventAPIs []*model.EventAPIDefinition, pageSize int, cursor string) (*model.CatalogSearchResultPage, error) {
+	var apiRows, eventAPIRows inMemoryRows
+	for _, api := range apis {
+		var specData *string
+		if api.Spec != nil {
+			specData = api.Spec.Data
+		}
+		apiRows.add(tenant, api.ID, api.ApplicationID, api.Name, api.Description, specData)
+	}
+	for _, eventAPI := range eventAPIs {
+		var specData *string
+		if eventAPI.Spec != nil {
+			specData = eventAPI.Spec.Data
+		}
+		eventAPIRows.add(tenant, eventAPI.ID, eventAPI.ApplicationID, eventAPI.Name, eventAPI.Description, specData)
+	}
+
+	args := append(append([]interface{}{query}, apiRows.args()...), eventAPIRows.args()...)
+
+	var entityCollection Collection
+	page, totalCount, err := r.PageableQuerier.ListWithArgs(ctx, tenant, pageSize, cursor, pagination.NewDescOrderBy("rank"), &entityCollection, nil, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*model.CatalogSearchResult
+	for _, entity := range entityCollection {
+		result, err := r.conv.FromEntity(entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting search result entity to model")
+		}
+
+		items = append(items, &result)
+	}
+
+	return &model.CatalogSearchResultPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
+
+// catalogTable unions the matching rows of the searched tables, ranked by their relevance to the query
+func catalogTable() string {
+	var selects []string
+	for _, t := range searchedTables {
+		document := fmt.Sprintf(`to_tsvector('english', %s)`, t.text)
+		selects = append(selects, fmt.Sprintf(`SELECT '%s' AS type, id, tenant_id, app_id, %s AS name, %s AS text, ts_rank(%s, %s)::float8 AS rank FROM %s WHERE %s @@ %s`,
+			t.resultType, t.name, t.text, document, searchQuery, t.table, document, searchQuery))
+	}
+
+	return fmt.Sprintf("(%s) AS catalog", strings.Join(selects, " UNION ALL "))
+}
+
+// inMemoryTable returns the table of the rows bound as the arrays of their ID, tenant, application ID, name and searched text,
+// starting from the firstPlaceholder
+func inMemoryTable(alias string, firstPlaceholder int) string {
+	p := firstPlaceholder
+	return fmt.Sprintf(`unnest($%d::uuid[], $%d::uuid[], $%d::uuid[], $%d::text[], $%d::text[]) AS %s(id, tenant_id, app_id, name, text)`,
+		p, p+1, p+2, p+3, p+4, alias)
+}
+
+type inMemoryRows struct {
+	ids, tenants, appIDs, names, texts []string
+}
+
+func (r *inMemoryRows) add(tenant, id, appID, name string, description, specData *string) {
+	text := name
+	for _, value := range []*string{description, specData} {
+		if value != nil {
+			text += " " + *value
+		}
+	}
+
+	r.ids = append(r.ids, id)
+	r.tenants = append(r.tenants, tenant)
+	r.appIDs = append(r.appIDs, appID)
+	r.names = append(r.names, name)
+	r.texts = append(r.texts, text)
+}
+
+func (r inMemoryRows) args() []interface{} {
+	return []interface{}{pq.Array(r.ids), pq.Array(r.tenants), pq.Array(r.appIDs), pq.Array(r.names), pq.Array(r.texts)}
+}
@@ -0,0 +1,111 @@
+package catalog_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalog"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalog/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_Search(t *testing.T) {
+	// given
+	tenantID := "tnt"
+	query := "invoices"
+	columns := []string{"type", "id", "app_id", "name", "snippet", "rank"}
+	apis := []*model.APIDefinition{fixAPI("1", "app")}
+	eventAPIs := []*model.EventAPIDefinition{fixEventAPI("3", "app")}
+	inMemoryArgs := []driver.Value{`{"1"}`, `{"tnt"}`, `{"app"}`, `{"Billing"}`, `{"Billing Pays the invoices openapi: 3.0.0"}`, `{"3"}`, `{"tnt"}`, `{"app"}`, `{"Orders"}`, `{"Orders"}`}
+	queryArgs := append([]driver.Value{tenantID, query}, inMemoryArgs...)
+	catalogTable := `\(SELECT 'API' AS type, id, tenant_id, app_id, name AS name, text AS text, (.+) FROM unnest\(\$3::uuid\[\], \$4::uuid\[\], \$5::uuid\[\], \$6::text\[\], \$7::text\[\]\) AS api_definitions\(id, tenant_id, app_id, name, text\) WHERE (.+) UNION ALL SELECT 'EVENT_API' AS type, (.+) FROM unnest\(\$8::uuid\[\], (.+)\) AS event_api_definitions\(id, tenant_id, app_id, name, text\) WHERE (.+) UNION ALL SELECT 'DOCUMENT' AS type, id, tenant_id, app_id, title AS name, (.+) FROM public.documents WHERE (.+)\) AS catalog`
+
+	nextPageCursor, err := pagination.EncodeKeysetCursor(pagination.KeysetCursor{OrderBy: "rank DESC", Value: 0.5, ID: "prev"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name          string
+		InputCursor   string
+		ExpectedQuery string
+		ExpectedArgs  []driver.Value
+	}{
+		{
+			Name:          "Success getting first page",
+			ExpectedQuery: `^SELECT type, id, app_id, name, ts_headline\('english', text, websearch_to_tsquery\('english', \$2\), 'StartSel=<b>, StopSel=</b>'\) AS snippet, rank FROM ` + catalogTable + ` WHERE tenant_id=\$1 ORDER BY rank DESC, id DESC LIMIT 3$`,
+			ExpectedArgs:  queryArgs,
+		},
+		{
+			Name:          "Success getting next page",
+			InputCursor:   nextPageCursor,
+			ExpectedQuery: `^SELECT (.+) FROM ` + catalogTable + ` WHERE tenant_id=\$1 AND \(rank, id\) < \(\$13, \$14\) ORDER BY rank DESC, id DESC LIMIT 3$`,
+			ExpectedArgs:  append(queryArgs, "0.5", "prev"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db, dbMock := testdb.MockDatabase(t)
+			defer dbMock.AssertExpectations(t)
+
+			rows := sqlmock.NewRows(columns).
+				AddRow("API", "1", "app", "Billing", "Pays the <b>invoices</b>", 0.9).
+				AddRow("DOCUMENT", "2", "app", "Invoices", "Pays the <b>invoices</b>", 0.7).
+				AddRow("EVENT_API", "3", "app", "Orders", "Pays the <b>invoices</b>", 0.1)
+			dbMock.ExpectQuery(testCase.ExpectedQuery).WithArgs(testCase.ExpectedArgs...).WillReturnRows(rows)
+			dbMock.ExpectQuery(`^SELECT COUNT\(\*\) FROM ` + catalogTable + ` WHERE tenant_id=\$1$`).
+				WithArgs(queryArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+			conv := &automock.Converter{}
+			conv.On("FromEntity", fixEntity("API", "1", "Billing", 0.9)).Return(*fixModelResult(model.CatalogSearchResultTypeAPI, "1", "Billing"), nil).Once()
+			conv.On("FromEntity", fixEntity("DOCUMENT", "2", "Invoices", 0.7)).Return(*fixModelResult(model.CatalogSearchResultTypeDocument, "2", "Invoices"), nil).Once()
+			defer conv.AssertExpectations(t)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			pgRepository := catalog.NewRepository(conv)
+
+			// when
+			page, err := pgRepository.Search(ctx, tenantID, query, apis, eventAPIs, 2, testCase.InputCursor)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, []*model.CatalogSearchResult{
+				fixModelResult(model.CatalogSearchResultTypeAPI, "1", "Billing"),
+				fixModelResult(model.CatalogSearchResultTypeDocument, "2", "Invoices"),
+			}, page.Data)
+			assert.Equal(t, 3, page.TotalCount)
+			assert.True(t, page.PageInfo.HasNextPage)
+			assert.NotEmpty(t, page.PageInfo.EndCursor)
+		})
+	}
+
+	t.Run("Returns error when converting failed", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).AddRow("API", "1", "app", "Billing", "Pays the <b>invoices</b>", 0.9)
+		dbMock.ExpectQuery(`^SELECT (.+) FROM ` + catalogTable).WithArgs(queryArgs...).WillReturnRows(rows)
+		dbMock.ExpectQuery(`^SELECT COUNT\(\*\) FROM `).WithArgs(queryArgs...).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		conv := &automock.Converter{}
+		conv.On("FromEntity", fixEntity("API", "1", "Billing", 0.9)).Return(model.CatalogSearchResult{}, errors.New("test error")).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		pgRepository := catalog.NewRepository(conv)
+
+		// when
+		_, err := pgRepository.Search(ctx, tenantID, query, apis, eventAPIs, 2, "")
+
+		// then
+		require.EqualError(t, err, "while converting search result entity to model: test error")
+	})
+}
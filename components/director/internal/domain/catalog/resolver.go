@@ -0,0 +1,73 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=CatalogService -output=automock -outpkg=automock -case=underscore
+type CatalogService interface {
+	Search(ctx context.Context, query string, pageSize int, cursor string) (*model.CatalogSearchResultPage, error)
+}
+
+//go:generate mockery -name=CatalogConverter -output=automock -outpkg=automock -case=underscore
+type CatalogConverter interface {
+	MultipleToGraphQL(in []*model.CatalogSearchResult) []*graphql.CatalogSearchResult
+}
+
+type Resolver struct {
+	transact  persistence.Transactioner
+	svc       CatalogService
+	converter CatalogConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc CatalogService, conv CatalogConverter) *Resolver {
+	return &Resolver{
+		transact:  transact,
+		svc:       svc,
+		converter: conv,
+	}
+}
+
+func (r *Resolver) SearchCatalog(ctx context.Context, query string, first *int, after *graphql.PageCursor) (*graphql.CatalogSearchResultPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	if first == nil {
+		return nil, errors.New("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	resultsPage, err := r.svc.Search(ctx, query, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.CatalogSearchResultPage{
+		Data:       r.converter.MultipleToGraphQL(resultsPage.Data),
+		TotalCount: resultsPage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(resultsPage.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(resultsPage.PageInfo.EndCursor),
+			HasNextPage: resultsPage.PageInfo.HasNextPage,
+		},
+	}, nil
+}
@@ -0,0 +1,71 @@
+package catalog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalog"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalog/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_SearchCatalog(t *testing.T) {
+	// given
+	modelResults := []*model.CatalogSearchResult{
+		fixModelResult(model.CatalogSearchResultTypeAPI, "1", "Billing"),
+		fixModelResult(model.CatalogSearchResultTypeDocument, "2", "Invoices"),
+	}
+	gqlResults := []*graphql.CatalogSearchResult{
+		fixGQLResult(graphql.CatalogSearchResultTypeAPI, "1", "Billing"),
+		fixGQLResult(graphql.CatalogSearchResultTypeDocument, "2", "Invoices"),
+	}
+	first := 2
+	after := graphql.PageCursor("test")
+	testErr := errors.New("Test error")
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.CatalogService{}
+		svc.On("Search", txtest.CtxWithDBMatcher(), "invoices", first, "test").Return(fixModelPage(modelResults), nil).Once()
+		defer svc.AssertExpectations(t)
+
+		conv := &automock.CatalogConverter{}
+		conv.On("MultipleToGraphQL", modelResults).Return(gqlResults).Once()
+		defer conv.AssertExpectations(t)
+
+		resolver := catalog.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.SearchCatalog(context.TODO(), "invoices", &first, &after)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLPage(gqlResults), result)
+	})
+
+	t.Run("Returns error when searching failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.CatalogService{}
+		svc.On("Search", txtest.CtxWithDBMatcher(), "invoices", first, "test").Return(nil, testErr).Once()
+		defer svc.AssertExpectations(t)
+
+		resolver := catalog.NewResolver(transact, svc, &automock.CatalogConverter{})
+
+		// when
+		_, err := resolver.SearchCatalog(context.TODO(), "invoices", &first, &after)
+
+		// then
+		require.Equal(t, testErr, err)
+	})
+}
@@ -0,0 +1,110 @@
+package catalog
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=CatalogRepository -output=automock -outpkg=automock -case=underscore
+type CatalogRepository interface {
+	Search(ctx context.Context, tenant string, query string, apis []*model.APIDefinition, eventAPIs []*model.EventAPIDefinition, pageSize int, cursor string) (*model.CatalogSearchResultPage, error)
+}
+
+//go:generate mockery -name=ApplicationRepository -output=automock -outpkg=automock -case=underscore
+type ApplicationRepository interface {
+	ListIDs(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]string, error)
+}
+
+//go:generate mockery -name=APIRepository -output=automock -outpkg=automock -case=underscore
+type APIRepository interface {
+	List(filter []*labelfilter.LabelFilter, pageSize *int, cursor *string) (*model.APIDefinitionPage, error)
+}
+
+//go:generate mockery -name=EventAPIRepository -output=automock -outpkg=automock -case=underscore
+type EventAPIRepository interface {
+	List(filter []*labelfilter.LabelFilter, pageSize *int, cursor *string) (*model.EventAPIDefinitionPage, error)
+}
+
+type service struct {
+	repo         CatalogRepository
+	appRepo      ApplicationRepository
+	apiRepo      APIRepository
+	eventAPIRepo EventAPIRepository
+}
+
+func NewService(repo CatalogRepository, appRepo ApplicationRepository, apiRepo APIRepository, eventAPIRepo EventAPIRepository) *service {
+	return &service{
+		repo:         repo,
+		appRepo:      appRepo,
+		apiRepo:      apiRepo,
+		eventAPIRepo: eventAPIRepo,
+	}
+}
+
+func (s *service) Search(ctx context.Context, query string, pageSize int, cursor string) (*model.CatalogSearchResultPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New("search query cannot be empty")
+	}
+
+	if pageSize < 1 || pageSize > 100 {
+		return nil, errors.New("page size must be between 1 and 100")
+	}
+
+	apis, eventAPIs, err := s.listTenantAPIs(ctx, tnt)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Search(ctx, tnt, query, apis, eventAPIs, pageSize, cursor)
+}
+
+// listTenantAPIs returns the APIs and Event APIs of the tenant's Applications, which are not stored in the database yet
+// TODO: Remove when APIs and Event APIs are stored in the database
+func (s *service) listTenantAPIs(ctx context.Context, tnt string) ([]*model.APIDefinition, []*model.EventAPIDefinition, error) {
+	appIDs, err := s.appRepo.ListIDs(ctx, tnt, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while listing Applications")
+	}
+
+	tenantApps := make(map[string]struct{})
+	for _, id := range appIDs {
+		tenantApps[id] = struct{}{}
+	}
+
+	apiPage, err := s.apiRepo.List(nil, nil, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while listing APIs")
+	}
+
+	var apis []*model.APIDefinition
+	for _, api := range apiPage.Data {
+		if _, ok := tenantApps[api.ApplicationID]; ok {
+			apis = append(apis, api)
+		}
+	}
+
+	eventAPIPage, err := s.eventAPIRepo.List(nil, nil, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while listing Event APIs")
+	}
+
+	var eventAPIs []*model.EventAPIDefinition
+	for _, eventAPI := range eventAPIPage.Data {
+		if _, ok := tenantApps[eventAPI.ApplicationID]; ok {
+			eventAPIs = append(eventAPIs, eventAPI)
+		}
+	}
+
+	return apis, eventAPIs, nil
+}
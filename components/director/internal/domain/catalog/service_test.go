@@ -0,0 +1,237 @@
+package catalog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalog"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalog/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Search(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	tnt := "tenant"
+	ctx := tenant.SaveToContext(context.TODO(), tnt)
+
+	page := fixModelPage([]*model.CatalogSearchResult{fixModelResult(model.CatalogSearchResultTypeAPI, "1", "Billing")})
+
+	tenantAPI := fixAPI("1", "app")
+	otherTenantAPI := fixAPI("2", "other-app")
+	apiPage := &model.APIDefinitionPage{Data: []*model.APIDefinition{tenantAPI, otherTenantAPI}}
+	tenantEventAPI := fixEventAPI("3", "app")
+	otherTenantEventAPI := fixEventAPI("4", "other-app")
+	eventAPIPage := &model.EventAPIDefinitionPage{Data: []*model.EventAPIDefinition{tenantEventAPI, otherTenantEventAPI}}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.CatalogRepository
+		AppRepositoryFn    func() *automock.ApplicationRepository
+		APIRepositoryFn    func() *automock.APIRepository
+		EventAPIRepoFn     func() *automock.EventAPIRepository
+		InputQuery         string
+		InputPageSize      int
+		ExpectedResult     *model.CatalogSearchResultPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.CatalogRepository {
+				repo := &automock.CatalogRepository{}
+				repo.On("Search", ctx, tnt, "billing", []*model.APIDefinition{tenantAPI}, []*model.EventAPIDefinition{tenantEventAPI}, 2, "cursor").Return(page, nil).Once()
+				return repo
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, []*labelfilter.LabelFilter(nil)).Return([]string{"app"}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("List", []*labelfilter.LabelFilter(nil), (*int)(nil), (*string)(nil)).Return(apiPage, nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("List", []*labelfilter.LabelFilter(nil), (*int)(nil), (*string)(nil)).Return(eventAPIPage, nil).Once()
+				return repo
+			},
+			InputQuery:     " billing ",
+			InputPageSize:  2,
+			ExpectedResult: page,
+		},
+		{
+			Name: "Returns error when searching failed",
+			RepositoryFn: func() *automock.CatalogRepository {
+				repo := &automock.CatalogRepository{}
+				repo.On("Search", ctx, tnt, "billing", []*model.APIDefinition{tenantAPI}, []*model.EventAPIDefinition{tenantEventAPI}, 2, "cursor").Return(nil, testErr).Once()
+				return repo
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, []*labelfilter.LabelFilter(nil)).Return([]string{"app"}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("List", []*labelfilter.LabelFilter(nil), (*int)(nil), (*string)(nil)).Return(apiPage, nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("List", []*labelfilter.LabelFilter(nil), (*int)(nil), (*string)(nil)).Return(eventAPIPage, nil).Once()
+				return repo
+			},
+			InputQuery:         "billing",
+			InputPageSize:      2,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing Applications failed",
+			RepositoryFn: func() *automock.CatalogRepository {
+				return &automock.CatalogRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, []*labelfilter.LabelFilter(nil)).Return(nil, testErr).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				return &automock.APIRepository{}
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				return &automock.EventAPIRepository{}
+			},
+			InputQuery:         "billing",
+			InputPageSize:      2,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing APIs failed",
+			RepositoryFn: func() *automock.CatalogRepository {
+				return &automock.CatalogRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, []*labelfilter.LabelFilter(nil)).Return([]string{"app"}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("List", []*labelfilter.LabelFilter(nil), (*int)(nil), (*string)(nil)).Return(nil, testErr).Once()
+				return repo
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				return &automock.EventAPIRepository{}
+			},
+			InputQuery:         "billing",
+			InputPageSize:      2,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing Event APIs failed",
+			RepositoryFn: func() *automock.CatalogRepository {
+				return &automock.CatalogRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListIDs", ctx, tnt, []*labelfilter.LabelFilter(nil)).Return([]string{"app"}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("List", []*labelfilter.LabelFilter(nil), (*int)(nil), (*string)(nil)).Return(apiPage, nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("List", []*labelfilter.LabelFilter(nil), (*int)(nil), (*string)(nil)).Return(nil, testErr).Once()
+				return repo
+			},
+			InputQuery:         "billing",
+			InputPageSize:      2,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when query is empty",
+			RepositoryFn: func() *automock.CatalogRepository {
+				return &automock.CatalogRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				return &automock.ApplicationRepository{}
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				return &automock.APIRepository{}
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				return &automock.EventAPIRepository{}
+			},
+			InputQuery:         "  ",
+			InputPageSize:      2,
+			ExpectedErrMessage: "search query cannot be empty",
+		},
+		{
+			Name: "Returns error when page size is bigger than 100",
+			RepositoryFn: func() *automock.CatalogRepository {
+				return &automock.CatalogRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				return &automock.ApplicationRepository{}
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				return &automock.APIRepository{}
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				return &automock.EventAPIRepository{}
+			},
+			InputQuery:         "billing",
+			InputPageSize:      101,
+			ExpectedErrMessage: "page size must be between 1 and 100",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			appRepo := testCase.AppRepositoryFn()
+			apiRepo := testCase.APIRepositoryFn()
+			eventAPIRepo := testCase.EventAPIRepoFn()
+			svc := catalog.NewService(repo, appRepo, apiRepo, eventAPIRepo)
+
+			// when
+			result, err := svc.Search(ctx, testCase.InputQuery, testCase.InputPageSize, "cursor")
+
+			// then
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			repo.AssertExpectations(t)
+			appRepo.AssertExpectations(t)
+			apiRepo.AssertExpectations(t)
+			eventAPIRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when tenant is not in the context", func(t *testing.T) {
+		svc := catalog.NewService(nil, nil, nil, nil)
+
+		// when
+		_, err := svc.Search(context.TODO(), "billing", 2, "")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
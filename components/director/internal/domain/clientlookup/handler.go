@@ -0,0 +1,122 @@
+package clientlookup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
+type ApplicationService interface {
+	Get(ctx context.Context, id string) (*model.Application, error)
+}
+
+//go:generate mockery -name=RuntimeService -output=automock -outpkg=automock -case=underscore
+type RuntimeService interface {
+	Get(ctx context.Context, id string) (*model.Runtime, error)
+}
+
+// Handler serves the lookups of Applications and Runtimes on the internal API used by the Connector.
+// Unlike the GraphQL queries it responds with the 404 status code when the object does not exist in the tenant.
+type Handler struct {
+	transact   persistence.Transactioner
+	appSvc     ApplicationService
+	runtimeSvc RuntimeService
+}
+
+func NewHandler(transact persistence.Transactioner, appSvc ApplicationService, runtimeSvc RuntimeService) *Handler {
+	return &Handler{
+		transact:   transact,
+		appSvc:     appSvc,
+		runtimeSvc: runtimeSvc,
+	}
+}
+
+type lookupResponse struct {
+	ID string `json:"id"`
+}
+
+// Register adds the lookup endpoints to the router
+func (h *Handler) Register(router *mux.Router) {
+	router.HandleFunc("/applications/{id}", h.GetApplication).Methods(http.MethodGet)
+	router.HandleFunc("/runtimes/{id}", h.GetRuntime).Methods(http.MethodGet)
+}
+
+func (h *Handler) GetApplication(w http.ResponseWriter, r *http.Request) {
+	h.lookup(w, r, "Application", func(ctx context.Context, id string) error {
+		_, err := h.appSvc.Get(ctx, id)
+		return err
+	})
+}
+
+func (h *Handler) GetRuntime(w http.ResponseWriter, r *http.Request) {
+	h.lookup(w, r, "Runtime", func(ctx context.Context, id string) error {
+		_, err := h.runtimeSvc.Get(ctx, id)
+		return err
+	})
+}
+
+func (h *Handler) lookup(w http.ResponseWriter, r *http.Request, objectType string, get func(ctx context.Context, id string) error) {
+	tenantID := r.Header.Get(tenant.TenantHeaderName)
+	if tenantID == "" {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Header `%s` is required", tenant.TenantHeaderName))
+		return
+	}
+
+	id := mux.Vars(r)["id"]
+
+	tx, err := h.transact.Begin()
+	if err != nil {
+		log.Error(errors.Wrap(err, "while opening the transaction"))
+		writeJSONError(w, http.StatusInternalServerError, "Internal error")
+		return
+	}
+	defer h.transact.RollbackUnlessCommited(tx)
+
+	ctx := persistence.SaveToContext(tenant.SaveToContext(r.Context(), tenantID), tx)
+
+	err = get(ctx, id)
+	if err != nil {
+		if repo.IsNotFoundError(err) {
+			writeJSONError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", objectType, id))
+			return
+		}
+
+		log.Error(errors.Wrapf(err, "while getting %s %s", objectType, id))
+		writeJSONError(w, http.StatusInternalServerError, "Internal error")
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(errors.Wrap(err, "while committing the transaction"))
+		writeJSONError(w, http.StatusInternalServerError, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(lookupResponse{ID: id})
+	if err != nil {
+		log.Error(errors.Wrap(err, "while writing the response"))
+	}
+}
+
+func writeJSONError(w http.ResponseWriter, statusCode int, errMessage string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []string{errMessage},
+	})
+	if err != nil {
+		log.Error(errors.Wrap(err, "while writing JSON error"))
+	}
+}
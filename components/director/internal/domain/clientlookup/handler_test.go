@@ -0,0 +1,169 @@
+package clientlookup_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/clientlookup"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/clientlookup/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	id := "foo"
+	tnt := "tenant"
+
+	ctxMatcher := mock.MatchedBy(func(ctx context.Context) bool {
+		persistenceOp, err := persistence.FromCtx(ctx)
+		if err != nil || persistenceOp == nil {
+			return false
+		}
+
+		ctxTenant, err := tenant.LoadFromContext(ctx)
+		return err == nil && ctxTenant == tnt
+	})
+
+	testCases := []struct {
+		Name               string
+		Path               string
+		Tenant             string
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppServiceFn       func() *automock.ApplicationService
+		RuntimeServiceFn   func() *automock.RuntimeService
+		ExpectedStatusCode int
+	}{
+		{
+			Name:            "Returns Application",
+			Path:            "/applications/" + id,
+			Tenant:          tnt,
+			TransactionerFn: txtest.NewTransactionContextGenerator(nil).ThatSucceeds,
+			AppServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", ctxMatcher, id).Return(&model.Application{ID: id, Tenant: tnt}, nil).Once()
+				return svc
+			},
+			RuntimeServiceFn:   emptyRuntimeService,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name:            "Returns Runtime",
+			Path:            "/runtimes/" + id,
+			Tenant:          tnt,
+			TransactionerFn: txtest.NewTransactionContextGenerator(nil).ThatSucceeds,
+			AppServiceFn:    emptyAppService,
+			RuntimeServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("Get", ctxMatcher, id).Return(&model.Runtime{ID: id, Tenant: tnt}, nil).Once()
+				return svc
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name:            "Returns Not Found when Application does not exist",
+			Path:            "/applications/" + id,
+			Tenant:          tnt,
+			TransactionerFn: txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			AppServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", ctxMatcher, id).Return(nil, repo.NewNotFoundError()).Once()
+				return svc
+			},
+			RuntimeServiceFn:   emptyRuntimeService,
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		{
+			Name:            "Returns Not Found when Runtime does not exist",
+			Path:            "/runtimes/" + id,
+			Tenant:          tnt,
+			TransactionerFn: txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			AppServiceFn:    emptyAppService,
+			RuntimeServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("Get", ctxMatcher, id).Return(nil, repo.NewNotFoundError()).Once()
+				return svc
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		{
+			Name:            "Returns Internal Server Error when service fails",
+			Path:            "/runtimes/" + id,
+			Tenant:          tnt,
+			TransactionerFn: txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			AppServiceFn:    emptyAppService,
+			RuntimeServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("Get", ctxMatcher, id).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			Name:               "Returns Internal Server Error when transaction fails",
+			Path:               "/runtimes/" + id,
+			Tenant:             tnt,
+			TransactionerFn:    txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin,
+			AppServiceFn:       emptyAppService,
+			RuntimeServiceFn:   emptyRuntimeService,
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			Name: "Returns Bad Request when tenant not provided",
+			Path: "/runtimes/" + id,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return &persistenceautomock.PersistenceTx{}, &persistenceautomock.Transactioner{}
+			},
+			AppServiceFn:       emptyAppService,
+			RuntimeServiceFn:   emptyRuntimeService,
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx, transact := testCase.TransactionerFn()
+			appSvc := testCase.AppServiceFn()
+			runtimeSvc := testCase.RuntimeServiceFn()
+
+			router := mux.NewRouter()
+			clientlookup.NewHandler(transact, appSvc, runtimeSvc).Register(router)
+
+			request := httptest.NewRequest(http.MethodGet, testCase.Path, nil)
+			if testCase.Tenant != "" {
+				request.Header.Set(tenant.TenantHeaderName, testCase.Tenant)
+			}
+			rr := httptest.NewRecorder()
+
+			// when
+			router.ServeHTTP(rr, request)
+
+			// then
+			assert.Equal(t, testCase.ExpectedStatusCode, rr.Code)
+
+			persistTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+			runtimeSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func emptyAppService() *automock.ApplicationService {
+	return &automock.ApplicationService{}
+}
+
+func emptyRuntimeService() *automock.RuntimeService {
+	return &automock.RuntimeService{}
+}
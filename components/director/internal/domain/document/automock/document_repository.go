@@ -6,6 +6,7 @@ import context "context"
 
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import orderby "github.com/kyma-incubator/compass/components/director/internal/orderby"
 
 // DocumentRepository is an autogenerated mock type for the DocumentRepository type
 type DocumentRepository struct {
@@ -84,13 +85,13 @@ func (_m *DocumentRepository) GetByID(ctx context.Context, tenant string, id str
 	return r0, r1
 }
 
-// ListByApplicationID provides a mock function with given fields: ctx, tenant, applicationID, pageSize, cursor
-func (_m *DocumentRepository) ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error) {
-	ret := _m.Called(ctx, tenant, applicationID, pageSize, cursor)
+// ListByApplicationID provides a mock function with given fields: ctx, tenant, applicationID, pageSize, cursor, orderBy
+func (_m *DocumentRepository) ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.DocumentPage, error) {
+	ret := _m.Called(ctx, tenant, applicationID, pageSize, cursor, orderBy)
 
 	var r0 *model.DocumentPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) *model.DocumentPage); ok {
-		r0 = rf(ctx, tenant, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string, *orderby.OrderBy) *model.DocumentPage); ok {
+		r0 = rf(ctx, tenant, applicationID, pageSize, cursor, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.DocumentPage)
@@ -98,8 +99,8 @@ func (_m *DocumentRepository) ListByApplicationID(ctx context.Context, tenant st
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) error); ok {
-		r1 = rf(ctx, tenant, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string, *orderby.OrderBy) error); ok {
+		r1 = rf(ctx, tenant, applicationID, pageSize, cursor, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
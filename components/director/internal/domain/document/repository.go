@@ -11,12 +11,16 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 )
 
 const documentTable = "public.documents"
 
 var documentColumns = []string{"id", "tenant_id", "app_id", "title", "display_name", "description", "format", "kind", "data"}
 
+// documentOrderColumns are the indexed columns the Documents can be ordered by
+var documentOrderColumns = map[orderby.Field]string{orderby.Title: "title", orderby.DisplayName: "display_name"}
+
 //go:generate mockery -name=Converter -output=automock -outpkg=automock -case=underscore
 type Converter interface {
 	ToEntity(in model.Document) (Entity, error)
@@ -38,7 +42,7 @@ func NewRepository(conv Converter) *repository {
 		ExistQuerier:    repo.NewExistQuerier(documentTable, "tenant_id"),
 		SingleGetter:    repo.NewSingleGetter(documentTable, "tenant_id", documentColumns),
 		Deleter:         repo.NewDeleter(documentTable, "tenant_id"),
-		PageableQuerier: repo.NewPageableQuerier(documentTable, "tenant_id", documentColumns),
+		PageableQuerier: repo.NewPageableQuerier(documentTable, "tenant_id", "id", documentColumns),
 		Creator:         repo.NewCreator(documentTable, documentColumns),
 
 		conv: conv,
@@ -98,11 +102,16 @@ func (r *repository) DeleteAllByApplicationID(ctx context.Context, tenant string
 	return r.Deleter.DeleteMany(ctx, tenant, repo.Conditions{{Field: "app_id", Val: applicationID}})
 }
 
-func (r *repository) ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error) {
+func (r *repository) ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.DocumentPage, error) {
+	paginationOrderBy, err := orderBy.ToPagination("id", documentOrderColumns)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting order")
+	}
+
 	appCondition := fmt.Sprintf("%s = %s", "app_id", pq.QuoteLiteral(applicationID))
 
 	var entityCollection Collection
-	page, totalCount, err := r.PageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &entityCollection, appCondition)
+	page, totalCount, err := r.PageableQuerier.List(ctx, tenant, pageSize, cursor, paginationOrderBy, &entityCollection, appCondition)
 	if err != nil {
 		return nil, err
 	}
@@ -11,8 +11,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -188,8 +190,7 @@ func TestRepository_CreateMany(t *testing.T) {
 func TestRepository_ListByApplicationID(t *testing.T) {
 	// GIVEN
 	tenantID := "tnt"
-	ExpectedLimit := 3
-	ExpectedOffset := 0
+	ExpectedLimit := 4
 	testErr := errors.New("Test error")
 
 	inputPageSize := 3
@@ -199,7 +200,7 @@ func TestRepository_ListByApplicationID(t *testing.T) {
 	docEntity2 := fixEntityDocument("2", appID())
 
 	selectQuery := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, tenant_id, app_id, title, display_name, description, format, kind, data
-		FROM public.documents WHERE tenant_id=$1 AND app_id = '%s' ORDER BY id LIMIT %d OFFSET %d`, appID(), ExpectedLimit, ExpectedOffset))
+		FROM public.documents WHERE tenant_id=$1 AND app_id = '%s' ORDER BY id LIMIT %d`, appID(), ExpectedLimit))
 
 	rawCountQuery := fmt.Sprintf(`SELECT COUNT(*) FROM public.documents WHERE tenant_id=$1 AND app_id = '%s'`, appID())
 	countQuery := regexp.QuoteMeta(rawCountQuery)
@@ -228,7 +229,7 @@ func TestRepository_ListByApplicationID(t *testing.T) {
 
 		pgRepository := document.NewRepository(conv)
 		// WHEN
-		modelAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID(), inputPageSize, inputCursor)
+		modelAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID(), inputPageSize, inputCursor, nil)
 		//THEN
 		require.NoError(t, err)
 		require.Len(t, modelAPIDef.Data, 2)
@@ -238,6 +239,51 @@ func TestRepository_ListByApplicationID(t *testing.T) {
 		assert.Equal(t, totalCount, modelAPIDef.TotalCount)
 	})
 
+	t.Run("Success ordered by title in descending order", func(t *testing.T) {
+		orderedSelectQuery := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, tenant_id, app_id, title, display_name, description, format, kind, data
+		FROM public.documents WHERE tenant_id=$1 AND app_id = '%s' ORDER BY title DESC, id DESC LIMIT %d`, appID(), ExpectedLimit))
+		rows := sqlmock.NewRows(columns).
+			AddRow(docEntity2.ID, docEntity2.TenantID, docEntity2.AppID, docEntity2.Title, docEntity2.DisplayName, docEntity2.Description, docEntity2.Format, docEntity2.Kind, docEntity2.Data).
+			AddRow(docEntity1.ID, docEntity1.TenantID, docEntity1.AppID, docEntity1.Title, docEntity1.DisplayName, docEntity1.Description, docEntity1.Format, docEntity1.Kind, docEntity1.Data)
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		sqlMock.ExpectQuery(orderedSelectQuery).
+			WithArgs(tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		conv := &automock.Converter{}
+		defer conv.AssertExpectations(t)
+
+		conv.On("FromEntity", *docEntity2).Return(model.Document{ID: docEntity2.ID}, nil).Once()
+		conv.On("FromEntity", *docEntity1).Return(model.Document{ID: docEntity1.ID}, nil).Once()
+
+		pgRepository := document.NewRepository(conv)
+		// WHEN
+		modelAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID(), inputPageSize, inputCursor, orderby.New(orderby.Title, pagination.DescOrderDirection))
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, modelAPIDef.Data, 2)
+		assert.Equal(t, docEntity2.ID, modelAPIDef.Data[0].ID)
+		assert.Equal(t, docEntity1.ID, modelAPIDef.Data[1].ID)
+	})
+
+	t.Run("Returns error when ordering by unsupported field", func(t *testing.T) {
+		conv := &automock.Converter{}
+		defer conv.AssertExpectations(t)
+
+		pgRepository := document.NewRepository(conv)
+		// WHEN
+		_, err := pgRepository.ListByApplicationID(context.TODO(), tenantID, appID(), inputPageSize, inputCursor, orderby.New(orderby.Name, pagination.AscOrderDirection))
+		//THEN
+		require.EqualError(t, err, "while converting order: ordering by NAME is not supported")
+	})
+
 	t.Run("DB Error", func(t *testing.T) {
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		defer sqlMock.AssertExpectations(t)
@@ -251,7 +297,7 @@ func TestRepository_ListByApplicationID(t *testing.T) {
 
 		pgRepository := document.NewRepository(conv)
 		// WHEN
-		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID(), 3, "")
+		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID(), 3, "", nil)
 		//THEN
 		require.Error(t, err)
 		require.Contains(t, err.Error(), testErr.Error())
@@ -280,7 +326,7 @@ func TestRepository_ListByApplicationID(t *testing.T) {
 
 		repo := document.NewRepository(conv)
 		//WHEN
-		_, err := repo.ListByApplicationID(ctx, tenantID, appID(), inputPageSize, inputCursor)
+		_, err := repo.ListByApplicationID(ctx, tenantID, appID(), inputPageSize, inputCursor, nil)
 		//THEN
 		require.Error(t, err)
 		require.Contains(t, err.Error(), testErr.Error())
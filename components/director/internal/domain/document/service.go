@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/pkg/errors"
 )
@@ -17,7 +18,7 @@ import (
 type DocumentRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Document, error)
-	ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error)
+	ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.DocumentPage, error)
 	Create(ctx context.Context, item *model.Document) error
 	Delete(ctx context.Context, tenant, id string) error
 }
@@ -64,13 +65,13 @@ func (s *service) Get(ctx context.Context, id string) (*model.Document, error) {
 	return document, nil
 }
 
-func (s *service) List(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error) {
+func (s *service) List(ctx context.Context, applicationID string, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.DocumentPage, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	return s.repo.ListByApplicationID(ctx, tnt, applicationID, pageSize, cursor)
+	return s.repo.ListByApplicationID(ctx, tnt, applicationID, pageSize, cursor, orderBy)
 }
 
 func (s *service) Create(ctx context.Context, applicationID string, in model.DocumentInput) (string, error) {
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	repopkg "github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
@@ -107,6 +108,7 @@ func TestService_List(t *testing.T) {
 
 	first := 2
 	after := "test"
+	orderBy := orderby.New(orderby.Title, pagination.AscOrderDirection)
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, modelDocuments[0].Tenant)
@@ -121,7 +123,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.DocumentRepository {
 				repo := &automock.DocumentRepository{}
-				repo.On("ListByApplicationID", ctx, tnt, applicationID, first, after).Return(documentPage, nil).Once()
+				repo.On("ListByApplicationID", ctx, tnt, applicationID, first, after, orderBy).Return(documentPage, nil).Once()
 				return repo
 			},
 			ExpectedResult:     documentPage,
@@ -131,7 +133,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when document listing failed",
 			RepositoryFn: func() *automock.DocumentRepository {
 				repo := &automock.DocumentRepository{}
-				repo.On("ListByApplicationID", ctx, tnt, applicationID, first, after).Return(nil, testErr).Once()
+				repo.On("ListByApplicationID", ctx, tnt, applicationID, first, after, orderBy).Return(nil, testErr).Once()
 				return repo
 			},
 			ExpectedResult:     nil,
@@ -146,7 +148,7 @@ func TestService_List(t *testing.T) {
 			svc := document.NewService(repo, nil, nil)
 
 			// when
-			docs, err := svc.List(ctx, applicationID, first, after)
+			docs, err := svc.List(ctx, applicationID, first, after, orderBy)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -7,6 +7,7 @@ import context "context"
 import labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import orderby "github.com/kyma-incubator/compass/components/director/internal/orderby"
 
 // EventAPIRepository is an autogenerated mock type for the EventAPIRepository type
 type EventAPIRepository struct {
@@ -136,13 +137,13 @@ func (_m *EventAPIRepository) List(filter []*labelfilter.LabelFilter, pageSize *
 	return r0, r1
 }
 
-// ListByApplicationID provides a mock function with given fields: applicationID, pageSize, cursor
-func (_m *EventAPIRepository) ListByApplicationID(applicationID string, pageSize *int, cursor *string) (*model.EventAPIDefinitionPage, error) {
-	ret := _m.Called(applicationID, pageSize, cursor)
+// ListByApplicationID provides a mock function with given fields: applicationID, pageSize, cursor, orderBy
+func (_m *EventAPIRepository) ListByApplicationID(applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.EventAPIDefinitionPage, error) {
+	ret := _m.Called(applicationID, pageSize, cursor, orderBy)
 
 	var r0 *model.EventAPIDefinitionPage
-	if rf, ok := ret.Get(0).(func(string, *int, *string) *model.EventAPIDefinitionPage); ok {
-		r0 = rf(applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(string, *int, *string, *orderby.OrderBy) *model.EventAPIDefinitionPage); ok {
+		r0 = rf(applicationID, pageSize, cursor, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.EventAPIDefinitionPage)
@@ -150,8 +151,8 @@ func (_m *EventAPIRepository) ListByApplicationID(applicationID string, pageSize
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, *int, *string) error); ok {
-		r1 = rf(applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(string, *int, *string, *orderby.OrderBy) error); ok {
+		r1 = rf(applicationID, pageSize, cursor, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
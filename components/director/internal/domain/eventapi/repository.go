@@ -3,11 +3,13 @@ package eventapi
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/lib/pq"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
@@ -60,7 +62,7 @@ func (r *inMemoryRepository) List(filter []*labelfilter.LabelFilter, pageSize *i
 	}, nil
 }
 
-func (r *inMemoryRepository) ListByApplicationID(applicationID string, pageSize *int, cursor *string) (*model.EventAPIDefinitionPage, error) {
+func (r *inMemoryRepository) ListByApplicationID(applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.EventAPIDefinitionPage, error) {
 	var items []*model.EventAPIDefinition
 	for _, a := range r.store {
 		if a.ApplicationID == applicationID {
@@ -68,6 +70,10 @@ func (r *inMemoryRepository) ListByApplicationID(applicationID string, pageSize
 		}
 	}
 
+	if err := sortEventAPIDefinitions(items, orderBy); err != nil {
+		return nil, err
+	}
+
 	return &model.EventAPIDefinitionPage{
 		Data:       items,
 		TotalCount: len(items),
@@ -79,6 +85,25 @@ func (r *inMemoryRepository) ListByApplicationID(applicationID string, pageSize
 	}, nil
 }
 
+// sortEventAPIDefinitions orders the EventAPIDefinitions the same way as the database does, as they are not stored there yet
+// TODO: remove this function after migrating to Database
+func sortEventAPIDefinitions(items []*model.EventAPIDefinition, orderBy *orderby.OrderBy) error {
+	var value func(item *model.EventAPIDefinition) string
+	switch orderBy.FieldOrID() {
+	case orderby.ID:
+		value = func(item *model.EventAPIDefinition) string { return item.ID }
+	case orderby.Name:
+		value = func(item *model.EventAPIDefinition) string { return item.Name }
+	default:
+		return errors.Errorf("ordering by %s is not supported", orderBy.FieldOrID())
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return orderBy.Less(orderby.Key{Value: value(items[i]), ID: items[i].ID}, orderby.Key{Value: value(items[j]), ID: items[j].ID})
+	})
+	return nil
+}
+
 func (r *inMemoryRepository) Create(item *model.EventAPIDefinition) error {
 	if item == nil {
 		return errors.New("item can not be nil")
@@ -142,6 +167,9 @@ var apiDefColumns = []string{"id", "tenant_id", "app_id", "name", "description",
 
 var idColumns = []string{"id"}
 
+// eventAPIDefOrderColumns are the indexed columns the Event API Definitions can be ordered by
+var eventAPIDefOrderColumns = map[orderby.Field]string{orderby.Name: "name"}
+
 var updatableColumns = []string{"name", "description", "group_name", "spec_data", "spec_format", "spec_type",
 	"version_value", "version_deprecated", "version_deprecated_since", "version_for_removal"}
 
@@ -164,7 +192,7 @@ type pgRepository struct {
 func NewPostgresRepository(conv EventAPIDefinitionConverter) *pgRepository {
 	return &pgRepository{
 		SingleGetter:    repo.NewSingleGetter(eventAPIDefTable, tenantColumn, apiDefColumns),
-		PageableQuerier: repo.NewPageableQuerier(eventAPIDefTable, tenantColumn, apiDefColumns),
+		PageableQuerier: repo.NewPageableQuerier(eventAPIDefTable, tenantColumn, "id", apiDefColumns),
 		Creator:         repo.NewCreator(eventAPIDefTable, apiDefColumns),
 		Updater:         repo.NewUpdater(eventAPIDefTable, updatableColumns, tenantColumn, idColumns),
 		Deleter:         repo.NewDeleter(eventAPIDefTable, tenantColumn),
@@ -179,10 +207,15 @@ func (r EventAPIDefCollection) Len() int {
 	return len(r)
 }
 
-func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error) {
+func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.EventAPIDefinitionPage, error) {
+	paginationOrderBy, err := orderBy.ToPagination("id", eventAPIDefOrderColumns)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting order")
+	}
+
 	appCond := fmt.Sprintf("app_id = %s ", pq.QuoteLiteral(applicationID))
 	var eventAPIDefCollection EventAPIDefCollection
-	page, totalCount, err := r.PageableQuerier.List(ctx, tenantID, pageSize, cursor, "id", &eventAPIDefCollection, appCond)
+	page, totalCount, err := r.PageableQuerier.List(ctx, tenantID, pageSize, cursor, paginationOrderBy, &eventAPIDefCollection, appCond)
 	if err != nil {
 		return nil, err
 	}
@@ -72,8 +72,7 @@ func TestPgRepository_GetByID(t *testing.T) {
 
 func TestPgRepository_ListByApplicationID(t *testing.T) {
 	// GIVEN
-	ExpectedLimit := 3
-	ExpectedOffset := 0
+	ExpectedLimit := 4
 
 	inputPageSize := 3
 	inputCursor := ""
@@ -85,7 +84,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 
 	selectQuery := fmt.Sprintf(`^SELECT (.+) FROM "public"."event_api_definitions" 
 		WHERE tenant_id=\$1 AND app_id = '%s' 
-		ORDER BY id LIMIT %d OFFSET %d`, appID, ExpectedLimit, ExpectedOffset)
+		ORDER BY id LIMIT %d`, appID, ExpectedLimit)
 
 	rawCountQuery := fmt.Sprintf(`SELECT COUNT(*) FROM "public"."event_api_definitions" 
 		WHERE tenant_id=$1 AND app_id = '%s'`, appID)
@@ -111,7 +110,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		convMock.On("FromEntity", secondEventAPIDefEntity).Return(model.EventAPIDefinition{ID: secondEventAPIDefID}, nil)
 		pgRepository := eventapi.NewPostgresRepository(convMock)
 		// WHEN
-		modelEventAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
+		modelEventAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor, nil)
 		//THEN
 		require.NoError(t, err)
 		require.Len(t, modelEventAPIDef.Data, 2)
@@ -142,7 +141,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		convMock.On("FromEntity", firstEventAPIDefEntity).Return(model.EventAPIDefinition{}, testErr).Once()
 		pgRepository := eventapi.NewPostgresRepository(convMock)
 		//WHEN
-		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
+		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor, nil)
 		//THEN
 		require.Error(t, err)
 		require.Contains(t, err.Error(), testErr.Error())
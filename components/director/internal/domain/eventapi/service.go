@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/pkg/errors"
 )
 
@@ -19,7 +20,7 @@ type EventAPIRepository interface {
 	GetByID(id string) (*model.EventAPIDefinition, error)
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	List(filter []*labelfilter.LabelFilter, pageSize *int, cursor *string) (*model.EventAPIDefinitionPage, error)
-	ListByApplicationID(applicationID string, pageSize *int, cursor *string) (*model.EventAPIDefinitionPage, error)
+	ListByApplicationID(applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.EventAPIDefinitionPage, error)
 	Create(item *model.EventAPIDefinition) error
 	CreateMany(items []*model.EventAPIDefinition) error
 	Update(item *model.EventAPIDefinition) error
@@ -54,8 +55,8 @@ func NewService(eventAPIRepo EventAPIRepository, fetchRequestRepo FetchRequestRe
 	}
 }
 
-func (s *service) List(ctx context.Context, applicationID string, pageSize *int, cursor *string) (*model.EventAPIDefinitionPage, error) {
-	return s.eventAPIRepo.ListByApplicationID(applicationID, pageSize, cursor)
+func (s *service) List(ctx context.Context, applicationID string, pageSize *int, cursor *string, orderBy *orderby.OrderBy) (*model.EventAPIDefinitionPage, error) {
+	return s.eventAPIRepo.ListByApplicationID(applicationID, pageSize, cursor, orderBy)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.EventAPIDefinition, error) {
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/stretchr/testify/mock"
@@ -107,6 +108,7 @@ func TestService_List(t *testing.T) {
 
 	first := 2
 	after := "test"
+	orderBy := orderby.New(orderby.Name, pagination.DescOrderDirection)
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, "tenant")
@@ -123,7 +125,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
-				repo.On("ListByApplicationID", applicationID, &first, &after).Return(eventAPIDefinitionPage, nil).Once()
+				repo.On("ListByApplicationID", applicationID, &first, &after, orderBy).Return(eventAPIDefinitionPage, nil).Once()
 				return repo
 			},
 			InputPageSize:      &first,
@@ -135,7 +137,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when EventAPI listing failed",
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
-				repo.On("ListByApplicationID", applicationID, &first, &after).Return(nil, testErr).Once()
+				repo.On("ListByApplicationID", applicationID, &first, &after, orderBy).Return(nil, testErr).Once()
 				return repo
 			},
 			InputPageSize:      &first,
@@ -152,7 +154,7 @@ func TestService_List(t *testing.T) {
 			svc := eventapi.NewService(repo, nil, nil)
 
 			// when
-			docs, err := svc.List(ctx, applicationID, testCase.InputPageSize, testCase.InputCursor)
+			docs, err := svc.List(ctx, applicationID, testCase.InputPageSize, testCase.InputCursor, orderBy)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
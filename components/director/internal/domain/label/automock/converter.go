@@ -52,3 +52,24 @@ func (_m *Converter) ToEntity(in model.Label) (label.Entity, error) {
 
 	return r0, r1
 }
+
+// UsageFromEntity provides a mock function with given fields: in
+func (_m *Converter) UsageFromEntity(in label.UsageEntity) (model.LabelValueUsage, error) {
+	ret := _m.Called(in)
+
+	var r0 model.LabelValueUsage
+	if rf, ok := ret.Get(0).(func(label.UsageEntity) model.LabelValueUsage); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.LabelValueUsage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(label.UsageEntity) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -79,3 +79,17 @@ func (c *converter) FromEntity(in Entity) (model.Label, error) {
 		Value:      valueUnmarshalled,
 	}, nil
 }
+
+func (c *converter) UsageFromEntity(in UsageEntity) (model.LabelValueUsage, error) {
+	var valueUnmarshalled interface{}
+	err := json.Unmarshal([]byte(in.Value), &valueUnmarshalled)
+	if err != nil {
+		return model.LabelValueUsage{}, errors.Wrap(err, "while unmarshalling Value")
+	}
+
+	return model.LabelValueUsage{
+		Value:        valueUnmarshalled,
+		Applications: in.Applications,
+		Runtimes:     in.Runtimes,
+	}, nil
+}
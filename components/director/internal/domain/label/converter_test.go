@@ -173,3 +173,24 @@ func fixLabelModel(id string, value interface{}) model.Label {
 		Value:      value,
 	}
 }
+
+func TestConverter_UsageFromEntity(t *testing.T) {
+	conv := label.NewConverter()
+
+	t.Run("Success", func(t *testing.T) {
+		// when
+		res, err := conv.UsageFromEntity(label.UsageEntity{Value: `"DEFAULT"`, Applications: 2, Runtimes: 1})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, model.LabelValueUsage{Value: "DEFAULT", Applications: 2, Runtimes: 1}, res)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		// when
+		_, err := conv.UsageFromEntity(label.UsageEntity{Value: "{json"})
+
+		// then
+		require.EqualError(t, err, "while unmarshalling Value: invalid character 'j' looking for beginning of object key string")
+	})
+}
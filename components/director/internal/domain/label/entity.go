@@ -12,3 +12,10 @@ type Entity struct {
 	RuntimeID sql.NullString `db:"runtime_id"`
 	Value     string         `db:"value"`
 }
+
+// UsageEntity is the number of Applications and Runtimes labeled with the value, or with the key if the value is not selected
+type UsageEntity struct {
+	Value        string `db:"value"`
+	Applications int    `db:"applications"`
+	Runtimes     int    `db:"runtimes"`
+}
@@ -4,67 +4,88 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
-	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
 )
 
-// SetCombination type defines possible result set combination for quering
-type SetCombination string
+const labelSubqueryFormat string = `%s IN (SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "tenant_id" = $1 AND "key" = %s%s)`
 
-const (
-	IntersectSet      SetCombination = "INTERSECT"
-	UnionSet          SetCombination = "UNION"
-	scenariosLabelKey string         = "SCENARIOS"
-	stmtPrefixFormat  string         = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "tenant_id" = '%s'`
-)
-
-// FilterQuery builds select query for given filters
+// FilterCondition builds the condition matching objects which labels satisfy all given filters
 //
-// It supports quering defined by `queryFor` parameter. All queries are created
-// in the context of given tenant
-func FilterQuery(queryFor model.LabelableObject, setCombination SetCombination, tenant uuid.UUID, filter []*labelfilter.LabelFilter) (string, error) {
-	if filter == nil {
+// It supports quering defined by `queryFor` parameter. The condition is built for the
+// object ID column of the outer statement, in the context of the tenant which is
+// expected to be bound as $1. Every label filter becomes a subquery for the label,
+// which are combined with AND, OR and NOT according to the filter tree, so the whole
+// filter is evaluated by a single statement. Keys and SQL/JSON path queries translated
+// into JSONB conditions are bound to args.
+func FilterCondition(queryFor model.LabelableObject, objectIDColumn string, filters []*labelfilter.LabelFilter, args *jsonpath.Args) (string, error) {
+	if len(filters) == 0 {
 		return "", nil
 	}
 
-	objectField := labelableObjectField(queryFor)
+	for _, filter := range filters {
+		if err := filter.Validate(); err != nil {
+			return "", errors.Wrap(err, "while validating label filter")
+		}
+	}
 
-	stmtPrefix := fmt.Sprintf(stmtPrefixFormat, objectField, tableName, objectField, tenant)
+	b := &conditionBuilder{objectField: labelableObjectField(queryFor), objectIDColumn: objectIDColumn, args: args}
+	return b.combine(filters, "AND")
+}
+
+type conditionBuilder struct {
+	objectField    string
+	objectIDColumn string
+	args           *jsonpath.Args
+}
 
-	var queryBuilder strings.Builder
-	for idx, lblFilter := range filter {
-		if idx > 0 {
-			queryBuilder.WriteString(fmt.Sprintf(` %s `, setCombination))
+func (b *conditionBuilder) condition(filter *labelfilter.LabelFilter) (string, error) {
+	switch {
+	case filter.And != nil:
+		return b.combine(filter.And, "AND")
+	case filter.Or != nil:
+		return b.combine(filter.Or, "OR")
+	case filter.Not != nil:
+		condition, err := b.condition(filter.Not)
+		if err != nil {
+			return "", err
 		}
+		return fmt.Sprintf("NOT %s", condition), nil
+	}
 
-		queryBuilder.WriteString(fmt.Sprintf(stmtPrefix))
+	// TODO: for optimization it can be detected if the given Key was already added to the query
+	// if so, it can be ommited
+	keyPlaceholder := b.args.Add(filter.Key)
 
-		// TODO: for optimization it can be detected if the given Key was already added to the query
-		// if so, it can be ommited
-		queryBuilder.WriteString(fmt.Sprintf(` AND "key" = %s`, pq.QuoteLiteral(lblFilter.Key)))
+	var valueCondition string
+	if filter.Query != nil {
+		query, err := jsonpath.Parse(*filter.Query)
+		if err != nil {
+			return "", errors.Wrapf(err, "while parsing query for label %s", filter.Key)
+		}
 
-		if lblFilter.Query != nil {
-			queryValue := *lblFilter.Query
-			// Handling the Scenarios label case - we assume that Query is
-			// in SQL/JSON path format supported by PostgreSQL 12. Till it
-			// is not production ready, we need to transform the Query from
-			// SQL/JSON path to old JSON queries.
-			if strings.ToUpper(lblFilter.Key) == scenariosLabelKey {
-				extractedValue, err := ExtractValueFromJSONPath(queryValue)
-				if err != nil {
-					return "", errors.Wrap(err, "while extracting value from JSON path")
-				}
+		valueCondition = fmt.Sprintf(` AND %s`, query.ToSQL(`"value"`, b.args))
+	}
 
-				queryValue = `["` + *extractedValue + `"]`
-			}
+	return fmt.Sprintf(labelSubqueryFormat, b.objectIDColumn, b.objectField, tableName, b.objectField, keyPlaceholder, valueCondition), nil
+}
 
-			queryBuilder.WriteString(fmt.Sprintf(` AND "value" @> %s`, pq.QuoteLiteral(queryValue)))
+func (b *conditionBuilder) combine(filters []*labelfilter.LabelFilter, operator string) (string, error) {
+	var conditions []string
+	for _, filter := range filters {
+		condition, err := b.condition(filter)
+		if err != nil {
+			return "", err
 		}
+		conditions = append(conditions, condition)
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0], nil
 	}
 
-	return queryBuilder.String(), nil
+	return fmt.Sprintf("(%s)", strings.Join(conditions, fmt.Sprintf(" %s ", operator))), nil
 }
@@ -4,152 +4,106 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
-
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
 )
 
-func Test_FilterQuery_Intersection(t *testing.T) {
-	tenantID := uuid.New()
-
-	fooQuery := `["foo-value"]`
-	barQuery := `["bar-value"]`
-	scenariosFooQuery := `$[*] ? (@ == "foo")`
-	scenariosBarPongQuery := `$[*] ? (@ == "bar pong")`
+func Test_FilterCondition(t *testing.T) {
+	tenant := uuid.New().String()
 
-	filterAllFoos := labelfilter.LabelFilter{
-		Key:   "Foo",
-		Query: nil,
-	}
-	filterAllBars := labelfilter.LabelFilter{
-		Key:   "Bar",
-		Query: nil,
-	}
-	filterFoosWithValues := labelfilter.LabelFilter{
-		Key:   "Foo",
-		Query: &fooQuery,
+	labelSubquery := func(key, valueCondition string) string {
+		return `"id" IN (SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL AND "tenant_id" = $1 AND "key" = ` + key + valueCondition + `)`
 	}
-	filterBarsWithValues := labelfilter.LabelFilter{
-		Key:   "Bar",
-		Query: &barQuery,
+	valueCondition := func(value string) string {
+		return ` AND "value" IS NOT NULL AND (CASE WHEN "value" IS NULL THEN false WHEN jsonb_typeof("value") = 'array' ` +
+			`THEN EXISTS (SELECT 1 FROM jsonb_array_elements("value") AS elem1(value) WHERE CASE WHEN jsonb_typeof(elem1.value) = 'string' THEN (elem1.value #>> '{}')::text = ` + value + `::text END) ` +
+			`ELSE CASE WHEN jsonb_typeof("value") = 'string' THEN ("value" #>> '{}')::text = ` + value + `::text END END)`
 	}
-	filterAllScenarios := labelfilter.LabelFilter{
-		Key:   "Scenarios",
-		Query: nil,
+	scenariosValueCondition := func(value string) string {
+		return ` AND EXISTS (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof("value") = 'array' THEN "value" WHEN "value" IS NOT NULL THEN jsonb_build_array("value") END) AS elem1(value) ` +
+			`WHERE elem1.value IS NOT NULL AND (CASE WHEN elem1.value IS NULL THEN false WHEN jsonb_typeof(elem1.value) = 'array' ` +
+			`THEN EXISTS (SELECT 1 FROM jsonb_array_elements(elem1.value) AS elem2(value) WHERE CASE WHEN jsonb_typeof(elem2.value) = 'string' THEN (elem2.value #>> '{}')::text = ` + value + `::text END) ` +
+			`ELSE CASE WHEN jsonb_typeof(elem1.value) = 'string' THEN (elem1.value #>> '{}')::text = ` + value + `::text END END))`
 	}
-	filterScenariosWithFooValues := labelfilter.LabelFilter{
-		Key:   "Scenarios",
-		Query: &scenariosFooQuery,
-	}
-	filterScenariosWithbarPongValues := labelfilter.LabelFilter{
-		Key:   "Scenarios",
-		Query: &scenariosBarPongQuery,
-	}
-
-	stmtPrefix := `SELECT "runtime_id" FROM public.labels ` +
-		`WHERE "runtime_id" IS NOT NULL AND "tenant_id" = '` + tenantID.String() + `'`
 
 	testCases := []struct {
-		Name                 string
-		ReturnSetCombination SetCombination
-		FilterInput          []*labelfilter.LabelFilter
-		ExpectedQueryFilter  string
-		ExpectedError        error
+		Name              string
+		FilterInput       []*labelfilter.LabelFilter
+		ExpectedCondition string
+		ExpectedArgs      []interface{}
+		ExpectedError     string
 	}{
 		{
-			Name:                 "Returns empty query filter when no label filters defined - intersect set",
-			ReturnSetCombination: IntersectSet,
-			FilterInput:          nil,
-			ExpectedQueryFilter:  "",
-			ExpectedError:        nil,
+			Name:              "Returns empty condition when no label filters defined",
+			FilterInput:       nil,
+			ExpectedCondition: "",
+			ExpectedArgs:      []interface{}{tenant},
 		}, {
-			Name:                 "Returns empty query filter when no label filters defined - union set",
-			ReturnSetCombination: UnionSet,
-			FilterInput:          nil,
-			ExpectedQueryFilter:  "",
-			ExpectedError:        nil,
+			Name:              "Query only for label assigned if label filter defined only with key",
+			FilterInput:       []*labelfilter.LabelFilter{labelfilter.NewForKey("foo")},
+			ExpectedCondition: labelSubquery("$2", ""),
+			ExpectedArgs:      []interface{}{tenant, "foo"},
 		}, {
-			Name:                 "Query only for label assigned if label filter defined only with key - intersect set",
-			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterAllFoos},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterAllFoos.Key + `'`,
-			ExpectedError:        nil,
+			Name:              "Query for labels assigned if multiple label filters defined",
+			FilterInput:       []*labelfilter.LabelFilter{labelfilter.NewForKey("foo"), labelfilter.NewForKey("bar")},
+			ExpectedCondition: "(" + labelSubquery("$2", "") + " AND " + labelSubquery("$3", "") + ")",
+			ExpectedArgs:      []interface{}{tenant, "foo", "bar"},
 		}, {
-			Name:                 "Query only for label assigned if label filter defined only with key - union set",
-			ReturnSetCombination: UnionSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterAllFoos},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterAllFoos.Key + `'`,
-			ExpectedError:        nil,
+			Name:              "Query for label assigned with value",
+			FilterInput:       []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery("foo", `$ ? (@ == "foo-value")`)},
+			ExpectedCondition: labelSubquery("$2", valueCondition("$3")),
+			ExpectedArgs:      []interface{}{tenant, "foo", "foo-value"},
 		}, {
-			Name:                 "Query only for labels assigned if label filter defined only with keys (multiple) - intersect set",
-			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterAllFoos, &filterAllBars},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterAllFoos.Key + `'` +
-				` INTERSECT ` + stmtPrefix + ` AND "key" = '` + filterAllBars.Key + `'`,
-			ExpectedError: nil,
+			Name:              "[Scenarios] Query for label assigned with value",
+			FilterInput:       []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery("scenarios", `$[*] ? (@ == "foo")`)},
+			ExpectedCondition: labelSubquery("$2", scenariosValueCondition("$3")),
+			ExpectedArgs:      []interface{}{tenant, "scenarios", "foo"},
 		}, {
-			Name:                 "Query only for labels assigned if label filter defined only with keys (multiple) - union set",
-			ReturnSetCombination: UnionSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterAllFoos, &filterAllBars},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterAllFoos.Key + `'` +
-				` UNION ` + stmtPrefix + ` AND "key" = '` + filterAllBars.Key + `'`,
-			ExpectedError: nil,
+			Name: "Query for labels combined with and, or and not",
+			FilterInput: []*labelfilter.LabelFilter{labelfilter.NewAnd(
+				labelfilter.NewForKeyWithQuery("env", `$ ? (@ == "prod")`),
+				labelfilter.NewOr(
+					labelfilter.NewForKeyWithQuery("region", `$ ? (@ == "eu")`),
+					labelfilter.NewForKeyWithQuery("region", `$ ? (@ == "us")`),
+				),
+				labelfilter.NewNot(labelfilter.NewForKey("deprecated")),
+			)},
+			ExpectedCondition: "(" + labelSubquery("$2", valueCondition("$3")) +
+				" AND (" + labelSubquery("$4", valueCondition("$5")) + " OR " + labelSubquery("$6", valueCondition("$7")) + ")" +
+				" AND NOT " + labelSubquery("$8", "") + ")",
+			ExpectedArgs: []interface{}{tenant, "env", "prod", "region", "eu", "region", "us", "deprecated"},
 		}, {
-			Name:                 "Query for label assigned with value - intersect set",
-			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterFoosWithValues},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterFoosWithValues.Key + `' AND "value" @> '` + *filterFoosWithValues.Query + `'`,
-			ExpectedError:        nil,
+			Name:          "Returns error when query is not a valid JSON path",
+			FilterInput:   []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery("foo", `["foo-value"]`)},
+			ExpectedError: "while parsing query for label foo",
 		}, {
-			Name:                 "Query for label assigned with value - union set",
-			ReturnSetCombination: UnionSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterFoosWithValues},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterFoosWithValues.Key + `' AND "value" @> '` + *filterFoosWithValues.Query + `'`,
-			ExpectedError:        nil,
-		}, {
-			Name:                 "Query for labels assigned with values (multiple) - intersect set",
-			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterFoosWithValues, &filterBarsWithValues},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterFoosWithValues.Key + `' AND "value" @> '` + *filterFoosWithValues.Query + `'` +
-				` INTERSECT ` + stmtPrefix + ` AND "key" = '` + filterBarsWithValues.Key + `' AND "value" @> '` + *filterBarsWithValues.Query + `'`,
-			ExpectedError: nil,
-		}, {
-			Name:                 "Query for labels assigned with values (multiple) - union set",
-			ReturnSetCombination: UnionSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterFoosWithValues, &filterBarsWithValues},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterFoosWithValues.Key + `' AND "value" @> '` + *filterFoosWithValues.Query + `'` +
-				` UNION ` + stmtPrefix + ` AND "key" = '` + filterBarsWithValues.Key + `' AND "value" @> '` + *filterBarsWithValues.Query + `'`,
-			ExpectedError: nil,
-		}, {
-			Name:                 "[Scenarios] Query for label assigned",
-			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterAllScenarios},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterAllScenarios.Key + `'`,
-			ExpectedError:        nil,
-		}, {
-			Name:                 "[Scenarios] Query for label assigned with value",
-			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterScenariosWithFooValues},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterScenariosWithFooValues.Key + `' AND "value" @> '["foo"]'`,
-			ExpectedError:        nil,
-		}, {
-			Name:                 "[Scenarios] Query for label assigned with values",
-			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterScenariosWithFooValues, &filterScenariosWithbarPongValues},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterScenariosWithFooValues.Key + `' AND "value" @> '["foo"]'` +
-				` INTERSECT ` + stmtPrefix + ` AND "key" = '` + filterScenariosWithbarPongValues.Key + `' AND "value" @> '["bar pong"]'`,
-			ExpectedError: nil,
+			Name:          "Returns error when label filter is not valid",
+			FilterInput:   []*labelfilter.LabelFilter{labelfilter.NewNot(&labelfilter.LabelFilter{})},
+			ExpectedError: "while validating label filter: label filter must specify exactly one of key, and, or, not",
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			queryFilter, err := FilterQuery(model.RuntimeLabelableObject, testCase.ReturnSetCombination, tenantID, testCase.FilterInput)
+			// given
+			args := jsonpath.NewArgs(tenant)
+
+			// when
+			condition, err := FilterCondition(model.RuntimeLabelableObject, `"id"`, testCase.FilterInput, args)
 
-			assert.Equal(t, testCase.ExpectedQueryFilter, queryFilter)
-			assert.Equal(t, testCase.ExpectedError, err)
+			// then
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedCondition, condition)
+			assert.Equal(t, testCase.ExpectedArgs, args.Values())
 		})
 	}
 }
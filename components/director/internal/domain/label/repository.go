@@ -21,6 +21,7 @@ var tableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "key", "v
 type Converter interface {
 	ToEntity(in model.Label) (Entity, error)
 	FromEntity(in Entity) (model.Label, error)
+	UsageFromEntity(in UsageEntity) (model.LabelValueUsage, error)
 }
 
 type repository struct {
@@ -134,6 +135,52 @@ func (r *repository) ListByKey(ctx context.Context, tenant, key string) ([]*mode
 	return labels, nil
 }
 
+// GetKeyUsage returns the number of Applications and Runtimes labeled with the key and with each of its distinct values.
+// Elements of array values are counted separately, so an object labeled with scenarios ["A", "B"] is counted for both A and B.
+func (r *repository) GetKeyUsage(ctx context.Context, tenant, key string) (*model.LabelKeyUsage, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching DB from context")
+	}
+
+	countStmt := fmt.Sprintf(`SELECT COUNT(app_id) AS applications, COUNT(runtime_id) AS runtimes FROM %s WHERE key = $1 AND tenant_id = $2`, tableName)
+
+	var keyUsage UsageEntity
+	err = persist.Get(&keyUsage, countStmt, key, tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "while counting Labels in DB")
+	}
+
+	valuesStmt := fmt.Sprintf(`SELECT value, COUNT(DISTINCT app_id) AS applications, COUNT(DISTINCT runtime_id) AS runtimes FROM (
+		SELECT app_id, runtime_id, jsonb_array_elements(value) AS value FROM %[1]s WHERE key = $1 AND tenant_id = $2 AND jsonb_typeof(value) = 'array'
+		UNION ALL
+		SELECT app_id, runtime_id, value FROM %[1]s WHERE key = $1 AND tenant_id = $2 AND jsonb_typeof(value) <> 'array'
+	) AS label_values GROUP BY value ORDER BY value`, tableName)
+
+	var entities []UsageEntity
+	err = persist.Select(&entities, valuesStmt, key, tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching Label values from DB")
+	}
+
+	values := make([]*model.LabelValueUsage, 0, len(entities))
+	for _, entity := range entities {
+		m, err := r.conv.UsageFromEntity(entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Label value usage entity to model")
+		}
+
+		values = append(values, &m)
+	}
+
+	return &model.LabelKeyUsage{
+		Key:          key,
+		Applications: keyUsage.Applications,
+		Runtimes:     keyUsage.Runtimes,
+		Values:       values,
+	}, nil
+}
+
 func (r *repository) Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error {
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
@@ -575,6 +575,87 @@ func TestRepository_ListByKey(t *testing.T) {
 	})
 }
 
+func TestRepository_GetKeyUsage(t *testing.T) {
+	tnt := "tenant"
+	labelKey := "scenarios"
+	countQuery := regexp.QuoteMeta(`SELECT COUNT(app_id) AS applications, COUNT(runtime_id) AS runtimes FROM public.labels WHERE key = $1 AND tenant_id = $2`)
+	valuesQuery := `^SELECT value, COUNT\(DISTINCT app_id\) AS applications, COUNT\(DISTINCT runtime_id\) AS runtimes FROM \(\s+` +
+		`SELECT app_id, runtime_id, jsonb_array_elements\(value\) AS value FROM public.labels WHERE key = \$1 AND tenant_id = \$2 AND jsonb_typeof\(value\) = 'array'\s+` +
+		`UNION ALL\s+` +
+		`SELECT app_id, runtime_id, value FROM public.labels WHERE key = \$1 AND tenant_id = \$2 AND jsonb_typeof\(value\) <> 'array'\s+` +
+		`\) AS label_values GROUP BY value ORDER BY value$`
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		entities := []label.UsageEntity{
+			{Value: `"DEFAULT"`, Applications: 3, Runtimes: 1},
+			{Value: `"FOO"`, Applications: 1, Runtimes: 0},
+		}
+		values := []*model.LabelValueUsage{
+			{Value: "DEFAULT", Applications: 3, Runtimes: 1},
+			{Value: "FOO", Applications: 1, Runtimes: 0},
+		}
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("UsageFromEntity", entities[0]).Return(*values[0], nil).Once()
+		mockConverter.On("UsageFromEntity", entities[1]).Return(*values[1], nil).Once()
+
+		repo := label.NewRepository(mockConverter)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(countQuery).WithArgs(labelKey, tnt).
+			WillReturnRows(sqlmock.NewRows([]string{"applications", "runtimes"}).AddRow(3, 1))
+		dbMock.ExpectQuery(valuesQuery).WithArgs(labelKey, tnt).
+			WillReturnRows(sqlmock.NewRows([]string{"value", "applications", "runtimes"}).
+				AddRow(`"DEFAULT"`, 3, 1).
+				AddRow(`"FOO"`, 1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		actual, err := repo.GetKeyUsage(ctx, tnt, labelKey)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &model.LabelKeyUsage{Key: labelKey, Applications: 3, Runtimes: 1, Values: values}, actual)
+	})
+
+	t.Run("Error when counting failed", func(t *testing.T) {
+		// GIVEN
+		repo := label.NewRepository(&automock.Converter{})
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(countQuery).WithArgs(labelKey, tnt).WillReturnError(errors.New("persistence error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		_, err := repo.GetKeyUsage(ctx, tnt, labelKey)
+		// THEN
+		require.EqualError(t, err, "while counting Labels in DB: persistence error")
+	})
+
+	t.Run("Error when listing values failed", func(t *testing.T) {
+		// GIVEN
+		repo := label.NewRepository(&automock.Converter{})
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(countQuery).WithArgs(labelKey, tnt).
+			WillReturnRows(sqlmock.NewRows([]string{"applications", "runtimes"}).AddRow(3, 1))
+		dbMock.ExpectQuery(valuesQuery).WithArgs(labelKey, tnt).WillReturnError(errors.New("persistence error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		_, err := repo.GetKeyUsage(ctx, tnt, labelKey)
+		// THEN
+		require.EqualError(t, err, "while fetching Label values from DB: persistence error")
+	})
+}
+
 func TestRepository_Delete(t *testing.T) {
 	t.Run("Success - Label for Runtime", func(t *testing.T) {
 		// GIVEN
@@ -89,6 +89,21 @@ func (s *labelUpsertService) UpsertLabel(ctx context.Context, tenant string, lab
 	return nil
 }
 
+// ValidateLabel validates the label value against the JSON schema of the LabelDefinition with the label key, without storing the label
+func (s *labelUpsertService) ValidateLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error {
+	labelDef, err := s.labelDefinitionRepo.GetByKey(ctx, tenant, labelInput.Key)
+	if err != nil {
+		return errors.Wrapf(err, "while reading LabelDefinition for key '%s'", labelInput.Key)
+	}
+
+	err = s.validateLabelInputValue(ctx, tenant, labelInput, labelDef)
+	if err != nil {
+		return errors.Wrapf(err, "while validating Label value for '%s'", labelInput.Key)
+	}
+
+	return nil
+}
+
 func (s *labelUpsertService) validateLabelInputValue(ctx context.Context, tenant string, labelInput *model.LabelInput, labelDef *model.LabelDefinition) error {
 	if labelDef == nil || labelDef.Schema == nil {
 		// nothing to validate
@@ -438,3 +438,106 @@ func TestLabelUpsertService_UpsertLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelUpsertService_ValidateLabel(t *testing.T) {
+	// given
+	tnt := "tenant"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+	var jsonSchema interface{} = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"foo": map[string]interface{}{
+				"type": "string",
+			},
+		},
+		"required": []interface{}{"foo"},
+	}
+	objectSchema := &model.LabelDefinition{
+		Key:    "test",
+		Tenant: tnt,
+		ID:     "foo",
+		Schema: &jsonSchema,
+	}
+
+	testErr := errors.New("Test error")
+
+	testCases := []struct {
+		Name           string
+		LabelDefRepoFn func() *automock.LabelDefinitionRepository
+		Value          interface{}
+
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success - No LabelDefinition",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, tnt, "test").Return(nil, nil).Once()
+				return repo
+			},
+			Value:              "string",
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Success - Validate value",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, tnt, "test").Return(objectSchema, nil).Once()
+				return repo
+			},
+			Value:              map[string]interface{}{"foo": "bar"},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Error - Validate value",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, tnt, "test").Return(objectSchema, nil).Once()
+				return repo
+			},
+			Value:              []interface{}{"test"},
+			ExpectedErrMessage: "Invalid type",
+		},
+		{
+			Name: "Error - Reading LabelDefinition",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, tnt, "test").Return(nil, testErr).Once()
+				return repo
+			},
+			Value:              "string",
+			ExpectedErrMessage: "Test error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := &automock.LabelRepository{}
+			labelDefRepo := testCase.LabelDefRepoFn()
+			uidService := &automock.UIDService{}
+
+			svc := label.NewLabelUpsertService(labelRepo, labelDefRepo, uidService)
+
+			// when
+			err := svc.ValidateLabel(ctx, tnt, &model.LabelInput{
+				Key:        "test",
+				Value:      testCase.Value,
+				ObjectType: model.RuntimeLabelableObject,
+				ObjectID:   "runtimeID",
+			})
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelRepo.AssertExpectations(t)
+			labelDefRepo.AssertExpectations(t)
+			uidService.AssertExpectations(t)
+		})
+	}
+}
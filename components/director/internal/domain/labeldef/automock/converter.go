@@ -12,6 +12,20 @@ type Converter struct {
 	mock.Mock
 }
 
+// ChangeToGraphQL provides a mock function with given fields: in
+func (_m *Converter) ChangeToGraphQL(in model.LabelValueChange) graphql.LabelValueChange {
+	ret := _m.Called(in)
+
+	var r0 graphql.LabelValueChange
+	if rf, ok := ret.Get(0).(func(model.LabelValueChange) graphql.LabelValueChange); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(graphql.LabelValueChange)
+	}
+
+	return r0
+}
+
 // FromEntity provides a mock function with given fields: in
 func (_m *Converter) FromEntity(in labeldef.Entity) (model.LabelDefinition, error) {
 	ret := _m.Called(in)
@@ -47,6 +61,20 @@ func (_m *Converter) FromGraphQL(input graphql.LabelDefinitionInput, tenant stri
 	return r0
 }
 
+// ScenarioToGraphQL provides a mock function with given fields: in
+func (_m *Converter) ScenarioToGraphQL(in model.Scenario) graphql.Scenario {
+	ret := _m.Called(in)
+
+	var r0 graphql.Scenario
+	if rf, ok := ret.Get(0).(func(model.Scenario) graphql.Scenario); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(graphql.Scenario)
+	}
+
+	return r0
+}
+
 // ToEntity provides a mock function with given fields: in
 func (_m *Converter) ToEntity(in model.LabelDefinition) (labeldef.Entity, error) {
 	ret := _m.Called(in)
@@ -81,3 +109,31 @@ func (_m *Converter) ToGraphQL(definition model.LabelDefinition) graphql.LabelDe
 
 	return r0
 }
+
+// TransformationFromGraphQL provides a mock function with given fields: in
+func (_m *Converter) TransformationFromGraphQL(in graphql.LabelValueTransformationInput) model.LabelValueTransformation {
+	ret := _m.Called(in)
+
+	var r0 model.LabelValueTransformation
+	if rf, ok := ret.Get(0).(func(graphql.LabelValueTransformationInput) model.LabelValueTransformation); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.LabelValueTransformation)
+	}
+
+	return r0
+}
+
+// UsageToGraphQL provides a mock function with given fields: in
+func (_m *Converter) UsageToGraphQL(in model.LabelKeyUsage) graphql.LabelKeyUsage {
+	ret := _m.Called(in)
+
+	var r0 graphql.LabelKeyUsage
+	if rf, ok := ret.Get(0).(func(model.LabelKeyUsage) graphql.LabelKeyUsage); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(graphql.LabelKeyUsage)
+	}
+
+	return r0
+}
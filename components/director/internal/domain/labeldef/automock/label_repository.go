@@ -77,6 +77,29 @@ func (_m *LabelRepository) GetByKey(ctx context.Context, tenant string, objectTy
 	return r0, r1
 }
 
+// GetKeyUsage provides a mock function with given fields: ctx, tenant, key
+func (_m *LabelRepository) GetKeyUsage(ctx context.Context, tenant string, key string) (*model.LabelKeyUsage, error) {
+	ret := _m.Called(ctx, tenant, key)
+
+	var r0 *model.LabelKeyUsage
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.LabelKeyUsage); ok {
+		r0 = rf(ctx, tenant, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LabelKeyUsage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByKey provides a mock function with given fields: ctx, tenant, key
 func (_m *LabelRepository) ListByKey(ctx context.Context, tenant string, key string) ([]*model.Label, error) {
 	ret := _m.Called(ctx, tenant, key)
@@ -122,3 +145,17 @@ func (_m *LabelRepository) ListForObject(ctx context.Context, tenant string, obj
 
 	return r0, r1
 }
+
+// Upsert provides a mock function with given fields: ctx, label
+func (_m *LabelRepository) Upsert(ctx context.Context, label *model.Label) error {
+	ret := _m.Called(ctx, label)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Label) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -70,6 +70,29 @@ func (_m *Service) Get(ctx context.Context, tenant string, key string) (*model.L
 	return r0, r1
 }
 
+// GetUsage provides a mock function with given fields: ctx, tenant, key
+func (_m *Service) GetUsage(ctx context.Context, tenant string, key string) (*model.LabelKeyUsage, error) {
+	ret := _m.Called(ctx, tenant, key)
+
+	var r0 *model.LabelKeyUsage
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.LabelKeyUsage); ok {
+		r0 = rf(ctx, tenant, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LabelKeyUsage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, tenant
 func (_m *Service) List(ctx context.Context, tenant string) ([]model.LabelDefinition, error) {
 	ret := _m.Called(ctx, tenant)
@@ -93,6 +116,29 @@ func (_m *Service) List(ctx context.Context, tenant string) ([]model.LabelDefini
 	return r0, r1
 }
 
+// PreviewUpdate provides a mock function with given fields: ctx, def, transformation
+func (_m *Service) PreviewUpdate(ctx context.Context, def model.LabelDefinition, transformation *model.LabelValueTransformation) ([]*model.LabelValueChange, error) {
+	ret := _m.Called(ctx, def, transformation)
+
+	var r0 []*model.LabelValueChange
+	if rf, ok := ret.Get(0).(func(context.Context, model.LabelDefinition, *model.LabelValueTransformation) []*model.LabelValueChange); ok {
+		r0 = rf(ctx, def, transformation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LabelValueChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.LabelDefinition, *model.LabelValueTransformation) error); ok {
+		r1 = rf(ctx, def, transformation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, ld
 func (_m *Service) Update(ctx context.Context, ld model.LabelDefinition) error {
 	ret := _m.Called(ctx, ld)
@@ -106,3 +152,26 @@ func (_m *Service) Update(ctx context.Context, ld model.LabelDefinition) error {
 
 	return r0
 }
+
+// UpdateWithTransformation provides a mock function with given fields: ctx, def, transformation
+func (_m *Service) UpdateWithTransformation(ctx context.Context, def model.LabelDefinition, transformation model.LabelValueTransformation) ([]*model.LabelValueChange, error) {
+	ret := _m.Called(ctx, def, transformation)
+
+	var r0 []*model.LabelValueChange
+	if rf, ok := ret.Get(0).(func(context.Context, model.LabelDefinition, model.LabelValueTransformation) []*model.LabelValueChange); ok {
+		r0 = rf(ctx, def, transformation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LabelValueChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.LabelDefinition, model.LabelValueTransformation) error); ok {
+		r1 = rf(ctx, def, transformation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -30,6 +30,83 @@ func (c *converter) ToGraphQL(in model.LabelDefinition) graphql.LabelDefinition
 	}
 }
 
+func (c *converter) UsageToGraphQL(in model.LabelKeyUsage) graphql.LabelKeyUsage {
+	values := make([]*graphql.LabelValueUsage, 0, len(in.Values))
+	for _, value := range in.Values {
+		if value == nil {
+			continue
+		}
+		values = append(values, &graphql.LabelValueUsage{
+			Value:        value.Value,
+			Applications: value.Applications,
+			Runtimes:     value.Runtimes,
+		})
+	}
+
+	return graphql.LabelKeyUsage{
+		Key:          in.Key,
+		Applications: in.Applications,
+		Runtimes:     in.Runtimes,
+		Values:       values,
+	}
+}
+
+func (c *converter) TransformationFromGraphQL(in graphql.LabelValueTransformationInput) model.LabelValueTransformation {
+	var mapping []*model.LabelValueMapping
+	for _, m := range in.Mapping {
+		if m == nil {
+			continue
+		}
+		mapping = append(mapping, &model.LabelValueMapping{
+			From: m.From,
+			To:   m.To,
+		})
+	}
+
+	var defaultValue interface{}
+	if in.Default != nil {
+		defaultValue = *in.Default
+	}
+
+	return model.LabelValueTransformation{
+		Mapping:  mapping,
+		Default:  defaultValue,
+		JSONPath: in.JSONPath,
+	}
+}
+
+func (c *converter) ChangeToGraphQL(in model.LabelValueChange) graphql.LabelValueChange {
+	var objectType graphql.LabelableObject
+	switch in.ObjectType {
+	case model.ApplicationLabelableObject:
+		objectType = graphql.LabelableObjectApplication
+	case model.RuntimeLabelableObject:
+		objectType = graphql.LabelableObjectRuntime
+	}
+
+	var newValue *interface{}
+	if in.NewValue != nil {
+		value := in.NewValue
+		newValue = &value
+	}
+
+	return graphql.LabelValueChange{
+		ObjectType: objectType,
+		ObjectID:   in.ObjectID,
+		OldValue:   in.OldValue,
+		NewValue:   newValue,
+		Valid:      in.Valid,
+	}
+}
+
+func (c *converter) ScenarioToGraphQL(in model.Scenario) graphql.Scenario {
+	return graphql.Scenario{
+		Name:         in.Name,
+		Applications: in.Applications,
+		Runtimes:     in.Runtimes,
+	}
+}
+
 func (c *converter) ToEntity(in model.LabelDefinition) (Entity, error) {
 	out := Entity{
 		ID:       in.ID,
@@ -50,6 +50,94 @@ func TestToGraphQL(t *testing.T) {
 	assert.Equal(t, anyString, *actual.Schema)
 }
 
+func TestUsageToGraphQL(t *testing.T) {
+	// GIVEN
+	sut := labeldef.NewConverter()
+	// WHEN
+	actual := sut.UsageToGraphQL(model.LabelKeyUsage{
+		Key:          "scenarios",
+		Applications: 3,
+		Runtimes:     1,
+		Values: []*model.LabelValueUsage{
+			{Value: "DEFAULT", Applications: 3, Runtimes: 1},
+			{Value: "FOO", Applications: 1},
+		},
+	})
+	// THEN
+	assert.Equal(t, graphql.LabelKeyUsage{
+		Key:          "scenarios",
+		Applications: 3,
+		Runtimes:     1,
+		Values: []*graphql.LabelValueUsage{
+			{Value: "DEFAULT", Applications: 3, Runtimes: 1},
+			{Value: "FOO", Applications: 1},
+		},
+	}, actual)
+}
+
+func TestTransformationFromGraphQL(t *testing.T) {
+	// GIVEN
+	sut := labeldef.NewConverter()
+	var defaultValue interface{} = "OTHER"
+	jsonPath := "$.region"
+	// WHEN
+	actual := sut.TransformationFromGraphQL(graphql.LabelValueTransformationInput{
+		Mapping: []*graphql.LabelValueMappingInput{
+			{From: "europe", To: "EU"},
+		},
+		Default:  &defaultValue,
+		JSONPath: &jsonPath,
+	})
+	// THEN
+	assert.Equal(t, model.LabelValueTransformation{
+		Mapping: []*model.LabelValueMapping{
+			{From: "europe", To: "EU"},
+		},
+		Default:  "OTHER",
+		JSONPath: &jsonPath,
+	}, actual)
+}
+
+func TestChangeToGraphQL(t *testing.T) {
+	// GIVEN
+	sut := labeldef.NewConverter()
+	var newValue interface{} = "EU"
+	// WHEN
+	actual := sut.ChangeToGraphQL(model.LabelValueChange{
+		LabelID:    "8b131225-f09d-4035-8091-1f12933863b3",
+		ObjectType: model.ApplicationLabelableObject,
+		ObjectID:   "foo",
+		OldValue:   "europe",
+		NewValue:   "EU",
+		Valid:      true,
+	})
+	// THEN
+	assert.Equal(t, graphql.LabelValueChange{
+		ObjectType: graphql.LabelableObjectApplication,
+		ObjectID:   "foo",
+		OldValue:   "europe",
+		NewValue:   &newValue,
+		Valid:      true,
+	}, actual)
+}
+
+func TestScenarioToGraphQL(t *testing.T) {
+	// GIVEN
+	sut := labeldef.NewConverter()
+	// WHEN
+	actual := sut.ScenarioToGraphQL(model.Scenario{
+		Name:         "FOO",
+		Applications: 2,
+		Runtimes:     1,
+	})
+	// THEN
+	assert.Equal(t, graphql.Scenario{
+		Name:         "FOO",
+		Applications: 2,
+		Runtimes:     1,
+	}, actual)
+}
+
 func TestToEntity(t *testing.T) {
 	// GIVEN
 	var schema interface{} = ExampleSchema{
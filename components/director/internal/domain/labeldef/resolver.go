@@ -30,6 +30,10 @@ func NewResolver(srv Service, conv Converter, transactioner persistence.Transact
 type Converter interface {
 	FromGraphQL(input graphql.LabelDefinitionInput, tenant string) model.LabelDefinition
 	ToGraphQL(definition model.LabelDefinition) graphql.LabelDefinition
+	UsageToGraphQL(in model.LabelKeyUsage) graphql.LabelKeyUsage
+	TransformationFromGraphQL(in graphql.LabelValueTransformationInput) model.LabelValueTransformation
+	ChangeToGraphQL(in model.LabelValueChange) graphql.LabelValueChange
+	ScenarioToGraphQL(in model.Scenario) graphql.Scenario
 	ToEntity(in model.LabelDefinition) (Entity, error)
 	FromEntity(in Entity) (model.LabelDefinition, error)
 }
@@ -39,8 +43,11 @@ type Service interface {
 	Create(ctx context.Context, ld model.LabelDefinition) (model.LabelDefinition, error)
 	Get(ctx context.Context, tenant string, key string) (*model.LabelDefinition, error)
 	List(ctx context.Context, tenant string) ([]model.LabelDefinition, error)
+	GetUsage(ctx context.Context, tenant string, key string) (*model.LabelKeyUsage, error)
 	Delete(ctx context.Context, tenant string, key string, deleteRelatedLabels bool) error
 	Update(ctx context.Context, ld model.LabelDefinition) error
+	UpdateWithTransformation(ctx context.Context, def model.LabelDefinition, transformation model.LabelValueTransformation) ([]*model.LabelValueChange, error)
+	PreviewUpdate(ctx context.Context, def model.LabelDefinition, transformation *model.LabelValueTransformation) ([]*model.LabelValueChange, error)
 }
 
 func (r *Resolver) CreateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput) (*graphql.LabelDefinition, error) {
@@ -129,7 +136,71 @@ func (r *Resolver) LabelDefinition(ctx context.Context, key string) (*graphql.La
 	return &c, nil
 }
 
-func (r *Resolver) UpdateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput) (*graphql.LabelDefinition, error) {
+func (r *Resolver) LabelValues(ctx context.Context, key string) (*graphql.LabelKeyUsage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transactioner.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transactioner.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	usage, err := r.srv.GetUsage(ctx, tnt, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting label values")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	out := r.conv.UsageToGraphQL(*usage)
+	return &out, nil
+}
+
+func (r *Resolver) PreviewLabelDefinitionUpdate(ctx context.Context, in graphql.LabelDefinitionInput, transformation *graphql.LabelValueTransformationInput) ([]*graphql.LabelValueChange, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transactioner.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transactioner.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	ld := r.conv.FromGraphQL(in, tnt)
+
+	var modelTransformation *model.LabelValueTransformation
+	if transformation != nil {
+		t := r.conv.TransformationFromGraphQL(*transformation)
+		modelTransformation = &t
+	}
+
+	changes, err := r.srv.PreviewUpdate(ctx, ld, modelTransformation)
+	if err != nil {
+		return nil, errors.Wrap(err, "while previewing label definition update")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	out := make([]*graphql.LabelValueChange, 0, len(changes))
+	for _, change := range changes {
+		c := r.conv.ChangeToGraphQL(*change)
+		out = append(out, &c)
+	}
+	return out, nil
+}
+
+func (r *Resolver) UpdateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput, transformation *graphql.LabelValueTransformationInput) (*graphql.LabelDefinition, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -146,7 +217,11 @@ func (r *Resolver) UpdateLabelDefinition(ctx context.Context, in graphql.LabelDe
 	// TODO: Use LabelDefinitionInput
 	ld := r.conv.FromGraphQL(in, tnt)
 
-	err = r.srv.Update(ctx, ld)
+	if transformation != nil {
+		_, err = r.srv.UpdateWithTransformation(ctx, ld, r.conv.TransformationFromGraphQL(*transformation))
+	} else {
+		err = r.srv.Update(ctx, ld)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "while updating label definition")
 	}
@@ -425,6 +425,164 @@ func TestQueryGivenLabelDefinition(t *testing.T) {
 	})
 }
 
+func TestQueryLabelValues(t *testing.T) {
+	tnt := "tenant"
+	t.Run("successfully returns usage of the label key", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(nil)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		givenModel := &model.LabelKeyUsage{
+			Key:          "scenarios",
+			Applications: 1,
+			Values:       []*model.LabelValueUsage{{Value: "DEFAULT", Applications: 1}},
+		}
+		expected := graphql.LabelKeyUsage{
+			Key:          "scenarios",
+			Applications: 1,
+			Values:       []*graphql.LabelValueUsage{{Value: "DEFAULT", Applications: 1}},
+		}
+
+		mockService := &automock.Service{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("GetUsage", contextThatHasTenant(tnt), tnt, "scenarios").Return(givenModel, nil)
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("UsageToGraphQL", *givenModel).Return(expected)
+
+		sut := labeldef.NewResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		actual, err := sut.LabelValues(ctx, "scenarios")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &expected, actual)
+	})
+
+	t.Run("got error on getting usage from service", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+
+		mockService := &automock.Service{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("GetUsage", contextThatHasTenant(tnt), tnt, "scenarios").Return(nil, errors.New("some error"))
+
+		sut := labeldef.NewResolver(mockService, nil, mockTransactioner)
+		// WHEN
+		_, err := sut.LabelValues(ctx, "scenarios")
+		// THEN
+		require.EqualError(t, err, "while getting label values: some error")
+	})
+
+	t.Run("returns error when missing tenant in context", func(t *testing.T) {
+		// GIVEN
+		sut := labeldef.NewResolver(nil, nil, nil)
+		// WHEN
+		_, err := sut.LabelValues(context.TODO(), "scenarios")
+		// THEN
+		require.EqualError(t, err, "Cannot read tenant from context")
+	})
+}
+
+func TestQueryPreviewLabelDefinitionUpdate(t *testing.T) {
+	tnt := "tenant"
+	gqlLabelDefinitionInput := graphql.LabelDefinitionInput{
+		Key:    "key",
+		Schema: fixBasicSchema(t),
+	}
+	modelLabelDefinition := model.LabelDefinition{
+		Key:    "key",
+		Schema: fixBasicSchema(t),
+	}
+	jsonPath := "$.firstName"
+	gqlTransformation := &graphql.LabelValueTransformationInput{JSONPath: &jsonPath}
+	modelTransformation := model.LabelValueTransformation{JSONPath: &jsonPath}
+
+	t.Run("successfully returns changes", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(nil)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		modelChange := &model.LabelValueChange{LabelID: "8b131225-f09d-4035-8091-1f12933863b3", ObjectType: model.RuntimeLabelableObject, ObjectID: "foo", OldValue: "val", Valid: false}
+		var oldValue interface{} = "val"
+		gqlChange := graphql.LabelValueChange{ObjectType: graphql.LabelableObjectRuntime, ObjectID: "foo", OldValue: oldValue, Valid: false}
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(modelLabelDefinition)
+		mockConverter.On("TransformationFromGraphQL", *gqlTransformation).Return(modelTransformation)
+		mockConverter.On("ChangeToGraphQL", *modelChange).Return(gqlChange)
+
+		mockService := &automock.Service{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("PreviewUpdate", contextThatHasTenant(tnt), modelLabelDefinition, &modelTransformation).Return([]*model.LabelValueChange{modelChange}, nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		actual, err := sut.PreviewLabelDefinitionUpdate(ctx, gqlLabelDefinitionInput, gqlTransformation)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*graphql.LabelValueChange{&gqlChange}, actual)
+	})
+
+	t.Run("got error on previewing update", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(modelLabelDefinition)
+
+		mockService := &automock.Service{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("PreviewUpdate", contextThatHasTenant(tnt), modelLabelDefinition, (*model.LabelValueTransformation)(nil)).Return(nil, errors.New("some error"))
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		_, err := sut.PreviewLabelDefinitionUpdate(ctx, gqlLabelDefinitionInput, nil)
+		// THEN
+		require.EqualError(t, err, "while previewing label definition update: some error")
+	})
+
+	t.Run("returns error when missing tenant in context", func(t *testing.T) {
+		// GIVEN
+		sut := labeldef.NewResolver(nil, nil, nil)
+		// WHEN
+		_, err := sut.PreviewLabelDefinitionUpdate(context.TODO(), gqlLabelDefinitionInput, nil)
+		// THEN
+		require.EqualError(t, err, "Cannot read tenant from context")
+	})
+}
+
 func TestResolver_DeleteLabelDefinition(t *testing.T) {
 	tnt := "tenant"
 
@@ -680,16 +838,53 @@ func TestUpdateLabelDefinition(t *testing.T) {
 		ctx = tenant.SaveToContext(ctx, tnt)
 		sut := labeldef.NewResolver(mockService, mockConverter, mockTransactioner)
 		// WHEN
-		actual, err := sut.UpdateLabelDefinition(ctx, gqlLabelDefinitionInput)
+		actual, err := sut.UpdateLabelDefinition(ctx, gqlLabelDefinitionInput, nil)
 		// THEN
 		require.NoError(t, err)
 		assert.Equal(t, "key", actual.Key)
 	})
+
+	t.Run("successfully updated Label Definition with transformation", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(nil)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		var defaultValue interface{} = "OTHER"
+		gqlTransformation := &graphql.LabelValueTransformationInput{Default: &defaultValue}
+		modelTransformation := model.LabelValueTransformation{Default: "OTHER"}
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(modelLabelDefinition)
+		mockConverter.On("TransformationFromGraphQL", *gqlTransformation).Return(modelTransformation)
+		mockConverter.On("ToGraphQL", modelLabelDefinition).Return(updatedGQLLabelDefinition)
+
+		mockService := &automock.Service{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("UpdateWithTransformation", contextThatHasTenant(tnt), modelLabelDefinition, modelTransformation).Return(nil, nil)
+		mockService.On("Get", contextThatHasTenant(tnt), tnt, modelLabelDefinition.Key).Return(&modelLabelDefinition, nil).Once()
+
+		ctx := persistence.SaveToContext(context.TODO(), nil)
+		ctx = tenant.SaveToContext(ctx, tnt)
+		sut := labeldef.NewResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		actual, err := sut.UpdateLabelDefinition(ctx, gqlLabelDefinitionInput, gqlTransformation)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "key", actual.Key)
+	})
+
 	t.Run("missing tenant in context", func(t *testing.T) {
 		// GIVEN
 		sut := labeldef.NewResolver(nil, nil, nil)
 		// WHEN
-		_, err := sut.UpdateLabelDefinition(context.TODO(), graphql.LabelDefinitionInput{})
+		_, err := sut.UpdateLabelDefinition(context.TODO(), graphql.LabelDefinitionInput{}, nil)
 		// THEN
 		require.EqualError(t, err, "Cannot read tenant from context")
 	})
@@ -703,7 +898,7 @@ func TestUpdateLabelDefinition(t *testing.T) {
 		ctx = tenant.SaveToContext(ctx, tnt)
 		sut := labeldef.NewResolver(nil, nil, mockTransactioner)
 		// WHEN
-		_, err := sut.UpdateLabelDefinition(ctx, graphql.LabelDefinitionInput{})
+		_, err := sut.UpdateLabelDefinition(ctx, graphql.LabelDefinitionInput{}, nil)
 		// THEN
 		require.EqualError(t, err, "while starting transaction: some error")
 	})
@@ -730,7 +925,7 @@ func TestUpdateLabelDefinition(t *testing.T) {
 		ctx = tenant.SaveToContext(ctx, tnt)
 		sut := labeldef.NewResolver(mockService, mockConverter, mockTransactioner)
 		// WHEN
-		_, err := sut.UpdateLabelDefinition(ctx, gqlLabelDefinitionInput)
+		_, err := sut.UpdateLabelDefinition(ctx, gqlLabelDefinitionInput, nil)
 		// THEN
 		require.EqualError(t, err, "while updating label definition: some error")
 
@@ -760,7 +955,7 @@ func TestUpdateLabelDefinition(t *testing.T) {
 		ctx = tenant.SaveToContext(ctx, tnt)
 		sut := labeldef.NewResolver(mockService, mockConverter, mockTransactioner)
 		// WHEN
-		_, err := sut.UpdateLabelDefinition(ctx, gqlLabelDefinitionInput)
+		_, err := sut.UpdateLabelDefinition(ctx, gqlLabelDefinitionInput, nil)
 		// THEN
 		require.EqualError(t, err, "while committing transaction: error on commit")
 	})
@@ -0,0 +1,195 @@
+package labeldef
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type ScenariosResolver struct {
+	conv          Converter
+	srv           ScenariosService
+	transactioner persistence.Transactioner
+}
+
+func NewScenariosResolver(srv ScenariosService, conv Converter, transactioner persistence.Transactioner) *ScenariosResolver {
+	return &ScenariosResolver{
+		conv:          conv,
+		srv:           srv,
+		transactioner: transactioner,
+	}
+}
+
+//go:generate mockery -name=ScenariosService -output=automock -outpkg=automock -case=underscore
+type ScenariosService interface {
+	List(ctx context.Context, tenant string) ([]model.Scenario, error)
+	Get(ctx context.Context, tenant string, name string) (*model.Scenario, error)
+	Create(ctx context.Context, tenant string, name string) error
+	Delete(ctx context.Context, tenant string, name string) error
+	Rename(ctx context.Context, tenant string, name string, newName string) error
+}
+
+func (r *ScenariosResolver) Scenarios(ctx context.Context) ([]*graphql.Scenario, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transactioner.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transactioner.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenarios, err := r.srv.List(ctx, tnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing scenarios")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	out := make([]*graphql.Scenario, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		c := r.conv.ScenarioToGraphQL(scenario)
+		out = append(out, &c)
+	}
+	return out, nil
+}
+
+func (r *ScenariosResolver) Scenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transactioner.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transactioner.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenario, err := r.srv.Get(ctx, tnt, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting scenario")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	if scenario == nil {
+		return nil, nil
+	}
+
+	out := r.conv.ScenarioToGraphQL(*scenario)
+	return &out, nil
+}
+
+func (r *ScenariosResolver) CreateScenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transactioner.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transactioner.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := r.srv.Create(ctx, tnt, name); err != nil {
+		return nil, errors.Wrap(err, "while creating scenario")
+	}
+
+	scenario, err := r.srv.Get(ctx, tnt, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting scenario")
+	}
+	if scenario == nil {
+		return nil, fmt.Errorf("scenario %s does not exist", name)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	out := r.conv.ScenarioToGraphQL(*scenario)
+	return &out, nil
+}
+
+func (r *ScenariosResolver) DeleteScenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transactioner.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transactioner.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenario, err := r.srv.Get(ctx, tnt, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting scenario")
+	}
+	if scenario == nil {
+		return nil, fmt.Errorf("scenario %s does not exist", name)
+	}
+
+	deleted := r.conv.ScenarioToGraphQL(*scenario)
+
+	if err := r.srv.Delete(ctx, tnt, name); err != nil {
+		return nil, errors.Wrap(err, "while deleting scenario")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return &deleted, nil
+}
+
+func (r *ScenariosResolver) RenameScenario(ctx context.Context, name string, newName string) (*graphql.Scenario, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transactioner.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transactioner.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := r.srv.Rename(ctx, tnt, name, newName); err != nil {
+		return nil, errors.Wrap(err, "while renaming scenario")
+	}
+
+	scenario, err := r.srv.Get(ctx, tnt, newName)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting scenario")
+	}
+	if scenario == nil {
+		return nil, fmt.Errorf("scenario %s does not exist", newName)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	out := r.conv.ScenarioToGraphQL(*scenario)
+	return &out, nil
+}
@@ -0,0 +1,361 @@
+package labeldef_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	pautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenariosResolver_Scenarios(t *testing.T) {
+	tnt := "tenant"
+	modelScenarios := []model.Scenario{
+		{Name: "DEFAULT", Applications: 2},
+		{Name: "FOO", Runtimes: 1},
+	}
+	gqlScenarios := []*graphql.Scenario{
+		{Name: "DEFAULT", Applications: 2},
+		{Name: "FOO", Runtimes: 1},
+	}
+
+	t.Run("successfully returns scenarios", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(nil)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("List", contextThatHasTenant(tnt), tnt).Return(modelScenarios, nil)
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ScenarioToGraphQL", modelScenarios[0]).Return(*gqlScenarios[0])
+		mockConverter.On("ScenarioToGraphQL", modelScenarios[1]).Return(*gqlScenarios[1])
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		actual, err := sut.Scenarios(ctx)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, gqlScenarios, actual)
+	})
+
+	t.Run("got error on listing scenarios", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("List", contextThatHasTenant(tnt), tnt).Return(nil, errors.New("some error"))
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, nil, mockTransactioner)
+		// WHEN
+		_, err := sut.Scenarios(ctx)
+		// THEN
+		require.EqualError(t, err, "while listing scenarios: some error")
+	})
+
+	t.Run("returns error when missing tenant in context", func(t *testing.T) {
+		// GIVEN
+		sut := labeldef.NewScenariosResolver(nil, nil, nil)
+		// WHEN
+		_, err := sut.Scenarios(context.TODO())
+		// THEN
+		require.EqualError(t, err, "Cannot read tenant from context")
+	})
+}
+
+func TestScenariosResolver_Scenario(t *testing.T) {
+	tnt := "tenant"
+
+	t.Run("successfully returns scenario", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(nil)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		modelScenario := &model.Scenario{Name: "FOO", Runtimes: 1}
+		gqlScenario := graphql.Scenario{Name: "FOO", Runtimes: 1}
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("Get", contextThatHasTenant(tnt), tnt, "FOO").Return(modelScenario, nil)
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ScenarioToGraphQL", *modelScenario).Return(gqlScenario)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		actual, err := sut.Scenario(ctx, "FOO")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &gqlScenario, actual)
+	})
+
+	t.Run("returns nil when scenario does not exist", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(nil)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("Get", contextThatHasTenant(tnt), tnt, "FOO").Return(nil, nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, nil, mockTransactioner)
+		// WHEN
+		actual, err := sut.Scenario(ctx, "FOO")
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestScenariosResolver_CreateScenario(t *testing.T) {
+	tnt := "tenant"
+	modelScenario := &model.Scenario{Name: "FOO"}
+	gqlScenario := graphql.Scenario{Name: "FOO"}
+
+	t.Run("successfully created scenario", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(nil)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("Create", contextThatHasTenant(tnt), tnt, "FOO").Return(nil)
+		mockService.On("Get", contextThatHasTenant(tnt), tnt, "FOO").Return(modelScenario, nil)
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ScenarioToGraphQL", *modelScenario).Return(gqlScenario)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		actual, err := sut.CreateScenario(ctx, "FOO")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &gqlScenario, actual)
+	})
+
+	t.Run("got error on creating scenario", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("Create", contextThatHasTenant(tnt), tnt, "FOO").Return(errors.New("some error"))
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, nil, mockTransactioner)
+		// WHEN
+		_, err := sut.CreateScenario(ctx, "FOO")
+		// THEN
+		require.EqualError(t, err, "while creating scenario: some error")
+	})
+
+	t.Run("got error on starting transaction", func(t *testing.T) {
+		// GIVEN
+		mockTransactioner := getInvalidMockTransactioner()
+		defer mockTransactioner.AssertExpectations(t)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(nil, nil, mockTransactioner)
+		// WHEN
+		_, err := sut.CreateScenario(ctx, "FOO")
+		// THEN
+		require.EqualError(t, err, "while starting transaction: some error")
+	})
+}
+
+func TestScenariosResolver_DeleteScenario(t *testing.T) {
+	tnt := "tenant"
+	modelScenario := &model.Scenario{Name: "FOO", Applications: 1}
+	gqlScenario := graphql.Scenario{Name: "FOO", Applications: 1}
+
+	t.Run("successfully deleted scenario", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(nil)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("Get", contextThatHasTenant(tnt), tnt, "FOO").Return(modelScenario, nil)
+		mockService.On("Delete", contextThatHasTenant(tnt), tnt, "FOO").Return(nil)
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ScenarioToGraphQL", *modelScenario).Return(gqlScenario)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		actual, err := sut.DeleteScenario(ctx, "FOO")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &gqlScenario, actual)
+	})
+
+	t.Run("returns error when scenario does not exist", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("Get", contextThatHasTenant(tnt), tnt, "FOO").Return(nil, nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, nil, mockTransactioner)
+		// WHEN
+		_, err := sut.DeleteScenario(ctx, "FOO")
+		// THEN
+		require.EqualError(t, err, "scenario FOO does not exist")
+	})
+
+	t.Run("got error on deleting scenario", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("Get", contextThatHasTenant(tnt), tnt, "DEFAULT").Return(&model.Scenario{Name: "DEFAULT"}, nil)
+		mockService.On("Delete", contextThatHasTenant(tnt), tnt, "DEFAULT").Return(errors.New("scenario DEFAULT can not be deleted"))
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ScenarioToGraphQL", model.Scenario{Name: "DEFAULT"}).Return(graphql.Scenario{Name: "DEFAULT"})
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		_, err := sut.DeleteScenario(ctx, "DEFAULT")
+		// THEN
+		require.EqualError(t, err, "while deleting scenario: scenario DEFAULT can not be deleted")
+	})
+}
+
+func TestScenariosResolver_RenameScenario(t *testing.T) {
+	tnt := "tenant"
+	modelScenario := &model.Scenario{Name: "BAR", Runtimes: 2}
+	gqlScenario := graphql.Scenario{Name: "BAR", Runtimes: 2}
+
+	t.Run("successfully renamed scenario", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(nil)
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("Rename", contextThatHasTenant(tnt), tnt, "FOO", "BAR").Return(nil)
+		mockService.On("Get", contextThatHasTenant(tnt), tnt, "BAR").Return(modelScenario, nil)
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ScenarioToGraphQL", *modelScenario).Return(gqlScenario)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, mockConverter, mockTransactioner)
+		// WHEN
+		actual, err := sut.RenameScenario(ctx, "FOO", "BAR")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &gqlScenario, actual)
+	})
+
+	t.Run("got error on committing transaction", func(t *testing.T) {
+		// GIVEN
+		mockPersistanceCtx := &pautomock.PersistenceTxOp{}
+		defer mockPersistanceCtx.AssertExpectations(t)
+		mockPersistanceCtx.On("Commit").Return(errors.New("error on commit"))
+
+		mockTransactioner := &pautomock.Transactioner{}
+		mockTransactioner.On("Begin").Return(mockPersistanceCtx, nil)
+		mockTransactioner.On("RollbackUnlessCommited", mock.Anything).Return(nil)
+		defer mockTransactioner.AssertExpectations(t)
+
+		mockService := &automock.ScenariosService{}
+		defer mockService.AssertExpectations(t)
+		mockService.On("Rename", contextThatHasTenant(tnt), tnt, "FOO", "BAR").Return(nil)
+		mockService.On("Get", contextThatHasTenant(tnt), tnt, "BAR").Return(modelScenario, nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		sut := labeldef.NewScenariosResolver(mockService, nil, mockTransactioner)
+		// WHEN
+		_, err := sut.RenameScenario(ctx, "FOO", "BAR")
+		// THEN
+		require.EqualError(t, err, "while committing transaction: error on commit")
+	})
+}
@@ -2,20 +2,32 @@ package labeldef
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/pkg/errors"
 )
 
+//go:generate mockery -name=ScenarioAssignmentRepository -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentRepository interface {
+	DeleteForScenario(ctx context.Context, tenant, scenario string) error
+	RenameScenario(ctx context.Context, tenant, scenario, newScenario string) error
+}
+
 type scenariosService struct {
-	repo       Repository
-	uidService UIDService
+	repo           Repository
+	labelRepo      LabelRepository
+	assignmentRepo ScenarioAssignmentRepository
+	uidService     UIDService
 }
 
-func NewScenariosService(r Repository, uidService UIDService) *scenariosService {
+func NewScenariosService(r Repository, labelRepo LabelRepository, assignmentRepo ScenarioAssignmentRepository, uidService UIDService) *scenariosService {
 	return &scenariosService{
-		repo:       r,
-		uidService: uidService,
+		repo:           r,
+		labelRepo:      labelRepo,
+		assignmentRepo: assignmentRepo,
+		uidService:     uidService,
 	}
 }
 
@@ -39,3 +51,285 @@ func (s *scenariosService) EnsureScenariosLabelDefinitionExists(ctx context.Cont
 	}
 	return nil
 }
+
+func (s *scenariosService) List(ctx context.Context, tenant string) ([]model.Scenario, error) {
+	names, err := s.listNames(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := s.labelRepo.GetKeyUsage(ctx, tenant, model.ScenariosKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting usage of label with key %s", model.ScenariosKey)
+	}
+
+	out := make([]model.Scenario, 0, len(names))
+	for _, name := range names {
+		out = append(out, scenarioWithUsage(name, usage))
+	}
+
+	return out, nil
+}
+
+func (s *scenariosService) Get(ctx context.Context, tenant string, name string) (*model.Scenario, error) {
+	scenarios, err := s.List(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scenario := range scenarios {
+		if scenario.Name == name {
+			return &scenario, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *scenariosService) Create(ctx context.Context, tenant string, name string) error {
+	if name == "" {
+		return errors.New("scenario name can not be empty")
+	}
+
+	err := s.EnsureScenariosLabelDefinitionExists(ctx, tenant)
+	if err != nil {
+		return errors.Wrapf(err, "while ensuring Label Definition with key %s exists", model.ScenariosKey)
+	}
+
+	ld, names, err := s.getDefinition(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	if indexOf(names, name) != -1 {
+		return fmt.Errorf("scenario %s already exists", name)
+	}
+
+	return s.updateSchema(ctx, ld, append(names, name))
+}
+
+// Delete removes the scenario from the enum and from the scenarios labels. Applications left without any scenario are assigned to the DEFAULT one,
+// and the scenarios labels left empty are deleted from Runtimes. The rules assigning Runtimes to the scenario are deleted as well.
+func (s *scenariosService) Delete(ctx context.Context, tenant string, name string) error {
+	if name == model.DefaultScenario {
+		return fmt.Errorf("scenario %s can not be deleted", model.DefaultScenario)
+	}
+
+	ld, names, err := s.getDefinition(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	idx := indexOf(names, name)
+	if idx == -1 {
+		return fmt.Errorf("scenario %s does not exist", name)
+	}
+
+	err = s.rewriteLabels(ctx, tenant, func(scenario string) (string, bool) {
+		return scenario, scenario != name
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.assignmentRepo.DeleteForScenario(ctx, tenant, name)
+	if err != nil {
+		return errors.Wrapf(err, "while deleting assignment rules for scenario %s", name)
+	}
+
+	newNames := append(append([]string{}, names[:idx]...), names[idx+1:]...)
+	return s.updateSchema(ctx, ld, newNames)
+}
+
+// Rename changes the name of the scenario in the enum, in the scenarios labels and in the rules assigning Runtimes to it
+func (s *scenariosService) Rename(ctx context.Context, tenant string, name string, newName string) error {
+	if name == model.DefaultScenario {
+		return fmt.Errorf("scenario %s can not be renamed", model.DefaultScenario)
+	}
+
+	if newName == "" {
+		return errors.New("scenario name can not be empty")
+	}
+
+	ld, names, err := s.getDefinition(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	idx := indexOf(names, name)
+	if idx == -1 {
+		return fmt.Errorf("scenario %s does not exist", name)
+	}
+
+	if indexOf(names, newName) != -1 {
+		return fmt.Errorf("scenario %s already exists", newName)
+	}
+
+	err = s.rewriteLabels(ctx, tenant, func(scenario string) (string, bool) {
+		if scenario == name {
+			return newName, true
+		}
+		return scenario, true
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.assignmentRepo.RenameScenario(ctx, tenant, name, newName)
+	if err != nil {
+		return errors.Wrapf(err, "while renaming scenario %s in assignment rules", name)
+	}
+
+	newNames := append([]string{}, names...)
+	newNames[idx] = newName
+	return s.updateSchema(ctx, ld, newNames)
+}
+
+func (s *scenariosService) listNames(ctx context.Context, tenant string) ([]string, error) {
+	ld, err := s.repo.GetByKey(ctx, tenant, model.ScenariosKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Label Definition with key %s", model.ScenariosKey)
+	}
+
+	if ld == nil {
+		return []string{model.DefaultScenario}, nil
+	}
+
+	return scenariosFromSchema(ld.Schema)
+}
+
+func (s *scenariosService) getDefinition(ctx context.Context, tenant string) (*model.LabelDefinition, []string, error) {
+	ld, err := s.repo.GetByKey(ctx, tenant, model.ScenariosKey)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while getting Label Definition with key %s", model.ScenariosKey)
+	}
+
+	if ld == nil {
+		return nil, nil, fmt.Errorf("Label Definition with key %s does not exist", model.ScenariosKey)
+	}
+
+	names, err := scenariosFromSchema(ld.Schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ld, names, nil
+}
+
+func (s *scenariosService) updateSchema(ctx context.Context, ld *model.LabelDefinition, names []string) error {
+	var schema interface{} = model.NewScenariosSchema(names)
+	ld.Schema = &schema
+
+	if err := ld.ValidateForUpdate(); err != nil {
+		return errors.Wrap(err, "while validating Label Definition")
+	}
+
+	if err := s.repo.Update(ctx, *ld); err != nil {
+		return errors.Wrapf(err, "while updating Label Definition with key %s", model.ScenariosKey)
+	}
+
+	return nil
+}
+
+// rewriteLabels replaces every scenario in the scenarios labels with the one returned by rewrite, or removes it if rewrite does not keep it
+func (s *scenariosService) rewriteLabels(ctx context.Context, tenant string, rewrite func(scenario string) (string, bool)) error {
+	labels, err := s.labelRepo.ListByKey(ctx, tenant, model.ScenariosKey)
+	if err != nil {
+		return errors.Wrapf(err, "while listing labels with key %s", model.ScenariosKey)
+	}
+
+	for _, label := range labels {
+		values, ok := label.Value.([]interface{})
+		if !ok {
+			continue
+		}
+
+		changed := false
+		newValues := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			scenario, ok := value.(string)
+			if !ok {
+				newValues = append(newValues, value)
+				continue
+			}
+
+			newScenario, keep := rewrite(scenario)
+			if !keep || newScenario != scenario {
+				changed = true
+			}
+			if keep {
+				newValues = append(newValues, newScenario)
+			}
+		}
+
+		if !changed {
+			continue
+		}
+
+		if len(newValues) == 0 && label.ObjectType == model.RuntimeLabelableObject {
+			err := s.labelRepo.Delete(ctx, tenant, label.ObjectType, label.ObjectID, model.ScenariosKey)
+			if err != nil {
+				return errors.Wrapf(err, "while deleting label with key %s for %s with ID %s", model.ScenariosKey, label.ObjectType, label.ObjectID)
+			}
+			continue
+		}
+
+		if len(newValues) == 0 {
+			newValues = append(newValues, model.ScenariosDefaultValue...)
+		}
+
+		label.Value = newValues
+		if err := s.labelRepo.Upsert(ctx, label); err != nil {
+			return errors.Wrapf(err, "while updating label with key %s for %s with ID %s", model.ScenariosKey, label.ObjectType, label.ObjectID)
+		}
+	}
+
+	return nil
+}
+
+func scenariosFromSchema(schema *interface{}) ([]string, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("schema of Label Definition with key %s is empty", model.ScenariosKey)
+	}
+
+	b, err := json.Marshal(*schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshaling scenarios schema")
+	}
+
+	var scenariosSchema struct {
+		Items struct {
+			Enum []string `json:"enum"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(b, &scenariosSchema); err != nil {
+		return nil, errors.Wrap(err, "while reading scenarios from schema")
+	}
+
+	return scenariosSchema.Items.Enum, nil
+}
+
+func scenarioWithUsage(name string, usage *model.LabelKeyUsage) model.Scenario {
+	scenario := model.Scenario{Name: name}
+	if usage == nil {
+		return scenario
+	}
+
+	for _, value := range usage.Values {
+		if value != nil && value.Value == name {
+			scenario.Applications = value.Applications
+			scenario.Runtimes = value.Runtimes
+		}
+	}
+
+	return scenario
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -97,7 +98,7 @@ func TestScenariosService_EnsureScenariosLabelDefinitionExists(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			ldRepo := testCase.LabelDefRepoFn()
 			uidSvc := testCase.UIDServiceFn()
-			svc := labeldef.NewScenariosService(ldRepo, uidSvc)
+			svc := labeldef.NewScenariosService(ldRepo, nil, nil, uidSvc)
 
 			// when
 			err := svc.EnsureScenariosLabelDefinitionExists(ctx, tnt)
@@ -115,3 +116,518 @@ func TestScenariosService_EnsureScenariosLabelDefinitionExists(t *testing.T) {
 		})
 	}
 }
+
+func TestScenariosService_List(t *testing.T) {
+	testErr := errors.New("Test error")
+	tnt := "tenant"
+	ctx := context.TODO()
+
+	usage := &model.LabelKeyUsage{
+		Key:          model.ScenariosKey,
+		Applications: 2,
+		Runtimes:     1,
+		Values: []*model.LabelValueUsage{
+			{Value: "DEFAULT", Applications: 2},
+			{Value: "FOO", Applications: 1, Runtimes: 1},
+		},
+	}
+
+	testCases := []struct {
+		Name           string
+		LabelDefRepoFn func() *automock.Repository
+		LabelRepoFn    func() *automock.LabelRepository
+		Expected       []model.Scenario
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT", "FOO", "BAR"), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetKeyUsage", ctx, tnt, model.ScenariosKey).Return(usage, nil).Once()
+				return repo
+			},
+			Expected: []model.Scenario{
+				{Name: "DEFAULT", Applications: 2},
+				{Name: "FOO", Applications: 1, Runtimes: 1},
+				{Name: "BAR"},
+			},
+		},
+		{
+			Name: "Success when scenarios label definition does not exist",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(nil, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetKeyUsage", ctx, tnt, model.ScenariosKey).Return(&model.LabelKeyUsage{Key: model.ScenariosKey}, nil).Once()
+				return repo
+			},
+			Expected: []model.Scenario{{Name: "DEFAULT"}},
+		},
+		{
+			Name: "Returns error when getting label definition failed",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when getting usage failed",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT"), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetKeyUsage", ctx, tnt, model.ScenariosKey).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ldRepo := testCase.LabelDefRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			svc := labeldef.NewScenariosService(ldRepo, labelRepo, nil, nil)
+
+			// when
+			scenarios, err := svc.List(ctx, tnt)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Nil(t, err)
+				assert.Equal(t, testCase.Expected, scenarios)
+			}
+
+			ldRepo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestScenariosService_Get(t *testing.T) {
+	tnt := "tenant"
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		ldRepo := &automock.Repository{}
+		defer ldRepo.AssertExpectations(t)
+		ldRepo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT", "FOO"), nil).Once()
+
+		labelRepo := &automock.LabelRepository{}
+		defer labelRepo.AssertExpectations(t)
+		labelRepo.On("GetKeyUsage", ctx, tnt, model.ScenariosKey).Return(&model.LabelKeyUsage{
+			Key:    model.ScenariosKey,
+			Values: []*model.LabelValueUsage{{Value: "FOO", Runtimes: 3}},
+		}, nil).Once()
+
+		svc := labeldef.NewScenariosService(ldRepo, labelRepo, nil, nil)
+
+		// when
+		scenario, err := svc.Get(ctx, tnt, "FOO")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &model.Scenario{Name: "FOO", Runtimes: 3}, scenario)
+	})
+
+	t.Run("Returns nil when scenario does not exist", func(t *testing.T) {
+		ldRepo := &automock.Repository{}
+		defer ldRepo.AssertExpectations(t)
+		ldRepo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT"), nil).Once()
+
+		labelRepo := &automock.LabelRepository{}
+		defer labelRepo.AssertExpectations(t)
+		labelRepo.On("GetKeyUsage", ctx, tnt, model.ScenariosKey).Return(&model.LabelKeyUsage{Key: model.ScenariosKey}, nil).Once()
+
+		svc := labeldef.NewScenariosService(ldRepo, labelRepo, nil, nil)
+
+		// when
+		scenario, err := svc.Get(ctx, tnt, "FOO")
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, scenario)
+	})
+}
+
+func TestScenariosService_Create(t *testing.T) {
+	testErr := errors.New("Test error")
+	tnt := "tenant"
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name           string
+		ScenarioName   string
+		LabelDefRepoFn func() *automock.Repository
+		ExpectedErr    error
+	}{
+		{
+			Name:         "Success",
+			ScenarioName: "FOO",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("Exists", ctx, tnt, model.ScenariosKey).Return(true, nil).Once()
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT"), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLD(tnt, "DEFAULT", "FOO")).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:         "Returns error when scenario already exists",
+			ScenarioName: "FOO",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("Exists", ctx, tnt, model.ScenariosKey).Return(true, nil).Once()
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT", "FOO"), nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("scenario FOO already exists"),
+		},
+		{
+			Name:         "Returns error when scenario name is empty",
+			ScenarioName: "",
+			LabelDefRepoFn: func() *automock.Repository {
+				return &automock.Repository{}
+			},
+			ExpectedErr: errors.New("scenario name can not be empty"),
+		},
+		{
+			Name:         "Returns error when updating label definition failed",
+			ScenarioName: "FOO",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("Exists", ctx, tnt, model.ScenariosKey).Return(true, nil).Once()
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT"), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLD(tnt, "DEFAULT", "FOO")).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ldRepo := testCase.LabelDefRepoFn()
+			svc := labeldef.NewScenariosService(ldRepo, nil, nil, nil)
+
+			// when
+			err := svc.Create(ctx, tnt, testCase.ScenarioName)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Nil(t, err)
+			}
+
+			ldRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestScenariosService_Delete(t *testing.T) {
+	testErr := errors.New("Test error")
+	tnt := "tenant"
+	ctx := context.TODO()
+
+	fixExistingLabels := func() []*model.Label {
+		return []*model.Label{
+			fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tnt, model.ScenariosKey, []interface{}{"DEFAULT", "FOO"}, "app1", model.ApplicationLabelableObject),
+			fixLabel("2037fc3d-be6c-4489-94cf-05518bac709f", tnt, model.ScenariosKey, []interface{}{"FOO"}, "app2", model.ApplicationLabelableObject),
+			fixLabel("6d2a9fbb-1ab6-4fa0-9b4e-2d3c4aa41b52", tnt, model.ScenariosKey, []interface{}{"FOO"}, "rtm1", model.RuntimeLabelableObject),
+			fixLabel("8b131225-f09d-4035-8091-1f12933863b3", tnt, model.ScenariosKey, []interface{}{"DEFAULT"}, "rtm2", model.RuntimeLabelableObject),
+		}
+	}
+
+	testCases := []struct {
+		Name             string
+		ScenarioName     string
+		LabelDefRepoFn   func() *automock.Repository
+		LabelRepoFn      func() *automock.LabelRepository
+		AssignmentRepoFn func() *automock.ScenarioAssignmentRepository
+		ExpectedErr      error
+	}{
+		{
+			Name:         "Success",
+			ScenarioName: "FOO",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT", "FOO", "BAR"), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLD(tnt, "DEFAULT", "BAR")).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", ctx, tnt, model.ScenariosKey).Return(fixExistingLabels(), nil).Once()
+				repo.On("Upsert", ctx, fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tnt, model.ScenariosKey, []interface{}{"DEFAULT"}, "app1", model.ApplicationLabelableObject)).Return(nil).Once()
+				repo.On("Upsert", ctx, fixLabel("2037fc3d-be6c-4489-94cf-05518bac709f", tnt, model.ScenariosKey, []interface{}{"DEFAULT"}, "app2", model.ApplicationLabelableObject)).Return(nil).Once()
+				repo.On("Delete", ctx, tnt, model.RuntimeLabelableObject, "rtm1", model.ScenariosKey).Return(nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("DeleteForScenario", ctx, tnt, "FOO").Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:         "Returns error when deleting DEFAULT scenario",
+			ScenarioName: "DEFAULT",
+			LabelDefRepoFn: func() *automock.Repository {
+				return &automock.Repository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				return &automock.ScenarioAssignmentRepository{}
+			},
+			ExpectedErr: errors.New("scenario DEFAULT can not be deleted"),
+		},
+		{
+			Name:         "Returns error when scenario does not exist",
+			ScenarioName: "FOO",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT"), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				return &automock.ScenarioAssignmentRepository{}
+			},
+			ExpectedErr: errors.New("scenario FOO does not exist"),
+		},
+		{
+			Name:         "Returns error when updating label failed",
+			ScenarioName: "FOO",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT", "FOO"), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", ctx, tnt, model.ScenariosKey).Return(fixExistingLabels(), nil).Once()
+				repo.On("Upsert", ctx, mock.Anything).Return(testErr).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				return &automock.ScenarioAssignmentRepository{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:         "Returns error when deleting assignment rules failed",
+			ScenarioName: "FOO",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT", "FOO"), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", ctx, tnt, model.ScenariosKey).Return([]*model.Label{}, nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("DeleteForScenario", ctx, tnt, "FOO").Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ldRepo := testCase.LabelDefRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			assignmentRepo := testCase.AssignmentRepoFn()
+			svc := labeldef.NewScenariosService(ldRepo, labelRepo, assignmentRepo, nil)
+
+			// when
+			err := svc.Delete(ctx, tnt, testCase.ScenarioName)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Nil(t, err)
+			}
+
+			ldRepo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+			assignmentRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestScenariosService_Rename(t *testing.T) {
+	testErr := errors.New("Test error")
+	tnt := "tenant"
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name             string
+		ScenarioName     string
+		NewName          string
+		LabelDefRepoFn   func() *automock.Repository
+		LabelRepoFn      func() *automock.LabelRepository
+		AssignmentRepoFn func() *automock.ScenarioAssignmentRepository
+		ExpectedErr      error
+	}{
+		{
+			Name:         "Success",
+			ScenarioName: "FOO",
+			NewName:      "BAZ",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT", "FOO", "BAR"), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLD(tnt, "DEFAULT", "BAZ", "BAR")).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", ctx, tnt, model.ScenariosKey).Return([]*model.Label{
+					fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tnt, model.ScenariosKey, []interface{}{"FOO", "BAR"}, "app1", model.ApplicationLabelableObject),
+					fixLabel("6d2a9fbb-1ab6-4fa0-9b4e-2d3c4aa41b52", tnt, model.ScenariosKey, []interface{}{"BAR"}, "rtm1", model.RuntimeLabelableObject),
+				}, nil).Once()
+				repo.On("Upsert", ctx, fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tnt, model.ScenariosKey, []interface{}{"BAZ", "BAR"}, "app1", model.ApplicationLabelableObject)).Return(nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("RenameScenario", ctx, tnt, "FOO", "BAZ").Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:         "Returns error when renaming DEFAULT scenario",
+			ScenarioName: "DEFAULT",
+			NewName:      "BAZ",
+			LabelDefRepoFn: func() *automock.Repository {
+				return &automock.Repository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				return &automock.ScenarioAssignmentRepository{}
+			},
+			ExpectedErr: errors.New("scenario DEFAULT can not be renamed"),
+		},
+		{
+			Name:         "Returns error when scenario with new name already exists",
+			ScenarioName: "FOO",
+			NewName:      "BAR",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT", "FOO", "BAR"), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				return &automock.ScenarioAssignmentRepository{}
+			},
+			ExpectedErr: errors.New("scenario BAR already exists"),
+		},
+		{
+			Name:         "Returns error when scenario does not exist",
+			ScenarioName: "FOO",
+			NewName:      "BAZ",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT"), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				return &automock.ScenarioAssignmentRepository{}
+			},
+			ExpectedErr: errors.New("scenario FOO does not exist"),
+		},
+		{
+			Name:         "Returns error when renaming scenario in assignment rules failed",
+			ScenarioName: "FOO",
+			NewName:      "BAZ",
+			LabelDefRepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", ctx, tnt, model.ScenariosKey).Return(fixScenariosLD(tnt, "DEFAULT", "FOO"), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", ctx, tnt, model.ScenariosKey).Return([]*model.Label{}, nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("RenameScenario", ctx, tnt, "FOO", "BAZ").Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ldRepo := testCase.LabelDefRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			assignmentRepo := testCase.AssignmentRepoFn()
+			svc := labeldef.NewScenariosService(ldRepo, labelRepo, assignmentRepo, nil)
+
+			// when
+			err := svc.Rename(ctx, tnt, testCase.ScenarioName, testCase.NewName)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Nil(t, err)
+			}
+
+			ldRepo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+			assignmentRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func fixScenariosLD(tenant string, scenarios ...string) *model.LabelDefinition {
+	var schema interface{} = model.NewScenariosSchema(scenarios)
+	return &model.LabelDefinition{
+		ID:     "003a0855-4eb0-486d-8fc6-3ab2f2312ca0",
+		Tenant: tenant,
+		Key:    model.ScenariosKey,
+		Schema: &schema,
+	}
+}
@@ -11,16 +11,18 @@ import (
 )
 
 type service struct {
-	repo       Repository
-	labelRepo  LabelRepository
-	uidService UIDService
+	repo                     Repository
+	labelRepo                LabelRepository
+	scenarioAssignmentEngine ScenarioAssignmentEngine
+	uidService               UIDService
 }
 
-func NewService(repo Repository, labelRepo LabelRepository, uidService UIDService) *service {
+func NewService(repo Repository, labelRepo LabelRepository, scenarioAssignmentEngine ScenarioAssignmentEngine, uidService UIDService) *service {
 	return &service{
-		repo:       repo,
-		labelRepo:  labelRepo,
-		uidService: uidService,
+		repo:                     repo,
+		labelRepo:                labelRepo,
+		scenarioAssignmentEngine: scenarioAssignmentEngine,
+		uidService:               uidService,
 	}
 }
 
@@ -36,14 +38,21 @@ type Repository interface {
 
 //go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
 type LabelRepository interface {
+	Upsert(ctx context.Context, label *model.Label) error
 	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
 	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
 	ListByKey(ctx context.Context, tenant, key string) ([]*model.Label, error)
+	GetKeyUsage(ctx context.Context, tenant, key string) (*model.LabelKeyUsage, error)
 	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
 	DeleteAll(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) error
 	DeleteByKey(ctx context.Context, tenant string, key string) error
 }
 
+//go:generate mockery -name=ScenarioAssignmentEngine -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentEngine interface {
+	EvaluateForRuntime(ctx context.Context, tenant, runtimeID string) error
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
@@ -79,18 +88,18 @@ func (s *service) List(ctx context.Context, tenant string) ([]model.LabelDefinit
 	return defs, nil
 }
 
-func (s *service) Update(ctx context.Context, def model.LabelDefinition) error {
-	if err := def.ValidateForUpdate(); err != nil {
-		return errors.Wrap(err, "while validating Label Definition")
-	}
-
-	ld, err := s.repo.GetByKey(ctx, def.Tenant, def.Key)
+func (s *service) GetUsage(ctx context.Context, tenant string, key string) (*model.LabelKeyUsage, error) {
+	usage, err := s.labelRepo.GetKeyUsage(ctx, tenant, key)
 	if err != nil {
-		return errors.Wrap(err, "while receiving Label Definition")
+		return nil, errors.Wrapf(err, `while getting usage of labels with key "%s"`, key)
 	}
+	return usage, nil
+}
 
-	if ld == nil {
-		return errors.Errorf("definition with %s key doesn't exist", def.Key)
+func (s *service) Update(ctx context.Context, def model.LabelDefinition) error {
+	ld, err := s.getForUpdate(ctx, def)
+	if err != nil {
+		return err
 	}
 
 	ld.Schema = def.Schema
@@ -108,6 +117,69 @@ func (s *service) Update(ctx context.Context, def model.LabelDefinition) error {
 	return nil
 }
 
+// UpdateWithTransformation rewrites the values of the existing labels with the key using the transformation and then updates the schema of the Label Definition.
+// It fails if any of the transformed values is not valid against the new schema, so that no label is changed once the transaction is rolled back.
+// The Scenario Assignment Rules are evaluated for the Runtimes which labels changed, as the rules may no longer match them or match them now.
+func (s *service) UpdateWithTransformation(ctx context.Context, def model.LabelDefinition, transformation model.LabelValueTransformation) ([]*model.LabelValueChange, error) {
+	ld, err := s.getForUpdate(ctx, def)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.transformExistingLabels(ctx, def, &transformation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range changes {
+		if !change.Valid {
+			return nil, errors.Errorf(`label with key "%s" is not valid against new schema for %s with ID "%s" after transformation`, def.Key, change.ObjectType, change.ObjectID)
+		}
+	}
+
+	for _, change := range changes {
+		err := s.labelRepo.Upsert(ctx, &model.Label{
+			ID:         change.LabelID,
+			Tenant:     def.Tenant,
+			Key:        def.Key,
+			Value:      change.NewValue,
+			ObjectType: change.ObjectType,
+			ObjectID:   change.ObjectID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, `while updating label with key "%s" for %s with ID "%s"`, def.Key, change.ObjectType, change.ObjectID)
+		}
+	}
+
+	ld.Schema = def.Schema
+	if err := s.repo.Update(ctx, *ld); err != nil {
+		return nil, errors.Wrap(err, "while updating Label Definition")
+	}
+
+	for _, change := range changes {
+		if change.ObjectType != model.RuntimeLabelableObject {
+			continue
+		}
+
+		if err := s.scenarioAssignmentEngine.EvaluateForRuntime(ctx, def.Tenant, change.ObjectID); err != nil {
+			return nil, errors.Wrapf(err, "while evaluating Scenario Assignment Rules for Runtime with ID %s", change.ObjectID)
+		}
+	}
+
+	return changes, nil
+}
+
+// PreviewUpdate returns the changes of the existing label values which the update with the optional transformation would make, without storing anything
+func (s *service) PreviewUpdate(ctx context.Context, def model.LabelDefinition, transformation *model.LabelValueTransformation) ([]*model.LabelValueChange, error) {
+	if _, err := s.getForUpdate(ctx, def); err != nil {
+		return nil, err
+	}
+
+	return s.transformExistingLabels(ctx, def, transformation)
+}
+
+// Delete deletes the Label Definition, together with the labels with its key if deleteRelatedLabels is set.
+// The Scenario Assignment Rules are evaluated for the Runtimes which labels were deleted, as the rules may no longer match them.
 func (s *service) Delete(ctx context.Context, tenant, key string, deleteRelatedLabels bool) error {
 	if key == model.ScenariosKey {
 		return fmt.Errorf("Label Definition with key %s can not be deleted", model.ScenariosKey)
@@ -121,22 +193,93 @@ func (s *service) Delete(ctx context.Context, tenant, key string, deleteRelatedL
 		return fmt.Errorf("Label Definition with key %s not found", key)
 	}
 
-	if deleteRelatedLabels {
+	existingLabels, err := s.labelRepo.ListByKey(ctx, tenant, key)
+	if err != nil {
+		return errors.Wrap(err, "while listing labels by key")
+	}
+	if len(existingLabels) > 0 {
+		if !deleteRelatedLabels {
+			return errors.New("could not delete label definition, it is already used by at least one label")
+		}
+
 		err := s.labelRepo.DeleteByKey(ctx, tenant, key)
 		if err != nil {
 			return errors.Wrapf(err, `while deleting labels with key "%s"`, key)
 		}
 	}
 
-	existingLabels, err := s.labelRepo.ListByKey(ctx, tenant, key)
+	if err := s.repo.DeleteByKey(ctx, tenant, ld.Key); err != nil {
+		return err
+	}
+
+	for _, label := range existingLabels {
+		if label.ObjectType != model.RuntimeLabelableObject {
+			continue
+		}
+
+		if err := s.scenarioAssignmentEngine.EvaluateForRuntime(ctx, tenant, label.ObjectID); err != nil {
+			return errors.Wrapf(err, "while evaluating Scenario Assignment Rules for Runtime with ID %s", label.ObjectID)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) getForUpdate(ctx context.Context, def model.LabelDefinition) (*model.LabelDefinition, error) {
+	if err := def.ValidateForUpdate(); err != nil {
+		return nil, errors.Wrap(err, "while validating Label Definition")
+	}
+
+	ld, err := s.repo.GetByKey(ctx, def.Tenant, def.Key)
 	if err != nil {
-		return errors.Wrap(err, "while listing labels by key")
+		return nil, errors.Wrap(err, "while receiving Label Definition")
 	}
-	if len(existingLabels) > 0 {
-		return errors.New("could not delete label definition, it is already used by at least one label")
+
+	if ld == nil {
+		return nil, errors.Errorf("definition with %s key doesn't exist", def.Key)
+	}
+
+	return ld, nil
+}
+
+// transformExistingLabels returns the changes of the existing labels with the key, including the labels which values stay the same but are not valid against the new schema
+func (s *service) transformExistingLabels(ctx context.Context, def model.LabelDefinition, transformation *model.LabelValueTransformation) ([]*model.LabelValueChange, error) {
+	transformer, err := newValueTransformer(transformation, def.Schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "while preparing label value transformation")
+	}
+
+	existingLabels, err := s.labelRepo.ListByKey(ctx, def.Tenant, def.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing labels by key")
+	}
+
+	var changes []*model.LabelValueChange
+	for _, label := range existingLabels {
+		newValue, valid, err := transformer.transform(label.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, `while transforming label for %s with ID "%s"`, label.ObjectType, label.ObjectID)
+		}
+
+		unchanged, err := jsonEqual(label.Value, newValue)
+		if err != nil {
+			return nil, errors.Wrap(err, "while comparing transformed value")
+		}
+		if unchanged && valid {
+			continue
+		}
+
+		changes = append(changes, &model.LabelValueChange{
+			LabelID:    label.ID,
+			ObjectType: label.ObjectType,
+			ObjectID:   label.ObjectID,
+			OldValue:   label.Value,
+			NewValue:   newValue,
+			Valid:      valid,
+		})
 	}
 
-	return s.repo.DeleteByKey(ctx, tenant, ld.Key)
+	return changes, nil
 }
 
 func (s *service) validateExistingLabelsAgainstSchema(ctx context.Context, schema interface{}, tenant, key string) error {
@@ -36,7 +36,7 @@ func TestServiceCreate(t *testing.T) {
 		mockRepository.On("Create", mock.Anything, defWithID).Return(nil)
 
 		ctx := context.TODO()
-		sut := labeldef.NewService(mockRepository, nil, mockUID)
+		sut := labeldef.NewService(mockRepository, nil, nil, mockUID)
 		// WHEN
 		actual, err := sut.Create(ctx, in)
 		// THEN
@@ -50,7 +50,7 @@ func TestServiceCreate(t *testing.T) {
 		defer mockUID.AssertExpectations(t)
 
 		mockUID.On("Generate").Return(fixUUID())
-		sut := labeldef.NewService(nil, nil, mockUID)
+		sut := labeldef.NewService(nil, nil, nil, mockUID)
 		// WHEN
 		_, err := sut.Create(context.TODO(), model.LabelDefinition{})
 		// THEN
@@ -67,7 +67,7 @@ func TestServiceCreate(t *testing.T) {
 
 		mockUID.On("Generate").Return(fixUUID())
 		mockRepository.On("Create", mock.Anything, mock.Anything).Return(errors.New("some error"))
-		sut := labeldef.NewService(mockRepository, nil, mockUID)
+		sut := labeldef.NewService(mockRepository, nil, nil, mockUID)
 		// WHEN
 		_, err := sut.Create(context.TODO(), model.LabelDefinition{Key: "key", Tenant: "tenant"})
 		// THEN
@@ -87,7 +87,7 @@ func TestServiceGet(t *testing.T) {
 			Tenant: "tenant",
 		}
 		mockRepository.On("GetByKey", ctx, "tenant", "key").Return(&given, nil)
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		actual, err := sut.Get(ctx, "tenant", "key")
 		// THEN
@@ -102,7 +102,7 @@ func TestServiceGet(t *testing.T) {
 		mockRepository.On("GetByKey", mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, errors.New("some error"))
 
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		_, err := sut.Get(context.TODO(), "tenant", "key")
 		// THEN
@@ -110,6 +110,42 @@ func TestServiceGet(t *testing.T) {
 	})
 }
 
+func TestServiceGetUsage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// GIVEN
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+		ctx := context.TODO()
+		given := &model.LabelKeyUsage{
+			Key:          "scenarios",
+			Applications: 2,
+			Runtimes:     1,
+			Values:       []*model.LabelValueUsage{{Value: "DEFAULT", Applications: 2, Runtimes: 1}},
+		}
+		mockLabelRepository.On("GetKeyUsage", ctx, "tenant", "scenarios").Return(given, nil)
+		sut := labeldef.NewService(nil, mockLabelRepository, nil, nil)
+		// WHEN
+		actual, err := sut.GetUsage(ctx, "tenant", "scenarios")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, given, actual)
+	})
+
+	t.Run("on error from repository", func(t *testing.T) {
+		// GIVEN
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+		mockLabelRepository.On("GetKeyUsage", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("some error"))
+
+		sut := labeldef.NewService(nil, mockLabelRepository, nil, nil)
+		// WHEN
+		_, err := sut.GetUsage(context.TODO(), "tenant", "scenarios")
+		// THEN
+		require.EqualError(t, err, `while getting usage of labels with key "scenarios": some error`)
+	})
+}
+
 func TestServiceList(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// GIVEN
@@ -128,7 +164,7 @@ func TestServiceList(t *testing.T) {
 		}
 		mockRepository.On("List", ctx, "tenant").Return(givenDefs, nil)
 
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		actual, err := sut.List(ctx, "tenant")
 		// THEN
@@ -142,7 +178,7 @@ func TestServiceList(t *testing.T) {
 		defer mockRepository.AssertExpectations(t)
 		ctx := context.TODO()
 		mockRepository.On("List", ctx, "tenant").Return(nil, errors.New("some error"))
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		_, err := sut.List(ctx, "tenant")
 		// THEN
@@ -213,7 +249,7 @@ func TestServiceUpdate(t *testing.T) {
 		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
 
 		ctx := context.TODO()
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
 		// WHEN
 		err := sut.Update(ctx, in)
 		// THEN
@@ -276,7 +312,7 @@ func TestServiceUpdate(t *testing.T) {
 		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
 
 		ctx := context.TODO()
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
 		// WHEN
 		err := sut.Update(ctx, in)
 		// THEN
@@ -287,7 +323,7 @@ func TestServiceUpdate(t *testing.T) {
 	t.Run("returns error when validation of Label Definition failed", func(t *testing.T) {
 		// GIVEN
 
-		sut := labeldef.NewService(nil, nil, nil)
+		sut := labeldef.NewService(nil, nil, nil, nil)
 		// WHEN
 		err := sut.Update(context.TODO(), model.LabelDefinition{})
 		// THEN
@@ -300,7 +336,7 @@ func TestServiceUpdate(t *testing.T) {
 		defer mockRepository.AssertExpectations(t)
 
 		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(nil, errors.New("some error"))
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		err := sut.Update(context.TODO(), model.LabelDefinition{Key: key, Tenant: tenant, Schema: fixBasicSchema(t)})
 		// THEN
@@ -313,7 +349,7 @@ func TestServiceUpdate(t *testing.T) {
 		defer mockRepository.AssertExpectations(t)
 
 		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(nil, nil)
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		err := sut.Update(context.TODO(), model.LabelDefinition{Key: key, Tenant: tenant, Schema: fixBasicSchema(t)})
 		// THEN
@@ -366,7 +402,7 @@ func TestServiceUpdate(t *testing.T) {
 
 		mockLabelRepository.On("ListByKey", context.TODO(), "tenant", "firstName").Return(existingLabels, nil).Once()
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
 		// WHEN
 		err := sut.Update(context.TODO(), *ld)
 		// THEN
@@ -390,7 +426,7 @@ func TestServiceUpdate(t *testing.T) {
 		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(ld, nil).Once()
 		mockRepository.On("Update", context.TODO(), *ld).Return(nil).Once()
 
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		err := sut.Update(context.TODO(), *ld)
 		// THEN
@@ -398,6 +434,309 @@ func TestServiceUpdate(t *testing.T) {
 	})
 }
 
+func TestServiceUpdateWithTransformation(t *testing.T) {
+	tenant := "tenant"
+	key := "region"
+
+	transformation := model.LabelValueTransformation{
+		Mapping: []*model.LabelValueMapping{
+			{From: "europe", To: "EU"},
+			{From: "united-states", To: "US"},
+		},
+		Default: "OTHER",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+		in := model.LabelDefinition{Tenant: tenant, Key: key, Schema: fixEnumSchema(t, "EU", "US", "OTHER")}
+
+		existingLabels := []*model.Label{
+			fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tenant, key, "europe", "foo", model.RuntimeLabelableObject),
+			fixLabel("2037fc3d-be6c-4489-94cf-05518bac709f", tenant, key, "asia", "bar", model.ApplicationLabelableObject),
+			fixLabel("6d2a9fbb-1ab6-4fa0-9b4e-2d3c4aa41b52", tenant, key, "US", "baz", model.RuntimeLabelableObject),
+		}
+
+		updatedLd := ld
+		updatedLd.Schema = in.Schema
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(&ld, nil).Once()
+		mockRepository.On("Update", context.TODO(), updatedLd).Return(nil).Once()
+		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
+		mockLabelRepository.On("Upsert", context.TODO(), fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tenant, key, "EU", "foo", model.RuntimeLabelableObject)).Return(nil).Once()
+		mockLabelRepository.On("Upsert", context.TODO(), fixLabel("2037fc3d-be6c-4489-94cf-05518bac709f", tenant, key, "OTHER", "bar", model.ApplicationLabelableObject)).Return(nil).Once()
+
+		mockEngine := &automock.ScenarioAssignmentEngine{}
+		defer mockEngine.AssertExpectations(t)
+		mockEngine.On("EvaluateForRuntime", context.TODO(), tenant, "foo").Return(nil).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, mockEngine, nil)
+		// WHEN
+		changes, err := sut.UpdateWithTransformation(context.TODO(), in, transformation)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.LabelValueChange{
+			{LabelID: "b9566e9d-83a2-4091-8c65-7a512b88f89e", ObjectType: model.RuntimeLabelableObject, ObjectID: "foo", OldValue: "europe", NewValue: "EU", Valid: true},
+			{LabelID: "2037fc3d-be6c-4489-94cf-05518bac709f", ObjectType: model.ApplicationLabelableObject, ObjectID: "bar", OldValue: "asia", NewValue: "OTHER", Valid: true},
+		}, changes)
+	})
+
+	t.Run("returns error when evaluating Scenario Assignment Rules failed", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+
+		mockEngine := &automock.ScenarioAssignmentEngine{}
+		defer mockEngine.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+		in := model.LabelDefinition{Tenant: tenant, Key: key, Schema: fixEnumSchema(t, "EU", "US", "OTHER")}
+
+		existingLabels := []*model.Label{
+			fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tenant, key, "europe", "foo", model.RuntimeLabelableObject),
+		}
+
+		updatedLd := ld
+		updatedLd.Schema = in.Schema
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(&ld, nil).Once()
+		mockRepository.On("Update", context.TODO(), updatedLd).Return(nil).Once()
+		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
+		mockLabelRepository.On("Upsert", context.TODO(), mock.Anything).Return(nil).Once()
+		mockEngine.On("EvaluateForRuntime", context.TODO(), tenant, "foo").Return(errors.New("some error")).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, mockEngine, nil)
+		// WHEN
+		_, err := sut.UpdateWithTransformation(context.TODO(), in, transformation)
+		// THEN
+		require.EqualError(t, err, "while evaluating Scenario Assignment Rules for Runtime with ID foo: some error")
+	})
+
+	t.Run("returns error when transformed label is not valid against new schema", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+		in := model.LabelDefinition{Tenant: tenant, Key: key, Schema: fixEnumSchema(t, "EU", "US")}
+
+		existingLabels := []*model.Label{
+			fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tenant, key, "europe", "foo", model.RuntimeLabelableObject),
+			fixLabel("2037fc3d-be6c-4489-94cf-05518bac709f", tenant, key, "asia", "bar", model.ApplicationLabelableObject),
+		}
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(&ld, nil).Once()
+		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
+		// WHEN
+		_, err := sut.UpdateWithTransformation(context.TODO(), in, model.LabelValueTransformation{Mapping: transformation.Mapping})
+		// THEN
+		require.EqualError(t, err, `label with key "region" is not valid against new schema for Application with ID "bar" after transformation`)
+	})
+
+	t.Run("returns error when default value is not valid against new schema", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+		in := model.LabelDefinition{Tenant: tenant, Key: key, Schema: fixEnumSchema(t, "EU", "US")}
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(&ld, nil).Once()
+
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
+		// WHEN
+		_, err := sut.UpdateWithTransformation(context.TODO(), in, transformation)
+		// THEN
+		require.EqualError(t, err, "while preparing label value transformation: default value is not valid against new schema")
+	})
+
+	t.Run("returns error when updating label failed", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+		in := model.LabelDefinition{Tenant: tenant, Key: key, Schema: fixEnumSchema(t, "EU", "US", "OTHER")}
+
+		existingLabels := []*model.Label{
+			fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tenant, key, "europe", "foo", model.RuntimeLabelableObject),
+		}
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(&ld, nil).Once()
+		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
+		mockLabelRepository.On("Upsert", context.TODO(), mock.Anything).Return(errors.New("some error")).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
+		// WHEN
+		_, err := sut.UpdateWithTransformation(context.TODO(), in, transformation)
+		// THEN
+		require.EqualError(t, err, `while updating label with key "region" for Runtime with ID "foo": some error`)
+	})
+
+	t.Run("returns error if Label Definition was not found", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
+		// WHEN
+		_, err := sut.UpdateWithTransformation(context.TODO(), model.LabelDefinition{Key: key, Tenant: tenant}, transformation)
+		// THEN
+		require.EqualError(t, err, "definition with region key doesn't exist")
+	})
+}
+
+func TestServicePreviewUpdate(t *testing.T) {
+	tenant := "tenant"
+	key := "region"
+
+	t.Run("success with JSON path projection", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+		in := model.LabelDefinition{Tenant: tenant, Key: key, Schema: fixEnumSchema(t, "EU", "US")}
+		jsonPath := "$.name"
+
+		existingLabels := []*model.Label{
+			fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tenant, key, map[string]interface{}{"name": "EU"}, "foo", model.RuntimeLabelableObject),
+			fixLabel("2037fc3d-be6c-4489-94cf-05518bac709f", tenant, key, map[string]interface{}{"zone": "a"}, "bar", model.ApplicationLabelableObject),
+		}
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(&ld, nil).Once()
+		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
+		// WHEN
+		changes, err := sut.PreviewUpdate(context.TODO(), in, &model.LabelValueTransformation{JSONPath: &jsonPath})
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.LabelValueChange{
+			{LabelID: "b9566e9d-83a2-4091-8c65-7a512b88f89e", ObjectType: model.RuntimeLabelableObject, ObjectID: "foo", OldValue: map[string]interface{}{"name": "EU"}, NewValue: "EU", Valid: true},
+			{LabelID: "2037fc3d-be6c-4489-94cf-05518bac709f", ObjectType: model.ApplicationLabelableObject, ObjectID: "bar", OldValue: map[string]interface{}{"zone": "a"}, NewValue: nil, Valid: false},
+		}, changes)
+	})
+
+	t.Run("success with mapping of array elements", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: "tenant", Key: "tags"}
+		in := model.LabelDefinition{Tenant: "tenant", Key: "tags"}
+		transformation := &model.LabelValueTransformation{
+			Mapping: []*model.LabelValueMapping{{From: "OLD", To: "NEW"}},
+		}
+
+		existingLabels := []*model.Label{
+			fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", "tenant", "tags", []interface{}{"foo", "OLD"}, "foo", model.ApplicationLabelableObject),
+		}
+
+		mockRepository.On("GetByKey", context.TODO(), "tenant", "tags").Return(&ld, nil).Once()
+		mockLabelRepository.On("ListByKey", context.TODO(), "tenant", "tags").Return(existingLabels, nil).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
+		// WHEN
+		changes, err := sut.PreviewUpdate(context.TODO(), in, transformation)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.LabelValueChange{
+			{LabelID: "b9566e9d-83a2-4091-8c65-7a512b88f89e", ObjectType: model.ApplicationLabelableObject, ObjectID: "foo", OldValue: []interface{}{"foo", "OLD"}, NewValue: []interface{}{"foo", "NEW"}, Valid: true},
+		}, changes)
+	})
+
+	t.Run("returns invalid labels when transformation is not provided", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+		in := model.LabelDefinition{Tenant: tenant, Key: key, Schema: fixEnumSchema(t, "EU", "US")}
+
+		existingLabels := []*model.Label{
+			fixLabel("b9566e9d-83a2-4091-8c65-7a512b88f89e", tenant, key, "EU", "foo", model.RuntimeLabelableObject),
+			fixLabel("2037fc3d-be6c-4489-94cf-05518bac709f", tenant, key, "asia", "bar", model.ApplicationLabelableObject),
+		}
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(&ld, nil).Once()
+		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
+		// WHEN
+		changes, err := sut.PreviewUpdate(context.TODO(), in, nil)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.LabelValueChange{
+			{LabelID: "2037fc3d-be6c-4489-94cf-05518bac709f", ObjectType: model.ApplicationLabelableObject, ObjectID: "bar", OldValue: "asia", NewValue: "asia", Valid: false},
+		}, changes)
+	})
+
+	t.Run("returns error when JSON path projection contains filter", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+		jsonPath := `$[*] ? (@ == "EU")`
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(&ld, nil).Once()
+
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
+		// WHEN
+		_, err := sut.PreviewUpdate(context.TODO(), model.LabelDefinition{Tenant: tenant, Key: key}, &model.LabelValueTransformation{JSONPath: &jsonPath})
+		// THEN
+		require.EqualError(t, err, "while preparing label value transformation: JSON path projection can not contain filter expression")
+	})
+
+	t.Run("returns error when listing labels failed", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+
+		ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+
+		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(&ld, nil).Once()
+		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(nil, errors.New("some error")).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
+		// WHEN
+		_, err := sut.PreviewUpdate(context.TODO(), model.LabelDefinition{Tenant: tenant, Key: key}, nil)
+		// THEN
+		require.EqualError(t, err, "while listing labels by key: some error")
+	})
+}
+
 func TestServiceDelete(t *testing.T) {
 	t.Run("success when no labels use labeldef", func(t *testing.T) {
 		// GIVEN
@@ -417,7 +756,7 @@ func TestServiceDelete(t *testing.T) {
 		mockRepository.On("DeleteByKey", ctx, tnt, given.Key).Return(nil).Once()
 		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return([]*model.Label{}, nil)
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -439,19 +778,60 @@ func TestServiceDelete(t *testing.T) {
 			Tenant: tnt,
 		}
 
+		existingLabels := []*model.Label{
+			fixLabel("test", tnt, given.Key, nil, "object1", model.ApplicationLabelableObject),
+			fixLabel("test2", tnt, given.Key, nil, "object2", model.RuntimeLabelableObject),
+		}
+
 		deleteRelatedResources := true
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(&given, nil).Once()
 		mockRepository.On("DeleteByKey", ctx, tnt, given.Key).Return(nil).Once()
+		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return(existingLabels, nil).Once()
 		mockLabelRepository.On("DeleteByKey", ctx, tnt, given.Key).Return(nil).Once()
-		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return([]*model.Label{}, nil).Once()
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil)
+		mockEngine := &automock.ScenarioAssignmentEngine{}
+		defer mockEngine.AssertExpectations(t)
+		mockEngine.On("EvaluateForRuntime", ctx, tnt, "object2").Return(nil).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, mockEngine, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
 		require.NoError(t, err)
 	})
 
+	t.Run("error when evaluating Scenario Assignment Rules failed", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		defer mockRepository.AssertExpectations(t)
+		mockLabelRepository := &automock.LabelRepository{}
+		defer mockLabelRepository.AssertExpectations(t)
+		mockEngine := &automock.ScenarioAssignmentEngine{}
+		defer mockEngine.AssertExpectations(t)
+
+		tnt := "tenant"
+		ctx := context.TODO()
+		given := model.LabelDefinition{
+			Key:    "key",
+			Tenant: tnt,
+		}
+		existingLabels := []*model.Label{
+			fixLabel("test", tnt, given.Key, nil, "object1", model.RuntimeLabelableObject),
+		}
+
+		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(&given, nil).Once()
+		mockRepository.On("DeleteByKey", ctx, tnt, given.Key).Return(nil).Once()
+		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return(existingLabels, nil).Once()
+		mockLabelRepository.On("DeleteByKey", ctx, tnt, given.Key).Return(nil).Once()
+		mockEngine.On("EvaluateForRuntime", ctx, tnt, "object1").Return(errors.New("test")).Once()
+
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, mockEngine, nil)
+		// WHEN
+		err := sut.Delete(ctx, tnt, given.Key, true)
+		// THEN
+		require.EqualError(t, err, "while evaluating Scenario Assignment Rules for Runtime with ID object1: test")
+	})
+
 	t.Run("error when deleting scenarios key", func(t *testing.T) {
 		// GIVEN
 		mockRepository := &automock.Repository{}
@@ -465,7 +845,7 @@ func TestServiceDelete(t *testing.T) {
 		}
 		deleteRelatedResources := false
 
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -494,7 +874,7 @@ func TestServiceDelete(t *testing.T) {
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(&given, nil).Once()
 		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return(existingLabels, nil)
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, "tenant", given.Key, deleteRelatedResources)
 		// THEN
@@ -518,7 +898,7 @@ func TestServiceDelete(t *testing.T) {
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(&given, nil).Once()
 		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return([]*model.Label{}, errors.New("test"))
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, "tenant", given.Key, deleteRelatedResources)
 		// THEN
@@ -539,7 +919,7 @@ func TestServiceDelete(t *testing.T) {
 		deleteRelatedResources := false
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(nil, nil).Once()
 
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -560,14 +940,14 @@ func TestServiceDelete(t *testing.T) {
 		deleteRelatedResources := false
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(nil, errors.New("")).Once()
 
-		sut := labeldef.NewService(mockRepository, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
 		require.Error(t, err)
 	})
 
-	t.Run("error during listing labels when trying to delete related resources", func(t *testing.T) {
+	t.Run("error during deleting labels when trying to delete related resources", func(t *testing.T) {
 		// GIVEN
 		testErr := errors.New("testErr")
 
@@ -583,11 +963,15 @@ func TestServiceDelete(t *testing.T) {
 			Key:    key,
 			Tenant: tnt,
 		}
+		existingLabels := []*model.Label{
+			fixLabel("test", tnt, given.Key, nil, "object1", model.ApplicationLabelableObject),
+		}
 		deleteRelatedResources := true
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(&given, nil).Once()
+		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return(existingLabels, nil).Once()
 		mockLabelRepository.On("DeleteByKey", ctx, tnt, given.Key).Return(testErr).Once()
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -655,6 +1039,22 @@ func fixSchema(t *testing.T, propertyName, propertyType, propertyDescription, re
 	return &objTemp
 }
 
+func fixEnumSchema(t *testing.T, values ...string) *interface{} {
+	enum, err := json.Marshal(values)
+	require.NoError(t, err)
+	sch := fmt.Sprintf(`{
+		"type": "string",
+		"enum": %s
+	  }`, enum)
+	var obj map[string]interface{}
+
+	err = json.Unmarshal([]byte(sch), &obj)
+	require.NoError(t, err)
+	var objTemp interface{}
+	objTemp = obj
+	return &objTemp
+}
+
 func fixLabel(id, tenant, key string, value interface{}, objectID string, objectType model.LabelableObject) *model.Label {
 	return &model.Label{
 		ID:         id,
@@ -0,0 +1,185 @@
+package labeldef
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
+	"github.com/pkg/errors"
+)
+
+type valueValidator interface {
+	ValidateRaw(value interface{}) (jsonschema.ValidationResult, error)
+}
+
+// valueTransformer applies the LabelValueTransformation to the existing label values and validates them against the new schema
+type valueTransformer struct {
+	mapping      []*model.LabelValueMapping
+	defaultValue interface{}
+	projection   *jsonpath.Query
+	validator    valueValidator
+}
+
+func newValueTransformer(transformation *model.LabelValueTransformation, schema *interface{}) (*valueTransformer, error) {
+	t := &valueTransformer{}
+
+	if schema != nil {
+		validator, err := jsonschema.NewValidatorFromRawSchema(*schema)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating validator for new schema")
+		}
+		t.validator = validator
+	}
+
+	if transformation == nil {
+		return t, nil
+	}
+
+	t.mapping = transformation.Mapping
+	t.defaultValue = transformation.Default
+
+	if transformation.JSONPath != nil {
+		projection, err := jsonpath.Parse(*transformation.JSONPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "while parsing JSON path projection")
+		}
+		if projection.Filter != nil {
+			return nil, errors.New("JSON path projection can not contain filter expression")
+		}
+		t.projection = projection
+	}
+
+	if t.defaultValue != nil {
+		valid, err := t.isValid(t.defaultValue)
+		if err != nil {
+			return nil, errors.Wrap(err, "while validating default value")
+		}
+		if !valid {
+			return nil, errors.New("default value is not valid against new schema")
+		}
+	}
+
+	return t, nil
+}
+
+// transform returns the new value and whether it is valid against the new schema. The returned value is nil if it is missing after the projection and there is no default.
+func (t *valueTransformer) transform(value interface{}) (interface{}, bool, error) {
+	newValue, found, err := t.project(value)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if found {
+		newValue, err = t.mapValue(newValue)
+		if err != nil {
+			return nil, false, err
+		}
+
+		valid, err := t.isValid(newValue)
+		if err != nil {
+			return nil, false, err
+		}
+		if valid {
+			return newValue, true, nil
+		}
+	}
+
+	if t.defaultValue != nil {
+		return t.defaultValue, true, nil
+	}
+
+	return newValue, false, nil
+}
+
+// project selects the new value with the JSON path. A path with wildcard always selects an array.
+func (t *valueTransformer) project(value interface{}) (interface{}, bool, error) {
+	if t.projection == nil {
+		return value, true, nil
+	}
+
+	items, err := t.projection.Select(value)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "while applying JSON path projection")
+	}
+
+	for _, accessor := range t.projection.Path {
+		if accessor.Kind == jsonpath.WildcardAccessor {
+			if len(items) == 0 {
+				return nil, false, nil
+			}
+			return items, true, nil
+		}
+	}
+
+	if len(items) == 0 {
+		return nil, false, nil
+	}
+	return items[0], true, nil
+}
+
+// mapValue replaces the value matching the mapping. If none matches the whole array, its elements are mapped one by one.
+func (t *valueTransformer) mapValue(value interface{}) (interface{}, error) {
+	mapped, found, err := t.lookup(value)
+	if err != nil || found {
+		return mapped, err
+	}
+
+	array, ok := value.([]interface{})
+	if !ok {
+		return value, nil
+	}
+
+	result := make([]interface{}, 0, len(array))
+	for _, element := range array {
+		mapped, _, err := t.lookup(element)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, mapped)
+	}
+
+	return result, nil
+}
+
+func (t *valueTransformer) lookup(value interface{}) (interface{}, bool, error) {
+	for _, mapping := range t.mapping {
+		equal, err := jsonEqual(value, mapping.From)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "while comparing value with mapping")
+		}
+		if equal {
+			return mapping.To, true, nil
+		}
+	}
+
+	return value, false, nil
+}
+
+func (t *valueTransformer) isValid(value interface{}) (bool, error) {
+	if t.validator == nil {
+		return true, nil
+	}
+
+	result, err := t.validator.ValidateRaw(value)
+	if err != nil {
+		return false, errors.Wrap(err, "while validating value against new schema")
+	}
+
+	return result.Valid, nil
+}
+
+// jsonEqual compares the values by their JSON encoding, so that for example numbers decoded from the database and from the GraphQL input are equal
+func jsonEqual(a, b interface{}) (bool, error) {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(aJSON, bJSON), nil
+}
@@ -16,11 +16,15 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalog"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/clientlookup"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventapi"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
@@ -36,6 +40,11 @@ type RootResolver struct {
 	healthCheck *healthcheck.Resolver
 	webhook     *webhook.Resolver
 	labelDef    *labeldef.Resolver
+	scenarios   *labeldef.ScenariosResolver
+	assignment  *scenarioassignment.Resolver
+	catalog     *catalog.Resolver
+
+	clientLookup *clientlookup.Handler
 }
 
 func NewRootResolver(transact persistence.Transactioner) *RootResolver {
@@ -52,6 +61,8 @@ func NewRootResolver(transact persistence.Transactioner) *RootResolver {
 	appConverter := application.NewConverter(webhookConverter, apiConverter, eventAPIConverter, docConverter)
 	labelDefConverter := labeldef.NewConverter()
 	labelConverter := label.NewConverter()
+	catalogConverter := catalog.NewConverter()
+	assignmentConverter := scenarioassignment.NewConverter()
 
 	healthcheckRepo := healthcheck.NewRepository()
 	runtimeRepo := runtime.NewRepository()
@@ -65,19 +76,23 @@ func NewRootResolver(transact persistence.Transactioner) *RootResolver {
 	docRepo := document.NewRepository(docConverter)
 	fetchRequestRepo := fetchrequest.NewRepository(frConverter)
 	runtimeAuthRepo := runtime_auth.NewRepository(runtimeAuthConverter)
+	catalogRepo := catalog.NewRepository(catalogConverter)
+	assignmentRepo := scenarioassignment.NewRepository(assignmentConverter)
 
 	uidService := uid.NewService()
 	runtimeAuthSvc := runtime_auth.NewService(runtimeAuthRepo, uidService)
 	labelUpsertService := label.NewLabelUpsertService(labelRepo, labelDefRepo, uidService)
-	scenariosService := labeldef.NewScenariosService(labelDefRepo, uidService)
+	scenariosService := labeldef.NewScenariosService(labelDefRepo, labelRepo, assignmentRepo, uidService)
+	assignmentSvc := scenarioassignment.NewService(assignmentRepo, runtimeRepo, labelRepo, scenariosService, uidService)
 	appSvc := application.NewService(applicationRepo, webhookRepo, apiRepo, eventAPIRepo, docRepo, runtimeRepo, labelRepo, fetchRequestRepo, labelUpsertService, scenariosService, uidService)
 	apiSvc := api.NewService(apiRepo, fetchRequestRepo, uidService)
 	eventAPISvc := eventapi.NewService(eventAPIRepo, fetchRequestRepo, uidService)
 	webhookSvc := webhook.NewService(webhookRepo, uidService)
 	docSvc := document.NewService(docRepo, fetchRequestRepo, uidService)
-	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosService, labelUpsertService, uidService)
+	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosService, labelUpsertService, assignmentSvc, uidService)
 	healthCheckSvc := healthcheck.NewService(healthcheckRepo)
-	labelDefService := labeldef.NewService(labelDefRepo, labelRepo, uidService)
+	labelDefService := labeldef.NewService(labelDefRepo, labelRepo, assignmentSvc, uidService)
+	catalogSvc := catalog.NewService(catalogRepo, applicationRepo, apiRepo, eventAPIRepo)
 
 	return &RootResolver{
 		app:         application.NewResolver(transact, appSvc, apiSvc, eventAPISvc, docSvc, webhookSvc, appConverter, docConverter, webhookConverter, apiConverter, eventAPIConverter),
@@ -88,9 +103,19 @@ func NewRootResolver(transact persistence.Transactioner) *RootResolver {
 		healthCheck: healthcheck.NewResolver(healthCheckSvc),
 		webhook:     webhook.NewResolver(transact, webhookSvc, appSvc, webhookConverter),
 		labelDef:    labeldef.NewResolver(labelDefService, labelDefConverter, transact),
+		scenarios:   labeldef.NewScenariosResolver(scenariosService, labelDefConverter, transact),
+		assignment:  scenarioassignment.NewResolver(transact, assignmentSvc, assignmentConverter),
+		catalog:     catalog.NewResolver(transact, catalogSvc, catalogConverter),
+
+		clientLookup: clientlookup.NewHandler(transact, appSvc, runtimeSvc),
 	}
 }
 
+// ClientLookupHandler returns the handler of the Application and Runtime lookups served on the internal API
+func (r *RootResolver) ClientLookupHandler() *clientlookup.Handler {
+	return r.clientLookup
+}
+
 func (r *RootResolver) Mutation() graphql.MutationResolver {
 	return &mutationResolver{r}
 }
@@ -120,8 +145,8 @@ type queryResolver struct {
 	*RootResolver
 }
 
-func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
-	return r.app.Applications(ctx, filter, first, after)
+func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, search *string, first *int, after *graphql.PageCursor, orderBy *graphql.ApplicationOrderBy) (*graphql.ApplicationPage, error) {
+	return r.app.Applications(ctx, filter, search, first, after, orderBy)
 }
 func (r *queryResolver) Application(ctx context.Context, id string) (*graphql.Application, error) {
 	return r.app.Application(ctx, id)
@@ -129,8 +154,8 @@ func (r *queryResolver) Application(ctx context.Context, id string) (*graphql.Ap
 func (r *queryResolver) ApplicationsForRuntime(ctx context.Context, runtimeID string, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
 	return r.app.ApplicationsForRuntime(ctx, runtimeID, first, after)
 }
-func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
-	return r.runtime.Runtimes(ctx, filter, first, after)
+func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, search *string, first *int, after *graphql.PageCursor, orderBy *graphql.RuntimeOrderBy) (*graphql.RuntimePage, error) {
+	return r.runtime.Runtimes(ctx, filter, search, first, after, orderBy)
 }
 func (r *queryResolver) Runtime(ctx context.Context, id string) (*graphql.Runtime, error) {
 	return r.runtime.Runtime(ctx, id)
@@ -141,9 +166,27 @@ func (r *queryResolver) LabelDefinitions(ctx context.Context) ([]*graphql.LabelD
 func (r *queryResolver) LabelDefinition(ctx context.Context, key string) (*graphql.LabelDefinition, error) {
 	return r.labelDef.LabelDefinition(ctx, key)
 }
+func (r *queryResolver) LabelValues(ctx context.Context, key string) (*graphql.LabelKeyUsage, error) {
+	return r.labelDef.LabelValues(ctx, key)
+}
+func (r *queryResolver) PreviewLabelDefinitionUpdate(ctx context.Context, in graphql.LabelDefinitionInput, transformation *graphql.LabelValueTransformationInput) ([]*graphql.LabelValueChange, error) {
+	return r.labelDef.PreviewLabelDefinitionUpdate(ctx, in, transformation)
+}
+func (r *queryResolver) Scenarios(ctx context.Context) ([]*graphql.Scenario, error) {
+	return r.scenarios.Scenarios(ctx)
+}
+func (r *queryResolver) Scenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	return r.scenarios.Scenario(ctx, name)
+}
+func (r *queryResolver) ScenarioAssignmentRules(ctx context.Context) ([]*graphql.ScenarioAssignmentRule, error) {
+	return r.assignment.ScenarioAssignmentRules(ctx)
+}
 func (r *queryResolver) HealthChecks(ctx context.Context, types []graphql.HealthCheckType, origin *string, first *int, after *graphql.PageCursor) (*graphql.HealthCheckPage, error) {
 	return r.healthCheck.HealthChecks(ctx, types, origin, first, after)
 }
+func (r *queryResolver) SearchCatalog(ctx context.Context, query string, first *int, after *graphql.PageCursor) (*graphql.CatalogSearchResultPage, error) {
+	return r.catalog.SearchCatalog(ctx, query, first, after)
+}
 
 type mutationResolver struct {
 	*RootResolver
@@ -155,6 +198,9 @@ func (r *mutationResolver) CreateApplication(ctx context.Context, in graphql.App
 func (r *mutationResolver) UpdateApplication(ctx context.Context, id string, in graphql.ApplicationInput) (*graphql.Application, error) {
 	return r.app.UpdateApplication(ctx, id, in)
 }
+func (r *mutationResolver) ReportApplicationPairing(ctx context.Context, id string, in graphql.PairingReportInput) (*graphql.Application, error) {
+	return r.app.ReportApplicationPairing(ctx, id, in)
+}
 func (r *mutationResolver) DeleteApplication(ctx context.Context, id string) (*graphql.Application, error) {
 	return r.app.DeleteApplication(ctx, id)
 }
@@ -203,6 +249,9 @@ func (r *mutationResolver) CreateRuntime(ctx context.Context, in graphql.Runtime
 func (r *mutationResolver) UpdateRuntime(ctx context.Context, id string, in graphql.RuntimeInput) (*graphql.Runtime, error) {
 	return r.runtime.UpdateRuntime(ctx, id, in)
 }
+func (r *mutationResolver) ReportRuntimePairing(ctx context.Context, id string, in graphql.PairingReportInput) (*graphql.Runtime, error) {
+	return r.runtime.ReportRuntimePairing(ctx, id, in)
+}
 func (r *mutationResolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
 	return r.runtime.DeleteRuntime(ctx, id)
 }
@@ -215,12 +264,27 @@ func (r *mutationResolver) DeleteDocument(ctx context.Context, id string) (*grap
 func (r *mutationResolver) CreateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput) (*graphql.LabelDefinition, error) {
 	return r.labelDef.CreateLabelDefinition(ctx, in)
 }
-func (r *mutationResolver) UpdateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput) (*graphql.LabelDefinition, error) {
-	return r.labelDef.UpdateLabelDefinition(ctx, in)
+func (r *mutationResolver) UpdateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput, transformation *graphql.LabelValueTransformationInput) (*graphql.LabelDefinition, error) {
+	return r.labelDef.UpdateLabelDefinition(ctx, in, transformation)
 }
 func (r *mutationResolver) DeleteLabelDefinition(ctx context.Context, key string, deleteRelatedLabels *bool) (*graphql.LabelDefinition, error) {
 	return r.labelDef.DeleteLabelDefinition(ctx, key, deleteRelatedLabels)
 }
+func (r *mutationResolver) CreateScenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	return r.scenarios.CreateScenario(ctx, name)
+}
+func (r *mutationResolver) DeleteScenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	return r.scenarios.DeleteScenario(ctx, name)
+}
+func (r *mutationResolver) RenameScenario(ctx context.Context, name string, newName string) (*graphql.Scenario, error) {
+	return r.scenarios.RenameScenario(ctx, name, newName)
+}
+func (r *mutationResolver) CreateScenarioAssignmentRule(ctx context.Context, in graphql.ScenarioAssignmentRuleInput) (*graphql.ScenarioAssignmentRule, error) {
+	return r.assignment.CreateScenarioAssignmentRule(ctx, in)
+}
+func (r *mutationResolver) DeleteScenarioAssignmentRule(ctx context.Context, id string) (*graphql.ScenarioAssignmentRule, error) {
+	return r.assignment.DeleteScenarioAssignmentRule(ctx, id)
+}
 func (r *mutationResolver) SetApplicationLabel(ctx context.Context, applicationID string, key string, value interface{}) (*graphql.Label, error) {
 	return r.app.SetApplicationLabel(ctx, applicationID, key, value)
 }
@@ -233,6 +297,18 @@ func (r *mutationResolver) SetRuntimeLabel(ctx context.Context, runtimeID string
 func (r *mutationResolver) DeleteRuntimeLabel(ctx context.Context, runtimeID string, key string) (*graphql.Label, error) {
 	return r.runtime.DeleteRuntimeLabel(ctx, runtimeID, key)
 }
+func (r *mutationResolver) SetLabelForApplications(ctx context.Context, filter []*graphql.LabelFilter, key string, value interface{}, dryRun *bool) (*graphql.BulkLabelResult, error) {
+	return r.app.SetLabelForApplications(ctx, filter, key, value, dryRun)
+}
+func (r *mutationResolver) DeleteLabelForApplications(ctx context.Context, filter []*graphql.LabelFilter, key string, dryRun *bool) (*graphql.BulkLabelResult, error) {
+	return r.app.DeleteLabelForApplications(ctx, filter, key, dryRun)
+}
+func (r *mutationResolver) SetLabelForRuntimes(ctx context.Context, filter []*graphql.LabelFilter, key string, value interface{}, dryRun *bool) (*graphql.BulkLabelResult, error) {
+	return r.runtime.SetLabelForRuntimes(ctx, filter, key, value, dryRun)
+}
+func (r *mutationResolver) DeleteLabelForRuntimes(ctx context.Context, filter []*graphql.LabelFilter, key string, dryRun *bool) (*graphql.BulkLabelResult, error) {
+	return r.runtime.DeleteLabelForRuntimes(ctx, filter, key, dryRun)
+}
 
 type applicationResolver struct {
 	*RootResolver
@@ -244,14 +320,14 @@ func (r *applicationResolver) Labels(ctx context.Context, obj *graphql.Applicati
 func (r *applicationResolver) Webhooks(ctx context.Context, obj *graphql.Application) ([]*graphql.Webhook, error) {
 	return r.app.Webhooks(ctx, obj)
 }
-func (r *applicationResolver) Apis(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor) (*graphql.APIDefinitionPage, error) {
-	return r.app.Apis(ctx, obj, group, first, after)
+func (r *applicationResolver) Apis(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor, orderBy *graphql.APIDefinitionOrderBy) (*graphql.APIDefinitionPage, error) {
+	return r.app.Apis(ctx, obj, group, first, after, orderBy)
 }
-func (r *applicationResolver) EventAPIs(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor) (*graphql.EventAPIDefinitionPage, error) {
-	return r.app.EventAPIs(ctx, obj, group, first, after)
+func (r *applicationResolver) EventAPIs(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor, orderBy *graphql.EventAPIDefinitionOrderBy) (*graphql.EventAPIDefinitionPage, error) {
+	return r.app.EventAPIs(ctx, obj, group, first, after, orderBy)
 }
-func (r *applicationResolver) Documents(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.DocumentPage, error) {
-	return r.app.Documents(ctx, obj, first, after)
+func (r *applicationResolver) Documents(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor, orderBy *graphql.DocumentOrderBy) (*graphql.DocumentPage, error) {
+	return r.app.Documents(ctx, obj, first, after, orderBy)
 }
 
 type runtimeResolver struct {
@@ -41,6 +41,20 @@ func (_m *RuntimeConverter) MultipleToGraphQL(in []*model.Runtime) []*graphql.Ru
 	return r0
 }
 
+// PairingReportFromGraphQL provides a mock function with given fields: in
+func (_m *RuntimeConverter) PairingReportFromGraphQL(in graphql.PairingReportInput) model.PairingReport {
+	ret := _m.Called(in)
+
+	var r0 model.PairingReport
+	if rf, ok := ret.Get(0).(func(graphql.PairingReportInput) model.PairingReport); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.PairingReport)
+	}
+
+	return r0
+}
+
 // ToGraphQL provides a mock function with given fields: in
 func (_m *RuntimeConverter) ToGraphQL(in *model.Runtime) *graphql.Runtime {
 	ret := _m.Called(in)
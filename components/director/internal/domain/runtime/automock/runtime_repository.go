@@ -6,6 +6,8 @@ import context "context"
 import labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import orderby "github.com/kyma-incubator/compass/components/director/internal/orderby"
+import search "github.com/kyma-incubator/compass/components/director/internal/search"
 
 // RuntimeRepository is an autogenerated mock type for the RuntimeRepository type
 type RuntimeRepository struct {
@@ -84,13 +86,13 @@ func (_m *RuntimeRepository) GetByID(ctx context.Context, tenant string, id stri
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
-func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, tenant, filter, query, pageSize, cursor, orderBy
+func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, query search.Query, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, tenant, filter, query, pageSize, cursor, orderBy)
 
 	var r0 *model.RuntimePage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.RuntimePage); ok {
-		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, search.Query, int, string, *orderby.OrderBy) *model.RuntimePage); ok {
+		r0 = rf(ctx, tenant, filter, query, pageSize, cursor, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RuntimePage)
@@ -98,8 +100,31 @@ func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, search.Query, int, string, *orderby.OrderBy) error); ok {
+		r1 = rf(ctx, tenant, filter, query, pageSize, cursor, orderBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListIDs provides a mock function with given fields: ctx, tenant, filter
+func (_m *RuntimeRepository) ListIDs(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]string, error) {
+	ret := _m.Called(ctx, tenant, filter)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter) []string); ok {
+		r0 = rf(ctx, tenant, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter) error); ok {
+		r1 = rf(ctx, tenant, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -6,6 +6,8 @@ import context "context"
 import labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 import mock "github.com/stretchr/testify/mock"
 import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import orderby "github.com/kyma-incubator/compass/components/director/internal/orderby"
+import search "github.com/kyma-incubator/compass/components/director/internal/search"
 
 // RuntimeService is an autogenerated mock type for the RuntimeService type
 type RuntimeService struct {
@@ -61,6 +63,29 @@ func (_m *RuntimeService) DeleteLabel(ctx context.Context, runtimeID string, key
 	return r0
 }
 
+// DeleteLabelForMatching provides a mock function with given fields: ctx, filter, key, dryRun
+func (_m *RuntimeService) DeleteLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, dryRun bool) ([]string, error) {
+	ret := _m.Called(ctx, filter, key, dryRun)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, string, bool) []string); ok {
+		r0 = rf(ctx, filter, key, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, string, bool) error); ok {
+		r1 = rf(ctx, filter, key, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *RuntimeService) Get(ctx context.Context, id string) (*model.Runtime, error) {
 	ret := _m.Called(ctx, id)
@@ -107,13 +132,13 @@ func (_m *RuntimeService) GetLabel(ctx context.Context, runtimeID string, key st
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, filter, pageSize, cursor
-func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	ret := _m.Called(ctx, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, filter, query, pageSize, cursor, orderBy
+func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelFilter, query search.Query, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, filter, query, pageSize, cursor, orderBy)
 
 	var r0 *model.RuntimePage
-	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) *model.RuntimePage); ok {
-		r0 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, search.Query, int, string, *orderby.OrderBy) *model.RuntimePage); ok {
+		r0 = rf(ctx, filter, query, pageSize, cursor, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RuntimePage)
@@ -121,8 +146,8 @@ func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelF
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, search.Query, int, string, *orderby.OrderBy) error); ok {
+		r1 = rf(ctx, filter, query, pageSize, cursor, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -153,6 +178,20 @@ func (_m *RuntimeService) ListLabels(ctx context.Context, runtimeID string) (map
 	return r0, r1
 }
 
+// ReportPairing provides a mock function with given fields: ctx, id, report
+func (_m *RuntimeService) ReportPairing(ctx context.Context, id string, report model.PairingReport) error {
+	ret := _m.Called(ctx, id, report)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PairingReport) error); ok {
+		r0 = rf(ctx, id, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetLabel provides a mock function with given fields: ctx, label
 func (_m *RuntimeService) SetLabel(ctx context.Context, label *model.LabelInput) error {
 	ret := _m.Called(ctx, label)
@@ -167,6 +206,29 @@ func (_m *RuntimeService) SetLabel(ctx context.Context, label *model.LabelInput)
 	return r0
 }
 
+// SetLabelForMatching provides a mock function with given fields: ctx, filter, key, value, dryRun
+func (_m *RuntimeService) SetLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, value interface{}, dryRun bool) ([]string, error) {
+	ret := _m.Called(ctx, filter, key, value, dryRun)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, string, interface{}, bool) []string); ok {
+		r0 = rf(ctx, filter, key, value, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, string, interface{}, bool) error); ok {
+		r1 = rf(ctx, filter, key, value, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *RuntimeService) Update(ctx context.Context, id string, in model.RuntimeInput) error {
 	ret := _m.Called(ctx, id, in)
@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"time"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
@@ -29,6 +31,7 @@ func (c *converter) ToGraphQL(in *model.Runtime) *graphql.Runtime {
 		Name:        in.Name,
 		Description: in.Description,
 		AgentAuth:   c.auth.ToGraphQL(in.AgentAuth),
+		Certificate: c.certificateToGraphQL(in.Certificate),
 	}
 }
 
@@ -83,3 +86,28 @@ func (c *converter) statusToGraphQL(in *model.RuntimeStatus) *graphql.RuntimeSta
 		Timestamp: graphql.Timestamp(in.Timestamp),
 	}
 }
+
+func (c *converter) PairingReportFromGraphQL(in graphql.PairingReportInput) model.PairingReport {
+	report := model.PairingReport{
+		Event: model.PairingEvent(in.Event),
+	}
+	if in.SerialNumber != nil {
+		report.SerialNumber = *in.SerialNumber
+	}
+	if in.ExpiresAt != nil {
+		report.ExpiresAt = time.Time(*in.ExpiresAt)
+	}
+
+	return report
+}
+
+func (c *converter) certificateToGraphQL(in *model.ClientCertificate) *graphql.ClientCertificate {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.ClientCertificate{
+		SerialNumber: in.SerialNumber,
+		ExpiresAt:    graphql.Timestamp(in.ExpiresAt),
+	}
+}
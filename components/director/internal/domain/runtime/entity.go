@@ -21,6 +21,9 @@ type Runtime struct {
 	StatusCondition string         `db:"status_condition"`
 	StatusTimestamp time.Time      `db:"status_timestamp"`
 	AgentAuth       string         `db:"auth"`
+
+	CertificateSerialNumber sql.NullString `db:"certificate_serial_number"`
+	CertificateExpiresAt    *time.Time     `db:"certificate_expires_at"`
 }
 
 // EntityFromRuntimeModel converts Runtime model to Runtime entity
@@ -38,14 +41,26 @@ func EntityFromRuntimeModel(model *model.Runtime) (*Runtime, error) {
 		return nil, errors.Wrap(err, "while marshalling AgentAuth")
 	}
 
+	var nullSerialNumber sql.NullString
+	var expiresAt *time.Time
+	if model.Certificate != nil {
+		nullSerialNumber = sql.NullString{
+			String: model.Certificate.SerialNumber,
+			Valid:  true,
+		}
+		expiresAt = &model.Certificate.ExpiresAt
+	}
+
 	return &Runtime{
-		ID:              model.ID,
-		TenantID:        model.Tenant,
-		Name:            model.Name,
-		Description:     nullDescription,
-		StatusCondition: string(model.Status.Condition),
-		StatusTimestamp: model.Status.Timestamp,
-		AgentAuth:       string(agentAuthMarshalled),
+		ID:                      model.ID,
+		TenantID:                model.Tenant,
+		Name:                    model.Name,
+		Description:             nullDescription,
+		StatusCondition:         string(model.Status.Condition),
+		StatusTimestamp:         model.Status.Timestamp,
+		AgentAuth:               string(agentAuthMarshalled),
+		CertificateSerialNumber: nullSerialNumber,
+		CertificateExpiresAt:    expiresAt,
 	}, nil
 }
 
@@ -63,6 +78,14 @@ func (e Runtime) ToModel() (*model.Runtime, error) {
 		return nil, errors.Wrap(err, "while unmarshalling AgentAuth")
 	}
 
+	var certificate *model.ClientCertificate
+	if e.CertificateSerialNumber.Valid && e.CertificateExpiresAt != nil {
+		certificate = &model.ClientCertificate{
+			SerialNumber: e.CertificateSerialNumber.String,
+			ExpiresAt:    *e.CertificateExpiresAt,
+		}
+	}
+
 	return &model.Runtime{
 		ID:          e.ID,
 		Tenant:      e.TenantID,
@@ -72,6 +95,7 @@ func (e Runtime) ToModel() (*model.Runtime, error) {
 			Condition: model.RuntimeStatusCondition(e.StatusCondition),
 			Timestamp: e.StatusTimestamp,
 		},
-		AgentAuth: &agentAuth,
+		AgentAuth:   &agentAuth,
+		Certificate: certificate,
 	}, nil
 }
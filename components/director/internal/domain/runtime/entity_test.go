@@ -162,3 +162,58 @@ func TestEntity_RuntimeToModel_RuntimeWithoutDescription(t *testing.T) {
 	assert.Equal(t, "foo", modelRuntime.AgentAuth.Credential.Basic.Username)
 	assert.Equal(t, "bar", modelRuntime.AgentAuth.Credential.Basic.Password)
 }
+
+func TestEntity_EntityFromRuntimeModel_RuntimeWithCertificate(t *testing.T) {
+	// given
+	time, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
+	require.NoError(t, err)
+
+	modelRuntime := model.Runtime{
+		ID:     uuid.New().String(),
+		Tenant: uuid.New().String(),
+		Name:   "Runtime ABC",
+		Status: &model.RuntimeStatus{
+			Condition: model.RuntimeStatusConditionReady,
+			Timestamp: time,
+		},
+		AgentAuth: &model.Auth{},
+		Certificate: &model.ClientCertificate{
+			SerialNumber: "1234",
+			ExpiresAt:    time,
+		},
+	}
+
+	// when
+	entityRuntime, err := runtime.EntityFromRuntimeModel(&modelRuntime)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, entityRuntime.CertificateSerialNumber.Valid)
+	assert.Equal(t, "1234", entityRuntime.CertificateSerialNumber.String)
+	require.NotNil(t, entityRuntime.CertificateExpiresAt)
+	assert.Equal(t, time, *entityRuntime.CertificateExpiresAt)
+}
+
+func TestEntity_RuntimeToModel_RuntimeWithCertificate(t *testing.T) {
+	// given
+	time, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
+	require.NoError(t, err)
+
+	entityRuntime := runtime.Runtime{
+		ID:                      uuid.New().String(),
+		TenantID:                uuid.New().String(),
+		Name:                    "Runtime QWE",
+		StatusCondition:         "READY",
+		StatusTimestamp:         time,
+		AgentAuth:               agentAuthStr,
+		CertificateSerialNumber: sql.NullString{Valid: true, String: "1234"},
+		CertificateExpiresAt:    &time,
+	}
+
+	// when
+	modelRuntime, err := entityRuntime.ToModel()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &model.ClientCertificate{SerialNumber: "1234", ExpiresAt: time}, modelRuntime.Certificate)
+}
@@ -81,6 +81,10 @@ func fixDetailedModelRuntime(t *testing.T, id, name, description string) *model.
 				},
 			},
 		},
+		Certificate: &model.ClientCertificate{
+			SerialNumber: "123",
+			ExpiresAt:    time,
+		},
 	}
 }
 
@@ -107,6 +111,10 @@ func fixDetailedGQLRuntime(t *testing.T, id, name, description string) *graphql.
 				Password: "bar",
 			},
 		},
+		Certificate: &graphql.ClientCertificate{
+			SerialNumber: "123",
+			ExpiresAt:    graphql.Timestamp(time),
+		},
 	}
 }
 
@@ -4,18 +4,27 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
 )
 
 const runtimeTable string = `public.runtimes`
 
-var runtimeColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "auth"}
+// runtimeOrderColumns are the indexed columns the runtimes can be ordered by
+var runtimeOrderColumns = map[orderby.Field]string{orderby.Name: "name"}
+
+// runtimeSearchColumns are the trigram indexed columns searched for the text
+var runtimeSearchColumns = []string{"name", "description"}
+
+var runtimeColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "auth", "certificate_serial_number", "certificate_expires_at"}
 
 type pgRepository struct {
 	*repo.ExistQuerier
@@ -31,9 +40,9 @@ func NewRepository() *pgRepository {
 		ExistQuerier:    repo.NewExistQuerier(runtimeTable, "tenant_id"),
 		SingleGetter:    repo.NewSingleGetter(runtimeTable, "tenant_id", runtimeColumns),
 		Deleter:         repo.NewDeleter(runtimeTable, "tenant_id"),
-		PageableQuerier: repo.NewPageableQuerier(runtimeTable, "tenant_id", runtimeColumns),
+		PageableQuerier: repo.NewPageableQuerier(runtimeTable, "tenant_id", "id", runtimeColumns),
 		Creator:         repo.NewCreator(runtimeTable, runtimeColumns),
-		Updater:         repo.NewUpdater(runtimeTable, []string{"name", "description", "status_condition", "status_timestamp"}, "tenant_id", []string{"id"}),
+		Updater:         repo.NewUpdater(runtimeTable, []string{"name", "description", "status_condition", "status_timestamp", "certificate_serial_number", "certificate_expires_at"}, "tenant_id", []string{"id"}),
 	}
 }
 
@@ -65,22 +74,22 @@ func (r RuntimeCollection) Len() int {
 	return len(r)
 }
 
-func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	var runtimesCollection RuntimeCollection
-	tenantID, err := uuid.Parse(tenant)
+func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, query search.Query, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.RuntimePage, error) {
+	paginationOrderBy, err := orderBy.ToPagination("id", runtimeOrderColumns)
 	if err != nil {
-		return nil, errors.Wrap(err, "while parsing tenant as UUID")
+		return nil, errors.Wrap(err, "while converting order")
 	}
-	filterSubquery, err := label.FilterQuery(model.RuntimeLabelableObject, label.IntersectSet, tenantID, filter)
+
+	var runtimesCollection RuntimeCollection
+	// the tenant is bound as $1 by the PageableQuerier
+	args := jsonpath.NewArgs(tenant)
+	filterCondition, err := label.FilterCondition(model.RuntimeLabelableObject, `"id"`, filter, args)
 	if err != nil {
 		return nil, errors.Wrap(err, "while building filter query")
 	}
-	var additionalConditions string
-	if filterSubquery != "" {
-		additionalConditions = fmt.Sprintf(`"id" IN (%s)`, filterSubquery)
-	}
+	searchCondition := query.Condition(runtimeSearchColumns, args)
 
-	page, totalCount, err := r.PageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &runtimesCollection, additionalConditions)
+	page, totalCount, err := r.PageableQuerier.ListWithArgs(ctx, tenant, pageSize, cursor, paginationOrderBy, &runtimesCollection, []string{filterCondition, searchCondition}, args.Values()[1:])
 
 	if err != nil {
 		return nil, err
@@ -102,6 +111,61 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		PageInfo:   page}, nil
 }
 
+// ListIDs returns the IDs of all runtimes matching the label filter, ordered by ID
+func (r *pgRepository) ListIDs(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]string, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching DB from context")
+	}
+
+	args := jsonpath.NewArgs(tenant)
+	filterCondition, err := label.FilterCondition(model.RuntimeLabelableObject, `"id"`, filter, args)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building filter query")
+	}
+
+	stmt := fmt.Sprintf(`SELECT "id" FROM %s WHERE "tenant_id" = $1`, runtimeTable)
+	if filterCondition != "" {
+		stmt = fmt.Sprintf(`%s AND %s`, stmt, filterCondition)
+	}
+	stmt = fmt.Sprintf(`%s ORDER BY "id"`, stmt)
+
+	var ids []string
+	err = persist.Select(&ids, stmt, args.Values()...)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing runtime IDs from DB")
+	}
+
+	return ids, nil
+}
+
+// Matches reports whether the runtime matches the label filter
+func (r *pgRepository) Matches(ctx context.Context, tenant, id string, filter []*labelfilter.LabelFilter) (bool, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "while fetching DB from context")
+	}
+
+	args := jsonpath.NewArgs(tenant)
+	filterCondition, err := label.FilterCondition(model.RuntimeLabelableObject, `"id"`, filter, args)
+	if err != nil {
+		return false, errors.Wrap(err, "while building filter query")
+	}
+
+	stmt := fmt.Sprintf(`SELECT "id" FROM %s WHERE "tenant_id" = $1 AND "id" = %s`, runtimeTable, args.Add(id))
+	if filterCondition != "" {
+		stmt = fmt.Sprintf(`%s AND %s`, stmt, filterCondition)
+	}
+
+	var matches bool
+	err = persist.Get(&matches, fmt.Sprintf(`SELECT EXISTS (%s)`, stmt), args.Values()...)
+	if err != nil {
+		return false, errors.Wrap(err, "while matching runtime in DB")
+	}
+
+	return matches, nil
+}
+
 func (r *pgRepository) Create(ctx context.Context, item *model.Runtime) error {
 	if item == nil {
 		return errors.New("item can not be empty")
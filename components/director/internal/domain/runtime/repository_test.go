@@ -2,6 +2,7 @@ package runtime_test
 
 import (
 	"context"
+	"database/sql/driver"
 	"encoding/base64"
 	"fmt"
 	"regexp"
@@ -16,6 +17,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -35,8 +38,8 @@ func TestPgRepository_GetByID_ShouldReturnRuntimeModelForRuntimeEntity(t *testin
 	sqlxDB, sqlMock := testdb.MockDatabase(t)
 	defer sqlMock.AssertExpectations(t)
 
-	rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "auth"}).
-		AddRow(runtimeID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, agentAuthStr)
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "auth", "certificate_serial_number", "certificate_expires_at"}).
+		AddRow(runtimeID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, agentAuthStr, "1234", timestamp)
 
 	sqlMock.ExpectQuery(`^SELECT (.+) FROM public.runtimes WHERE tenant_id = \$1 AND id = \$2$`).
 		WithArgs(tenantID, runtimeID).
@@ -54,6 +57,7 @@ func TestPgRepository_GetByID_ShouldReturnRuntimeModelForRuntimeEntity(t *testin
 	assert.NoError(t, sqlMock.ExpectationsWereMet())
 	assert.Equal(t, runtimeID, modelRuntime.ID)
 	assert.Equal(t, tenantID, modelRuntime.Tenant)
+	assert.Equal(t, &model.ClientCertificate{SerialNumber: "1234", ExpiresAt: timestamp}, modelRuntime.Certificate)
 }
 
 func TestPgRepository_List(t *testing.T) {
@@ -65,42 +69,57 @@ func TestPgRepository_List(t *testing.T) {
 	runtime1ID := uuid.New().String()
 	runtime2ID := uuid.New().String()
 
-	limit := 2
-	offset := 3
+	previousPageRuntimeID := uuid.New().String()
+	nextPageCursor, err := pagination.EncodeKeysetCursor(pagination.KeysetCursor{OrderBy: "id", Value: previousPageRuntimeID, ID: previousPageRuntimeID})
+	require.NoError(t, err)
+	nextPageByNameCursor, err := pagination.EncodeKeysetCursor(pagination.KeysetCursor{OrderBy: "name DESC", Value: "Runtime XYZ", ID: previousPageRuntimeID})
+	require.NoError(t, err)
 
-	pageableQuery := `^SELECT (.+) FROM public.runtimes WHERE tenant_id=\$1 ORDER BY id LIMIT %d OFFSET %d$`
 	countQuery := regexp.QuoteMeta(`SELECT COUNT(*) FROM public.runtimes WHERE tenant_id=$1`)
 
 	testCases := []struct {
-		Name           string
-		InputCursor    string
-		InputPageSize  int
-		ExpectedOffset int
-		ExpectedLimit  int
-		Rows           *sqlmock.Rows
-		TotalCount     int
+		Name          string
+		InputCursor   string
+		InputPageSize int
+		InputOrderBy  *orderby.OrderBy
+		ExpectedQuery string
+		ExpectedArgs  []driver.Value
+		Rows          *sqlmock.Rows
+		TotalCount    int
 	}{
 		{
-			Name:           "Success getting first page",
-			InputPageSize:  2,
-			InputCursor:    "",
-			ExpectedOffset: 0,
-			ExpectedLimit:  limit,
+			Name:          "Success getting first page",
+			InputPageSize: 2,
+			InputCursor:   "",
+			ExpectedQuery: `^SELECT (.+) FROM public.runtimes WHERE tenant_id=\$1 ORDER BY id LIMIT 3$`,
+			ExpectedArgs:  []driver.Value{tenantID},
 			Rows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "auth"}).
 				AddRow(runtime1ID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, agentAuthStr).
 				AddRow(runtime2ID, tenantID, "Runtime XYZ", "Description for runtime XYZ", "INITIAL", timestamp, agentAuthStr),
 			TotalCount: 2,
 		},
 		{
-			Name:           "Success getting next page",
-			InputPageSize:  2,
-			InputCursor:    convertIntToBase64String(offset),
-			ExpectedOffset: offset,
-			ExpectedLimit:  limit,
+			Name:          "Success getting next page",
+			InputPageSize: 2,
+			InputCursor:   nextPageCursor,
+			ExpectedQuery: `^SELECT (.+) FROM public.runtimes WHERE tenant_id=\$1 AND id > \$2 ORDER BY id LIMIT 3$`,
+			ExpectedArgs:  []driver.Value{tenantID, previousPageRuntimeID},
 			Rows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "auth"}).
 				AddRow(runtime1ID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, agentAuthStr).
 				AddRow(runtime2ID, tenantID, "Runtime XYZ", "Description for runtime XYZ", "INITIAL", timestamp, agentAuthStr),
-			TotalCount: 2,
+			TotalCount: 4,
+		},
+		{
+			Name:          "Success getting next page ordered by name in descending order",
+			InputPageSize: 2,
+			InputCursor:   nextPageByNameCursor,
+			InputOrderBy:  orderby.New(orderby.Name, pagination.DescOrderDirection),
+			ExpectedQuery: `^SELECT (.+) FROM public.runtimes WHERE tenant_id=\$1 AND \(name, id\) < \(\$2, \$3\) ORDER BY name DESC, id DESC LIMIT 3$`,
+			ExpectedArgs:  []driver.Value{tenantID, "Runtime XYZ", previousPageRuntimeID},
+			Rows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "auth"}).
+				AddRow(runtime1ID, tenantID, "Runtime DEF", "Description for runtime DEF", "INITIAL", timestamp, agentAuthStr).
+				AddRow(runtime2ID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, agentAuthStr),
+			TotalCount: 4,
 		}}
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
@@ -109,10 +128,9 @@ func TestPgRepository_List(t *testing.T) {
 			defer sqlMock.AssertExpectations(t)
 			ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 			pgRepository := runtime.NewRepository()
-			expectedQuery := fmt.Sprintf(pageableQuery, testCase.ExpectedLimit, testCase.ExpectedOffset)
 
-			sqlMock.ExpectQuery(expectedQuery).
-				WithArgs(tenantID).
+			sqlMock.ExpectQuery(testCase.ExpectedQuery).
+				WithArgs(testCase.ExpectedArgs...).
 				WillReturnRows(testCase.Rows)
 			countRow := sqlMock.NewRows([]string{"count"}).AddRow(testCase.TotalCount)
 
@@ -121,11 +139,12 @@ func TestPgRepository_List(t *testing.T) {
 				WillReturnRows(countRow)
 
 			//THEN
-			modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, testCase.InputPageSize, testCase.InputCursor)
+			modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, "", testCase.InputPageSize, testCase.InputCursor, testCase.InputOrderBy)
 
 			//THEN
 			require.NoError(t, err)
-			assert.Equal(t, testCase.ExpectedLimit, modelRuntimePage.TotalCount)
+			assert.Equal(t, testCase.TotalCount, modelRuntimePage.TotalCount)
+			assert.False(t, modelRuntimePage.PageInfo.HasNextPage)
 			require.NoError(t, sqlMock.ExpectationsWereMet())
 
 			assert.Equal(t, runtime1ID, modelRuntimePage.Data[0].ID)
@@ -137,17 +156,43 @@ func TestPgRepository_List(t *testing.T) {
 		})
 	}
 
-	t.Run("Returns error when decoded cursor is non-positive number", func(t *testing.T) {
+	t.Run("Success searching by name and description", func(t *testing.T) {
 		//GIVEN
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		defer sqlMock.AssertExpectations(t)
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		pgRepository := runtime.NewRepository()
+
+		sqlMock.ExpectQuery(`^SELECT (.+) FROM public.runtimes WHERE tenant_id=\$1 AND \(name ILIKE \$2 OR description ILIKE \$2\) ORDER BY id LIMIT 3$`).
+			WithArgs(tenantID, "%abc%").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "auth"}).
+				AddRow(runtime1ID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, agentAuthStr))
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.runtimes WHERE tenant_id=$1 AND (name ILIKE $2 OR description ILIKE $2)`)).
+			WithArgs(tenantID, "%abc%").
+			WillReturnRows(sqlMock.NewRows([]string{"count"}).AddRow(1))
+
+		//WHEN
+		modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, "abc", 2, "", nil)
+
 		//THEN
-		_, err := pgRepository.List(ctx, tenantID, nil, 2, convertIntToBase64String(-3))
+		require.NoError(t, err)
+		require.Len(t, modelRuntimePage.Data, 1)
+		assert.Equal(t, runtime1ID, modelRuntimePage.Data[0].ID)
+		assert.Equal(t, 1, modelRuntimePage.TotalCount)
+	})
+
+	t.Run("Returns error when cursor is not correct", func(t *testing.T) {
+		//GIVEN
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := runtime.NewRepository()
+		//THEN
+		_, err := pgRepository.List(ctx, tenantID, nil, "", 2, convertIntToBase64String(-3), nil)
 
 		//THEN
-		require.EqualError(t, err, "while decoding page cursor: cursor is not correct")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decoding page cursor: cursor is not correct")
 	})
 }
 
@@ -168,23 +213,23 @@ func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntitie
 		AddRow(runtime1ID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, agentAuthStr).
 		AddRow(runtime2ID, tenantID, "Runtime XYZ", "Description for runtime XYZ", "INITIAL", timestamp, agentAuthStr)
 
-	filterQuery := fmt.Sprintf(`  AND "id" IN 
+	filterQuery := `  AND "id" IN 
 						\(SELECT "runtime_id" FROM public.labels 
 							WHERE "runtime_id" IS NOT NULL 
-							AND "tenant_id" = '%s' 
-							AND "key" = 'foo'\)`, tenantID)
+							AND "tenant_id" = \$1 
+							AND "key" = \$2\)`
 	sqlQuery := fmt.Sprintf(`^SELECT (.+) FROM public.runtimes 
-								WHERE tenant_id=\$1 %s ORDER BY id LIMIT %d OFFSET 0`, filterQuery, rowSize)
+								WHERE tenant_id=\$1 %s ORDER BY id LIMIT %d`, filterQuery, rowSize+1)
 
 	sqlMock.ExpectQuery(sqlQuery).
-		WithArgs(tenantID).
+		WithArgs(tenantID, "foo").
 		WillReturnRows(rows)
 
 	countRows := sqlMock.NewRows([]string{"count"}).AddRow(rowSize)
 
 	countQuery := fmt.Sprintf(`^SELECT COUNT\(\*\) FROM public.runtimes WHERE tenant_id=\$1 %s`, filterQuery)
 	sqlMock.ExpectQuery(countQuery).
-		WithArgs(tenantID).
+		WithArgs(tenantID, "foo").
 		WillReturnRows(countRows)
 
 	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
@@ -197,7 +242,7 @@ func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntitie
 	pgRepository := runtime.NewRepository()
 
 	// when
-	modelRuntimePage, err := pgRepository.List(ctx, tenantID, filter, rowSize, "")
+	modelRuntimePage, err := pgRepository.List(ctx, tenantID, filter, "", rowSize, "", nil)
 
 	//then
 	assert.NoError(t, err)
@@ -243,7 +288,7 @@ func TestPgRepository_Create_ShouldCreateRuntimeEntityFromValidModel(t *testing.
 	defer sqlMock.AssertExpectations(t)
 
 	sqlMock.ExpectExec(`^INSERT INTO public.runtimes \(.+\) VALUES \(.+\)$`).
-		WithArgs(modelRuntime.ID, modelRuntime.Tenant, modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, agentAuthStr).
+		WithArgs(modelRuntime.ID, modelRuntime.Tenant, modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, agentAuthStr, nil, nil).
 		WillReturnResult(sqlmock.NewResult(-1, 1))
 
 	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
@@ -282,13 +327,17 @@ func TestPgRepository_Update_ShouldUpdateRuntimeEntityFromValidModel(t *testing.
 				},
 			},
 		},
+		Certificate: &model.ClientCertificate{
+			SerialNumber: "1234",
+			ExpiresAt:    timestamp,
+		},
 	}
 
 	sqlxDB, sqlMock := testdb.MockDatabase(t)
 	defer sqlMock.AssertExpectations(t)
 
-	sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET name = ?, description = ?, status_condition = ?, status_timestamp = ? WHERE tenant_id = ? AND id = ?`)).
-		WithArgs(modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, modelRuntime.Tenant, modelRuntime.ID).
+	sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET name = ?, description = ?, status_condition = ?, status_timestamp = ?, certificate_serial_number = ?, certificate_expires_at = ? WHERE tenant_id = ? AND id = ?`)).
+		WithArgs(modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, modelRuntime.Certificate.SerialNumber, modelRuntime.Certificate.ExpiresAt, modelRuntime.Tenant, modelRuntime.ID).
 		WillReturnResult(sqlmock.NewResult(-1, 1))
 
 	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
@@ -350,6 +399,63 @@ func TestPgRepository_Exist(t *testing.T) {
 	assert.True(t, ex)
 }
 
+func TestPgRepository_ListIDs(t *testing.T) {
+	// given
+	runtime1ID := uuid.New().String()
+	runtime2ID := uuid.New().String()
+	tenantID := uuid.New().String()
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	sqlMock.ExpectQuery(`^SELECT "id" FROM public.runtimes WHERE "tenant_id" = \$1 AND "id" IN 
+						\(SELECT "runtime_id" FROM public.labels 
+							WHERE "runtime_id" IS NOT NULL 
+							AND "tenant_id" = \$1 
+							AND "key" = \$2\) ORDER BY "id"$`).
+		WithArgs(tenantID, "foo").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(runtime1ID).AddRow(runtime2ID))
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	pgRepository := runtime.NewRepository()
+
+	// when
+	ids, err := pgRepository.ListIDs(ctx, tenantID, []*labelfilter.LabelFilter{labelfilter.NewForKey("foo")})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{runtime1ID, runtime2ID}, ids)
+}
+
+func TestPgRepository_Matches(t *testing.T) {
+	// given
+	runtimeID := uuid.New().String()
+	tenantID := uuid.New().String()
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	sqlMock.ExpectQuery(`^SELECT EXISTS \(SELECT "id" FROM public.runtimes WHERE "tenant_id" = \$1 AND "id" = \$3 AND "id" IN 
+						\(SELECT "runtime_id" FROM public.labels 
+							WHERE "runtime_id" IS NOT NULL 
+							AND "tenant_id" = \$1 
+							AND "key" = \$2\)\)$`).
+		WithArgs(tenantID, "foo", runtimeID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	pgRepository := runtime.NewRepository()
+
+	// when
+	matches, err := pgRepository.Matches(ctx, tenantID, runtimeID, []*labelfilter.LabelFilter{labelfilter.NewForKey("foo")})
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, matches)
+}
+
 func convertIntToBase64String(number int) string {
 	return string(base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(number))))
 }
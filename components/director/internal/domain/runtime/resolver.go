@@ -11,6 +11,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
@@ -19,13 +21,16 @@ import (
 type RuntimeService interface {
 	Create(ctx context.Context, in model.RuntimeInput) (string, error)
 	Update(ctx context.Context, id string, in model.RuntimeInput) error
+	ReportPairing(ctx context.Context, id string, report model.PairingReport) error
 	Get(ctx context.Context, id string) (*model.Runtime, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, query search.Query, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.RuntimePage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, runtimeID string, key string) (*model.Label, error)
 	ListLabels(ctx context.Context, runtimeID string) (map[string]*model.Label, error)
 	DeleteLabel(ctx context.Context, runtimeID string, key string) error
+	SetLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, value interface{}, dryRun bool) ([]string, error)
+	DeleteLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, dryRun bool) ([]string, error)
 }
 
 //go:generate mockery -name=RuntimeConverter -output=automock -outpkg=automock -case=underscore
@@ -33,6 +38,7 @@ type RuntimeConverter interface {
 	ToGraphQL(in *model.Runtime) *graphql.Runtime
 	MultipleToGraphQL(in []*model.Runtime) []*graphql.Runtime
 	InputFromGraphQL(in graphql.RuntimeInput) model.RuntimeInput
+	PairingReportFromGraphQL(in graphql.PairingReportInput) model.PairingReport
 }
 
 type Resolver struct {
@@ -50,7 +56,7 @@ func NewResolver(transact persistence.Transactioner, svc RuntimeService, conv Ru
 }
 
 // TODO: Proper error handling
-func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, searchQuery *string, first *int, after *graphql.PageCursor, orderBy *graphql.RuntimeOrderBy) (*graphql.RuntimePage, error) {
 	labelFilter := labelfilter.MultipleFromGraphQL(filter)
 
 	var cursor string
@@ -70,7 +76,7 @@ func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter,
 		return nil, errors.New("missing required parameter 'first'")
 	}
 
-	runtimesPage, err := r.svc.List(ctx, labelFilter, *first, cursor)
+	runtimesPage, err := r.svc.List(ctx, labelFilter, search.FromGraphQL(searchQuery), *first, cursor, orderby.FromRuntimeGraphQL(orderBy))
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +182,37 @@ func (r *Resolver) UpdateRuntime(ctx context.Context, id string, in graphql.Runt
 	return gqlRuntime, nil
 }
 
+func (r *Resolver) ReportRuntimePairing(ctx context.Context, id string, in graphql.PairingReportInput) (*graphql.Runtime, error) {
+	convertedIn := r.converter.PairingReportFromGraphQL(in)
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = r.svc.ReportPairing(ctx, id, convertedIn)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	gqlRuntime := r.converter.ToGraphQL(runtime)
+
+	return gqlRuntime, nil
+}
+
 func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -265,6 +302,62 @@ func (r *Resolver) DeleteRuntimeLabel(ctx context.Context, runtimeID string, key
 	}, nil
 }
 
+func (r *Resolver) SetLabelForRuntimes(ctx context.Context, filter []*graphql.LabelFilter, key string, value interface{}, dryRun *bool) (*graphql.BulkLabelResult, error) {
+	isDryRun := dryRun != nil && *dryRun
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	ids, err := r.svc.SetLabelForMatching(ctx, labelfilter.MultipleFromGraphQL(filter), key, value, isDryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.BulkLabelResult{
+		Key:         key,
+		AffectedIDs: ids,
+		DryRun:      isDryRun,
+	}, nil
+}
+
+func (r *Resolver) DeleteLabelForRuntimes(ctx context.Context, filter []*graphql.LabelFilter, key string, dryRun *bool) (*graphql.BulkLabelResult, error) {
+	isDryRun := dryRun != nil && *dryRun
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	ids, err := r.svc.DeleteLabelForMatching(ctx, labelfilter.MultipleFromGraphQL(filter), key, isDryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.BulkLabelResult{
+		Key:         key,
+		AffectedIDs: ids,
+		DryRun:      isDryRun,
+	}, nil
+}
+
 func (r *Resolver) Labels(ctx context.Context, obj *graphql.Runtime, key *string) (graphql.Labels, error) {
 	if obj == nil {
 		return nil, errors.New("Runtime cannot be empty")
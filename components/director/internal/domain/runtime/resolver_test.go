@@ -3,6 +3,7 @@ package runtime_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 
@@ -13,10 +14,15 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var contextParam = mock.MatchedBy(func(ctx context.Context) bool {
@@ -301,6 +307,95 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 	}
 }
 
+func TestResolver_ReportRuntimePairing(t *testing.T) {
+	// given
+	modelRuntime := fixModelRuntime("foo", "tenant-foo", "Foo", "Lorem ipsum")
+	gqlRuntime := fixGQLRuntime("foo", "Foo", "Lorem ipsum")
+	testErr := errors.New("Test error")
+
+	serialNumber := "123"
+	expiresAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	gqlExpiresAt := graphql.Timestamp(expiresAt)
+	gqlInput := graphql.PairingReportInput{
+		Event:        graphql.PairingEventIssued,
+		SerialNumber: &serialNumber,
+		ExpiresAt:    &gqlExpiresAt,
+	}
+	modelInput := model.PairingReport{
+		Event:        model.PairingEventIssued,
+		SerialNumber: serialNumber,
+		ExpiresAt:    expiresAt,
+	}
+	runtimeID := "foo"
+
+	testCases := []struct {
+		Name            string
+		PersistenceFn   func() *persistenceautomock.PersistenceTx
+		ServiceFn       func() *automock.RuntimeService
+		ExpectedRuntime *graphql.Runtime
+		ExpectedErr     error
+	}{
+		{
+			Name: "Success",
+			PersistenceFn: func() *persistenceautomock.PersistenceTx {
+				persistTx := &persistenceautomock.PersistenceTx{}
+				persistTx.On("Commit").Return(nil).Once()
+				return persistTx
+			},
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("ReportPairing", contextParam, runtimeID, modelInput).Return(nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				return svc
+			},
+			ExpectedRuntime: gqlRuntime,
+			ExpectedErr:     nil,
+		},
+		{
+			Name: "Returns error when reporting pairing failed",
+			PersistenceFn: func() *persistenceautomock.PersistenceTx {
+				return &persistenceautomock.PersistenceTx{}
+			},
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("ReportPairing", contextParam, runtimeID, modelInput).Return(testErr).Once()
+				return svc
+			},
+			ExpectedRuntime: nil,
+			ExpectedErr:     testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx := testCase.PersistenceFn()
+			transact := &persistenceautomock.Transactioner{}
+			transact.On("Begin").Return(persistTx, nil).Once()
+			transact.On("RollbackUnlessCommited", persistTx).Return().Once()
+			svc := testCase.ServiceFn()
+			converter := &automock.RuntimeConverter{}
+			converter.On("PairingReportFromGraphQL", gqlInput).Return(modelInput).Once()
+			if testCase.ExpectedRuntime != nil {
+				converter.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
+			}
+
+			resolver := runtime.NewResolver(transact, svc, converter)
+
+			// when
+			result, err := resolver.ReportRuntimePairing(context.TODO(), runtimeID, gqlInput)
+
+			// then
+			assert.Equal(t, testCase.ExpectedRuntime, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+			persistTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+		})
+	}
+}
+
 func TestResolver_DeleteRuntime(t *testing.T) {
 	// given
 	modelRuntime := fixModelRuntime("foo", "tenant-foo", "Foo", "Bar")
@@ -538,7 +633,12 @@ func TestResolver_Runtimes(t *testing.T) {
 	gqlAfter := graphql.PageCursor("test")
 	after := "test"
 	filter := []*labelfilter.LabelFilter{{Key: ""}}
-	gqlFilter := []*graphql.LabelFilter{{Key: ""}}
+	gqlFilter := []*graphql.LabelFilter{{}}
+	gqlDesc := graphql.OrderDirectionDesc
+	gqlOrderBy := &graphql.RuntimeOrderBy{Field: graphql.RuntimeOrderFieldName, Direction: &gqlDesc}
+	orderBy := orderby.New(orderby.Name, pagination.DescOrderDirection)
+	gqlSearch := "billing"
+	searchQuery := search.Query("billing")
 	testErr := errors.New("Test error")
 
 	testCases := []struct {
@@ -569,7 +669,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			},
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("List", contextParam, filter, first, after).Return(fixRuntimePage(modelRuntimes), nil).Once()
+				svc.On("List", contextParam, filter, searchQuery, first, after, orderBy).Return(fixRuntimePage(modelRuntimes), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.RuntimeConverter {
@@ -598,7 +698,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			},
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("List", contextParam, filter, first, after).Return(nil, testErr).Once()
+				svc.On("List", contextParam, filter, searchQuery, first, after, orderBy).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.RuntimeConverter {
@@ -623,7 +723,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			resolver := runtime.NewResolver(transact, svc, converter)
 
 			// when
-			result, err := resolver.Runtimes(context.TODO(), testCase.InputLabelFilters, testCase.InputFirst, testCase.InputAfter)
+			result, err := resolver.Runtimes(context.TODO(), testCase.InputLabelFilters, &gqlSearch, testCase.InputFirst, testCase.InputAfter, gqlOrderBy)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -993,3 +1093,115 @@ func TestResolver_Labels(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_SetLabelForRuntimes(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	envKey := "env"
+	gqlFilter := []*graphql.LabelFilter{{Key: &envKey}}
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKey("env")}
+	ids := []string{"foo", "bar"}
+	dryRun := true
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		svc := &automock.RuntimeService{}
+		svc.On("SetLabelForMatching", contextParam, filter, "key", "value", false).Return(ids, nil).Once()
+		resolver := runtime.NewResolver(transact, svc, nil)
+
+		// when
+		result, err := resolver.SetLabelForRuntimes(context.TODO(), gqlFilter, "key", "value", nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.BulkLabelResult{Key: "key", AffectedIDs: ids, DryRun: false}, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+
+	t.Run("Success in dry run mode", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		svc := &automock.RuntimeService{}
+		svc.On("SetLabelForMatching", contextParam, filter, "key", "value", true).Return(ids, nil).Once()
+		resolver := runtime.NewResolver(transact, svc, nil)
+
+		// when
+		result, err := resolver.SetLabelForRuntimes(context.TODO(), gqlFilter, "key", "value", &dryRun)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.BulkLabelResult{Key: "key", AffectedIDs: ids, DryRun: true}, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+
+	t.Run("Returns error when setting labels failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		svc := &automock.RuntimeService{}
+		svc.On("SetLabelForMatching", contextParam, filter, "key", "value", false).Return(nil, testErr).Once()
+		resolver := runtime.NewResolver(transact, svc, nil)
+
+		// when
+		result, err := resolver.SetLabelForRuntimes(context.TODO(), gqlFilter, "key", "value", nil)
+
+		// then
+		assert.Equal(t, testErr, err)
+		assert.Nil(t, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+}
+
+func TestResolver_DeleteLabelForRuntimes(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	envKey := "env"
+	gqlFilter := []*graphql.LabelFilter{{Key: &envKey}}
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKey("env")}
+	ids := []string{"foo", "bar"}
+	dryRun := true
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		svc := &automock.RuntimeService{}
+		svc.On("DeleteLabelForMatching", contextParam, filter, "key", false).Return(ids, nil).Once()
+		resolver := runtime.NewResolver(transact, svc, nil)
+
+		// when
+		result, err := resolver.DeleteLabelForRuntimes(context.TODO(), gqlFilter, "key", nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.BulkLabelResult{Key: "key", AffectedIDs: ids, DryRun: false}, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+
+	t.Run("Success in dry run mode", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		svc := &automock.RuntimeService{}
+		svc.On("DeleteLabelForMatching", contextParam, filter, "key", true).Return(ids, nil).Once()
+		resolver := runtime.NewResolver(transact, svc, nil)
+
+		// when
+		result, err := resolver.DeleteLabelForRuntimes(context.TODO(), gqlFilter, "key", &dryRun)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.BulkLabelResult{Key: "key", AffectedIDs: ids, DryRun: true}, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+
+	t.Run("Returns error when deleting labels failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		svc := &automock.RuntimeService{}
+		svc.On("DeleteLabelForMatching", contextParam, filter, "key", false).Return(nil, testErr).Once()
+		resolver := runtime.NewResolver(transact, svc, nil)
+
+		// when
+		result, err := resolver.DeleteLabelForRuntimes(context.TODO(), gqlFilter, "key", nil)
+
+		// then
+		assert.Equal(t, testErr, err)
+		assert.Nil(t, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc)
+	})
+}
@@ -7,8 +7,11 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
 
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/pkg/errors"
 )
 
@@ -16,10 +19,11 @@ import (
 type RuntimeRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Runtime, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, query search.Query, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.RuntimePage, error)
 	Create(ctx context.Context, item *model.Runtime) error
 	Update(ctx context.Context, item *model.Runtime) error
 	Delete(ctx context.Context, tenant, id string) error
+	ListIDs(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]string, error)
 }
 
 //go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
@@ -34,6 +38,7 @@ type LabelRepository interface {
 type LabelUpsertService interface {
 	UpsertMultipleLabels(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, labels map[string]interface{}) error
 	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
+	ValidateLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
 }
 
 //go:generate mockery -name=ScenariosService -output=automock -outpkg=automock -case=underscore
@@ -41,6 +46,13 @@ type ScenariosService interface {
 	EnsureScenariosLabelDefinitionExists(ctx context.Context, tenant string) error
 }
 
+//go:generate mockery -name=ScenarioAssignmentEngine -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentEngine interface {
+	EvaluateForRuntime(ctx context.Context, tenant, runtimeID string) error
+	ReleaseForRuntime(ctx context.Context, tenant, runtimeID string, previous *model.Label) error
+	RestoreForRuntime(ctx context.Context, tenant, runtimeID string, previous *model.Label) error
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
@@ -50,16 +62,18 @@ type service struct {
 	repo      RuntimeRepository
 	labelRepo LabelRepository
 
-	labelUpsertService LabelUpsertService
-	uidService         UIDService
-	scenariosService   ScenariosService
+	labelUpsertService       LabelUpsertService
+	uidService               UIDService
+	scenariosService         ScenariosService
+	scenarioAssignmentEngine ScenarioAssignmentEngine
+	timestampGen             timestamp.Generator
 }
 
-func NewService(repo RuntimeRepository, labelRepo LabelRepository, scenariosService ScenariosService, labelUpsertService LabelUpsertService, uidService UIDService) *service {
-	return &service{repo: repo, labelRepo: labelRepo, scenariosService: scenariosService, labelUpsertService: labelUpsertService, uidService: uidService}
+func NewService(repo RuntimeRepository, labelRepo LabelRepository, scenariosService ScenariosService, labelUpsertService LabelUpsertService, scenarioAssignmentEngine ScenarioAssignmentEngine, uidService UIDService) *service {
+	return &service{repo: repo, labelRepo: labelRepo, scenariosService: scenariosService, labelUpsertService: labelUpsertService, scenarioAssignmentEngine: scenarioAssignmentEngine, uidService: uidService, timestampGen: timestamp.DefaultGenerator()}
 }
 
-func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
+func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, query search.Query, pageSize int, cursor string, orderBy *orderby.OrderBy) (*model.RuntimePage, error) {
 	rtmTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while loading tenant from context")
@@ -69,7 +83,7 @@ func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, p
 		return nil, errors.New("page size must be between 1 and 100")
 	}
 
-	return s.repo.List(ctx, rtmTenant, filter, pageSize, cursor)
+	return s.repo.List(ctx, rtmTenant, filter, query, pageSize, cursor, orderBy)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.Runtime, error) {
@@ -128,6 +142,11 @@ func (s *service) Create(ctx context.Context, in model.RuntimeInput) (string, er
 		return id, errors.Wrapf(err, "while creating multiple labels for Runtime")
 	}
 
+	err = s.scenarioAssignmentEngine.EvaluateForRuntime(ctx, rtmTenant, id)
+	if err != nil {
+		return id, errors.Wrap(err, "while assigning Runtime to scenarios")
+	}
+
 	return id, nil
 }
 
@@ -143,12 +162,14 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeInput)
 	}
 
 	currentStatuts := rtm.Status
+	currentCertificate := rtm.Certificate
 
 	rtm = in.ToRuntime(id, rtm.Tenant)
 
 	if rtm.Status.Condition == "" {
 		rtm.Status = currentStatuts
 	}
+	rtm.Certificate = currentCertificate
 
 	err = s.repo.Update(ctx, rtm)
 	if err != nil {
@@ -160,6 +181,11 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeInput)
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	previousScenarios, err := s.getScenariosLabel(ctx, rtmTenant, id)
+	if err != nil {
+		return err
+	}
+
 	err = s.labelRepo.DeleteAll(ctx, rtmTenant, model.RuntimeLabelableObject, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting all labels for Runtime")
@@ -170,6 +196,58 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeInput)
 		return errors.Wrapf(err, "while creating multiple labels for Runtime")
 	}
 
+	if _, ok := in.Labels[model.ScenariosKey]; ok {
+		err = s.scenarioAssignmentEngine.ReleaseForRuntime(ctx, rtmTenant, id, previousScenarios)
+		if err != nil {
+			return errors.Wrap(err, "while releasing Runtime scenarios set manually")
+		}
+	} else {
+		err = s.scenarioAssignmentEngine.RestoreForRuntime(ctx, rtmTenant, id, previousScenarios)
+		if err != nil {
+			return errors.Wrap(err, "while restoring Runtime scenarios assigned by rules")
+		}
+	}
+
+	err = s.scenarioAssignmentEngine.EvaluateForRuntime(ctx, rtmTenant, id)
+	if err != nil {
+		return errors.Wrap(err, "while assigning Runtime to scenarios")
+	}
+
+	return nil
+}
+
+// ReportPairing updates the Runtime status and client certificate metadata after the Connector issued, renewed or revoked its certificate.
+func (s *service) ReportPairing(ctx context.Context, id string, report model.PairingReport) error {
+	err := report.Validate()
+	if err != nil {
+		return errors.Wrap(err, "while validating pairing report")
+	}
+
+	rtm, err := s.Get(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "while getting Runtime")
+	}
+
+	if !report.Affects(rtm.Certificate) {
+		return nil
+	}
+
+	condition := model.RuntimeStatusConditionReady
+	if report.Event == model.PairingEventRevoked {
+		condition = model.RuntimeStatusConditionInitial
+	}
+
+	rtm.Status = &model.RuntimeStatus{
+		Condition: condition,
+		Timestamp: s.timestampGen(),
+	}
+	rtm.Certificate = report.Certificate()
+
+	err = s.repo.Update(ctx, rtm)
+	if err != nil {
+		return errors.Wrap(err, "while updating Runtime")
+	}
+
 	return nil
 }
 
@@ -203,11 +281,31 @@ func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) er
 		return fmt.Errorf("Runtime with ID %s doesn't exist", labelInput.ObjectID)
 	}
 
+	var previousScenarios *model.Label
+	if labelInput.Key == model.ScenariosKey {
+		previousScenarios, err = s.getScenariosLabel(ctx, rtmTenant, labelInput.ObjectID)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = s.labelUpsertService.UpsertLabel(ctx, rtmTenant, labelInput)
 	if err != nil {
 		return errors.Wrapf(err, "while creating label for Runtime")
 	}
 
+	if labelInput.Key == model.ScenariosKey {
+		err = s.scenarioAssignmentEngine.ReleaseForRuntime(ctx, rtmTenant, labelInput.ObjectID, previousScenarios)
+		if err != nil {
+			return errors.Wrap(err, "while releasing Runtime scenarios set manually")
+		}
+	}
+
+	err = s.scenarioAssignmentEngine.EvaluateForRuntime(ctx, rtmTenant, labelInput.ObjectID)
+	if err != nil {
+		return errors.Wrap(err, "while assigning Runtime to scenarios")
+	}
+
 	return nil
 }
 
@@ -275,5 +373,123 @@ func (s *service) DeleteLabel(ctx context.Context, runtimeID string, key string)
 		return errors.Wrapf(err, "while deleting Runtime label")
 	}
 
+	err = s.scenarioAssignmentEngine.EvaluateForRuntime(ctx, rtmTenant, runtimeID)
+	if err != nil {
+		return errors.Wrap(err, "while assigning Runtime to scenarios")
+	}
+
 	return nil
 }
+
+// SetLabelForMatching sets the label on every Runtime matching the label filter and returns their IDs.
+// In the dry run mode the label value is only validated and the IDs of Runtimes which would be labelled are returned.
+func (s *service) SetLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, value interface{}, dryRun bool) ([]string, error) {
+	rtmTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if len(filter) == 0 {
+		return nil, errors.New("label filter cannot be empty")
+	}
+
+	ids, err := s.repo.ListIDs(ctx, rtmTenant, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Runtimes matching label filter")
+	}
+
+	if dryRun {
+		err = s.labelUpsertService.ValidateLabel(ctx, rtmTenant, &model.LabelInput{
+			Key:        key,
+			Value:      value,
+			ObjectType: model.RuntimeLabelableObject,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "while validating label for Runtimes")
+		}
+
+		return ids, nil
+	}
+
+	for _, id := range ids {
+		var previousScenarios *model.Label
+		if key == model.ScenariosKey {
+			previousScenarios, err = s.getScenariosLabel(ctx, rtmTenant, id)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = s.labelUpsertService.UpsertLabel(ctx, rtmTenant, &model.LabelInput{
+			Key:        key,
+			Value:      value,
+			ObjectType: model.RuntimeLabelableObject,
+			ObjectID:   id,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "while creating label for Runtime %s", id)
+		}
+
+		if key == model.ScenariosKey {
+			err = s.scenarioAssignmentEngine.ReleaseForRuntime(ctx, rtmTenant, id, previousScenarios)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while releasing scenarios set manually for Runtime %s", id)
+			}
+		}
+
+		err = s.scenarioAssignmentEngine.EvaluateForRuntime(ctx, rtmTenant, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while assigning Runtime %s to scenarios", id)
+		}
+	}
+
+	return ids, nil
+}
+
+// DeleteLabelForMatching deletes the label from every Runtime matching the label filter which has it and returns their IDs.
+// In the dry run mode nothing is deleted and the IDs of Runtimes which would be affected are returned.
+func (s *service) DeleteLabelForMatching(ctx context.Context, filter []*labelfilter.LabelFilter, key string, dryRun bool) ([]string, error) {
+	rtmTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if len(filter) == 0 {
+		return nil, errors.New("label filter cannot be empty")
+	}
+
+	// only the Runtimes which have the label are affected
+	filterWithKey := append([]*labelfilter.LabelFilter{labelfilter.NewForKey(key)}, filter...)
+	ids, err := s.repo.ListIDs(ctx, rtmTenant, filterWithKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Runtimes matching label filter")
+	}
+
+	if dryRun {
+		return ids, nil
+	}
+
+	for _, id := range ids {
+		err = s.labelRepo.Delete(ctx, rtmTenant, model.RuntimeLabelableObject, id, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while deleting label from Runtime %s", id)
+		}
+
+		err = s.scenarioAssignmentEngine.EvaluateForRuntime(ctx, rtmTenant, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while assigning Runtime %s to scenarios", id)
+		}
+	}
+
+	return ids, nil
+}
+
+// getScenariosLabel returns the scenarios label of the Runtime, or nil if the Runtime has none
+func (s *service) getScenariosLabel(ctx context.Context, tenant, runtimeID string) (*model.Label, error) {
+	labels, err := s.labelRepo.ListForObject(ctx, tenant, model.RuntimeLabelableObject, runtimeID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing labels for Runtime with ID %s", runtimeID)
+	}
+
+	return labels[model.ScenariosKey], nil
+}
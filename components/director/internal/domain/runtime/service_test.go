@@ -3,11 +3,14 @@ package runtime_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
+	"github.com/kyma-incubator/compass/components/director/internal/search"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
@@ -39,13 +42,14 @@ func TestService_Create(t *testing.T) {
 	ctx = tenant.SaveToContext(ctx, tnt)
 
 	testCases := []struct {
-		Name                 string
-		RuntimeRepositoryFn  func() *automock.RuntimeRepository
-		ScenariosServiceFn   func() *automock.ScenariosService
-		LabelUpsertServiceFn func() *automock.LabelUpsertService
-		UIDServiceFn         func() *automock.UIDService
-		Input                model.RuntimeInput
-		ExpectedErr          error
+		Name                       string
+		RuntimeRepositoryFn        func() *automock.RuntimeRepository
+		ScenariosServiceFn         func() *automock.ScenariosService
+		LabelUpsertServiceFn       func() *automock.LabelUpsertService
+		UIDServiceFn               func() *automock.UIDService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		Input                      model.RuntimeInput
+		ExpectedErr                error
 	}{
 		{
 			Name: "Success",
@@ -69,6 +73,11 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return(id)
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForRuntime", ctx, tnt, id).Return(nil).Once()
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: nil,
 		},
@@ -93,6 +102,9 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return(id)
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
@@ -114,6 +126,9 @@ func TestService_Create(t *testing.T) {
 				svc := &automock.UIDService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			Input:       model.RuntimeInput{Name: ""},
 			ExpectedErr: errors.New("a DNS-1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character")},
 		{
@@ -134,6 +149,9 @@ func TestService_Create(t *testing.T) {
 				svc := &automock.UIDService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			Input:       model.RuntimeInput{Name: "upperCase"},
 			ExpectedErr: errors.New("a DNS-1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character"),
 		},
@@ -157,6 +175,39 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return("").Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			Input:       modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when assigning Runtime to scenarios failed",
+			RuntimeRepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("Create", ctx, runtimeModel).Return(nil).Once()
+				return repo
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				repo := &automock.ScenariosService{}
+				repo.On("EnsureScenariosLabelDefinitionExists", contextThatHasTenant(tnt), tnt).Return(nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				repo := &automock.LabelUpsertService{}
+				repo.On("UpsertMultipleLabels", ctx, "tenant", model.RuntimeLabelableObject, id, modelInput.Labels).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id)
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForRuntime", ctx, tnt, id).Return(testErr).Once()
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
@@ -168,7 +219,8 @@ func TestService_Create(t *testing.T) {
 			idSvc := testCase.UIDServiceFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
 			scenariosSvc := testCase.ScenariosServiceFn()
-			svc := runtime.NewService(repo, nil, scenariosSvc, labelSvc, idSvc)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, nil, scenariosSvc, labelSvc, engine, idSvc)
 
 			// when
 			result, err := svc.Create(ctx, testCase.Input)
@@ -185,6 +237,7 @@ func TestService_Create(t *testing.T) {
 			idSvc.AssertExpectations(t)
 			labelSvc.AssertExpectations(t)
 			scenariosSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 }
@@ -202,6 +255,13 @@ func TestService_Update(t *testing.T) {
 		},
 	}
 
+	scenariosInput := model.RuntimeInput{
+		Name: "bar",
+		Labels: map[string]interface{}{
+			model.ScenariosKey: []interface{}{"DEFAULT"},
+		},
+	}
+
 	inputRuntimeModel := mock.MatchedBy(func(rtm *model.Runtime) bool {
 		return rtm.Name == modelInput.Name
 	})
@@ -216,14 +276,18 @@ func TestService_Update(t *testing.T) {
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tnt)
 
+	previousScenarios := &model.Label{ID: "label", Tenant: tnt, Key: model.ScenariosKey, Value: []interface{}{"DEFAULT", "assigned"}, ObjectID: "foo", ObjectType: model.RuntimeLabelableObject}
+	previousLabels := map[string]*model.Label{model.ScenariosKey: previousScenarios}
+
 	testCases := []struct {
-		Name                 string
-		RepositoryFn         func() *automock.RuntimeRepository
-		LabelRepositoryFn    func() *automock.LabelRepository
-		LabelUpsertServiceFn func() *automock.LabelUpsertService
-		Input                model.RuntimeInput
-		InputID              string
-		ExpectedErrMessage   string
+		Name                       string
+		RepositoryFn               func() *automock.RuntimeRepository
+		LabelRepositoryFn          func() *automock.LabelRepository
+		LabelUpsertServiceFn       func() *automock.LabelUpsertService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		Input                      model.RuntimeInput
+		InputID                    string
+		ExpectedErrMessage         string
 	}{
 		{
 			Name: "Success",
@@ -235,6 +299,7 @@ func TestService_Update(t *testing.T) {
 			},
 			LabelRepositoryFn: func() *automock.LabelRepository {
 				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID).Return(previousLabels, nil).Once()
 				repo.On("DeleteAll", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID).Return(nil).Once()
 				return repo
 			},
@@ -243,10 +308,96 @@ func TestService_Update(t *testing.T) {
 				repo.On("UpsertMultipleLabels", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID, modelInput.Labels).Return(nil).Once()
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("RestoreForRuntime", ctx, tnt, "foo", previousScenarios).Return(nil).Once()
+				engine.On("EvaluateForRuntime", ctx, tnt, "foo").Return(nil).Once()
+				return engine
+			},
 			InputID:            "foo",
 			Input:              modelInput,
 			ExpectedErrMessage: "",
 		},
+		{
+			Name: "Success for scenarios label set manually",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, "foo").Return(runtimeModel, nil).Once()
+				repo.On("Update", ctx, inputRuntimeModel).Return(nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID).Return(previousLabels, nil).Once()
+				repo.On("DeleteAll", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID).Return(nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				repo := &automock.LabelUpsertService{}
+				repo.On("UpsertMultipleLabels", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID, scenariosInput.Labels).Return(nil).Once()
+				return repo
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("ReleaseForRuntime", ctx, tnt, "foo", previousScenarios).Return(nil).Once()
+				engine.On("EvaluateForRuntime", ctx, tnt, "foo").Return(nil).Once()
+				return engine
+			},
+			InputID:            "foo",
+			Input:              scenariosInput,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when listing labels failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, "foo").Return(runtimeModel, nil).Once()
+				repo.On("Update", ctx, inputRuntimeModel).Return(nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputID:            "foo",
+			Input:              modelInput,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when restoring scenarios assigned by rules failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, "foo").Return(runtimeModel, nil).Once()
+				repo.On("Update", ctx, inputRuntimeModel).Return(nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID).Return(previousLabels, nil).Once()
+				repo.On("DeleteAll", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID).Return(nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				repo := &automock.LabelUpsertService{}
+				repo.On("UpsertMultipleLabels", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID, modelInput.Labels).Return(nil).Once()
+				return repo
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("RestoreForRuntime", ctx, tnt, "foo", previousScenarios).Return(testErr).Once()
+				return engine
+			},
+			InputID:            "foo",
+			Input:              modelInput,
+			ExpectedErrMessage: testErr.Error(),
+		},
 		{
 			Name: "Returns error when name is empty",
 			RepositoryFn: func() *automock.RuntimeRepository {
@@ -261,6 +412,9 @@ func TestService_Update(t *testing.T) {
 				repo := &automock.LabelUpsertService{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			Input:              model.RuntimeInput{Name: ""},
 			ExpectedErrMessage: "a DNS-1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character",
 		},
@@ -280,6 +434,9 @@ func TestService_Update(t *testing.T) {
 				repo := &automock.LabelUpsertService{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			InputID:            "foo",
 			Input:              modelInput,
 			ExpectedErrMessage: testErr.Error(),
@@ -299,6 +456,9 @@ func TestService_Update(t *testing.T) {
 				repo := &automock.LabelUpsertService{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			InputID:            "foo",
 			Input:              modelInput,
 			ExpectedErrMessage: testErr.Error(),
@@ -310,7 +470,8 @@ func TestService_Update(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
-			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, engine, nil)
 
 			// when
 			err := svc.Update(ctx, testCase.InputID, testCase.Input)
@@ -325,6 +486,162 @@ func TestService_Update(t *testing.T) {
 			repo.AssertExpectations(t)
 			labelRepo.AssertExpectations(t)
 			labelSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ReportPairing(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	tnt := "tenant"
+	timestamp := time.Now()
+	expiresAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+
+	fixRuntime := func() *model.Runtime {
+		return &model.Runtime{
+			ID:     id,
+			Tenant: tnt,
+			Name:   "Foo",
+			Status: &model.RuntimeStatus{
+				Condition: model.RuntimeStatusConditionInitial,
+			},
+			Certificate: &model.ClientCertificate{
+				SerialNumber: "old",
+				ExpiresAt:    expiresAt,
+			},
+		}
+	}
+
+	issuedReport := model.PairingReport{Event: model.PairingEventIssued, SerialNumber: "123", ExpiresAt: expiresAt}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.RuntimeRepository
+		Input              model.PairingReport
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success for issued certificate",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixRuntime(), nil).Once()
+				repo.On("Update", ctx, &model.Runtime{
+					ID:     id,
+					Tenant: tnt,
+					Name:   "Foo",
+					Status: &model.RuntimeStatus{
+						Condition: model.RuntimeStatusConditionReady,
+						Timestamp: timestamp,
+					},
+					Certificate: &model.ClientCertificate{
+						SerialNumber: "123",
+						ExpiresAt:    expiresAt,
+					},
+				}).Return(nil).Once()
+				return repo
+			},
+			Input: issuedReport,
+		},
+		{
+			Name: "Success for revoked certificate",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixRuntime(), nil).Once()
+				repo.On("Update", ctx, &model.Runtime{
+					ID:     id,
+					Tenant: tnt,
+					Name:   "Foo",
+					Status: &model.RuntimeStatus{
+						Condition: model.RuntimeStatusConditionInitial,
+						Timestamp: timestamp,
+					},
+				}).Return(nil).Once()
+				return repo
+			},
+			Input: model.PairingReport{Event: model.PairingEventRevoked},
+		},
+		{
+			Name: "Success for revoked current certificate",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixRuntime(), nil).Once()
+				repo.On("Update", ctx, &model.Runtime{
+					ID:     id,
+					Tenant: tnt,
+					Name:   "Foo",
+					Status: &model.RuntimeStatus{
+						Condition: model.RuntimeStatusConditionInitial,
+						Timestamp: timestamp,
+					},
+				}).Return(nil).Once()
+				return repo
+			},
+			Input: model.PairingReport{Event: model.PairingEventRevoked, SerialNumber: "old"},
+		},
+		{
+			Name: "Ignores revocation of other than current certificate",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixRuntime(), nil).Once()
+				return repo
+			},
+			Input: model.PairingReport{Event: model.PairingEventRevoked, SerialNumber: "other"},
+		},
+		{
+			Name: "Returns error when report is invalid",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				return &automock.RuntimeRepository{}
+			},
+			Input:              model.PairingReport{Event: model.PairingEventRenewed},
+			ExpectedErrMessage: "while validating pairing report",
+		},
+		{
+			Name: "Returns error when runtime retrieval failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(nil, testErr).Once()
+				return repo
+			},
+			Input:              issuedReport,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when runtime update failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixRuntime(), nil).Once()
+				repo.On("Update", ctx, mock.Anything).Return(testErr).Once()
+				return repo
+			},
+			Input:              issuedReport,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			// when
+			err := svc.ReportPairing(ctx, id, testCase.Input)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
 		})
 	}
 }
@@ -380,7 +697,7 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := runtime.NewService(repo, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -450,7 +767,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
 
 			// when
 			rtm, err := svc.Get(ctx, testCase.InputID)
@@ -489,6 +806,8 @@ func TestService_List(t *testing.T) {
 	first := 2
 	after := "test"
 	filter := []*labelfilter.LabelFilter{{Key: ""}}
+	orderBy := orderby.New(orderby.Name, pagination.AscOrderDirection)
+	searchQuery := search.Query("billing")
 
 	tnt := "tenant"
 
@@ -508,7 +827,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(runtimePage, nil).Once()
+				repo.On("List", ctx, tnt, filter, searchQuery, first, after, orderBy).Return(runtimePage, nil).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -521,7 +840,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when runtime listing failed",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(nil, testErr).Once()
+				repo.On("List", ctx, tnt, filter, searchQuery, first, after, orderBy).Return(nil, testErr).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -560,10 +879,10 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
 
 			// when
-			rtm, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, testCase.InputCursor)
+			rtm, err := svc.List(ctx, testCase.InputLabelFilters, searchQuery, testCase.InputPageSize, testCase.InputCursor, orderBy)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -672,7 +991,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil)
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.GetLabel(ctx, testCase.InputApplicationID, testCase.InputLabel.Key)
@@ -786,7 +1105,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil)
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.ListLabels(ctx, testCase.InputApplicationID)
@@ -822,13 +1141,25 @@ func TestService_SetLabel(t *testing.T) {
 		ObjectType: model.RuntimeLabelableObject,
 	}
 
+	scenariosLabel := model.LabelInput{
+		Key:        model.ScenariosKey,
+		Value:      []interface{}{"DEFAULT"},
+		ObjectID:   runtimeID,
+		ObjectType: model.RuntimeLabelableObject,
+	}
+
+	previousScenarios := &model.Label{ID: "label", Tenant: tnt, Key: model.ScenariosKey, Value: []interface{}{"assigned"}, ObjectID: runtimeID, ObjectType: model.RuntimeLabelableObject}
+	previousLabels := map[string]*model.Label{model.ScenariosKey: previousScenarios}
+
 	testCases := []struct {
-		Name                 string
-		RepositoryFn         func() *automock.RuntimeRepository
-		LabelUpsertServiceFn func() *automock.LabelUpsertService
-		InputRuntimeID       string
-		InputLabel           *model.LabelInput
-		ExpectedErrMessage   string
+		Name                       string
+		RepositoryFn               func() *automock.RuntimeRepository
+		LabelRepositoryFn          func() *automock.LabelRepository
+		LabelUpsertServiceFn       func() *automock.LabelUpsertService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		InputRuntimeID             string
+		InputLabel                 *model.LabelInput
+		ExpectedErrMessage         string
 	}{
 		{
 			Name: "Success",
@@ -837,15 +1168,76 @@ func TestService_SetLabel(t *testing.T) {
 				repo.On("Exists", ctx, tnt, runtimeID).Return(true, nil).Once()
 				return repo
 			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				svc := &automock.LabelUpsertService{}
 				svc.On("UpsertLabel", ctx, tnt, &modelLabel).Return(nil).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForRuntime", ctx, tnt, runtimeID).Return(nil).Once()
+				return engine
+			},
 			InputRuntimeID:     runtimeID,
 			InputLabel:         &modelLabel,
 			ExpectedErrMessage: "",
 		},
+		{
+			Name: "Success for scenarios label set manually",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("Exists", ctx, tnt, runtimeID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeID).Return(previousLabels, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, &scenariosLabel).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("ReleaseForRuntime", ctx, tnt, runtimeID, previousScenarios).Return(nil).Once()
+				engine.On("EvaluateForRuntime", ctx, tnt, runtimeID).Return(nil).Once()
+				return engine
+			},
+			InputRuntimeID:     runtimeID,
+			InputLabel:         &scenariosLabel,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when releasing scenarios set manually failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("Exists", ctx, tnt, runtimeID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeID).Return(previousLabels, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, &scenariosLabel).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("ReleaseForRuntime", ctx, tnt, runtimeID, previousScenarios).Return(testErr).Once()
+				return engine
+			},
+			InputRuntimeID:     runtimeID,
+			InputLabel:         &scenariosLabel,
+			ExpectedErrMessage: testErr.Error(),
+		},
 		{
 			Name: "Returns error when runtime update failed",
 			RepositoryFn: func() *automock.RuntimeRepository {
@@ -853,11 +1245,17 @@ func TestService_SetLabel(t *testing.T) {
 				repo.On("Exists", ctx, tnt, runtimeID).Return(true, nil).Once()
 				return repo
 			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				svc := &automock.LabelUpsertService{}
 				svc.On("UpsertLabel", ctx, tnt, &modelLabel).Return(testErr).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			InputRuntimeID:     runtimeID,
 			InputLabel:         &modelLabel,
 			ExpectedErrMessage: testErr.Error(),
@@ -869,21 +1267,75 @@ func TestService_SetLabel(t *testing.T) {
 				repo.On("Exists", ctx, tnt, runtimeID).Return(false, testErr).Once()
 				return repo
 			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputRuntimeID:     runtimeID,
+			InputLabel:         &modelLabel,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when assigning Runtime to scenarios failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("Exists", ctx, tnt, runtimeID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, &modelLabel).Return(nil).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForRuntime", ctx, tnt, runtimeID).Return(testErr).Once()
+				return engine
+			},
 			InputRuntimeID:     runtimeID,
 			InputLabel:         &modelLabel,
 			ExpectedErrMessage: testErr.Error(),
 		},
+		{
+			Name: "Returns error when listing labels failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("Exists", ctx, tnt, runtimeID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeID).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputRuntimeID:     runtimeID,
+			InputLabel:         &scenariosLabel,
+			ExpectedErrMessage: testErr.Error(),
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
+			labelRepo := testCase.LabelRepositoryFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
-			svc := runtime.NewService(repo, nil, nil, labelSvc, nil)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, engine, nil)
 
 			// when
 			err := svc.SetLabel(ctx, testCase.InputLabel)
@@ -896,7 +1348,9 @@ func TestService_SetLabel(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
 			labelSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 }
@@ -914,12 +1368,13 @@ func TestService_DeleteLabel(t *testing.T) {
 	labelKey := "key"
 
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.RuntimeRepository
-		LabelRepositoryFn  func() *automock.LabelRepository
-		InputRuntimeID     string
-		InputKey           string
-		ExpectedErrMessage string
+		Name                       string
+		RepositoryFn               func() *automock.RuntimeRepository
+		LabelRepositoryFn          func() *automock.LabelRepository
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		InputRuntimeID             string
+		InputKey                   string
+		ExpectedErrMessage         string
 	}{
 		{
 			Name: "Success",
@@ -933,6 +1388,11 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo.On("Delete", ctx, tnt, model.RuntimeLabelableObject, runtimeID, labelKey).Return(nil).Once()
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForRuntime", ctx, tnt, runtimeID).Return(nil).Once()
+				return engine
+			},
 			InputRuntimeID:     runtimeID,
 			InputKey:           labelKey,
 			ExpectedErrMessage: "",
@@ -949,6 +1409,9 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo.On("Delete", ctx, tnt, model.RuntimeLabelableObject, runtimeID, labelKey).Return(testErr).Once()
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			InputRuntimeID:     runtimeID,
 			InputKey:           labelKey,
 			ExpectedErrMessage: testErr.Error(),
@@ -964,6 +1427,9 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo := &automock.LabelRepository{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			InputRuntimeID:     runtimeID,
 			InputKey:           labelKey,
 			ExpectedErrMessage: testErr.Error(),
@@ -974,7 +1440,8 @@ func TestService_DeleteLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, labelRepo, nil, nil, engine, nil)
 
 			// when
 			err := svc.DeleteLabel(ctx, testCase.InputRuntimeID, testCase.InputKey)
@@ -988,6 +1455,7 @@ func TestService_DeleteLabel(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 }
@@ -1001,3 +1469,341 @@ func contextThatHasTenant(expectedTenant string) interface{} {
 		return actualTenant == expectedTenant
 	})
 }
+
+func TestService_SetLabelForMatching(t *testing.T) {
+	// given
+	tnt := "tenant"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+
+	testErr := errors.New("Test error")
+
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKey("env")}
+	ids := []string{"foo", "bar"}
+	value := []interface{}{"value1"}
+
+	labelInput := func(id string) *model.LabelInput {
+		return &model.LabelInput{
+			Key:        "key",
+			Value:      value,
+			ObjectID:   id,
+			ObjectType: model.RuntimeLabelableObject,
+		}
+	}
+
+	testCases := []struct {
+		Name                       string
+		RepositoryFn               func() *automock.RuntimeRepository
+		LabelServiceFn             func() *automock.LabelUpsertService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		InputFilter                []*labelfilter.LabelFilter
+		InputDryRun                bool
+		ExpectedIDs                []string
+		ExpectedErrMessage         string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(ids, nil).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, labelInput("foo")).Return(nil).Once()
+				svc.On("UpsertLabel", ctx, tnt, labelInput("bar")).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForRuntime", ctx, tnt, "foo").Return(nil).Once()
+				engine.On("EvaluateForRuntime", ctx, tnt, "bar").Return(nil).Once()
+				return engine
+			},
+			InputFilter:        filter,
+			InputDryRun:        false,
+			ExpectedIDs:        ids,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Success in dry run mode",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(ids, nil).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("ValidateLabel", ctx, tnt, labelInput("")).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputFilter:        filter,
+			InputDryRun:        true,
+			ExpectedIDs:        ids,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when label value is invalid in dry run mode",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(ids, nil).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("ValidateLabel", ctx, tnt, labelInput("")).Return(testErr).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputFilter:        filter,
+			InputDryRun:        true,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when label set failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(ids, nil).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, labelInput("foo")).Return(testErr).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputFilter:        filter,
+			InputDryRun:        false,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing Runtimes failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, tnt, filter).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputFilter:        filter,
+			InputDryRun:        false,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when filter is empty",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputFilter:        nil,
+			InputDryRun:        false,
+			ExpectedErrMessage: "label filter cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelSvc := testCase.LabelServiceFn()
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, nil, nil, labelSvc, engine, nil)
+
+			// when
+			result, err := svc.SetLabelForMatching(ctx, testCase.InputFilter, "key", value, testCase.InputDryRun)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedIDs, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+			labelSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Success for scenarios label set manually", func(t *testing.T) {
+		repo := &automock.RuntimeRepository{}
+		repo.On("ListIDs", ctx, tnt, filter).Return([]string{"foo"}, nil).Once()
+		labelSvc := &automock.LabelUpsertService{}
+		labelSvc.On("UpsertLabel", ctx, tnt, &model.LabelInput{
+			Key:        model.ScenariosKey,
+			Value:      value,
+			ObjectID:   "foo",
+			ObjectType: model.RuntimeLabelableObject,
+		}).Return(nil).Once()
+		previousScenarios := &model.Label{ID: "label", Tenant: tnt, Key: model.ScenariosKey, Value: []interface{}{"assigned"}, ObjectID: "foo", ObjectType: model.RuntimeLabelableObject}
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObject", ctx, tnt, model.RuntimeLabelableObject, "foo").Return(map[string]*model.Label{model.ScenariosKey: previousScenarios}, nil).Once()
+		engine := &automock.ScenarioAssignmentEngine{}
+		engine.On("ReleaseForRuntime", ctx, tnt, "foo", previousScenarios).Return(nil).Once()
+		engine.On("EvaluateForRuntime", ctx, tnt, "foo").Return(nil).Once()
+		svc := runtime.NewService(repo, labelRepo, nil, labelSvc, engine, nil)
+
+		// when
+		result, err := svc.SetLabelForMatching(ctx, filter, model.ScenariosKey, value, false)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo"}, result)
+
+		repo.AssertExpectations(t)
+		labelRepo.AssertExpectations(t)
+		labelSvc.AssertExpectations(t)
+		engine.AssertExpectations(t)
+	})
+}
+
+func TestService_DeleteLabelForMatching(t *testing.T) {
+	// given
+	tnt := "tenant"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+
+	testErr := errors.New("Test error")
+
+	labelKey := "key"
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKey("env")}
+	filterWithKey := []*labelfilter.LabelFilter{labelfilter.NewForKey(labelKey), labelfilter.NewForKey("env")}
+	ids := []string{"foo", "bar"}
+
+	testCases := []struct {
+		Name                       string
+		RepositoryFn               func() *automock.RuntimeRepository
+		LabelRepositoryFn          func() *automock.LabelRepository
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		InputKey                   string
+		InputDryRun                bool
+		ExpectedIDs                []string
+		ExpectedErrMessage         string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, tnt, filterWithKey).Return(ids, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("Delete", ctx, tnt, model.RuntimeLabelableObject, "foo", labelKey).Return(nil).Once()
+				repo.On("Delete", ctx, tnt, model.RuntimeLabelableObject, "bar", labelKey).Return(nil).Once()
+				return repo
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForRuntime", ctx, tnt, "foo").Return(nil).Once()
+				engine.On("EvaluateForRuntime", ctx, tnt, "bar").Return(nil).Once()
+				return engine
+			},
+			InputKey:           labelKey,
+			InputDryRun:        false,
+			ExpectedIDs:        ids,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Success in dry run mode",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, tnt, filterWithKey).Return(ids, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				return repo
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputKey:           labelKey,
+			InputDryRun:        true,
+			ExpectedIDs:        ids,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when label delete failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, tnt, filterWithKey).Return(ids, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("Delete", ctx, tnt, model.RuntimeLabelableObject, "foo", labelKey).Return(testErr).Once()
+				return repo
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputKey:           labelKey,
+			InputDryRun:        false,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing Runtimes failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, tnt, filterWithKey).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				return repo
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			InputKey:           labelKey,
+			InputDryRun:        false,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelRepo := testCase.LabelRepositoryFn()
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, labelRepo, nil, nil, engine, nil)
+
+			// when
+			result, err := svc.DeleteLabelForMatching(ctx, filter, testCase.InputKey, testCase.InputDryRun)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedIDs, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+			engine.AssertExpectations(t)
+		})
+	}
+}
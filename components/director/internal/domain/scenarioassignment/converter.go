@@ -0,0 +1,76 @@
+package scenarioassignment
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.ScenarioAssignmentRule) *graphql.ScenarioAssignmentRule {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.ScenarioAssignmentRule{
+		ID:       in.ID,
+		Scenario: in.Scenario,
+		Selector: &graphql.LabelSelector{
+			Key:   in.Selector.Key,
+			Query: in.Selector.Query,
+		},
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.ScenarioAssignmentRule) []*graphql.ScenarioAssignmentRule {
+	rules := make([]*graphql.ScenarioAssignmentRule, 0, len(in))
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+
+		rules = append(rules, c.ToGraphQL(r))
+	}
+
+	return rules
+}
+
+func (c *converter) InputFromGraphQL(in graphql.ScenarioAssignmentRuleInput) model.ScenarioAssignmentRuleInput {
+	var selector model.LabelSelector
+	if in.Selector != nil {
+		selector.Key = in.Selector.Key
+		selector.Query = in.Selector.Query
+	}
+
+	return model.ScenarioAssignmentRuleInput{
+		Scenario: in.Scenario,
+		Selector: selector,
+	}
+}
+
+func (c *converter) ToEntity(in model.ScenarioAssignmentRule) Entity {
+	return Entity{
+		ID:            in.ID,
+		TenantID:      in.Tenant,
+		Scenario:      in.Scenario,
+		SelectorKey:   in.Selector.Key,
+		SelectorQuery: repo.NewNullableString(in.Selector.Query),
+	}
+}
+
+func (c *converter) FromEntity(in Entity) model.ScenarioAssignmentRule {
+	return model.ScenarioAssignmentRule{
+		ID:       in.ID,
+		Tenant:   in.TenantID,
+		Scenario: in.Scenario,
+		Selector: model.LabelSelector{
+			Key:   in.SelectorKey,
+			Query: repo.StringPtrFromNullableString(in.SelectorQuery),
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package scenarioassignment_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    *model.ScenarioAssignmentRule
+		Expected *graphql.ScenarioAssignmentRule
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixModelRule(testRuleID, testScenario, testKey, str(testQuery)),
+			Expected: fixGQLRule(testRuleID, testScenario, testKey, str(testQuery)),
+		},
+		{
+			Name:     "Without query",
+			Input:    fixModelRule(testRuleID, testScenario, testKey, nil),
+			Expected: fixGQLRule(testRuleID, testScenario, testKey, nil),
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			converter := scenarioassignment.NewConverter()
+
+			// when
+			res := converter.ToGraphQL(testCase.Input)
+
+			// then
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// given
+	input := []*model.ScenarioAssignmentRule{
+		fixModelRule("foo", testScenario, testKey, nil),
+		fixModelRule("bar", testScenario, testKey, str(testQuery)),
+		nil,
+	}
+	expected := []*graphql.ScenarioAssignmentRule{
+		fixGQLRule("foo", testScenario, testKey, nil),
+		fixGQLRule("bar", testScenario, testKey, str(testQuery)),
+	}
+	converter := scenarioassignment.NewConverter()
+
+	// when
+	res := converter.MultipleToGraphQL(input)
+
+	// then
+	assert.Equal(t, expected, res)
+}
+
+func TestConverter_InputFromGraphQL(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    graphql.ScenarioAssignmentRuleInput
+		Expected model.ScenarioAssignmentRuleInput
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixGQLRuleInput(testScenario, testKey, str(testQuery)),
+			Expected: fixModelRuleInput(testScenario, testKey, str(testQuery)),
+		},
+		{
+			Name:     "Without selector",
+			Input:    graphql.ScenarioAssignmentRuleInput{Scenario: testScenario},
+			Expected: model.ScenarioAssignmentRuleInput{Scenario: testScenario},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			converter := scenarioassignment.NewConverter()
+
+			// when
+			res := converter.InputFromGraphQL(testCase.Input)
+
+			// then
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_EntityRoundTrip(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Model  model.ScenarioAssignmentRule
+		Entity scenarioassignment.Entity
+	}{
+		{
+			Name:   "With query",
+			Model:  *fixModelRule(testRuleID, testScenario, testKey, str(testQuery)),
+			Entity: fixEntity(str(testQuery)),
+		},
+		{
+			Name:   "Without query",
+			Model:  *fixModelRule(testRuleID, testScenario, testKey, nil),
+			Entity: fixEntity(nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			converter := scenarioassignment.NewConverter()
+
+			// when
+			entity := converter.ToEntity(testCase.Model)
+			rule := converter.FromEntity(entity)
+
+			// then
+			assert.Equal(t, testCase.Entity, entity)
+			assert.Equal(t, testCase.Model, rule)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package scenarioassignment
+
+import "database/sql"
+
+type Entity struct {
+	ID            string         `db:"id"`
+	TenantID      string         `db:"tenant_id"`
+	Scenario      string         `db:"scenario"`
+	SelectorKey   string         `db:"selector_key"`
+	SelectorQuery sql.NullString `db:"selector_query"`
+}
+
+type Collection []Entity
+
+func (c Collection) Len() int {
+	return len(c)
+}
+
+// AssignmentEntity is the scenario added to the scenarios label of the Runtime by the assignment rules
+type AssignmentEntity struct {
+	TenantID  string `db:"tenant_id"`
+	RuntimeID string `db:"runtime_id"`
+	Scenario  string `db:"scenario"`
+}
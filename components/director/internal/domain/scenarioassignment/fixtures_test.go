@@ -0,0 +1,76 @@
+package scenarioassignment_test
+
+import (
+	"database/sql"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	testTenant   = "6f84ee70-ebb6-42b4-9a2b-ce6bb7e9b8b4"
+	testRuleID   = "b7f2e6fe-ff4e-4c45-a33e-68e8d3a30bd3"
+	testScenario = "EU_APPS"
+	testKey      = "region"
+	testQuery    = `$[*] ? (@ == "eu")`
+)
+
+func fixModelRule(id, scenario, key string, query *string) *model.ScenarioAssignmentRule {
+	return &model.ScenarioAssignmentRule{
+		ID:       id,
+		Tenant:   testTenant,
+		Scenario: scenario,
+		Selector: model.LabelSelector{Key: key, Query: query},
+	}
+}
+
+func fixModelRuleInput(scenario, key string, query *string) model.ScenarioAssignmentRuleInput {
+	return model.ScenarioAssignmentRuleInput{
+		Scenario: scenario,
+		Selector: model.LabelSelector{Key: key, Query: query},
+	}
+}
+
+func fixGQLRule(id, scenario, key string, query *string) *graphql.ScenarioAssignmentRule {
+	return &graphql.ScenarioAssignmentRule{
+		ID:       id,
+		Scenario: scenario,
+		Selector: &graphql.LabelSelector{Key: key, Query: query},
+	}
+}
+
+func fixGQLRuleInput(scenario, key string, query *string) graphql.ScenarioAssignmentRuleInput {
+	return graphql.ScenarioAssignmentRuleInput{
+		Scenario: scenario,
+		Selector: &graphql.LabelSelectorInput{Key: key, Query: query},
+	}
+}
+
+func fixEntity(query *string) scenarioassignment.Entity {
+	entity := scenarioassignment.Entity{
+		ID:          testRuleID,
+		TenantID:    testTenant,
+		Scenario:    testScenario,
+		SelectorKey: testKey,
+	}
+	if query != nil {
+		entity.SelectorQuery = sql.NullString{String: *query, Valid: true}
+	}
+	return entity
+}
+
+func fixScenariosLabel(id, runtimeID string, scenarios ...interface{}) *model.Label {
+	return &model.Label{
+		ID:         id,
+		Tenant:     testTenant,
+		Key:        model.ScenariosKey,
+		Value:      scenarios,
+		ObjectID:   runtimeID,
+		ObjectType: model.RuntimeLabelableObject,
+	}
+}
+
+func str(s string) *string {
+	return &s
+}
@@ -0,0 +1,144 @@
+package scenarioassignment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableName           = "public.scenario_assignment_rules"
+	assignmentTableName = "public.runtime_scenario_assignments"
+)
+
+var (
+	ruleColumns       = []string{"id", "tenant_id", "scenario", "selector_key", "selector_query"}
+	assignmentColumns = []string{"tenant_id", "runtime_id", "scenario"}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in model.ScenarioAssignmentRule) Entity
+	FromEntity(in Entity) model.ScenarioAssignmentRule
+}
+
+type repository struct {
+	singleGetter      *repo.SingleGetter
+	creator           *repo.Creator
+	deleter           *repo.Deleter
+	lister            *repo.Lister
+	assignmentCreator *repo.Creator
+	assignmentDeleter *repo.Deleter
+	conv              EntityConverter
+}
+
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		singleGetter:      repo.NewSingleGetter(tableName, "tenant_id", ruleColumns),
+		creator:           repo.NewCreator(tableName, ruleColumns),
+		deleter:           repo.NewDeleter(tableName, "tenant_id"),
+		lister:            repo.NewLister(tableName, "tenant_id", ruleColumns),
+		assignmentCreator: repo.NewCreator(assignmentTableName, assignmentColumns),
+		assignmentDeleter: repo.NewDeleter(assignmentTableName, "tenant_id"),
+		conv:              conv,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, item model.ScenarioAssignmentRule) error {
+	return r.creator.Create(ctx, r.conv.ToEntity(item))
+}
+
+func (r *repository) GetByID(ctx context.Context, tenant, id string) (*model.ScenarioAssignmentRule, error) {
+	var entity Entity
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{{Field: "id", Val: id}}, &entity); err != nil {
+		return nil, err
+	}
+
+	rule := r.conv.FromEntity(entity)
+	return &rule, nil
+}
+
+func (r *repository) List(ctx context.Context, tenant string) ([]*model.ScenarioAssignmentRule, error) {
+	var entities Collection
+	if err := r.lister.List(ctx, tenant, &entities); err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.ScenarioAssignmentRule, 0, len(entities))
+	for _, entity := range entities {
+		rule := r.conv.FromEntity(entity)
+		out = append(out, &rule)
+	}
+
+	return out, nil
+}
+
+func (r *repository) Delete(ctx context.Context, tenant, id string) error {
+	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{{Field: "id", Val: id}})
+}
+
+// DeleteForScenario deletes the rules assigning Runtimes to the scenario and forgets which Runtimes were assigned to it by the rules
+func (r *repository) DeleteForScenario(ctx context.Context, tenant, scenario string) error {
+	conditions := repo.Conditions{{Field: "scenario", Val: scenario}}
+
+	if err := r.deleter.DeleteMany(ctx, tenant, conditions); err != nil {
+		return errors.Wrapf(err, "while deleting assignment rules for scenario %s", scenario)
+	}
+
+	if err := r.assignmentDeleter.DeleteMany(ctx, tenant, conditions); err != nil {
+		return errors.Wrapf(err, "while deleting assignments to scenario %s", scenario)
+	}
+
+	return nil
+}
+
+// RenameScenario changes the name of the scenario in the rules and in the assignments made by them
+func (r *repository) RenameScenario(ctx context.Context, tenant, scenario, newScenario string) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range []string{tableName, assignmentTableName} {
+		stmt := fmt.Sprintf(`UPDATE %s SET scenario = $1 WHERE tenant_id = $2 AND scenario = $3`, table)
+		if _, err := persist.Exec(stmt, newScenario, tenant, scenario); err != nil {
+			return errors.Wrapf(err, "while renaming scenario in '%s' table", table)
+		}
+	}
+
+	return nil
+}
+
+// ListAssigned returns the scenarios which were added to the scenarios label of the Runtime by the rules
+func (r *repository) ListAssigned(ctx context.Context, tenant, runtimeID string) ([]string, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT scenario FROM %s WHERE tenant_id = $1 AND runtime_id = $2 ORDER BY scenario`, assignmentTableName)
+
+	var scenarios []string
+	if err := persist.Select(&scenarios, stmt, tenant, runtimeID); err != nil {
+		return nil, errors.Wrap(err, "while listing assigned scenarios from DB")
+	}
+
+	return scenarios, nil
+}
+
+// Assign records that the scenario was added to the scenarios label of the Runtime by the rules
+func (r *repository) Assign(ctx context.Context, tenant, runtimeID, scenario string) error {
+	return r.assignmentCreator.Create(ctx, AssignmentEntity{
+		TenantID:  tenant,
+		RuntimeID: runtimeID,
+		Scenario:  scenario,
+	})
+}
+
+func (r *repository) Unassign(ctx context.Context, tenant, runtimeID, scenario string) error {
+	return r.assignmentDeleter.DeleteOne(ctx, tenant, repo.Conditions{{Field: "runtime_id", Val: runtimeID}, {Field: "scenario", Val: scenario}})
+}
@@ -0,0 +1,238 @@
+package scenarioassignment_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ruleColumns = []string{"id", "tenant_id", "scenario", "selector_key", "selector_query"}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// given
+		rule := *fixModelRule(testRuleID, testScenario, testKey, str(testQuery))
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToEntity", rule).Return(fixEntity(str(testQuery))).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.scenario_assignment_rules ( id, tenant_id, scenario, selector_key, selector_query ) VALUES ( ?, ?, ?, ?, ? )")).
+			WithArgs(testRuleID, testTenant, testScenario, testKey, testQuery).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenarioassignment.NewRepository(conv)
+
+		// when
+		err := repo.Create(ctx, rule)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Returns error when inserting failed", func(t *testing.T) {
+		// given
+		rule := *fixModelRule(testRuleID, testScenario, testKey, nil)
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToEntity", rule).Return(fixEntity(nil)).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec("INSERT INTO .*").WillReturnError(errors.New("some error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenarioassignment.NewRepository(conv)
+
+		// when
+		err := repo.Create(ctx, rule)
+
+		// then
+		require.EqualError(t, err, "while inserting row to 'public.scenario_assignment_rules' table: some error")
+	})
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	// given
+	rule := *fixModelRule(testRuleID, testScenario, testKey, nil)
+	conv := &automock.EntityConverter{}
+	defer conv.AssertExpectations(t)
+	conv.On("FromEntity", fixEntity(nil)).Return(rule).Once()
+
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	rows := sqlmock.NewRows(ruleColumns).AddRow(testRuleID, testTenant, testScenario, testKey, nil)
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, scenario, selector_key, selector_query FROM public.scenario_assignment_rules WHERE tenant_id = $1 AND id = $2")).
+		WithArgs(testTenant, testRuleID).WillReturnRows(rows)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := scenarioassignment.NewRepository(conv)
+
+	// when
+	result, err := repo.GetByID(ctx, testTenant, testRuleID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &rule, result)
+}
+
+func TestRepository_List(t *testing.T) {
+	// given
+	rule := *fixModelRule(testRuleID, testScenario, testKey, str(testQuery))
+	conv := &automock.EntityConverter{}
+	defer conv.AssertExpectations(t)
+	conv.On("FromEntity", fixEntity(str(testQuery))).Return(rule).Once()
+
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	rows := sqlmock.NewRows(ruleColumns).AddRow(testRuleID, testTenant, testScenario, testKey, testQuery)
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, scenario, selector_key, selector_query FROM public.scenario_assignment_rules WHERE tenant_id=$1")).
+		WithArgs(testTenant).WillReturnRows(rows)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := scenarioassignment.NewRepository(conv)
+
+	// when
+	result, err := repo.List(ctx, testTenant)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []*model.ScenarioAssignmentRule{&rule}, result)
+}
+
+func TestRepository_Delete(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.scenario_assignment_rules WHERE tenant_id = $1 AND id = $2")).
+		WithArgs(testTenant, testRuleID).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := scenarioassignment.NewRepository(nil)
+
+	// when
+	err := repo.Delete(ctx, testTenant, testRuleID)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestRepository_DeleteForScenario(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.scenario_assignment_rules WHERE tenant_id = $1 AND scenario = $2")).
+			WithArgs(testTenant, testScenario).WillReturnResult(sqlmock.NewResult(-1, 2))
+		dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.runtime_scenario_assignments WHERE tenant_id = $1 AND scenario = $2")).
+			WithArgs(testTenant, testScenario).WillReturnResult(sqlmock.NewResult(-1, 3))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenarioassignment.NewRepository(nil)
+
+		// when
+		err := repo.DeleteForScenario(ctx, testTenant, testScenario)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Returns error when deleting rules failed", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec("DELETE FROM .*").WillReturnError(errors.New("some error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenarioassignment.NewRepository(nil)
+
+		// when
+		err := repo.DeleteForScenario(ctx, testTenant, testScenario)
+
+		// then
+		require.EqualError(t, err, "while deleting assignment rules for scenario EU_APPS: while deleting from database: some error")
+	})
+}
+
+func TestRepository_RenameScenario(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta("UPDATE public.scenario_assignment_rules SET scenario = $1 WHERE tenant_id = $2 AND scenario = $3")).
+		WithArgs("NEW", testTenant, testScenario).WillReturnResult(sqlmock.NewResult(-1, 1))
+	dbMock.ExpectExec(regexp.QuoteMeta("UPDATE public.runtime_scenario_assignments SET scenario = $1 WHERE tenant_id = $2 AND scenario = $3")).
+		WithArgs("NEW", testTenant, testScenario).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := scenarioassignment.NewRepository(nil)
+
+	// when
+	err := repo.RenameScenario(ctx, testTenant, testScenario, "NEW")
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestRepository_ListAssigned(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	rows := sqlmock.NewRows([]string{"scenario"}).AddRow("BAR").AddRow(testScenario)
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT scenario FROM public.runtime_scenario_assignments WHERE tenant_id = $1 AND runtime_id = $2 ORDER BY scenario")).
+		WithArgs(testTenant, "rtm").WillReturnRows(rows)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := scenarioassignment.NewRepository(nil)
+
+	// when
+	result, err := repo.ListAssigned(ctx, testTenant, "rtm")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"BAR", testScenario}, result)
+}
+
+func TestRepository_Assign(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.runtime_scenario_assignments ( tenant_id, runtime_id, scenario ) VALUES ( ?, ?, ? )")).
+		WithArgs(testTenant, "rtm", testScenario).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := scenarioassignment.NewRepository(nil)
+
+	// when
+	err := repo.Assign(ctx, testTenant, "rtm", testScenario)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestRepository_Unassign(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM public.runtime_scenario_assignments WHERE tenant_id = $1 AND runtime_id = $2 AND scenario = $3")).
+		WithArgs(testTenant, "rtm", testScenario).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := scenarioassignment.NewRepository(nil)
+
+	// when
+	err := repo.Unassign(ctx, testTenant, "rtm", testScenario)
+
+	// then
+	require.NoError(t, err)
+}
@@ -0,0 +1,108 @@
+package scenarioassignment
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=ScenarioAssignmentRuleService -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentRuleService interface {
+	Get(ctx context.Context, id string) (*model.ScenarioAssignmentRule, error)
+	List(ctx context.Context) ([]*model.ScenarioAssignmentRule, error)
+	Create(ctx context.Context, in model.ScenarioAssignmentRuleInput) (string, error)
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockery -name=ScenarioAssignmentRuleConverter -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentRuleConverter interface {
+	ToGraphQL(in *model.ScenarioAssignmentRule) *graphql.ScenarioAssignmentRule
+	MultipleToGraphQL(in []*model.ScenarioAssignmentRule) []*graphql.ScenarioAssignmentRule
+	InputFromGraphQL(in graphql.ScenarioAssignmentRuleInput) model.ScenarioAssignmentRuleInput
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      ScenarioAssignmentRuleService
+	conv     ScenarioAssignmentRuleConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc ScenarioAssignmentRuleService, conv ScenarioAssignmentRuleConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+func (r *Resolver) ScenarioAssignmentRules(ctx context.Context) ([]*graphql.ScenarioAssignmentRule, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	rules, err := r.svc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return r.conv.MultipleToGraphQL(rules), nil
+}
+
+func (r *Resolver) CreateScenarioAssignmentRule(ctx context.Context, in graphql.ScenarioAssignmentRuleInput) (*graphql.ScenarioAssignmentRule, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.Create(ctx, r.conv.InputFromGraphQL(in))
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return r.conv.ToGraphQL(rule), nil
+}
+
+func (r *Resolver) DeleteScenarioAssignmentRule(ctx context.Context, id string) (*graphql.ScenarioAssignmentRule, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	rule, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.svc.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return r.conv.ToGraphQL(rule), nil
+}
@@ -0,0 +1,224 @@
+package scenarioassignment_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ScenarioAssignmentRules(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	modelRules := []*model.ScenarioAssignmentRule{fixModelRule(testRuleID, testScenario, testKey, nil)}
+	gqlRules := []*graphql.ScenarioAssignmentRule{fixGQLRule(testRuleID, testScenario, testKey, nil)}
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txGen.ThatSucceeds()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher()).Return(modelRules, nil).Once()
+
+		conv := &automock.ScenarioAssignmentRuleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("MultipleToGraphQL", modelRules).Return(gqlRules).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.ScenarioAssignmentRules(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlRules, result)
+	})
+
+	t.Run("Returns error when listing rules failed", func(t *testing.T) {
+		persistTx, transact := txGen.ThatDoesntExpectCommit()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.ScenarioAssignmentRules(context.TODO())
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+
+	t.Run("Returns error on committing transaction", func(t *testing.T) {
+		persistTx, transact := txGen.ThatFailsOnCommit()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher()).Return(modelRules, nil).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.ScenarioAssignmentRules(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestResolver_CreateScenarioAssignmentRule(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	gqlInput := fixGQLRuleInput(testScenario, testKey, str(testQuery))
+	modelInput := fixModelRuleInput(testScenario, testKey, str(testQuery))
+	modelRule := fixModelRule(testRuleID, testScenario, testKey, str(testQuery))
+	gqlRule := fixGQLRule(testRuleID, testScenario, testKey, str(testQuery))
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txGen.ThatSucceeds()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), modelInput).Return(testRuleID, nil).Once()
+		svc.On("Get", txtest.CtxWithDBMatcher(), testRuleID).Return(modelRule, nil).Once()
+
+		conv := &automock.ScenarioAssignmentRuleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+		conv.On("ToGraphQL", modelRule).Return(gqlRule).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.CreateScenarioAssignmentRule(context.TODO(), gqlInput)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlRule, result)
+	})
+
+	t.Run("Returns error on starting transaction", func(t *testing.T) {
+		persistTx, transact := txGen.ThatFailsOnBegin()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		resolver := scenarioassignment.NewResolver(transact, nil, nil)
+
+		// when
+		_, err := resolver.CreateScenarioAssignmentRule(context.TODO(), gqlInput)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+
+	t.Run("Returns error when creating rule failed", func(t *testing.T) {
+		persistTx, transact := txGen.ThatDoesntExpectCommit()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), modelInput).Return("", testErr).Once()
+
+		conv := &automock.ScenarioAssignmentRuleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// when
+		_, err := resolver.CreateScenarioAssignmentRule(context.TODO(), gqlInput)
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+}
+
+func TestResolver_DeleteScenarioAssignmentRule(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	modelRule := fixModelRule(testRuleID, testScenario, testKey, nil)
+	gqlRule := fixGQLRule(testRuleID, testScenario, testKey, nil)
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txGen.ThatSucceeds()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testRuleID).Return(modelRule, nil).Once()
+		svc.On("Delete", txtest.CtxWithDBMatcher(), testRuleID).Return(nil).Once()
+
+		conv := &automock.ScenarioAssignmentRuleConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToGraphQL", modelRule).Return(gqlRule).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.DeleteScenarioAssignmentRule(context.TODO(), testRuleID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlRule, result)
+	})
+
+	t.Run("Returns error when rule does not exist", func(t *testing.T) {
+		persistTx, transact := txGen.ThatDoesntExpectCommit()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testRuleID).Return(nil, testErr).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.DeleteScenarioAssignmentRule(context.TODO(), testRuleID)
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+
+	t.Run("Returns error when deleting rule failed", func(t *testing.T) {
+		persistTx, transact := txGen.ThatDoesntExpectCommit()
+		defer persistTx.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentRuleService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testRuleID).Return(modelRule, nil).Once()
+		svc.On("Delete", txtest.CtxWithDBMatcher(), testRuleID).Return(testErr).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.DeleteScenarioAssignmentRule(context.TODO(), testRuleID)
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+	})
+}
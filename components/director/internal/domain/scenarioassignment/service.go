@@ -0,0 +1,400 @@
+package scenarioassignment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=ScenarioAssignmentRuleRepository -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentRuleRepository interface {
+	Create(ctx context.Context, item model.ScenarioAssignmentRule) error
+	GetByID(ctx context.Context, tenant, id string) (*model.ScenarioAssignmentRule, error)
+	List(ctx context.Context, tenant string) ([]*model.ScenarioAssignmentRule, error)
+	Delete(ctx context.Context, tenant, id string) error
+	ListAssigned(ctx context.Context, tenant, runtimeID string) ([]string, error)
+	Assign(ctx context.Context, tenant, runtimeID, scenario string) error
+	Unassign(ctx context.Context, tenant, runtimeID, scenario string) error
+}
+
+//go:generate mockery -name=RuntimeRepository -output=automock -outpkg=automock -case=underscore
+type RuntimeRepository interface {
+	ListIDs(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]string, error)
+	Matches(ctx context.Context, tenant, id string, filter []*labelfilter.LabelFilter) (bool, error)
+}
+
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+	Upsert(ctx context.Context, label *model.Label) error
+	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
+}
+
+//go:generate mockery -name=ScenariosService -output=automock -outpkg=automock -case=underscore
+type ScenariosService interface {
+	Get(ctx context.Context, tenant string, name string) (*model.Scenario, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo             ScenarioAssignmentRuleRepository
+	runtimeRepo      RuntimeRepository
+	labelRepo        LabelRepository
+	scenariosService ScenariosService
+	uidService       UIDService
+}
+
+func NewService(repo ScenarioAssignmentRuleRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, scenariosService ScenariosService, uidService UIDService) *service {
+	return &service{
+		repo:             repo,
+		runtimeRepo:      runtimeRepo,
+		labelRepo:        labelRepo,
+		scenariosService: scenariosService,
+		uidService:       uidService,
+	}
+}
+
+func (s *service) Get(ctx context.Context, id string) (*model.ScenarioAssignmentRule, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.repo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Scenario Assignment Rule with ID %s", id)
+	}
+
+	return rule, nil
+}
+
+func (s *service) List(ctx context.Context) ([]*model.ScenarioAssignmentRule, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.repo.List(ctx, tnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Scenario Assignment Rules")
+	}
+
+	return rules, nil
+}
+
+// Create creates the rule and assigns the Runtimes matching its selector to the scenario
+func (s *service) Create(ctx context.Context, in model.ScenarioAssignmentRuleInput) (string, error) {
+	if err := in.Validate(); err != nil {
+		return "", errors.Wrap(err, "while validating Scenario Assignment Rule input")
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	scenario, err := s.scenariosService.Get(ctx, tnt, in.Scenario)
+	if err != nil {
+		return "", errors.Wrapf(err, "while getting scenario %s", in.Scenario)
+	}
+	if scenario == nil {
+		return "", fmt.Errorf("scenario %s does not exist", in.Scenario)
+	}
+
+	rule := in.ToScenarioAssignmentRule(s.uidService.Generate(), tnt)
+	if err := s.repo.Create(ctx, *rule); err != nil {
+		return "", errors.Wrap(err, "while creating Scenario Assignment Rule")
+	}
+
+	if err := s.evaluateAll(ctx, tnt); err != nil {
+		return "", err
+	}
+
+	return rule.ID, nil
+}
+
+// Delete deletes the rule and removes the Runtimes, which were assigned to the scenario only by this rule, from the scenario
+func (s *service) Delete(ctx context.Context, id string) error {
+	rule, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, rule.Tenant, rule.ID); err != nil {
+		return errors.Wrapf(err, "while deleting Scenario Assignment Rule with ID %s", id)
+	}
+
+	return s.evaluateAll(ctx, rule.Tenant)
+}
+
+// EvaluateForRuntime brings the scenarios label of the Runtime in line with the rules matching its labels.
+// The scenarios of the matching rules are added to the label, and the scenarios which were added by the rules which no longer match are removed from it.
+// The scenarios set manually are never removed.
+func (s *service) EvaluateForRuntime(ctx context.Context, tenant, runtimeID string) error {
+	rules, err := s.repo.List(ctx, tenant)
+	if err != nil {
+		return errors.Wrap(err, "while listing Scenario Assignment Rules")
+	}
+
+	var desired []string
+	for _, rule := range rules {
+		matches, err := s.runtimeRepo.Matches(ctx, tenant, runtimeID, []*labelfilter.LabelFilter{ruleFilter(rule)})
+		if err != nil {
+			return errors.Wrapf(err, "while matching Runtime with ID %s against Scenario Assignment Rule with ID %s", runtimeID, rule.ID)
+		}
+
+		if matches && indexOf(desired, rule.Scenario) == -1 {
+			desired = append(desired, rule.Scenario)
+		}
+	}
+
+	return s.applyForRuntime(ctx, tenant, runtimeID, desired)
+}
+
+// ReleaseForRuntime is called after the scenarios label of the Runtime was set manually, previous is the label before the change.
+// The scenarios assigned by the rules which the caller added to the label become the ones set manually, so the rules never remove them.
+// The scenarios kept in the label stay assigned by the rules, and the assignments of the scenarios removed from the label are kept,
+// so the rules do not add them back while they match.
+func (s *service) ReleaseForRuntime(ctx context.Context, tenant, runtimeID string, previous *model.Label) error {
+	labels, err := s.labelRepo.ListForObject(ctx, tenant, model.RuntimeLabelableObject, runtimeID)
+	if err != nil {
+		return errors.Wrapf(err, "while listing labels for Runtime with ID %s", runtimeID)
+	}
+
+	assigned, err := s.repo.ListAssigned(ctx, tenant, runtimeID)
+	if err != nil {
+		return errors.Wrapf(err, "while listing scenarios assigned to Runtime with ID %s", runtimeID)
+	}
+
+	before := scenariosFromLabel(previous)
+	current := scenariosFromLabel(labels[model.ScenariosKey])
+	for _, scenario := range assigned {
+		if indexOf(current, scenario) == -1 || indexOf(before, scenario) != -1 {
+			continue
+		}
+
+		if err := s.repo.Unassign(ctx, tenant, runtimeID, scenario); err != nil {
+			return errors.Wrapf(err, "while unassigning Runtime with ID %s from scenario %s", runtimeID, scenario)
+		}
+	}
+
+	return nil
+}
+
+// RestoreForRuntime is called after all labels of the Runtime were replaced without the scenarios label, previous is the scenarios label before the change.
+// Replacing the labels does not remove the scenarios manually, so the scenarios assigned by the rules which were in the label are added back to it.
+func (s *service) RestoreForRuntime(ctx context.Context, tenant, runtimeID string, previous *model.Label) error {
+	assigned, err := s.repo.ListAssigned(ctx, tenant, runtimeID)
+	if err != nil {
+		return errors.Wrapf(err, "while listing scenarios assigned to Runtime with ID %s", runtimeID)
+	}
+
+	labels, err := s.labelRepo.ListForObject(ctx, tenant, model.RuntimeLabelableObject, runtimeID)
+	if err != nil {
+		return errors.Wrapf(err, "while listing labels for Runtime with ID %s", runtimeID)
+	}
+
+	label := labels[model.ScenariosKey]
+	before := scenariosFromLabel(previous)
+	current := scenariosFromLabel(label)
+	changed := false
+
+	for _, scenario := range assigned {
+		if indexOf(before, scenario) == -1 || indexOf(current, scenario) != -1 {
+			continue
+		}
+
+		current = append(current, scenario)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return s.writeScenarios(ctx, tenant, runtimeID, label, current)
+}
+
+func (s *service) evaluateAll(ctx context.Context, tenant string) error {
+	desired, err := s.desiredScenarios(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	ids, err := s.runtimeRepo.ListIDs(ctx, tenant, nil)
+	if err != nil {
+		return errors.Wrap(err, "while listing Runtimes")
+	}
+
+	for _, id := range ids {
+		if err := s.applyForRuntime(ctx, tenant, id, desired[id]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// desiredScenarios returns the scenarios of the rules matching the Runtimes by their IDs
+func (s *service) desiredScenarios(ctx context.Context, tenant string) (map[string][]string, error) {
+	rules, err := s.repo.List(ctx, tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Scenario Assignment Rules")
+	}
+
+	desired := make(map[string][]string)
+	for _, rule := range rules {
+		ids, err := s.runtimeRepo.ListIDs(ctx, tenant, []*labelfilter.LabelFilter{ruleFilter(rule)})
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing Runtimes matching Scenario Assignment Rule with ID %s", rule.ID)
+		}
+
+		for _, id := range ids {
+			if indexOf(desired[id], rule.Scenario) == -1 {
+				desired[id] = append(desired[id], rule.Scenario)
+			}
+		}
+	}
+
+	return desired, nil
+}
+
+// applyForRuntime adds the desired scenarios to the scenarios label of the Runtime and removes the ones assigned by the rules which are not desired anymore.
+// A desired scenario which is already in the label and was not assigned by the rules was set manually, so it is left as it is.
+// A desired scenario which was assigned by the rules but is not in the label was removed manually, so it is not added back.
+func (s *service) applyForRuntime(ctx context.Context, tenant, runtimeID string, desired []string) error {
+	labels, err := s.labelRepo.ListForObject(ctx, tenant, model.RuntimeLabelableObject, runtimeID)
+	if err != nil {
+		return errors.Wrapf(err, "while listing labels for Runtime with ID %s", runtimeID)
+	}
+
+	assigned, err := s.repo.ListAssigned(ctx, tenant, runtimeID)
+	if err != nil {
+		return errors.Wrapf(err, "while listing scenarios assigned to Runtime with ID %s", runtimeID)
+	}
+
+	label := labels[model.ScenariosKey]
+	current := scenariosFromLabel(label)
+	changed := false
+
+	for _, scenario := range assigned {
+		if indexOf(desired, scenario) != -1 {
+			continue
+		}
+
+		if indexOf(current, scenario) != -1 {
+			current = remove(current, scenario)
+			changed = true
+		}
+
+		if err := s.repo.Unassign(ctx, tenant, runtimeID, scenario); err != nil {
+			return errors.Wrapf(err, "while unassigning Runtime with ID %s from scenario %s", runtimeID, scenario)
+		}
+	}
+
+	for _, scenario := range desired {
+		if indexOf(assigned, scenario) != -1 || indexOf(current, scenario) != -1 {
+			continue
+		}
+
+		if err := s.repo.Assign(ctx, tenant, runtimeID, scenario); err != nil {
+			return errors.Wrapf(err, "while assigning Runtime with ID %s to scenario %s", runtimeID, scenario)
+		}
+		current = append(current, scenario)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return s.writeScenarios(ctx, tenant, runtimeID, label, current)
+}
+
+// writeScenarios stores the scenarios in the scenarios label of the Runtime, or deletes the label if there are none
+func (s *service) writeScenarios(ctx context.Context, tenant, runtimeID string, label *model.Label, scenarios []string) error {
+	if len(scenarios) == 0 {
+		err := s.labelRepo.Delete(ctx, tenant, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey)
+		if err != nil {
+			return errors.Wrapf(err, "while deleting label with key %s for Runtime with ID %s", model.ScenariosKey, runtimeID)
+		}
+		return nil
+	}
+
+	if label == nil {
+		label = &model.Label{
+			ID:         s.uidService.Generate(),
+			Tenant:     tenant,
+			Key:        model.ScenariosKey,
+			ObjectID:   runtimeID,
+			ObjectType: model.RuntimeLabelableObject,
+		}
+	}
+
+	value := make([]interface{}, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		value = append(value, scenario)
+	}
+	label.Value = value
+
+	if err := s.labelRepo.Upsert(ctx, label); err != nil {
+		return errors.Wrapf(err, "while updating label with key %s for Runtime with ID %s", model.ScenariosKey, runtimeID)
+	}
+
+	return nil
+}
+
+func ruleFilter(rule *model.ScenarioAssignmentRule) *labelfilter.LabelFilter {
+	if rule.Selector.Query != nil {
+		return labelfilter.NewForKeyWithQuery(rule.Selector.Key, *rule.Selector.Query)
+	}
+
+	return labelfilter.NewForKey(rule.Selector.Key)
+}
+
+func scenariosFromLabel(label *model.Label) []string {
+	if label == nil {
+		return nil
+	}
+
+	values, ok := label.Value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	scenarios := make([]string, 0, len(values))
+	for _, value := range values {
+		if scenario, ok := value.(string); ok {
+			scenarios = append(scenarios, scenario)
+		}
+	}
+
+	return scenarios
+}
+
+func remove(scenarios []string, scenario string) []string {
+	out := make([]string, 0, len(scenarios))
+	for _, s := range scenarios {
+		if s != scenario {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func indexOf(scenarios []string, scenario string) int {
+	for i, s := range scenarios {
+		if s == scenario {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,684 @@
+package scenarioassignment_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_EvaluateForRuntime(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	runtimeID := "rtm"
+	labelID := "4e2e2f9a-5b0c-4a77-bb1c-a4b2c4d1e7f0"
+
+	rules := []*model.ScenarioAssignmentRule{fixModelRule(testRuleID, testScenario, testKey, str(testQuery))}
+	ruleFilter := []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery(testKey, testQuery)}
+
+	matching := func() *automock.RuntimeRepository {
+		repo := &automock.RuntimeRepository{}
+		repo.On("Matches", ctx, testTenant, runtimeID, ruleFilter).Return(true, nil).Once()
+		return repo
+	}
+	notMatching := func() *automock.RuntimeRepository {
+		repo := &automock.RuntimeRepository{}
+		repo.On("Matches", ctx, testTenant, runtimeID, ruleFilter).Return(false, nil).Once()
+		return repo
+	}
+	labels := func(scenarios ...interface{}) map[string]*model.Label {
+		return map[string]*model.Label{model.ScenariosKey: fixScenariosLabel(labelID, runtimeID, scenarios...)}
+	}
+
+	testCases := []struct {
+		Name          string
+		RepoFn        func() *automock.ScenarioAssignmentRuleRepository
+		RuntimeRepoFn func() *automock.RuntimeRepository
+		LabelRepoFn   func() *automock.LabelRepository
+		UIDServiceFn  func() *automock.UIDService
+		ExpectedErr   error
+	}{
+		{
+			Name: "Adds the scenario of the matching rule",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{}, nil).Once()
+				repo.On("Assign", ctx, testTenant, runtimeID, testScenario).Return(nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: matching,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels("DEFAULT"), nil).Once()
+				repo.On("Upsert", ctx, fixScenariosLabel(labelID, runtimeID, "DEFAULT", testScenario)).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Creates the scenarios label when it is missing",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{}, nil).Once()
+				repo.On("Assign", ctx, testTenant, runtimeID, testScenario).Return(nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: matching,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(map[string]*model.Label{}, nil).Once()
+				repo.On("Upsert", ctx, fixScenariosLabel(labelID, runtimeID, testScenario)).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(labelID).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Keeps the matching scenario set manually without recording it",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{}, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: matching,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels(testScenario), nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Keeps the scenario set manually when no rule matches",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{}, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: notMatching,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Removes the scenario assigned by the rule which no longer matches",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario}, nil).Once()
+				repo.On("Unassign", ctx, testTenant, runtimeID, testScenario).Return(nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: notMatching,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels("DEFAULT", testScenario), nil).Once()
+				repo.On("Upsert", ctx, fixScenariosLabel(labelID, runtimeID, "DEFAULT")).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Deletes the scenarios label left empty",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario}, nil).Once()
+				repo.On("Unassign", ctx, testTenant, runtimeID, testScenario).Return(nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: notMatching,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels(testScenario), nil).Once()
+				repo.On("Delete", ctx, testTenant, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Forgets the assignment removed manually from the label when the rule no longer matches",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario}, nil).Once()
+				repo.On("Unassign", ctx, testTenant, runtimeID, testScenario).Return(nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: notMatching,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels("DEFAULT"), nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Does not add back the assigned scenario removed manually when the rule still matches",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario}, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: matching,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels("DEFAULT"), nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Returns error when listing rules failed",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(nil, testErr).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				return &automock.RuntimeRepository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when matching Runtime against the rule failed",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("Matches", ctx, testTenant, runtimeID, ruleFilter).Return(false, testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when recording the assignment failed",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{}, nil).Once()
+				repo.On("Assign", ctx, testTenant, runtimeID, testScenario).Return(testErr).Once()
+				return repo
+			},
+			RuntimeRepoFn: matching,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels("DEFAULT"), nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			runtimeRepo := testCase.RuntimeRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			uidSvc := testCase.UIDServiceFn()
+			svc := scenarioassignment.NewService(repo, runtimeRepo, labelRepo, nil, uidSvc)
+
+			// when
+			err := svc.EvaluateForRuntime(ctx, testTenant, runtimeID)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+			runtimeRepo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ReleaseForRuntime(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	runtimeID := "rtm"
+	labelID := "4e2e2f9a-5b0c-4a77-bb1c-a4b2c4d1e7f0"
+
+	labels := map[string]*model.Label{model.ScenariosKey: fixScenariosLabel(labelID, runtimeID, "DEFAULT", testScenario)}
+
+	testCases := []struct {
+		Name        string
+		Previous    *model.Label
+		RepoFn      func() *automock.ScenarioAssignmentRuleRepository
+		LabelRepoFn func() *automock.LabelRepository
+		ExpectedErr error
+	}{
+		{
+			Name:     "Forgets the assignments of the scenarios added to the label and keeps the ones removed from it",
+			Previous: fixScenariosLabel(labelID, runtimeID, "DEFAULT"),
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario, "removed"}, nil).Once()
+				repo.On("Unassign", ctx, testTenant, runtimeID, testScenario).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels, nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:     "Keeps the assignments of the scenarios which were already in the label",
+			Previous: fixScenariosLabel(labelID, runtimeID, testScenario),
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario}, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels, nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Returns error when listing labels failed",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				return &automock.ScenarioAssignmentRuleRepository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when forgetting the assignment failed",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario}, nil).Once()
+				repo.On("Unassign", ctx, testTenant, runtimeID, testScenario).Return(testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(labels, nil).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			svc := scenarioassignment.NewService(repo, nil, labelRepo, nil, nil)
+
+			// when
+			err := svc.ReleaseForRuntime(ctx, testTenant, runtimeID, testCase.Previous)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RestoreForRuntime(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	runtimeID := "rtm"
+	labelID := "4e2e2f9a-5b0c-4a77-bb1c-a4b2c4d1e7f0"
+
+	previous := fixScenariosLabel(labelID, runtimeID, "DEFAULT", testScenario)
+	restored := fixScenariosLabel(labelID, runtimeID, testScenario)
+
+	testCases := []struct {
+		Name         string
+		RepoFn       func() *automock.ScenarioAssignmentRuleRepository
+		LabelRepoFn  func() *automock.LabelRepository
+		UIDServiceFn func() *automock.UIDService
+		ExpectedErr  error
+	}{
+		{
+			Name: "Adds back the scenarios assigned by the rules which were in the label",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario, "removed"}, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(map[string]*model.Label{}, nil).Once()
+				repo.On("Upsert", ctx, restored).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(labelID).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Does nothing when none of the assigned scenarios was in the label",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{"removed"}, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(map[string]*model.Label{}, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Returns error when listing assigned scenarios failed",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when updating label failed",
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario}, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(map[string]*model.Label{}, nil).Once()
+				repo.On("Upsert", ctx, restored).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(labelID).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			uidService := testCase.UIDServiceFn()
+			svc := scenarioassignment.NewService(repo, nil, labelRepo, nil, uidService)
+
+			// when
+			err := svc.RestoreForRuntime(ctx, testTenant, runtimeID, previous)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+			uidService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	in := fixModelRuleInput(testScenario, testKey, nil)
+	rule := fixModelRule(testRuleID, testScenario, testKey, nil)
+
+	testCases := []struct {
+		Name               string
+		Input              model.ScenarioAssignmentRuleInput
+		RepoFn             func() *automock.ScenarioAssignmentRuleRepository
+		RuntimeRepoFn      func() *automock.RuntimeRepository
+		ScenariosServiceFn func() *automock.ScenariosService
+		UIDServiceFn       func() *automock.UIDService
+		ExpectedErr        error
+	}{
+		{
+			Name:  "Success",
+			Input: in,
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("Create", ctx, *rule).Return(nil).Once()
+				repo.On("List", ctx, testTenant).Return([]*model.ScenarioAssignmentRule{}, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListIDs", ctx, testTenant, ([]*labelfilter.LabelFilter)(nil)).Return([]string{}, nil).Once()
+				return repo
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("Get", ctx, testTenant, testScenario).Return(&model.Scenario{Name: testScenario}, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testRuleID).Once()
+				return svc
+			},
+		},
+		{
+			Name:  "Returns error when input is invalid",
+			Input: fixModelRuleInput(testScenario, "", nil),
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				return &automock.ScenarioAssignmentRuleRepository{}
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				return &automock.RuntimeRepository{}
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				return &automock.ScenariosService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: errors.New("missing Selector Key field"),
+		},
+		{
+			Name:  "Returns error when scenario does not exist",
+			Input: in,
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				return &automock.ScenarioAssignmentRuleRepository{}
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				return &automock.RuntimeRepository{}
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("Get", ctx, testTenant, testScenario).Return(nil, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: errors.New("scenario EU_APPS does not exist"),
+		},
+		{
+			Name:  "Returns error when creating rule failed",
+			Input: in,
+			RepoFn: func() *automock.ScenarioAssignmentRuleRepository {
+				repo := &automock.ScenarioAssignmentRuleRepository{}
+				repo.On("Create", ctx, *rule).Return(testErr).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				return &automock.RuntimeRepository{}
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("Get", ctx, testTenant, testScenario).Return(&model.Scenario{Name: testScenario}, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testRuleID).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			runtimeRepo := testCase.RuntimeRepoFn()
+			scenariosSvc := testCase.ScenariosServiceFn()
+			uidSvc := testCase.UIDServiceFn()
+			svc := scenarioassignment.NewService(repo, runtimeRepo, nil, scenariosSvc, uidSvc)
+
+			// when
+			id, err := svc.Create(ctx, testCase.Input)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testRuleID, id)
+			}
+
+			repo.AssertExpectations(t)
+			runtimeRepo.AssertExpectations(t)
+			scenariosSvc.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	runtimeID := "rtm"
+	labelID := "4e2e2f9a-5b0c-4a77-bb1c-a4b2c4d1e7f0"
+	rule := fixModelRule(testRuleID, testScenario, testKey, nil)
+
+	repo := &automock.ScenarioAssignmentRuleRepository{}
+	defer repo.AssertExpectations(t)
+	repo.On("GetByID", ctx, testTenant, testRuleID).Return(rule, nil).Once()
+	repo.On("Delete", ctx, testTenant, testRuleID).Return(nil).Once()
+	repo.On("List", ctx, testTenant).Return([]*model.ScenarioAssignmentRule{}, nil).Once()
+	repo.On("ListAssigned", ctx, testTenant, runtimeID).Return([]string{testScenario}, nil).Once()
+	repo.On("Unassign", ctx, testTenant, runtimeID, testScenario).Return(nil).Once()
+
+	runtimeRepo := &automock.RuntimeRepository{}
+	defer runtimeRepo.AssertExpectations(t)
+	runtimeRepo.On("ListIDs", ctx, testTenant, ([]*labelfilter.LabelFilter)(nil)).Return([]string{runtimeID}, nil).Once()
+
+	labelRepo := &automock.LabelRepository{}
+	defer labelRepo.AssertExpectations(t)
+	labelRepo.On("ListForObject", ctx, testTenant, model.RuntimeLabelableObject, runtimeID).Return(map[string]*model.Label{
+		model.ScenariosKey: fixScenariosLabel(labelID, runtimeID, "DEFAULT", testScenario),
+	}, nil).Once()
+	labelRepo.On("Upsert", ctx, fixScenariosLabel(labelID, runtimeID, "DEFAULT")).Return(nil).Once()
+
+	svc := scenarioassignment.NewService(repo, runtimeRepo, labelRepo, nil, nil)
+
+	// when
+	err := svc.Delete(ctx, testRuleID)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestService_List(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	rules := []*model.ScenarioAssignmentRule{fixModelRule(testRuleID, testScenario, testKey, nil)}
+
+	repo := &automock.ScenarioAssignmentRuleRepository{}
+	defer repo.AssertExpectations(t)
+	repo.On("List", ctx, testTenant).Return(rules, nil).Once()
+
+	svc := scenarioassignment.NewService(repo, nil, nil, nil, nil)
+
+	// when
+	result, err := svc.List(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, rules, result)
+}
@@ -1,22 +1,104 @@
 package labelfilter
 
-import "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+import (
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
 
+// LabelFilter is either a single label condition with the Key and the optional Query,
+// or the composition of other filters with exactly one of And, Or and Not
 type LabelFilter struct {
 	Key   string
 	Query *string
+	And   []*LabelFilter
+	Or    []*LabelFilter
+	Not   *LabelFilter
+}
+
+func NewForKey(key string) *LabelFilter {
+	return &LabelFilter{Key: key}
+}
+
+func NewForKeyWithQuery(key, query string) *LabelFilter {
+	return &LabelFilter{Key: key, Query: &query}
+}
+
+func NewAnd(filters ...*LabelFilter) *LabelFilter {
+	return &LabelFilter{And: filters}
+}
+
+func NewOr(filters ...*LabelFilter) *LabelFilter {
+	return &LabelFilter{Or: filters}
+}
+
+func NewNot(filter *LabelFilter) *LabelFilter {
+	return &LabelFilter{Not: filter}
+}
+
+// Validate checks that every filter in the tree is either the label condition or exactly one composition
+func (f *LabelFilter) Validate() error {
+	set := 0
+	if f.Key != "" {
+		set++
+	}
+	if f.And != nil {
+		set++
+	}
+	if f.Or != nil {
+		set++
+	}
+	if f.Not != nil {
+		set++
+	}
+
+	switch {
+	case set != 1:
+		return errors.New("label filter must specify exactly one of key, and, or, not")
+	case f.Query != nil && f.Key == "":
+		return errors.New("label filter query requires the key")
+	case f.And != nil && len(f.And) == 0:
+		return errors.New("label filter and must not be empty")
+	case f.Or != nil && len(f.Or) == 0:
+		return errors.New("label filter or must not be empty")
+	}
+
+	for _, child := range append(append([]*LabelFilter{f.Not}, f.And...), f.Or...) {
+		if child == nil {
+			continue
+		}
+		if err := child.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func FromGraphQL(in *graphql.LabelFilter) *LabelFilter {
+	if in == nil {
+		return nil
+	}
+
+	var key string
+	if in.Key != nil {
+		key = *in.Key
+	}
+
 	return &LabelFilter{
-		Key:   in.Key,
+		Key:   key,
 		Query: in.Query,
+		And:   MultipleFromGraphQL(in.And),
+		Or:    MultipleFromGraphQL(in.Or),
+		Not:   FromGraphQL(in.Not),
 	}
 }
 
 func MultipleFromGraphQL(in []*graphql.LabelFilter) []*LabelFilter {
-	var filters []*LabelFilter
+	if in == nil {
+		return nil
+	}
 
+	filters := []*LabelFilter{}
 	for _, f := range in {
 		filters = append(filters, FromGraphQL(f))
 	}
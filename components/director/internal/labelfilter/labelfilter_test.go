@@ -6,13 +6,15 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFromGraphQL(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		query := "foo"
+		key := "label"
 		in := &graphql.LabelFilter{
-			Key:   "label",
+			Key:   &key,
 			Query: &query,
 		}
 
@@ -27,8 +29,9 @@ func TestFromGraphQL(t *testing.T) {
 	})
 
 	t.Run("Empty query", func(t *testing.T) {
+		key := "label"
 		in := &graphql.LabelFilter{
-			Key:   "label",
+			Key:   &key,
 			Query: nil,
 		}
 
@@ -46,13 +49,15 @@ func TestFromGraphQL(t *testing.T) {
 func TestMultipleFromGraphQL(t *testing.T) {
 	queryFoo := "foo"
 	queryBar := "bar"
+	key := "label"
+	key2 := "label2"
 	in := []*graphql.LabelFilter{
 		{
-			Key:   "label",
+			Key:   &key,
 			Query: &queryFoo,
 		},
 		{
-			Key:   "label2",
+			Key:   &key2,
 			Query: &queryBar,
 		},
 	}
@@ -72,3 +77,82 @@ func TestMultipleFromGraphQL(t *testing.T) {
 
 	assert.Equal(t, expected, result)
 }
+
+func TestFromGraphQL_Composition(t *testing.T) {
+	// given
+	env, region, deprecated := "env", "region", "deprecated"
+	prod, eu, us := `$ ? (@ == "prod")`, `$ ? (@ == "eu")`, `$ ? (@ == "us")`
+	in := &graphql.LabelFilter{
+		And: []*graphql.LabelFilter{
+			{Key: &env, Query: &prod},
+			{Or: []*graphql.LabelFilter{
+				{Key: &region, Query: &eu},
+				{Key: &region, Query: &us},
+			}},
+			{Not: &graphql.LabelFilter{Key: &deprecated}},
+		},
+	}
+
+	expected := labelfilter.NewAnd(
+		labelfilter.NewForKeyWithQuery(env, prod),
+		labelfilter.NewOr(
+			labelfilter.NewForKeyWithQuery(region, eu),
+			labelfilter.NewForKeyWithQuery(region, us),
+		),
+		labelfilter.NewNot(labelfilter.NewForKey(deprecated)),
+	)
+
+	// when
+	result := labelfilter.FromGraphQL(in)
+
+	// then
+	assert.Equal(t, expected, result)
+	assert.NoError(t, result.Validate())
+}
+
+func TestLabelFilter_Validate(t *testing.T) {
+	query := "$"
+
+	testCases := []struct {
+		Name          string
+		Input         *labelfilter.LabelFilter
+		ExpectedError string
+	}{
+		{
+			Name:          "Nothing specified",
+			Input:         &labelfilter.LabelFilter{},
+			ExpectedError: "label filter must specify exactly one of key, and, or, not",
+		},
+		{
+			Name:          "Key with composition",
+			Input:         &labelfilter.LabelFilter{Key: "foo", Not: labelfilter.NewForKey("bar")},
+			ExpectedError: "label filter must specify exactly one of key, and, or, not",
+		},
+		{
+			Name:          "Query without key",
+			Input:         &labelfilter.LabelFilter{Query: &query, Not: labelfilter.NewForKey("bar")},
+			ExpectedError: "label filter query requires the key",
+		},
+		{
+			Name:          "Empty or",
+			Input:         &labelfilter.LabelFilter{Or: []*labelfilter.LabelFilter{}},
+			ExpectedError: "label filter or must not be empty",
+		},
+		{
+			Name:          "Invalid nested filter",
+			Input:         labelfilter.NewAnd(labelfilter.NewForKey("foo"), labelfilter.NewNot(&labelfilter.LabelFilter{})),
+			ExpectedError: "label filter must specify exactly one of key, and, or, not",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			err := testCase.Input.Validate()
+
+			// then
+			require.Error(t, err)
+			assert.EqualError(t, err, testCase.ExpectedError)
+		})
+	}
+}
@@ -17,6 +17,7 @@ type Application struct {
 	Description    *string
 	Status         *ApplicationStatus
 	HealthCheckURL *string
+	Certificate    *ClientCertificate
 }
 
 type ApplicationStatus struct {
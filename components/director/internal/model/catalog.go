@@ -0,0 +1,28 @@
+package model
+
+import (
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+type CatalogSearchResultType string
+
+const (
+	CatalogSearchResultTypeAPI      CatalogSearchResultType = "API"
+	CatalogSearchResultTypeEventAPI CatalogSearchResultType = "EVENT_API"
+	CatalogSearchResultTypeDocument CatalogSearchResultType = "DOCUMENT"
+)
+
+type CatalogSearchResult struct {
+	Type          CatalogSearchResultType
+	ID            string
+	ApplicationID string
+	Name          string
+	// Snippet is the fragment of the searched text with the matches wrapped in <b> tags
+	Snippet string
+}
+
+type CatalogSearchResultPage struct {
+	Data       []*CatalogSearchResult
+	PageInfo   *pagination.Page
+	TotalCount int
+}
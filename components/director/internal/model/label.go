@@ -9,6 +9,21 @@ type Label struct {
 	ObjectType LabelableObject
 }
 
+// LabelKeyUsage is the number of Applications and Runtimes labeled with the key and the distinct values of the label
+type LabelKeyUsage struct {
+	Key          string
+	Applications int
+	Runtimes     int
+	Values       []*LabelValueUsage
+}
+
+// LabelValueUsage is the number of Applications and Runtimes labeled with the value. Every element of an array value is a separate value.
+type LabelValueUsage struct {
+	Value        interface{}
+	Applications int
+	Runtimes     int
+}
+
 type LabelInput struct {
 	Key        string
 	Value      interface{}
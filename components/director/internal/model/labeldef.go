@@ -83,3 +83,29 @@ func (def *LabelDefinition) validateScenariosSchema() error {
 
 	return nil
 }
+
+// LabelValueTransformation rewrites the values of the existing labels when the schema of the LabelDefinition is updated.
+// The JSON path projection is applied first and the mapping next. Default replaces the values which are missing
+// after the projection or are not valid against the new schema.
+type LabelValueTransformation struct {
+	Mapping  []*LabelValueMapping
+	Default  interface{}
+	JSONPath *string
+}
+
+// LabelValueMapping replaces the label value equal to From with To
+type LabelValueMapping struct {
+	From interface{}
+	To   interface{}
+}
+
+// LabelValueChange is the change of the existing label value made by the LabelValueTransformation.
+// NewValue is nil if the value is missing after the projection, and Valid tells if NewValue is valid against the new schema.
+type LabelValueChange struct {
+	LabelID    string
+	ObjectType LabelableObject
+	ObjectID   string
+	OldValue   interface{}
+	NewValue   interface{}
+	Valid      bool
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type ClientCertificate struct {
+	SerialNumber string
+	ExpiresAt    time.Time
+}
+
+type PairingEvent string
+
+const (
+	PairingEventIssued  PairingEvent = "ISSUED"
+	PairingEventRenewed PairingEvent = "RENEWED"
+	PairingEventRevoked PairingEvent = "REVOKED"
+)
+
+type PairingReport struct {
+	Event        PairingEvent
+	SerialNumber string
+	ExpiresAt    time.Time
+}
+
+func (r PairingReport) Validate() error {
+	switch r.Event {
+	case PairingEventIssued, PairingEventRenewed:
+		if r.SerialNumber == "" {
+			return errors.Errorf("serial number is required for %s event", r.Event)
+		}
+		if r.ExpiresAt.IsZero() {
+			return errors.Errorf("expiration time is required for %s event", r.Event)
+		}
+		return nil
+	case PairingEventRevoked:
+		return nil
+	default:
+		return errors.Errorf("unknown pairing event %s", r.Event)
+	}
+}
+
+// Affects reports whether the event changes the given current client certificate.
+// A revocation with a serial number affects only the certificate with that serial number,
+// a revocation without it revokes all certificates of the client.
+func (r PairingReport) Affects(current *ClientCertificate) bool {
+	if r.Event != PairingEventRevoked || r.SerialNumber == "" {
+		return true
+	}
+
+	return current != nil && current.SerialNumber == r.SerialNumber
+}
+
+// Certificate returns the client certificate the report describes, or nil when the certificate has been revoked.
+func (r PairingReport) Certificate() *ClientCertificate {
+	if r.Event == PairingEventRevoked {
+		return nil
+	}
+
+	return &ClientCertificate{
+		SerialNumber: r.SerialNumber,
+		ExpiresAt:    r.ExpiresAt,
+	}
+}
@@ -0,0 +1,135 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairingReport_Validate(t *testing.T) {
+	// given
+	expiresAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		Name        string
+		Input       model.PairingReport
+		ExpectedErr bool
+	}{
+		{
+			Name:  "Issued",
+			Input: model.PairingReport{Event: model.PairingEventIssued, SerialNumber: "123", ExpiresAt: expiresAt},
+		},
+		{
+			Name:  "Renewed",
+			Input: model.PairingReport{Event: model.PairingEventRenewed, SerialNumber: "123", ExpiresAt: expiresAt},
+		},
+		{
+			Name:  "Revoked without certificate details",
+			Input: model.PairingReport{Event: model.PairingEventRevoked},
+		},
+		{
+			Name:        "Issued without serial number",
+			Input:       model.PairingReport{Event: model.PairingEventIssued, ExpiresAt: expiresAt},
+			ExpectedErr: true,
+		},
+		{
+			Name:        "Renewed without expiration time",
+			Input:       model.PairingReport{Event: model.PairingEventRenewed, SerialNumber: "123"},
+			ExpectedErr: true,
+		},
+		{
+			Name:        "Unknown event",
+			Input:       model.PairingReport{Event: "UNKNOWN"},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			err := testCase.Input.Validate()
+
+			// then
+			if testCase.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPairingReport_Affects(t *testing.T) {
+	// given
+	current := &model.ClientCertificate{SerialNumber: "123"}
+
+	testCases := []struct {
+		Name     string
+		Input    model.PairingReport
+		Current  *model.ClientCertificate
+		Expected bool
+	}{
+		{
+			Name:     "Issued certificate",
+			Input:    model.PairingReport{Event: model.PairingEventIssued, SerialNumber: "456"},
+			Current:  current,
+			Expected: true,
+		},
+		{
+			Name:     "Revoked all certificates",
+			Input:    model.PairingReport{Event: model.PairingEventRevoked},
+			Current:  current,
+			Expected: true,
+		},
+		{
+			Name:     "Revoked current certificate",
+			Input:    model.PairingReport{Event: model.PairingEventRevoked, SerialNumber: "123"},
+			Current:  current,
+			Expected: true,
+		},
+		{
+			Name:     "Revoked other certificate",
+			Input:    model.PairingReport{Event: model.PairingEventRevoked, SerialNumber: "456"},
+			Current:  current,
+			Expected: false,
+		},
+		{
+			Name:     "Revoked certificate without current certificate",
+			Input:    model.PairingReport{Event: model.PairingEventRevoked, SerialNumber: "456"},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			result := testCase.Input.Affects(testCase.Current)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestPairingReport_Certificate(t *testing.T) {
+	// given
+	expiresAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Returns certificate for issued certificate", func(t *testing.T) {
+		// when
+		result := model.PairingReport{Event: model.PairingEventIssued, SerialNumber: "123", ExpiresAt: expiresAt}.Certificate()
+
+		// then
+		assert.Equal(t, &model.ClientCertificate{SerialNumber: "123", ExpiresAt: expiresAt}, result)
+	})
+
+	t.Run("Returns nil for revoked certificate", func(t *testing.T) {
+		// when
+		result := model.PairingReport{Event: model.PairingEventRevoked, SerialNumber: "123"}.Certificate()
+
+		// then
+		assert.Nil(t, result)
+	})
+}
@@ -17,6 +17,7 @@ type Runtime struct {
 	Tenant      string
 	Status      *RuntimeStatus
 	AgentAuth   *Auth
+	Certificate *ClientCertificate
 }
 
 type RuntimeStatus struct {
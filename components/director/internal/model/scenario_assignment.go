@@ -0,0 +1,51 @@
+package model
+
+import "github.com/pkg/errors"
+
+// ScenarioAssignmentRule assigns every Runtime which labels match the Selector to the Scenario
+type ScenarioAssignmentRule struct {
+	ID       string
+	Tenant   string
+	Scenario string
+	Selector LabelSelector
+}
+
+// LabelSelector matches the objects with the label Key. If the Query is provided, the label value has to match it as well.
+type LabelSelector struct {
+	Key   string
+	Query *string
+}
+
+type ScenarioAssignmentRuleInput struct {
+	Scenario string
+	Selector LabelSelector
+}
+
+func (i *ScenarioAssignmentRuleInput) ToScenarioAssignmentRule(id, tenant string) *ScenarioAssignmentRule {
+	if i == nil {
+		return nil
+	}
+
+	return &ScenarioAssignmentRule{
+		ID:       id,
+		Tenant:   tenant,
+		Scenario: i.Scenario,
+		Selector: i.Selector,
+	}
+}
+
+func (i *ScenarioAssignmentRuleInput) Validate() error {
+	if i.Scenario == "" {
+		return errors.New("missing Scenario field")
+	}
+
+	if i.Selector.Key == "" {
+		return errors.New("missing Selector Key field")
+	}
+
+	if i.Selector.Key == ScenariosKey {
+		return errors.Errorf("label with key %s can not be used in the selector", ScenariosKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenarioAssignmentRuleInput_ToScenarioAssignmentRule(t *testing.T) {
+	// given
+	query := `$[*] ? (@ == "eu")`
+	in := &model.ScenarioAssignmentRuleInput{
+		Scenario: "EU_APPS",
+		Selector: model.LabelSelector{Key: "region", Query: &query},
+	}
+
+	// when
+	result := in.ToScenarioAssignmentRule("foo", "bar")
+
+	// then
+	assert.Equal(t, &model.ScenarioAssignmentRule{
+		ID:       "foo",
+		Tenant:   "bar",
+		Scenario: "EU_APPS",
+		Selector: model.LabelSelector{Key: "region", Query: &query},
+	}, result)
+}
+
+func TestScenarioAssignmentRuleInput_ToScenarioAssignmentRule_Nil(t *testing.T) {
+	// given
+	var in *model.ScenarioAssignmentRuleInput
+
+	// when
+	result := in.ToScenarioAssignmentRule("foo", "bar")
+
+	// then
+	assert.Nil(t, result)
+}
+
+func TestScenarioAssignmentRuleInput_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         model.ScenarioAssignmentRuleInput
+		ExpectedError string
+	}{
+		{
+			Name:  "Success",
+			Input: model.ScenarioAssignmentRuleInput{Scenario: "EU_APPS", Selector: model.LabelSelector{Key: "region"}},
+		},
+		{
+			Name:          "Missing Scenario",
+			Input:         model.ScenarioAssignmentRuleInput{Selector: model.LabelSelector{Key: "region"}},
+			ExpectedError: "missing Scenario field",
+		},
+		{
+			Name:          "Missing Selector Key",
+			Input:         model.ScenarioAssignmentRuleInput{Scenario: "EU_APPS"},
+			ExpectedError: "missing Selector Key field",
+		},
+		{
+			Name:          "Scenarios label in the Selector",
+			Input:         model.ScenarioAssignmentRuleInput{Scenario: "EU_APPS", Selector: model.LabelSelector{Key: model.ScenariosKey}},
+			ExpectedError: "label with key scenarios can not be used in the selector",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			err := testCase.Input.Validate()
+
+			// then
+			if testCase.ExpectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, testCase.ExpectedError)
+			}
+		})
+	}
+}
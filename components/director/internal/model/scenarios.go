@@ -1,20 +1,13 @@
 package model
 
 const (
-	ScenariosKey = "scenarios"
+	ScenariosKey    = "scenarios"
+	DefaultScenario = "DEFAULT"
 )
 
 var (
-	ScenariosDefaultValue = []interface{}{"DEFAULT"}
-	ScenariosSchema       = map[string]interface{}{
-		"type":        "array",
-		"minItems":    1,
-		"uniqueItems": true,
-		"items": map[string]interface{}{
-			"type": "string",
-			"enum": []string{"DEFAULT"},
-		},
-	}
+	ScenariosDefaultValue = []interface{}{DefaultScenario}
+	ScenariosSchema       = NewScenariosSchema([]string{DefaultScenario})
 	// This schema is used to validate ScenariosSchema (allows only modifications to enum field)
 	SchemaForScenariosSchema = map[string]interface{}{
 		"type":                 "object",
@@ -52,3 +45,23 @@ var (
 		},
 	}
 )
+
+// Scenario is the value of the scenarios label enum with the number of Applications and Runtimes assigned to it
+type Scenario struct {
+	Name         string
+	Applications int
+	Runtimes     int
+}
+
+// NewScenariosSchema returns ScenariosSchema with the given scenarios in the enum
+func NewScenariosSchema(scenarios []string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "array",
+		"minItems":    1,
+		"uniqueItems": true,
+		"items": map[string]interface{}{
+			"type": "string",
+			"enum": scenarios,
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package orderby
+
+import (
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/pkg/errors"
+)
+
+type Field string
+
+const (
+	ID          Field = "ID"
+	Name        Field = "NAME"
+	Title       Field = "TITLE"
+	DisplayName Field = "DISPLAY_NAME"
+)
+
+// OrderBy is the field the listed objects are ordered by. Objects with the same value of the field are ordered by their IDs.
+// The nil OrderBy orders the objects by their IDs in ascending order.
+type OrderBy struct {
+	Field     Field
+	Direction pagination.OrderDirection
+}
+
+func New(field Field, direction pagination.OrderDirection) *OrderBy {
+	return &OrderBy{Field: field, Direction: direction}
+}
+
+func FromApplicationGraphQL(in *graphql.ApplicationOrderBy) *OrderBy {
+	if in == nil {
+		return nil
+	}
+	return fromGraphQL(in.Field.String(), in.Direction)
+}
+
+func FromRuntimeGraphQL(in *graphql.RuntimeOrderBy) *OrderBy {
+	if in == nil {
+		return nil
+	}
+	return fromGraphQL(in.Field.String(), in.Direction)
+}
+
+func FromAPIDefinitionGraphQL(in *graphql.APIDefinitionOrderBy) *OrderBy {
+	if in == nil {
+		return nil
+	}
+	return fromGraphQL(in.Field.String(), in.Direction)
+}
+
+func FromEventAPIDefinitionGraphQL(in *graphql.EventAPIDefinitionOrderBy) *OrderBy {
+	if in == nil {
+		return nil
+	}
+	return fromGraphQL(in.Field.String(), in.Direction)
+}
+
+func FromDocumentGraphQL(in *graphql.DocumentOrderBy) *OrderBy {
+	if in == nil {
+		return nil
+	}
+	return fromGraphQL(in.Field.String(), in.Direction)
+}
+
+func fromGraphQL(field string, direction *graphql.OrderDirection) *OrderBy {
+	orderBy := New(Field(field), pagination.AscOrderDirection)
+	if direction != nil && *direction == graphql.OrderDirectionDesc {
+		orderBy.Direction = pagination.DescOrderDirection
+	}
+
+	return orderBy
+}
+
+// ToPagination returns the order by the column of the field. The ID field is always mapped to the ID column,
+// other fields must be indexed columns of the table, so they are listed explicitly by the repository.
+func (o *OrderBy) ToPagination(idColumn string, columns map[Field]string) (pagination.OrderBy, error) {
+	if o == nil {
+		return pagination.NewAscOrderBy(idColumn), nil
+	}
+
+	column := idColumn
+	if o.Field != ID {
+		var ok bool
+		column, ok = columns[o.Field]
+		if !ok {
+			return pagination.OrderBy{}, errors.Errorf("ordering by %s is not supported", o.Field)
+		}
+	}
+
+	orderBy := pagination.OrderBy{Column: column, Direction: o.Direction}
+	if err := orderBy.Validate(); err != nil {
+		return pagination.OrderBy{}, err
+	}
+
+	return orderBy, nil
+}
+
+// Key is the value of the ordered field and the ID of the object, used to order objects which are not stored in the database
+type Key struct {
+	Value string
+	ID    string
+}
+
+// Less reports whether the object with the key a precedes the object with the key b, the same way as the database orders them
+func (o *OrderBy) Less(a, b Key) bool {
+	if o != nil && o.Direction == pagination.DescOrderDirection {
+		a, b = b, a
+	}
+
+	if a.Value != b.Value {
+		return a.Value < b.Value
+	}
+	return a.ID < b.ID
+}
+
+// FieldOrID returns the ordered field, which is ID for the nil OrderBy
+func (o *OrderBy) FieldOrID() Field {
+	if o == nil {
+		return ID
+	}
+	return o.Field
+}
@@ -0,0 +1,111 @@
+package orderby_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/orderby"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		desc := graphql.OrderDirectionDesc
+		in := &graphql.DocumentOrderBy{Field: graphql.DocumentOrderFieldDisplayName, Direction: &desc}
+
+		result := orderby.FromDocumentGraphQL(in)
+
+		assert.Equal(t, orderby.New(orderby.DisplayName, pagination.DescOrderDirection), result)
+	})
+
+	t.Run("Ascending when direction is not provided", func(t *testing.T) {
+		in := &graphql.ApplicationOrderBy{Field: graphql.ApplicationOrderFieldName}
+
+		result := orderby.FromApplicationGraphQL(in)
+
+		assert.Equal(t, orderby.New(orderby.Name, pagination.AscOrderDirection), result)
+	})
+
+	t.Run("Nil when order is not provided", func(t *testing.T) {
+		result := orderby.FromRuntimeGraphQL(nil)
+
+		assert.Nil(t, result)
+	})
+}
+
+func TestOrderBy_ToPagination(t *testing.T) {
+	columns := map[orderby.Field]string{orderby.Name: "name"}
+
+	testCases := []struct {
+		Name     string
+		Input    *orderby.OrderBy
+		Expected pagination.OrderBy
+		ErrMsg   string
+	}{
+		{
+			Name:     "Default order",
+			Input:    nil,
+			Expected: pagination.NewAscOrderBy("id"),
+		},
+		{
+			Name:     "Order by ID",
+			Input:    orderby.New(orderby.ID, pagination.DescOrderDirection),
+			Expected: pagination.NewDescOrderBy("id"),
+		},
+		{
+			Name:     "Order by column",
+			Input:    orderby.New(orderby.Name, pagination.AscOrderDirection),
+			Expected: pagination.NewAscOrderBy("name"),
+		},
+		{
+			Name:   "Error when field is not supported",
+			Input:  orderby.New(orderby.Title, pagination.AscOrderDirection),
+			ErrMsg: "ordering by TITLE is not supported",
+		},
+		{
+			Name:   "Error when direction is unknown",
+			Input:  orderby.New(orderby.Name, "UP"),
+			ErrMsg: "unknown order direction UP",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			result, err := testCase.Input.ToPagination("id", columns)
+
+			if testCase.ErrMsg != "" {
+				require.EqualError(t, err, testCase.ErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestOrderBy_Less(t *testing.T) {
+	keys := func() []orderby.Key {
+		return []orderby.Key{{Value: "b", ID: "1"}, {Value: "a", ID: "3"}, {Value: "b", ID: "2"}}
+	}
+
+	t.Run("Ascending", func(t *testing.T) {
+		orderBy := orderby.New(orderby.Name, pagination.AscOrderDirection)
+		result := keys()
+
+		sort.Slice(result, func(i, j int) bool { return orderBy.Less(result[i], result[j]) })
+
+		assert.Equal(t, []orderby.Key{{Value: "a", ID: "3"}, {Value: "b", ID: "1"}, {Value: "b", ID: "2"}}, result)
+	})
+
+	t.Run("Descending", func(t *testing.T) {
+		orderBy := orderby.New(orderby.Name, pagination.DescOrderDirection)
+		result := keys()
+
+		sort.Slice(result, func(i, j int) bool { return orderBy.Less(result[i], result[j]) })
+
+		assert.Equal(t, []orderby.Key{{Value: "b", ID: "2"}, {Value: "b", ID: "1"}, {Value: "a", ID: "3"}}, result)
+	})
+}
@@ -134,3 +134,7 @@ func uuidB() string {
 func uuidC() string {
 	return "cccccccc-cccc-cccc-cccc-cccccccccccc"
 }
+
+func uuidD() string {
+	return "dddddddd-dddd-dddd-dddd-dddddddddddd"
+}
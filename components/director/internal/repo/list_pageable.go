@@ -2,25 +2,33 @@ package repo
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
+	"reflect"
 	"strings"
 
+	"github.com/jmoiron/sqlx/reflectx"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
 )
 
+// mapper maps columns to the fields of the listed objects the same way as sqlx does by default
+var mapper = reflectx.NewMapperFunc("db", strings.ToLower)
+
 type PageableQuerier struct {
 	tableName       string
 	selectedColumns string
 	tenantColumn    string
+	idColumn        string
 }
 
-func NewPageableQuerier(tableName, tenantColumn string, selectedColumns []string) *PageableQuerier {
+func NewPageableQuerier(tableName, tenantColumn, idColumn string, selectedColumns []string) *PageableQuerier {
 	return &PageableQuerier{
 		tableName:       tableName,
 		selectedColumns: strings.Join(selectedColumns, ", "),
 		tenantColumn:    tenantColumn,
+		idColumn:        idColumn,
 	}
 }
 
@@ -29,40 +37,57 @@ type Collection interface {
 }
 
 // List returns Page, TotalCount or error
-func (g *PageableQuerier) List(ctx context.Context, tenant string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...string) (*pagination.Page, int, error) {
+func (g *PageableQuerier) List(ctx context.Context, tenant string, pageSize int, cursor string, orderBy pagination.OrderBy, dest Collection, additionalConditions ...string) (*pagination.Page, int, error) {
+	return g.ListWithArgs(ctx, tenant, pageSize, cursor, orderBy, dest, additionalConditions, nil)
+}
+
+// ListWithArgs works as List, binding the arguments to the placeholders of the additional conditions.
+// The placeholders start with $2, as $1 is the tenant.
+//
+// The page starts right after the object the cursor points to, ordered by the given column and the ID column in the given direction.
+// The total count is computed only if it is requested in the context, otherwise -1 is returned.
+func (g *PageableQuerier) ListWithArgs(ctx context.Context, tenant string, pageSize int, cursor string, orderBy pagination.OrderBy, dest Collection, additionalConditions []string, args []interface{}) (*pagination.Page, int, error) {
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	offset, err := pagination.DecodeOffsetCursor(cursor)
+	keysetCursor, err := pagination.DecodeKeysetCursor(cursor, orderBy.String())
 	if err != nil {
 		return nil, -1, errors.Wrap(err, "while decoding page cursor")
 	}
 
-	paginationSQL, err := pagination.ConvertOffsetLimitAndOrderedColumnToSQL(pageSize, offset, orderByColumn)
+	queryArgs := append([]interface{}{tenant}, args...)
+	keysetCondition, keysetArgs, orderAndLimitSQL, err := pagination.ConvertKeysetAndLimitToSQL(pageSize, keysetCursor, orderBy, g.idColumn, len(queryArgs)+1)
 	if err != nil {
-		return nil, -1, errors.Wrap(err, "while converting offset and limit to cursor")
+		return nil, -1, errors.Wrap(err, "while converting cursor and limit to SQL")
 	}
 
 	stmtWithoutPagination := buildSelectStatement(g.selectedColumns, g.tableName, g.tenantColumn, additionalConditions)
-	stmtWithPagination := fmt.Sprintf("%s %s", stmtWithoutPagination, paginationSQL)
+	conditionsWithKeyset := append(append([]string{}, additionalConditions...), keysetCondition)
+	stmtWithPagination := fmt.Sprintf("%s %s", buildSelectStatement(g.selectedColumns, g.tableName, g.tenantColumn, conditionsWithKeyset), orderAndLimitSQL)
 
-	err = persist.Select(dest, stmtWithPagination, tenant)
+	err = persist.Select(dest, stmtWithPagination, append(queryArgs, keysetArgs...)...)
 	if err != nil {
 		return nil, -1, errors.Wrap(err, "while fetching list of objects from DB")
 	}
 
-	totalCount, err := g.getTotalCount(persist, stmtWithoutPagination, tenant)
-	if err != nil {
-		return nil, -1, err
+	totalCount := -1
+	if pagination.IsTotalCountRequested(ctx) {
+		totalCount, err = g.getTotalCount(persist, stmtWithoutPagination, queryArgs)
+		if err != nil {
+			return nil, -1, err
+		}
 	}
 
 	hasNextPage := false
 	endCursor := ""
-	if totalCount > offset+dest.Len() {
+	if dest.Len() > pageSize {
 		hasNextPage = true
-		endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		endCursor, err = g.truncateToPageSize(dest, pageSize, orderBy)
+		if err != nil {
+			return nil, -1, errors.Wrap(err, "while creating next page cursor")
+		}
 	}
 	return &pagination.Page{
 		StartCursor: cursor,
@@ -71,13 +96,57 @@ func (g *PageableQuerier) List(ctx context.Context, tenant string, pageSize int,
 	}, totalCount, nil
 }
 
-func (g *PageableQuerier) getTotalCount(persist persistence.PersistenceOp, query string, tenant string) (int, error) {
+func (g *PageableQuerier) getTotalCount(persist persistence.PersistenceOp, query string, args []interface{}) (int, error) {
 	stmt := strings.Replace(query, g.selectedColumns, "COUNT(*)", 1)
 	var totalCount int
-	err := persist.Get(&totalCount, stmt, tenant)
+	err := persist.Get(&totalCount, stmt, args...)
 	if err != nil {
 		return -1, errors.Wrap(err, "while counting objects")
 	}
 
 	return totalCount, nil
 }
+
+// truncateToPageSize drops the additional object fetched to detect the next page and returns the cursor pointing to the last object of the page
+func (g *PageableQuerier) truncateToPageSize(dest Collection, pageSize int, orderBy pagination.OrderBy) (string, error) {
+	slice := reflect.Indirect(reflect.ValueOf(dest))
+	if slice.Kind() != reflect.Slice || !slice.CanSet() {
+		return "", errors.Errorf("expected pointer to slice, got %T", dest)
+	}
+
+	last := reflect.Indirect(slice.Index(pageSize - 1))
+	slice.Set(slice.Slice(0, pageSize))
+
+	orderByValue, err := columnValue(last, orderBy.Column)
+	if err != nil {
+		return "", err
+	}
+	id, err := columnValue(last, g.idColumn)
+	if err != nil {
+		return "", err
+	}
+
+	return pagination.EncodeKeysetCursor(pagination.KeysetCursor{
+		OrderBy: orderBy.String(),
+		Value:   orderByValue,
+		ID:      id,
+	})
+}
+
+func columnValue(object reflect.Value, column string) (interface{}, error) {
+	if object.Kind() != reflect.Struct {
+		return nil, errors.Errorf("expected struct, got %s", object.Type())
+	}
+
+	fieldInfo := mapper.TypeMap(object.Type()).GetByPath(column)
+	if fieldInfo == nil {
+		return nil, errors.Errorf("missing field for column %s in %s", column, object.Type())
+	}
+
+	value := reflectx.FieldByIndexesReadOnly(object, fieldInfo.Index).Interface()
+	if valuer, ok := value.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return value, nil
+}
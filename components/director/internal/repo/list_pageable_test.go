@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,8 +25,10 @@ func TestListPageable(t *testing.T) {
 	peterRow := []driver.Value{peterID, givenTenant, "Peter", "Griffin", 40}
 	homer := User{FirstName: "Homer", LastName: "Simpson", Age: 55, Tenant: givenTenant, ID: homerID}
 	homerRow := []driver.Value{homerID, givenTenant, "Homer", "Simpson", 55}
+	bartID := uuidD()
+	bartRow := []driver.Value{bartID, givenTenant, "Bart", "Simpson", 10}
 
-	sut := repo.NewPageableQuerier("users", "tenant_col",
+	sut := repo.NewPageableQuerier("users", "tenant_col", "id_col",
 		[]string{"id_col", "tenant_col", "first_name", "last_name", "age"})
 
 	t.Run("returns first page and there are no more pages", func(t *testing.T) {
@@ -35,12 +38,12 @@ func TestListPageable(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
 			AddRow(peterRow...).
 			AddRow(homerRow...)
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 10 OFFSET 0`)).WithArgs(givenTenant).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 11`)).WithArgs(givenTenant).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_col=$1`)).WithArgs(givenTenant).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(2))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, givenTenant, 10, "", "id_col", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, givenTenant, 10, "", pagination.NewAscOrderBy("id_col"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 2, actualTotal)
 		assert.Len(t, dest, 2)
@@ -55,16 +58,19 @@ func TestListPageable(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
 			AddRow(peterRow...).
-			AddRow(homerRow...)
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 2 OFFSET 0`)).WithArgs(givenTenant).WillReturnRows(rows)
+			AddRow(homerRow...).
+			AddRow(bartRow...)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 3`)).WithArgs(givenTenant).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_col=$1`)).WithArgs(givenTenant).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, givenTenant, 2, "", "id_col", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, givenTenant, 2, "", pagination.NewAscOrderBy("id_col"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 2)
+		assert.Equal(t, peter, dest[0])
+		assert.Equal(t, homer, dest[1])
 		assert.True(t, actualPage.HasNextPage)
 		assert.NotEmpty(t, actualPage.EndCursor)
 	})
@@ -74,30 +80,34 @@ func TestListPageable(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		rowsForPage1 := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
-			AddRow(peterRow...)
-		rowsForPage2 := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
+			AddRow(peterRow...).
 			AddRow(homerRow...)
+		rowsForPage2 := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
+			AddRow(homerRow...).
+			AddRow(bartRow...)
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 1 OFFSET 0`)).WithArgs(givenTenant).WillReturnRows(rowsForPage1)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 2`)).WithArgs(givenTenant).WillReturnRows(rowsForPage1)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_col=$1`)).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 1 OFFSET 1`)).WithArgs(givenTenant).WillReturnRows(rowsForPage2)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 AND id_col > $2 ORDER BY id_col LIMIT 2`)).WithArgs(givenTenant, peterID).WillReturnRows(rowsForPage2)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_col=$1`)).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var first UserCollection
 
-		actualFirstPage, actualTotal, err := sut.List(ctx, givenTenant, 1, "", "id_col", &first)
+		actualFirstPage, actualTotal, err := sut.List(ctx, givenTenant, 1, "", pagination.NewAscOrderBy("id_col"), &first)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, first, 1)
+		assert.Equal(t, peter, first[0])
 		assert.True(t, actualFirstPage.HasNextPage)
 		assert.NotEmpty(t, actualFirstPage.EndCursor)
 
 		var second UserCollection
-		actualSecondPage, actualTotal, err := sut.List(ctx, givenTenant, 1, actualFirstPage.EndCursor, "id_col", &second)
+		actualSecondPage, actualTotal, err := sut.List(ctx, givenTenant, 1, actualFirstPage.EndCursor, pagination.NewAscOrderBy("id_col"), &second)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, second, 1)
+		assert.Equal(t, homer, second[0])
 		assert.True(t, actualSecondPage.HasNextPage)
 		assert.NotEmpty(t, actualSecondPage.EndCursor)
 
@@ -109,17 +119,35 @@ func TestListPageable(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
 			AddRow(peterRow...)
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 AND first_name='Peter' AND age > 18 ORDER BY id_col LIMIT 2 OFFSET 0`)).WithArgs(givenTenant).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 AND first_name='Peter' AND age > 18 ORDER BY id_col LIMIT 3`)).WithArgs(givenTenant).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_col=$1 AND first_name='Peter' AND age > 18`)).WithArgs(givenTenant).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, givenTenant, 2, "", "id_col", &dest, "first_name='Peter'", "age > 18")
+		actualPage, actualTotal, err := sut.List(ctx, givenTenant, 2, "", pagination.NewAscOrderBy("id_col"), &dest, "first_name='Peter'", "age > 18")
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 1)
-		assert.True(t, actualPage.HasNextPage)
-		assert.NotEmpty(t, actualPage.EndCursor)
+		assert.False(t, actualPage.HasNextPage)
+		assert.Empty(t, actualPage.EndCursor)
+	})
+
+	t.Run("returns page with additional conditions and arguments", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 AND first_name=$2 AND age > $3 ORDER BY id_col LIMIT 3`)).WithArgs(givenTenant, "Peter", 18).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_col=$1 AND first_name=$2 AND age > $3`)).WithArgs(givenTenant, "Peter", 18).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		actualPage, actualTotal, err := sut.ListWithArgs(ctx, givenTenant, 2, "", pagination.NewAscOrderBy("id_col"), &dest, []string{"first_name=$2", "age > $3"}, []interface{}{"Peter", 18})
+		require.NoError(t, err)
+		assert.Equal(t, 100, actualTotal)
+		assert.Len(t, dest, 1)
+		assert.False(t, actualPage.HasNextPage)
 	})
 
 	t.Run("returns empty page", func(t *testing.T) {
@@ -127,34 +155,122 @@ func TestListPageable(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"})
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 2 OFFSET 0`)).WithArgs(givenTenant).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 3`)).WithArgs(givenTenant).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_col=$1`)).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(0))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, givenTenant, 2, "", "id_col", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, givenTenant, 2, "", pagination.NewAscOrderBy("id_col"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 0, actualTotal)
 		assert.Empty(t, dest)
 		assert.False(t, actualPage.HasNextPage)
 	})
 
+	t.Run("returns next page ordered by other column and the ID", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rowsForPage1 := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
+			AddRow(peterRow...).
+			AddRow(homerRow...)
+		rowsForPage2 := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
+			AddRow(homerRow...)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 AND first_name=$2 ORDER BY age, id_col LIMIT 2`)).WithArgs(givenTenant, "Peter").WillReturnRows(rowsForPage1)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 AND first_name=$2 AND (age, id_col) > ($3, $4) ORDER BY age, id_col LIMIT 2`)).WithArgs(givenTenant, "Peter", "40", peterID).WillReturnRows(rowsForPage2)
+		ctx := pagination.SaveTotalCountRequestedToContext(persistence.SaveToContext(context.TODO(), db), false)
+
+		var first UserCollection
+		actualFirstPage, _, err := sut.ListWithArgs(ctx, givenTenant, 1, "", pagination.NewAscOrderBy("age"), &first, []string{"first_name=$2"}, []interface{}{"Peter"})
+		require.NoError(t, err)
+		require.True(t, actualFirstPage.HasNextPage)
+
+		var second UserCollection
+		actualSecondPage, _, err := sut.ListWithArgs(ctx, givenTenant, 1, actualFirstPage.EndCursor, pagination.NewAscOrderBy("age"), &second, []string{"first_name=$2"}, []interface{}{"Peter"})
+		require.NoError(t, err)
+		assert.Equal(t, UserCollection{homer}, second)
+		assert.False(t, actualSecondPage.HasNextPage)
+	})
+
+	t.Run("returns next page in descending order", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rowsForPage1 := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
+			AddRow(homerRow...).
+			AddRow(peterRow...)
+		rowsForPage2 := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY age DESC, id_col DESC LIMIT 2`)).WithArgs(givenTenant).WillReturnRows(rowsForPage1)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 AND (age, id_col) < ($2, $3) ORDER BY age DESC, id_col DESC LIMIT 2`)).WithArgs(givenTenant, "55", homerID).WillReturnRows(rowsForPage2)
+		ctx := pagination.SaveTotalCountRequestedToContext(persistence.SaveToContext(context.TODO(), db), false)
+
+		var first UserCollection
+		actualFirstPage, _, err := sut.List(ctx, givenTenant, 1, "", pagination.NewDescOrderBy("age"), &first)
+		require.NoError(t, err)
+		assert.Equal(t, UserCollection{homer}, first)
+		require.True(t, actualFirstPage.HasNextPage)
+
+		var second UserCollection
+		actualSecondPage, _, err := sut.List(ctx, givenTenant, 1, actualFirstPage.EndCursor, pagination.NewDescOrderBy("age"), &second)
+		require.NoError(t, err)
+		assert.Equal(t, UserCollection{peter}, second)
+		assert.False(t, actualSecondPage.HasNextPage)
+	})
+
+	t.Run("does not count objects if total count is not requested", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 3`)).WithArgs(givenTenant).WillReturnRows(rows)
+		ctx := pagination.SaveTotalCountRequestedToContext(persistence.SaveToContext(context.TODO(), db), false)
+		var dest UserCollection
+
+		actualPage, actualTotal, err := sut.List(ctx, givenTenant, 2, "", pagination.NewAscOrderBy("id_col"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, -1, actualTotal)
+		assert.Len(t, dest, 1)
+		assert.False(t, actualPage.HasNextPage)
+	})
+
+	t.Run("returns error if cursor was created for other order", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		cursor, err := pagination.EncodeKeysetCursor(pagination.KeysetCursor{OrderBy: "age", Value: 40, ID: peterID})
+		require.NoError(t, err)
+
+		_, _, err = sut.List(ctx, givenTenant, 2, cursor, pagination.NewAscOrderBy("id_col"), nil)
+		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: it was created for order by age")
+	})
+
+	t.Run("returns error if cursor was created for other direction", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		cursor, err := pagination.EncodeKeysetCursor(pagination.KeysetCursor{OrderBy: "age", Value: 40, ID: peterID})
+		require.NoError(t, err)
+
+		_, _, err = sut.List(ctx, givenTenant, 2, cursor, pagination.NewDescOrderBy("age"), nil)
+		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: it was created for order by age")
+	})
+
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
-		_, _, err := sut.List(ctx, givenTenant, 2, "", "id_col", nil)
+		_, _, err := sut.List(ctx, givenTenant, 2, "", pagination.NewAscOrderBy("id_col"), nil)
 		require.EqualError(t, err, "unable to fetch database from context")
 	})
 
 	t.Run("returns error if wrong cursor", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.List(ctx, givenTenant, 2, "zzz", "", nil)
+		_, _, err := sut.List(ctx, givenTenant, 2, "zzz", pagination.OrderBy{}, nil)
 		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
 	})
 
 	t.Run("returns error if wrong pagination attributes", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.List(ctx, givenTenant, -3, "", "id_col", nil)
-		require.EqualError(t, err, "while converting offset and limit to cursor: page size cannot be smaller than 1")
+		_, _, err := sut.List(ctx, givenTenant, -3, "", pagination.NewAscOrderBy("id_col"), nil)
+		require.EqualError(t, err, "while converting cursor and limit to SQL: page size cannot be smaller than 1")
 	})
 
 	t.Run("returns error on db operation", func(t *testing.T) {
@@ -165,7 +281,7 @@ func TestListPageable(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		_, _, err := sut.List(ctx, givenTenant, 2, "", "id_col", &dest)
+		_, _, err := sut.List(ctx, givenTenant, 2, "", pagination.NewAscOrderBy("id_col"), &dest)
 		require.EqualError(t, err, "while fetching list of objects from DB: some error")
 	})
 
@@ -174,12 +290,12 @@ func TestListPageable(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"})
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 2 OFFSET 0`)).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 ORDER BY id_col LIMIT 3`)).WillReturnRows(rows)
 		mock.ExpectQuery(`SELECT COUNT\(\*\).*`).WillReturnError(someError())
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		_, _, err := sut.List(ctx, givenTenant, 2, "", "id_col", &dest)
+		_, _, err := sut.List(ctx, givenTenant, 2, "", pagination.NewAscOrderBy("id_col"), &dest)
 		require.EqualError(t, err, "while counting objects: some error")
 	})
 
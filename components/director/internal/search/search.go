@@ -0,0 +1,57 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Query is the text searched for in the name and the description of the objects. The empty Query matches all objects.
+type Query string
+
+func FromGraphQL(in *string) Query {
+	if in == nil {
+		return ""
+	}
+
+	return Query(strings.TrimSpace(*in))
+}
+
+// Condition builds the condition matching objects which any of the columns contains the query, ignoring case.
+//
+// The query is bound to args as the ILIKE pattern with LIKE wildcards escaped, so it is matched literally
+// and the trigram indexes of the columns can be used. The condition is empty for the empty query.
+func (q Query) Condition(columns []string, args *jsonpath.Args) string {
+	if q == "" || len(columns) == 0 {
+		return ""
+	}
+
+	placeholder := args.Add(fmt.Sprintf("%%%s%%", likeEscaper.Replace(string(q))))
+
+	var conditions []string
+	for _, column := range columns {
+		conditions = append(conditions, fmt.Sprintf("%s ILIKE %s", column, placeholder))
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(conditions, " OR "))
+}
+
+// Matches reports whether any of the values contains the query ignoring case, the same way as the Condition does,
+// for objects which are not stored in the database
+func (q Query) Matches(values ...*string) bool {
+	if q == "" {
+		return true
+	}
+
+	query := strings.ToLower(string(q))
+	for _, value := range values {
+		if value != nil && strings.Contains(strings.ToLower(*value), query) {
+			return true
+		}
+	}
+
+	return false
+}
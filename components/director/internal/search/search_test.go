@@ -0,0 +1,67 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/search"
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
+	"github.com/kyma-incubator/compass/components/director/pkg/strings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromGraphQL(t *testing.T) {
+	t.Run("Trims the query", func(t *testing.T) {
+		assert.Equal(t, search.Query("billing"), search.FromGraphQL(strings.Ptr("  billing ")))
+	})
+
+	t.Run("Empty when query is not provided", func(t *testing.T) {
+		assert.Equal(t, search.Query(""), search.FromGraphQL(nil))
+	})
+}
+
+func TestQuery_Condition(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Query             search.Query
+		ExpectedCondition string
+		ExpectedArgs      []interface{}
+	}{
+		{
+			Name:              "Matches any column",
+			Query:             "Billing",
+			ExpectedCondition: `("name" ILIKE $2 OR "description" ILIKE $2)`,
+			ExpectedArgs:      []interface{}{"tenant", "%Billing%"},
+		},
+		{
+			Name:              "Escapes wildcards",
+			Query:             `100%_off\`,
+			ExpectedCondition: `("name" ILIKE $2 OR "description" ILIKE $2)`,
+			ExpectedArgs:      []interface{}{"tenant", `%100\%\_off\\%`},
+		},
+		{
+			Name:              "Empty for empty query",
+			Query:             "",
+			ExpectedCondition: "",
+			ExpectedArgs:      []interface{}{"tenant"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			args := jsonpath.NewArgs("tenant")
+
+			condition := testCase.Query.Condition([]string{`"name"`, `"description"`}, args)
+
+			assert.Equal(t, testCase.ExpectedCondition, condition)
+			assert.Equal(t, testCase.ExpectedArgs, args.Values())
+		})
+	}
+}
+
+func TestQuery_Matches(t *testing.T) {
+	description := "Handles the BILLING of orders"
+
+	assert.True(t, search.Query("billing").Matches(strings.Ptr("orders"), &description))
+	assert.True(t, search.Query("").Matches(strings.Ptr("orders"), nil))
+	assert.False(t, search.Query("billing").Matches(strings.Ptr("orders"), nil))
+}
@@ -6,6 +6,7 @@ type Application struct {
 	Description    *string            `json:"description"`
 	Status         *ApplicationStatus `json:"status"`
 	HealthCheckURL *string            `json:"healthCheckURL"`
+	Certificate    *ClientCertificate `json:"certificate"`
 }
 
 // Extended types used by external API
@@ -16,6 +16,10 @@ type CredentialData interface {
 // To specify page details, query specify two parameters: `first` and `after`.
 // `first` specify page size, `after` is a cursor for the next page. When requesting first page, set `after` to empty value.
 // For requesting next page, set `after` to `pageInfo.endCursor` returned from previous query.
+// The cursor is opaque and points to the last object of the previous page, so objects created or deleted in the meantime are neither skipped nor duplicated.
+// `totalCount` is computed only if it is selected, so omit it when it is not needed.
+// To change the order of objects, query specifies `orderBy` parameter. Objects with the same value of the field are ordered by ID in the same direction.
+// The cursor is valid only for the order it was created for, so request next pages with the same `orderBy`.
 type Pageable interface {
 	IsPageable()
 }
@@ -30,6 +34,11 @@ type APIDefinitionInput struct {
 	DefaultAuth *AuthInput    `json:"defaultAuth"`
 }
 
+type APIDefinitionOrderBy struct {
+	Field     APIDefinitionOrderField `json:"field"`
+	Direction *OrderDirection         `json:"direction"`
+}
+
 type APIDefinitionPage struct {
 	Data       []*APIDefinition `json:"data"`
 	PageInfo   *PageInfo        `json:"pageInfo"`
@@ -56,6 +65,11 @@ type ApplicationInput struct {
 	Documents      []*DocumentInput           `json:"documents"`
 }
 
+type ApplicationOrderBy struct {
+	Field     ApplicationOrderField `json:"field"`
+	Direction *OrderDirection       `json:"direction"`
+}
+
 type ApplicationPage struct {
 	Data       []*Application `json:"data"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
@@ -95,6 +109,13 @@ type BasicCredentialDataInput struct {
 	Password string `json:"password"`
 }
 
+type BulkLabelResult struct {
+	Key string `json:"key"`
+	// IDs of the objects which were labeled or unlabeled, or would be in the dry run mode
+	AffectedIDs []string `json:"affectedIDs"`
+	DryRun      bool     `json:"dryRun"`
+}
+
 type CSRFTokenCredentialRequestAuth struct {
 	TokenEndpointURL      string         `json:"tokenEndpointURL"`
 	Credential            CredentialData `json:"credential"`
@@ -109,6 +130,27 @@ type CSRFTokenCredentialRequestAuthInput struct {
 	AdditionalQueryParams *QueryParams         `json:"additionalQueryParams"`
 }
 
+type CatalogSearchResult struct {
+	Type          CatalogSearchResultType `json:"type"`
+	ID            string                  `json:"id"`
+	ApplicationID string                  `json:"applicationID"`
+	Name          string                  `json:"name"`
+	Snippet       string                  `json:"snippet"`
+}
+
+type CatalogSearchResultPage struct {
+	Data       []*CatalogSearchResult `json:"data"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+func (CatalogSearchResultPage) IsPageable() {}
+
+type ClientCertificate struct {
+	SerialNumber string    `json:"serialNumber"`
+	ExpiresAt    Timestamp `json:"expiresAt"`
+}
+
 type CredentialDataInput struct {
 	Basic *BasicCredentialDataInput `json:"basic"`
 	Oauth *OAuthCredentialDataInput `json:"oauth"`
@@ -132,6 +174,11 @@ type DocumentInput struct {
 	FetchRequest *FetchRequestInput `json:"fetchRequest"`
 }
 
+type DocumentOrderBy struct {
+	Field     DocumentOrderField `json:"field"`
+	Direction *OrderDirection    `json:"direction"`
+}
+
 type DocumentPage struct {
 	Data       []*Document `json:"data"`
 	PageInfo   *PageInfo   `json:"pageInfo"`
@@ -159,6 +206,11 @@ type EventAPIDefinitionInput struct {
 	Version     *VersionInput      `json:"version"`
 }
 
+type EventAPIDefinitionOrderBy struct {
+	Field     EventAPIDefinitionOrderField `json:"field"`
+	Direction *OrderDirection              `json:"direction"`
+}
+
 type EventAPIDefinitionPage struct {
 	Data       []*EventAPIDefinition `json:"data"`
 	PageInfo   *PageInfo             `json:"pageInfo"`
@@ -226,12 +278,72 @@ type LabelDefinitionInput struct {
 	Schema *interface{} `json:"schema"`
 }
 
+// Filter is either a single label condition with the key and the optional query,
+// or the composition of other filters with exactly one of 'and', 'or' and 'not'.
 type LabelFilter struct {
 	// Label key. If query for the filter is not provided, returns every object with given label key regardless of its value.
-	Key string `json:"key"`
+	Key *string `json:"key"`
 	// Optional SQL/JSON Path expression. If query is not provided, returns every object with given label key regardless of its value.
 	// Currently only a limited subset of expressions is supported.
 	Query *string `json:"query"`
+	// Returns objects matching all the filters.
+	And []*LabelFilter `json:"and"`
+	// Returns objects matching any of the filters.
+	Or []*LabelFilter `json:"or"`
+	// Returns objects not matching the filter.
+	Not *LabelFilter `json:"not"`
+}
+
+type LabelKeyUsage struct {
+	Key          string             `json:"key"`
+	Applications int                `json:"applications"`
+	Runtimes     int                `json:"runtimes"`
+	Values       []*LabelValueUsage `json:"values"`
+}
+
+type LabelSelector struct {
+	Key string `json:"key"`
+	// Optional SQL/JSON Path expression. If query is not provided, matches every Runtime with given label key regardless of its value.
+	Query *string `json:"query"`
+}
+
+type LabelSelectorInput struct {
+	// Label key. The scenarios label can not be used.
+	Key string `json:"key"`
+	// Optional SQL/JSON Path expression. If query is not provided, matches every Runtime with given label key regardless of its value.
+	Query *string `json:"query"`
+}
+
+type LabelValueChange struct {
+	ObjectType LabelableObject `json:"objectType"`
+	ObjectID   string          `json:"objectID"`
+	OldValue   interface{}     `json:"oldValue"`
+	// New value of the label. It is null if the value is missing after the projection and there is no default.
+	NewValue *interface{} `json:"newValue"`
+	// Whether the new value is valid against the new schema
+	Valid bool `json:"valid"`
+}
+
+type LabelValueMappingInput struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// Rewrites the values of the existing labels when the schema of the Label Definition is updated.
+// The projection is applied first and then the mapping. If the result is missing or not valid against the new schema, the default is used.
+type LabelValueTransformationInput struct {
+	// Replaces the values equal to 'from'. If none matches an array value, its elements are mapped one by one.
+	Mapping []*LabelValueMappingInput `json:"mapping"`
+	// Value used for the labels which are missing after the projection or not valid against the new schema
+	Default *interface{} `json:"default"`
+	// JSON path selecting the new value from the existing one, for example '$.region'. A path with wildcard selects an array.
+	JSONPath *string `json:"jsonPath"`
+}
+
+type LabelValueUsage struct {
+	Value        interface{} `json:"value"`
+	Applications int         `json:"applications"`
+	Runtimes     int         `json:"runtimes"`
 }
 
 type OAuthCredentialData struct {
@@ -254,6 +366,13 @@ type PageInfo struct {
 	HasNextPage bool       `json:"hasNextPage"`
 }
 
+type PairingReportInput struct {
+	Event PairingEvent `json:"event"`
+	//  serialNumber and expiresAt are required for ISSUED and RENEWED events, serialNumber of REVOKED event limits it to that certificate
+	SerialNumber *string    `json:"serialNumber"`
+	ExpiresAt    *Timestamp `json:"expiresAt"`
+}
+
 type RuntimeAuth struct {
 	RuntimeID string `json:"runtimeID"`
 	Auth      *Auth  `json:"auth"`
@@ -265,6 +384,11 @@ type RuntimeInput struct {
 	Labels      *Labels `json:"labels"`
 }
 
+type RuntimeOrderBy struct {
+	Field     RuntimeOrderField `json:"field"`
+	Direction *OrderDirection   `json:"direction"`
+}
+
 type RuntimePage struct {
 	Data       []*Runtime `json:"data"`
 	PageInfo   *PageInfo  `json:"pageInfo"`
@@ -278,6 +402,28 @@ type RuntimeStatus struct {
 	Timestamp Timestamp              `json:"timestamp"`
 }
 
+// Scenario is a value of the enum in the schema of the scenarios Label Definition
+type Scenario struct {
+	Name string `json:"name"`
+	// Number of Applications assigned to the scenario
+	Applications int `json:"applications"`
+	// Number of Runtimes assigned to the scenario
+	Runtimes int `json:"runtimes"`
+}
+
+// Rule adding the scenario to the scenarios label of every Runtime which labels match the selector.
+// The scenarios added by the rules are removed when the Runtime stops matching them, the scenarios set manually are kept and the ones removed manually are not added back. Updating the Runtime without the scenarios label keeps the scenarios added by the rules.
+type ScenarioAssignmentRule struct {
+	ID       string         `json:"id"`
+	Scenario string         `json:"scenario"`
+	Selector *LabelSelector `json:"selector"`
+}
+
+type ScenarioAssignmentRuleInput struct {
+	Scenario string              `json:"scenario"`
+	Selector *LabelSelectorInput `json:"selector"`
+}
+
 type Version struct {
 	// for example 4.6
 	Value      string `json:"value"`
@@ -309,6 +455,47 @@ type WebhookInput struct {
 	Auth *AuthInput             `json:"auth"`
 }
 
+type APIDefinitionOrderField string
+
+const (
+	APIDefinitionOrderFieldID   APIDefinitionOrderField = "ID"
+	APIDefinitionOrderFieldName APIDefinitionOrderField = "NAME"
+)
+
+var AllAPIDefinitionOrderField = []APIDefinitionOrderField{
+	APIDefinitionOrderFieldID,
+	APIDefinitionOrderFieldName,
+}
+
+func (e APIDefinitionOrderField) IsValid() bool {
+	switch e {
+	case APIDefinitionOrderFieldID, APIDefinitionOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e APIDefinitionOrderField) String() string {
+	return string(e)
+}
+
+func (e *APIDefinitionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = APIDefinitionOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid APIDefinitionOrderField", str)
+	}
+	return nil
+}
+
+func (e APIDefinitionOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type APISpecType string
 
 const (
@@ -350,6 +537,47 @@ func (e APISpecType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ApplicationOrderField string
+
+const (
+	ApplicationOrderFieldID   ApplicationOrderField = "ID"
+	ApplicationOrderFieldName ApplicationOrderField = "NAME"
+)
+
+var AllApplicationOrderField = []ApplicationOrderField{
+	ApplicationOrderFieldID,
+	ApplicationOrderFieldName,
+}
+
+func (e ApplicationOrderField) IsValid() bool {
+	switch e {
+	case ApplicationOrderFieldID, ApplicationOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationOrderField) String() string {
+	return string(e)
+}
+
+func (e *ApplicationOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationOrderField", str)
+	}
+	return nil
+}
+
+func (e ApplicationOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ApplicationStatusCondition string
 
 const (
@@ -434,6 +662,49 @@ func (e ApplicationWebhookType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type CatalogSearchResultType string
+
+const (
+	CatalogSearchResultTypeAPI      CatalogSearchResultType = "API"
+	CatalogSearchResultTypeEventAPI CatalogSearchResultType = "EVENT_API"
+	CatalogSearchResultTypeDocument CatalogSearchResultType = "DOCUMENT"
+)
+
+var AllCatalogSearchResultType = []CatalogSearchResultType{
+	CatalogSearchResultTypeAPI,
+	CatalogSearchResultTypeEventAPI,
+	CatalogSearchResultTypeDocument,
+}
+
+func (e CatalogSearchResultType) IsValid() bool {
+	switch e {
+	case CatalogSearchResultTypeAPI, CatalogSearchResultTypeEventAPI, CatalogSearchResultTypeDocument:
+		return true
+	}
+	return false
+}
+
+func (e CatalogSearchResultType) String() string {
+	return string(e)
+}
+
+func (e *CatalogSearchResultType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CatalogSearchResultType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CatalogSearchResultType", str)
+	}
+	return nil
+}
+
+func (e CatalogSearchResultType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type DocumentFormat string
 
 const (
@@ -473,6 +744,90 @@ func (e DocumentFormat) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type DocumentOrderField string
+
+const (
+	DocumentOrderFieldID          DocumentOrderField = "ID"
+	DocumentOrderFieldTitle       DocumentOrderField = "TITLE"
+	DocumentOrderFieldDisplayName DocumentOrderField = "DISPLAY_NAME"
+)
+
+var AllDocumentOrderField = []DocumentOrderField{
+	DocumentOrderFieldID,
+	DocumentOrderFieldTitle,
+	DocumentOrderFieldDisplayName,
+}
+
+func (e DocumentOrderField) IsValid() bool {
+	switch e {
+	case DocumentOrderFieldID, DocumentOrderFieldTitle, DocumentOrderFieldDisplayName:
+		return true
+	}
+	return false
+}
+
+func (e DocumentOrderField) String() string {
+	return string(e)
+}
+
+func (e *DocumentOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DocumentOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DocumentOrderField", str)
+	}
+	return nil
+}
+
+func (e DocumentOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type EventAPIDefinitionOrderField string
+
+const (
+	EventAPIDefinitionOrderFieldID   EventAPIDefinitionOrderField = "ID"
+	EventAPIDefinitionOrderFieldName EventAPIDefinitionOrderField = "NAME"
+)
+
+var AllEventAPIDefinitionOrderField = []EventAPIDefinitionOrderField{
+	EventAPIDefinitionOrderFieldID,
+	EventAPIDefinitionOrderFieldName,
+}
+
+func (e EventAPIDefinitionOrderField) IsValid() bool {
+	switch e {
+	case EventAPIDefinitionOrderFieldID, EventAPIDefinitionOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e EventAPIDefinitionOrderField) String() string {
+	return string(e)
+}
+
+func (e *EventAPIDefinitionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventAPIDefinitionOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventAPIDefinitionOrderField", str)
+	}
+	return nil
+}
+
+func (e EventAPIDefinitionOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type EventAPISpecType string
 
 const (
@@ -678,6 +1033,172 @@ func (e HealthCheckType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type LabelableObject string
+
+const (
+	LabelableObjectApplication LabelableObject = "APPLICATION"
+	LabelableObjectRuntime     LabelableObject = "RUNTIME"
+)
+
+var AllLabelableObject = []LabelableObject{
+	LabelableObjectApplication,
+	LabelableObjectRuntime,
+}
+
+func (e LabelableObject) IsValid() bool {
+	switch e {
+	case LabelableObjectApplication, LabelableObjectRuntime:
+		return true
+	}
+	return false
+}
+
+func (e LabelableObject) String() string {
+	return string(e)
+}
+
+func (e *LabelableObject) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LabelableObject(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LabelableObject", str)
+	}
+	return nil
+}
+
+func (e LabelableObject) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PairingEvent string
+
+const (
+	PairingEventIssued  PairingEvent = "ISSUED"
+	PairingEventRenewed PairingEvent = "RENEWED"
+	PairingEventRevoked PairingEvent = "REVOKED"
+)
+
+var AllPairingEvent = []PairingEvent{
+	PairingEventIssued,
+	PairingEventRenewed,
+	PairingEventRevoked,
+}
+
+func (e PairingEvent) IsValid() bool {
+	switch e {
+	case PairingEventIssued, PairingEventRenewed, PairingEventRevoked:
+		return true
+	}
+	return false
+}
+
+func (e PairingEvent) String() string {
+	return string(e)
+}
+
+func (e *PairingEvent) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PairingEvent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PairingEvent", str)
+	}
+	return nil
+}
+
+func (e PairingEvent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RuntimeOrderField string
+
+const (
+	RuntimeOrderFieldID   RuntimeOrderField = "ID"
+	RuntimeOrderFieldName RuntimeOrderField = "NAME"
+)
+
+var AllRuntimeOrderField = []RuntimeOrderField{
+	RuntimeOrderFieldID,
+	RuntimeOrderFieldName,
+}
+
+func (e RuntimeOrderField) IsValid() bool {
+	switch e {
+	case RuntimeOrderFieldID, RuntimeOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e RuntimeOrderField) String() string {
+	return string(e)
+}
+
+func (e *RuntimeOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RuntimeOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RuntimeOrderField", str)
+	}
+	return nil
+}
+
+func (e RuntimeOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RuntimeStatusCondition string
 
 const (
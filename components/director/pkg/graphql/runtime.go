@@ -6,7 +6,8 @@ type Runtime struct {
 	Description *string        `json:"description"`
 	Status      *RuntimeStatus `json:"status"`
 	// TODO: directive for checking auth
-	AgentAuth *Auth `json:"agentAuth"`
+	AgentAuth   *Auth              `json:"agentAuth"`
+	Certificate *ClientCertificate `json:"certificate"`
 }
 
 // Extended types used by external API
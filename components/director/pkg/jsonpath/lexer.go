@@ -0,0 +1,188 @@
+package jsonpath
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenRoot
+	tokenCurrent
+	tokenDot
+	tokenLeftBracket
+	tokenRightBracket
+	tokenLeftParen
+	tokenRightParen
+	tokenWildcard
+	tokenQuestion
+	tokenOperator
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenString
+	tokenNumber
+	tokenIdentifier
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+var punctuation = []struct {
+	text string
+	kind tokenKind
+}{
+	{"==", tokenOperator},
+	{"!=", tokenOperator},
+	{"<>", tokenOperator},
+	{"<=", tokenOperator},
+	{">=", tokenOperator},
+	{"&&", tokenAnd},
+	{"||", tokenOr},
+	{"<", tokenOperator},
+	{">", tokenOperator},
+	{"!", tokenNot},
+	{"$", tokenRoot},
+	{"@", tokenCurrent},
+	{".", tokenDot},
+	{"[", tokenLeftBracket},
+	{"]", tokenRightBracket},
+	{"(", tokenLeftParen},
+	{")", tokenRightParen},
+	{"*", tokenWildcard},
+	{"?", tokenQuestion},
+}
+
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+
+	runes := []rune(input)
+	for pos := 0; pos < len(runes); {
+		char := runes[pos]
+
+		switch {
+		case unicode.IsSpace(char):
+			pos++
+		case char == '"':
+			value, next, err := readString(runes, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value, pos: pos})
+			pos = next
+		case unicode.IsDigit(char) || (char == '-' && pos+1 < len(runes) && unicode.IsDigit(runes[pos+1])):
+			value, next := readNumber(runes, pos)
+			tokens = append(tokens, token{kind: tokenNumber, value: value, pos: pos})
+			pos = next
+		case unicode.IsLetter(char) || char == '_':
+			next := pos
+			for next < len(runes) && (unicode.IsLetter(runes[next]) || unicode.IsDigit(runes[next]) || runes[next] == '_') {
+				next++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, value: string(runes[pos:next]), pos: pos})
+			pos = next
+		default:
+			matched := false
+			for _, p := range punctuation {
+				if strings.HasPrefix(string(runes[pos:]), p.text) {
+					tokens = append(tokens, token{kind: p.kind, value: p.text, pos: pos})
+					pos += len([]rune(p.text))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, errors.Errorf("unexpected character %q at position %d", char, pos)
+			}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+func readString(runes []rune, start int) (string, int, error) {
+	var builder strings.Builder
+
+	for pos := start + 1; pos < len(runes); pos++ {
+		switch runes[pos] {
+		case '"':
+			return builder.String(), pos + 1, nil
+		case '\\':
+			if pos+1 >= len(runes) {
+				return "", 0, errors.Errorf("unterminated string starting at position %d", start)
+			}
+			pos++
+			switch runes[pos] {
+			case '"', '\\', '/':
+				builder.WriteRune(runes[pos])
+			case 'b':
+				builder.WriteRune('\b')
+			case 'f':
+				builder.WriteRune('\f')
+			case 'n':
+				builder.WriteRune('\n')
+			case 'r':
+				builder.WriteRune('\r')
+			case 't':
+				builder.WriteRune('\t')
+			case 'u':
+				if pos+4 >= len(runes) {
+					return "", 0, errors.Errorf("invalid unicode escape at position %d", pos)
+				}
+				code, err := strconv.ParseUint(string(runes[pos+1:pos+5]), 16, 32)
+				if err != nil {
+					return "", 0, errors.Errorf("invalid unicode escape at position %d", pos)
+				}
+				builder.WriteRune(rune(code))
+				pos += 4
+			default:
+				return "", 0, errors.Errorf("invalid escape sequence at position %d", pos)
+			}
+		default:
+			builder.WriteRune(runes[pos])
+		}
+	}
+
+	return "", 0, errors.Errorf("unterminated string starting at position %d", start)
+}
+
+func readNumber(runes []rune, start int) (string, int) {
+	pos := start
+	if runes[pos] == '-' {
+		pos++
+	}
+
+	pos = skipDigits(runes, pos)
+
+	if pos+1 < len(runes) && runes[pos] == '.' && unicode.IsDigit(runes[pos+1]) {
+		pos = skipDigits(runes, pos+1)
+	}
+
+	if pos < len(runes) && (runes[pos] == 'e' || runes[pos] == 'E') {
+		exponent := pos + 1
+		if exponent < len(runes) && (runes[exponent] == '+' || runes[exponent] == '-') {
+			exponent++
+		}
+		if exponent < len(runes) && unicode.IsDigit(runes[exponent]) {
+			pos = skipDigits(runes, exponent)
+		}
+	}
+
+	return string(runes[start:pos]), pos
+}
+
+func skipDigits(runes []rune, pos int) int {
+	for pos < len(runes) && unicode.IsDigit(runes[pos]) {
+		pos++
+	}
+
+	return pos
+}
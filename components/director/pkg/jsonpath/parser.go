@@ -0,0 +1,390 @@
+package jsonpath
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+type AccessorKind int
+
+const (
+	MemberAccessor AccessorKind = iota
+	IndexAccessor
+	WildcardAccessor
+)
+
+// Accessor is a single step of the path, for example `.name`, `[0]` or `[*]`
+type Accessor struct {
+	Kind  AccessorKind
+	Key   string
+	Index int
+}
+
+// Query is the parsed JSON path, optionally filtered with the predicate applied to the items selected by the path
+type Query struct {
+	Path   []Accessor
+	Filter Predicate
+}
+
+// Predicate is the condition of the filter expression
+type Predicate interface {
+	predicate()
+}
+
+type And struct {
+	Left, Right Predicate
+}
+
+type Or struct {
+	Left, Right Predicate
+}
+
+type Not struct {
+	Operand Predicate
+}
+
+type LiteralKind int
+
+const (
+	StringLiteral LiteralKind = iota
+	NumberLiteral
+	BooleanLiteral
+	NullLiteral
+)
+
+// Literal keeps numbers as written in the query, so that they are compared by the database without precision loss
+type Literal struct {
+	Kind  LiteralKind
+	Value string
+}
+
+// Comparison compares the value under the path relative to the current item with the literal
+type Comparison struct {
+	Path     []Accessor
+	Operator string
+	Value    Literal
+}
+
+type Exists struct {
+	Path []Accessor
+}
+
+type LikeRegex struct {
+	Path            []Accessor
+	Pattern         string
+	CaseInsensitive bool
+}
+
+func (And) predicate()        {}
+func (Or) predicate()         {}
+func (Not) predicate()        {}
+func (Comparison) predicate() {}
+func (Exists) predicate()     {}
+func (LikeRegex) predicate()  {}
+
+var flippedOperators = map[string]string{
+	"==": "==",
+	"!=": "!=",
+	"<>": "!=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+// Parse parses the subset of the SQL/JSON path language used in label filters.
+// Supported are member, index and wildcard accessors, and the filter with comparisons, `exists`, `like_regex`, `&&`, `||` and `!`.
+func Parse(input string) (*Query, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing JSON path %q", input)
+	}
+
+	p := &parser{tokens: tokens}
+	query, err := p.parseQuery()
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing JSON path %q", input)
+	}
+
+	return query, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) parseQuery() (*Query, error) {
+	if _, err := p.expect(tokenRoot); err != nil {
+		return nil, err
+	}
+
+	path, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+
+	query := &Query{Path: path}
+	if p.peek().kind == tokenQuestion {
+		p.next()
+		if _, err := p.expect(tokenLeftParen); err != nil {
+			return nil, err
+		}
+		query.Filter, err = p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := p.expect(tokenEOF); err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+func (p *parser) parsePath() ([]Accessor, error) {
+	var path []Accessor
+
+	for {
+		switch p.peek().kind {
+		case tokenDot:
+			p.next()
+			tok := p.next()
+			switch tok.kind {
+			case tokenIdentifier, tokenString:
+				path = append(path, Accessor{Kind: MemberAccessor, Key: tok.value})
+			case tokenWildcard:
+				return nil, errors.Errorf("member wildcard at position %d is not supported", tok.pos)
+			default:
+				return nil, unexpected(tok)
+			}
+		case tokenLeftBracket:
+			p.next()
+			tok := p.next()
+			switch tok.kind {
+			case tokenWildcard:
+				path = append(path, Accessor{Kind: WildcardAccessor})
+			case tokenNumber:
+				index, err := strconv.Atoi(tok.value)
+				if err != nil || index < 0 {
+					return nil, errors.Errorf("invalid array index %s at position %d", tok.value, tok.pos)
+				}
+				path = append(path, Accessor{Kind: IndexAccessor, Index: index})
+			default:
+				return nil, unexpected(tok)
+			}
+			if _, err := p.expect(tokenRightBracket); err != nil {
+				return nil, err
+			}
+		default:
+			return path, nil
+		}
+	}
+}
+
+func (p *parser) parseOr() (Predicate, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = Or{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Predicate, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = And{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Predicate, error) {
+	tok := p.peek()
+
+	switch {
+	case tok.kind == tokenNot:
+		p.next()
+		if _, err := p.expect(tokenLeftParen); err != nil {
+			return nil, err
+		}
+		operand, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen); err != nil {
+			return nil, err
+		}
+		return Not{Operand: operand}, nil
+	case tok.kind == tokenLeftParen:
+		p.next()
+		predicate, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen); err != nil {
+			return nil, err
+		}
+		return predicate, nil
+	case tok.kind == tokenIdentifier && tok.value == "exists":
+		p.next()
+		if _, err := p.expect(tokenLeftParen); err != nil {
+			return nil, err
+		}
+		path, err := p.parseCurrentPath()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen); err != nil {
+			return nil, err
+		}
+		return Exists{Path: path}, nil
+	case tok.kind == tokenCurrent:
+		return p.parsePathPredicate()
+	default:
+		literal, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		operator, err := p.expect(tokenOperator)
+		if err != nil {
+			return nil, err
+		}
+		path, err := p.parseCurrentPath()
+		if err != nil {
+			return nil, err
+		}
+		return newComparison(path, flippedOperators[operator.value], literal, operator.pos)
+	}
+}
+
+func (p *parser) parsePathPredicate() (Predicate, error) {
+	path, err := p.parseCurrentPath()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.next()
+	switch {
+	case tok.kind == tokenOperator:
+		literal, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		operator := tok.value
+		if operator == "<>" {
+			operator = "!="
+		}
+		return newComparison(path, operator, literal, tok.pos)
+	case tok.kind == tokenIdentifier && tok.value == "like_regex":
+		pattern, err := p.expect(tokenString)
+		if err != nil {
+			return nil, err
+		}
+		predicate := LikeRegex{Path: path, Pattern: pattern.value}
+		if flag := p.peek(); flag.kind == tokenIdentifier && flag.value == "flag" {
+			p.next()
+			flags, err := p.expect(tokenString)
+			if err != nil {
+				return nil, err
+			}
+			if flags.value != "i" {
+				return nil, errors.Errorf("unsupported like_regex flag %q at position %d", flags.value, flags.pos)
+			}
+			predicate.CaseInsensitive = true
+		}
+		return predicate, nil
+	default:
+		return nil, unexpected(tok)
+	}
+}
+
+func newComparison(path []Accessor, operator string, literal Literal, pos int) (Predicate, error) {
+	if (literal.Kind == BooleanLiteral || literal.Kind == NullLiteral) && operator != "==" && operator != "!=" {
+		return nil, errors.Errorf("operator %s at position %d cannot be used with %s", operator, pos, literal.Value)
+	}
+
+	return Comparison{Path: path, Operator: operator, Value: literal}, nil
+}
+
+func (p *parser) parseCurrentPath() ([]Accessor, error) {
+	if _, err := p.expect(tokenCurrent); err != nil {
+		return nil, err
+	}
+
+	return p.parsePath()
+}
+
+func (p *parser) parseLiteral() (Literal, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenString:
+		return Literal{Kind: StringLiteral, Value: tok.value}, nil
+	case tokenNumber:
+		return Literal{Kind: NumberLiteral, Value: tok.value}, nil
+	case tokenIdentifier:
+		switch tok.value {
+		case "true", "false":
+			return Literal{Kind: BooleanLiteral, Value: tok.value}, nil
+		case "null":
+			return Literal{Kind: NullLiteral, Value: tok.value}, nil
+		}
+	}
+
+	return Literal{}, unexpected(tok)
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+func (p *parser) expect(kind tokenKind) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return token{}, unexpected(tok)
+	}
+
+	return tok, nil
+}
+
+func unexpected(tok token) error {
+	if tok.kind == tokenEOF {
+		return errors.New("unexpected end of expression")
+	}
+
+	return errors.Errorf("unexpected %q at position %d", tok.value, tok.pos)
+}
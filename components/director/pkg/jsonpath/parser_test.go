@@ -0,0 +1,122 @@
+package jsonpath_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected *jsonpath.Query
+	}{
+		{
+			Name:     "Root",
+			Input:    `$`,
+			Expected: &jsonpath.Query{},
+		},
+		{
+			Name:  "Accessors",
+			Input: `$.foo."bar baz"[2][*]`,
+			Expected: &jsonpath.Query{Path: []jsonpath.Accessor{
+				{Kind: jsonpath.MemberAccessor, Key: "foo"},
+				{Kind: jsonpath.MemberAccessor, Key: "bar baz"},
+				{Kind: jsonpath.IndexAccessor, Index: 2},
+				{Kind: jsonpath.WildcardAccessor},
+			}},
+		},
+		{
+			Name:  "Scenarios filter",
+			Input: `$[*] ? (@ == "DEFAULT")`,
+			Expected: &jsonpath.Query{
+				Path:   []jsonpath.Accessor{{Kind: jsonpath.WildcardAccessor}},
+				Filter: jsonpath.Comparison{Operator: "==", Value: jsonpath.Literal{Kind: jsonpath.StringLiteral, Value: "DEFAULT"}},
+			},
+		},
+		{
+			Name:  "Flips operator when literal is on the left side",
+			Input: `$ ? (10 < @.size)`,
+			Expected: &jsonpath.Query{
+				Filter: jsonpath.Comparison{
+					Path:     []jsonpath.Accessor{{Kind: jsonpath.MemberAccessor, Key: "size"}},
+					Operator: ">",
+					Value:    jsonpath.Literal{Kind: jsonpath.NumberLiteral, Value: "10"},
+				},
+			},
+		},
+		{
+			Name:  "Operator precedence",
+			Input: `$ ? (@.a == true || exists(@.b) && !(@.c <> null))`,
+			Expected: &jsonpath.Query{
+				Filter: jsonpath.Or{
+					Left: jsonpath.Comparison{
+						Path:     []jsonpath.Accessor{{Kind: jsonpath.MemberAccessor, Key: "a"}},
+						Operator: "==",
+						Value:    jsonpath.Literal{Kind: jsonpath.BooleanLiteral, Value: "true"},
+					},
+					Right: jsonpath.And{
+						Left: jsonpath.Exists{Path: []jsonpath.Accessor{{Kind: jsonpath.MemberAccessor, Key: "b"}}},
+						Right: jsonpath.Not{Operand: jsonpath.Comparison{
+							Path:     []jsonpath.Accessor{{Kind: jsonpath.MemberAccessor, Key: "c"}},
+							Operator: "!=",
+							Value:    jsonpath.Literal{Kind: jsonpath.NullLiteral, Value: "null"},
+						}},
+					},
+				},
+			},
+		},
+		{
+			Name:  "Like regex with flag",
+			Input: `$ ? (@ like_regex "^prod-\\d+$" flag "i")`,
+			Expected: &jsonpath.Query{
+				Filter: jsonpath.LikeRegex{Pattern: `^prod-\d+$`, CaseInsensitive: true},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			query, err := jsonpath.Parse(testCase.Input)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, query)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         string
+		ExpectedError string
+	}{
+		{Name: "Empty", Input: ``, ExpectedError: "unexpected end of expression"},
+		{Name: "Raw JSON value", Input: `["foo"]`, ExpectedError: `unexpected "[" at position 0`},
+		{Name: "Unterminated string", Input: `$ ? (@ == "foo)`, ExpectedError: "unterminated string starting at position 10"},
+		{Name: "Missing closing parenthesis", Input: `$ ? (@ == "foo"`, ExpectedError: "unexpected end of expression"},
+		{Name: "Path compared with path", Input: `$ ? (@.a == @.b)`, ExpectedError: `unexpected "@" at position 12`},
+		{Name: "Ordering boolean", Input: `$ ? (@ > true)`, ExpectedError: "operator > at position 7 cannot be used with true"},
+		{Name: "Unsupported flag", Input: `$ ? (@ like_regex "a" flag "x")`, ExpectedError: `unsupported like_regex flag "x"`},
+		{Name: "Member wildcard", Input: `$.*`, ExpectedError: "member wildcard at position 2 is not supported"},
+		{Name: "Trailing tokens", Input: `$.foo bar`, ExpectedError: `unexpected "bar" at position 6`},
+		{Name: "Unknown character", Input: `$ ? (@ == 'foo')`, ExpectedError: `unexpected character '\'' at position 10`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			_, err := jsonpath.Parse(testCase.Input)
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.ExpectedError)
+		})
+	}
+}
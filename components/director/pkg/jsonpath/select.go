@@ -0,0 +1,52 @@
+package jsonpath
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Select evaluates the path of the query on the JSON value decoded into interface{} and returns the selected items.
+// It follows the semantics of ToSQL: member and index accessors select nothing from values of other types,
+// while the wildcard treats a non-array value as a single element array.
+// Filter expressions are evaluated only by the database, so they are not supported.
+func (q *Query) Select(value interface{}) ([]interface{}, error) {
+	if q.Filter != nil {
+		return nil, errors.New("filter expressions are not supported when selecting values")
+	}
+
+	items := []interface{}{value}
+	for _, accessor := range q.Path {
+		var selected []interface{}
+		for _, item := range items {
+			selected = append(selected, accessor.selectFrom(item)...)
+		}
+		items = selected
+	}
+
+	return items, nil
+}
+
+func (a Accessor) selectFrom(item interface{}) []interface{} {
+	switch a.Kind {
+	case MemberAccessor:
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value, found := object[a.Key]
+		if !found {
+			return nil
+		}
+		return []interface{}{value}
+	case IndexAccessor:
+		array, ok := item.([]interface{})
+		if !ok || a.Index >= len(array) {
+			return nil
+		}
+		return []interface{}{array[a.Index]}
+	default:
+		if array, ok := item.([]interface{}); ok {
+			return array
+		}
+		return []interface{}{item}
+	}
+}
@@ -0,0 +1,89 @@
+package jsonpath_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
+)
+
+func TestQuery_Select(t *testing.T) {
+	value := map[string]interface{}{
+		"region": "eu",
+		"zones": []interface{}{
+			map[string]interface{}{"name": "a"},
+			map[string]interface{}{"name": "b"},
+			"c",
+		},
+	}
+
+	testCases := []struct {
+		Name          string
+		Input         string
+		ExpectedItems []interface{}
+	}{
+		{
+			Name:          "Root",
+			Input:         `$`,
+			ExpectedItems: []interface{}{value},
+		},
+		{
+			Name:          "Member",
+			Input:         `$.region`,
+			ExpectedItems: []interface{}{"eu"},
+		},
+		{
+			Name:          "Missing member",
+			Input:         `$.country`,
+			ExpectedItems: nil,
+		},
+		{
+			Name:          "Index",
+			Input:         `$.zones[1].name`,
+			ExpectedItems: []interface{}{"b"},
+		},
+		{
+			Name:          "Index out of range",
+			Input:         `$.zones[3]`,
+			ExpectedItems: nil,
+		},
+		{
+			Name:          "Wildcard skips items without the member",
+			Input:         `$.zones[*].name`,
+			ExpectedItems: []interface{}{"a", "b"},
+		},
+		{
+			Name:          "Wildcard on non-array value",
+			Input:         `$.region[*]`,
+			ExpectedItems: []interface{}{"eu"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			query, err := jsonpath.Parse(testCase.Input)
+			require.NoError(t, err)
+
+			// when
+			items, err := query.Select(value)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedItems, items)
+		})
+	}
+
+	t.Run("Error when query has filter", func(t *testing.T) {
+		query, err := jsonpath.Parse(`$ ? (@ == "eu")`)
+		require.NoError(t, err)
+
+		// when
+		_, err = query.Select(value)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "filter expressions are not supported")
+	})
+}
@@ -0,0 +1,150 @@
+package jsonpath
+
+import (
+	"fmt"
+)
+
+// Args collects values bound to the numbered placeholders of the generated SQL
+type Args struct {
+	values []interface{}
+}
+
+// NewArgs creates Args starting with the given values, so that the generated placeholders follow them
+func NewArgs(values ...interface{}) *Args {
+	return &Args{values: values}
+}
+
+// Add binds the value and returns its placeholder
+func (a *Args) Add(value interface{}) string {
+	a.values = append(a.values, value)
+	return fmt.Sprintf("$%d", len(a.values))
+}
+
+func (a *Args) Values() []interface{} {
+	return a.values
+}
+
+var sqlOperators = map[string]string{
+	"==": "=",
+	"!=": "<>",
+	"<":  "<",
+	"<=": "<=",
+	">":  ">",
+	">=": ">=",
+}
+
+var literalTypes = map[LiteralKind]struct {
+	jsonType string
+	sqlType  string
+}{
+	StringLiteral:  {jsonType: "string", sqlType: "text"},
+	NumberLiteral:  {jsonType: "number", sqlType: "numeric"},
+	BooleanLiteral: {jsonType: "boolean", sqlType: "boolean"},
+}
+
+// ToSQL translates the query into the condition matching rows whose JSONB column contains at least one item selected by the query.
+// It follows the lax mode of SQL/JSON path: comparisons of arrays match if any element matches, and comparisons of values of different types never match.
+// All keys and literals are bound as arguments, so the condition is safe to embed in the statement.
+func (q *Query) ToSQL(column string, args *Args) string {
+	b := &sqlBuilder{args: args}
+
+	return b.path(column, q.Path, func(item string) string {
+		if q.Filter == nil {
+			return fmt.Sprintf("%s IS NOT NULL", item)
+		}
+		return fmt.Sprintf("%s IS NOT NULL AND %s", item, b.predicate(item, q.Filter))
+	})
+}
+
+type sqlBuilder struct {
+	args    *Args
+	aliases int
+}
+
+func (b *sqlBuilder) path(expr string, path []Accessor, condition func(item string) string) string {
+	if len(path) == 0 {
+		return condition(expr)
+	}
+
+	accessor := path[0]
+	switch accessor.Kind {
+	case MemberAccessor:
+		return b.path(fmt.Sprintf("(%s -> %s::text)", expr, b.args.Add(accessor.Key)), path[1:], condition)
+	case IndexAccessor:
+		return b.path(fmt.Sprintf("(%s -> %s::int)", expr, b.args.Add(accessor.Index)), path[1:], condition)
+	default:
+		return b.anyElement(expr, func(element string) string {
+			return b.path(element, path[1:], condition)
+		})
+	}
+}
+
+// anyElement matches if the condition is true for any element of the array, a non-array value is treated as a single element array
+func (b *sqlBuilder) anyElement(expr string, condition func(element string) string) string {
+	alias := b.alias()
+	return fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof(%[1]s) = 'array' THEN %[1]s WHEN %[1]s IS NOT NULL THEN jsonb_build_array(%[1]s) END) AS %[2]s(value) WHERE %[3]s)",
+		expr, alias, condition(alias+".value"))
+}
+
+func (b *sqlBuilder) predicate(item string, predicate Predicate) string {
+	switch p := predicate.(type) {
+	case And:
+		return fmt.Sprintf("(%s AND %s)", b.predicate(item, p.Left), b.predicate(item, p.Right))
+	case Or:
+		return fmt.Sprintf("(%s OR %s)", b.predicate(item, p.Left), b.predicate(item, p.Right))
+	case Not:
+		return fmt.Sprintf("(NOT %s)", b.predicate(item, p.Operand))
+	case Exists:
+		return b.path(item, p.Path, func(value string) string {
+			return fmt.Sprintf("%s IS NOT NULL", value)
+		})
+	case Comparison:
+		compare := b.comparison(p.Operator, p.Value)
+		return b.path(item, p.Path, func(value string) string {
+			return b.unwrapped(value, compare)
+		})
+	case LikeRegex:
+		operator := "~"
+		if p.CaseInsensitive {
+			operator = "~*"
+		}
+		pattern := b.args.Add(p.Pattern)
+		return b.path(item, p.Path, func(value string) string {
+			return b.unwrapped(value, func(scalar string) string {
+				return fmt.Sprintf("CASE WHEN jsonb_typeof(%[1]s) = 'string' THEN (%[1]s #>> '{}') %[2]s %[3]s::text END", scalar, operator, pattern)
+			})
+		})
+	}
+
+	return "false"
+}
+
+// unwrapped evaluates the condition on the value or on the elements of the array.
+// A missing value does not match, while the condition returns NULL for values of different type, so that negating it does not match either.
+func (b *sqlBuilder) unwrapped(value string, condition func(scalar string) string) string {
+	alias := b.alias()
+	return fmt.Sprintf(
+		"(CASE WHEN %[1]s IS NULL THEN false WHEN jsonb_typeof(%[1]s) = 'array' THEN EXISTS (SELECT 1 FROM jsonb_array_elements(%[1]s) AS %[2]s(value) WHERE %[3]s) ELSE %[4]s END)",
+		value, alias, condition(alias+".value"), condition(value))
+}
+
+func (b *sqlBuilder) comparison(operator string, literal Literal) func(scalar string) string {
+	if literal.Kind == NullLiteral {
+		return func(scalar string) string {
+			return fmt.Sprintf("jsonb_typeof(%s) %s 'null'", scalar, sqlOperators[operator])
+		}
+	}
+
+	types := literalTypes[literal.Kind]
+	placeholder := b.args.Add(literal.Value)
+	return func(scalar string) string {
+		return fmt.Sprintf("CASE WHEN jsonb_typeof(%[1]s) = '%[2]s' THEN (%[1]s #>> '{}')::%[3]s %[4]s %[5]s::%[3]s END",
+			scalar, types.jsonType, types.sqlType, sqlOperators[operator], placeholder)
+	}
+}
+
+func (b *sqlBuilder) alias() string {
+	b.aliases++
+	return fmt.Sprintf("elem%d", b.aliases)
+}
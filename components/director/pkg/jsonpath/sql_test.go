@@ -0,0 +1,81 @@
+package jsonpath_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonpath"
+)
+
+func TestQuery_ToSQL(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Input        string
+		ExpectedSQL  string
+		ExpectedArgs []interface{}
+	}{
+		{
+			Name:         "Root",
+			Input:        `$`,
+			ExpectedSQL:  `"value" IS NOT NULL`,
+			ExpectedArgs: []interface{}{"tenant"},
+		},
+		{
+			Name:         "Member and index",
+			Input:        `$.foo[1]`,
+			ExpectedSQL:  `(("value" -> $2::text) -> $3::int) IS NOT NULL`,
+			ExpectedArgs: []interface{}{"tenant", "foo", 1},
+		},
+		{
+			Name:  "Any element equal to string",
+			Input: `$[*] ? (@ == "foo")`,
+			ExpectedSQL: `EXISTS (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof("value") = 'array' THEN "value" WHEN "value" IS NOT NULL THEN jsonb_build_array("value") END) AS elem1(value) ` +
+				`WHERE elem1.value IS NOT NULL AND (CASE WHEN elem1.value IS NULL THEN false WHEN jsonb_typeof(elem1.value) = 'array' ` +
+				`THEN EXISTS (SELECT 1 FROM jsonb_array_elements(elem1.value) AS elem2(value) WHERE CASE WHEN jsonb_typeof(elem2.value) = 'string' THEN (elem2.value #>> '{}')::text = $2::text END) ` +
+				`ELSE CASE WHEN jsonb_typeof(elem1.value) = 'string' THEN (elem1.value #>> '{}')::text = $2::text END END))`,
+			ExpectedArgs: []interface{}{"tenant", "foo"},
+		},
+		{
+			Name:  "Numeric comparison and regex",
+			Input: `$ ? (@.size >= 1.5 && !(@.name like_regex "^a" flag "i"))`,
+			ExpectedSQL: `"value" IS NOT NULL AND (` +
+				`(CASE WHEN ("value" -> $3::text) IS NULL THEN false WHEN jsonb_typeof(("value" -> $3::text)) = 'array' ` +
+				`THEN EXISTS (SELECT 1 FROM jsonb_array_elements(("value" -> $3::text)) AS elem1(value) WHERE CASE WHEN jsonb_typeof(elem1.value) = 'number' THEN (elem1.value #>> '{}')::numeric >= $2::numeric END) ` +
+				`ELSE CASE WHEN jsonb_typeof(("value" -> $3::text)) = 'number' THEN (("value" -> $3::text) #>> '{}')::numeric >= $2::numeric END END) AND ` +
+				`(NOT (CASE WHEN ("value" -> $5::text) IS NULL THEN false WHEN jsonb_typeof(("value" -> $5::text)) = 'array' ` +
+				`THEN EXISTS (SELECT 1 FROM jsonb_array_elements(("value" -> $5::text)) AS elem2(value) WHERE CASE WHEN jsonb_typeof(elem2.value) = 'string' THEN (elem2.value #>> '{}') ~* $4::text END) ` +
+				`ELSE CASE WHEN jsonb_typeof(("value" -> $5::text)) = 'string' THEN (("value" -> $5::text) #>> '{}') ~* $4::text END END)))`,
+			ExpectedArgs: []interface{}{"tenant", "1.5", "size", "^a", "name"},
+		},
+		{
+			Name:         "Exists or null",
+			Input:        `$ ? (exists(@.foo) || @.bar == null)`,
+			ExpectedSQL:  `"value" IS NOT NULL AND (("value" -> $2::text) IS NOT NULL OR (CASE WHEN ("value" -> $3::text) IS NULL THEN false WHEN jsonb_typeof(("value" -> $3::text)) = 'array' THEN EXISTS (SELECT 1 FROM jsonb_array_elements(("value" -> $3::text)) AS elem1(value) WHERE jsonb_typeof(elem1.value) = 'null') ELSE jsonb_typeof(("value" -> $3::text)) = 'null' END))`,
+			ExpectedArgs: []interface{}{"tenant", "foo", "bar"},
+		},
+		{
+			Name:         "Injection attempt is bound as argument",
+			Input:        `$."'; DROP TABLE labels; --" ? (@ == "' OR 1=1 --")`,
+			ExpectedSQL:  `("value" -> $2::text) IS NOT NULL AND (CASE WHEN ("value" -> $2::text) IS NULL THEN false WHEN jsonb_typeof(("value" -> $2::text)) = 'array' THEN EXISTS (SELECT 1 FROM jsonb_array_elements(("value" -> $2::text)) AS elem1(value) WHERE CASE WHEN jsonb_typeof(elem1.value) = 'string' THEN (elem1.value #>> '{}')::text = $3::text END) ELSE CASE WHEN jsonb_typeof(("value" -> $2::text)) = 'string' THEN (("value" -> $2::text) #>> '{}')::text = $3::text END END)`,
+			ExpectedArgs: []interface{}{"tenant", "'; DROP TABLE labels; --", "' OR 1=1 --"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			query, err := jsonpath.Parse(testCase.Input)
+			require.NoError(t, err)
+			args := jsonpath.NewArgs("tenant")
+
+			// when
+			sql := query.ToSQL(`"value"`, args)
+
+			// then
+			assert.Equal(t, testCase.ExpectedSQL, sql)
+			assert.Equal(t, testCase.ExpectedArgs, args.Values())
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package pagination
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+type OrderDirection string
+
+const (
+	AscOrderDirection  OrderDirection = "ASC"
+	DescOrderDirection OrderDirection = "DESC"
+)
+
+// OrderBy is the column the objects are ordered by. The order is ascending if the direction is empty.
+type OrderBy struct {
+	Column    string
+	Direction OrderDirection
+}
+
+func NewAscOrderBy(column string) OrderBy {
+	return OrderBy{Column: column, Direction: AscOrderDirection}
+}
+
+func NewDescOrderBy(column string) OrderBy {
+	return OrderBy{Column: column, Direction: DescOrderDirection}
+}
+
+// String returns the column for the ascending order and the column with DESC keyword for the descending order,
+// so that cursors created for the same column but the other direction are not accepted
+func (o OrderBy) String() string {
+	if o.IsDescending() {
+		return fmt.Sprintf("%s %s", o.Column, DescOrderDirection)
+	}
+
+	return o.Column
+}
+
+func (o OrderBy) IsDescending() bool {
+	return o.Direction == DescOrderDirection
+}
+
+func (o OrderBy) Validate() error {
+	if o.Column == "" {
+		return errors.New("to use pagination you must provide column to order by")
+	}
+
+	switch o.Direction {
+	case "", AscOrderDirection, DescOrderDirection:
+		return nil
+	default:
+		return errors.Errorf("unknown order direction %s", o.Direction)
+	}
+}
@@ -1,65 +1,105 @@
 package pagination
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+
 	"github.com/pkg/errors"
-	"strconv"
-	"strings"
 )
 
-const surprise = "DpKtJ4j9jDq"
-
 type Page struct {
 	StartCursor string
 	EndCursor   string
 	HasNextPage bool
 }
 
-func DecodeOffsetCursor(cursor string) (int, error) {
-	if cursor == "" {
-		return 0, nil
+// KeysetCursor points to the last object of the page by the value of the column the objects are ordered by and its ID,
+// so that the next page starts right after it, even if objects were created or deleted in the meantime
+type KeysetCursor struct {
+	OrderBy string      `json:"orderBy"`
+	Value   interface{} `json:"value"`
+	ID      interface{} `json:"id"`
+}
+
+// EncodeKeysetCursor returns the opaque cursor for the client
+func EncodeKeysetCursor(cursor KeysetCursor) (string, error) {
+	if cursor.Value == nil || cursor.ID == nil {
+		return "", errors.New("cursor cannot point to object with empty order by value or ID")
 	}
 
-	decodedValue, err := base64.StdEncoding.DecodeString(cursor)
+	encoded, err := json.Marshal(cursor)
 	if err != nil {
-		return 0, errors.Wrap(err, "cursor is not correct")
+		return "", errors.Wrap(err, "while encoding cursor")
 	}
 
-	realCursor := strings.TrimPrefix(string(decodedValue), surprise)
+	return base64.StdEncoding.EncodeToString(encoded), nil
+}
 
-	offset, err := strconv.Atoi(realCursor)
-	if err != nil {
-		return 0, errors.Wrap(err, "cursor is not correct")
+// DecodeKeysetCursor decodes the cursor received from the client. It returns nil for the empty cursor, which points to the first page.
+// The cursor must be created for the same order, numbers are returned as json.Number, so that they are passed to the database without precision loss.
+func DecodeKeysetCursor(cursor, orderBy string) (*KeysetCursor, error) {
+	if cursor == "" {
+		return nil, nil
 	}
 
-	if offset < 0 {
-		return 0, errors.New("cursor is not correct")
+	decodedValue, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "cursor is not correct")
 	}
 
-	return offset, nil
-}
+	var keysetCursor KeysetCursor
+	decoder := json.NewDecoder(bytes.NewReader(decodedValue))
+	decoder.UseNumber()
+	err = decoder.Decode(&keysetCursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "cursor is not correct")
+	}
 
-func EncodeNextOffsetCursor(offset, pageSize int) string {
-	nextPage := pageSize + offset
+	if keysetCursor.Value == nil || keysetCursor.ID == nil {
+		return nil, errors.New("cursor is not correct")
+	}
 
-	cursor := surprise + strconv.Itoa(nextPage)
+	if keysetCursor.OrderBy != orderBy {
+		return nil, errors.Errorf("cursor is not correct: it was created for order by %s", keysetCursor.OrderBy)
+	}
 
-	return base64.StdEncoding.EncodeToString([]byte(cursor))
+	return &keysetCursor, nil
 }
 
-func ConvertOffsetLimitAndOrderedColumnToSQL(pageSize, offset int, orderedColumn string) (string, error) {
-	if orderedColumn == "" {
-		return "", errors.New("to use pagination you must provide column to order by")
+// ConvertKeysetAndLimitToSQL returns the condition selecting objects after the cursor with its arguments, which placeholders start with firstPlaceholder,
+// and the ORDER BY and LIMIT clause. The ID column is the tiebreaker for objects with the same order by value, so the order is stable.
+// It is ordered in the same direction as the column to order by, so a single index on both columns serves both directions.
+// The limit is one object more than the page size, so that the caller can find out if there is the next page.
+func ConvertKeysetAndLimitToSQL(pageSize int, cursor *KeysetCursor, orderBy OrderBy, idColumn string, firstPlaceholder int) (string, []interface{}, string, error) {
+	if err := orderBy.Validate(); err != nil {
+		return "", nil, "", err
 	}
 
 	if pageSize < 1 {
-		return "", errors.New("page size cannot be smaller than 1")
+		return "", nil, "", errors.New("page size cannot be smaller than 1")
+	}
+
+	direction, comparison := "", ">"
+	if orderBy.IsDescending() {
+		direction, comparison = " DESC", "<"
+	}
+
+	if orderBy.Column == idColumn {
+		orderAndLimit := fmt.Sprintf(`ORDER BY %s%s LIMIT %d`, idColumn, direction, pageSize+1)
+		if cursor == nil {
+			return "", nil, orderAndLimit, nil
+		}
+
+		return fmt.Sprintf(`%s %s $%d`, idColumn, comparison, firstPlaceholder), []interface{}{cursor.ID}, orderAndLimit, nil
 	}
 
-	if offset < 0 {
-		return "", errors.New("offset cannot be smaller than 0")
+	orderAndLimit := fmt.Sprintf(`ORDER BY %s%s, %s%s LIMIT %d`, orderBy.Column, direction, idColumn, direction, pageSize+1)
+	if cursor == nil {
+		return "", nil, orderAndLimit, nil
 	}
 
-	return fmt.Sprintf(` ORDER BY %s LIMIT %d OFFSET %d`, orderedColumn, pageSize, offset), nil
+	condition := fmt.Sprintf(`(%s, %s) %s ($%d, $%d)`, orderBy.Column, idColumn, comparison, firstPlaceholder, firstPlaceholder+1)
+	return condition, []interface{}{cursor.Value, cursor.ID}, orderAndLimit, nil
 }
@@ -2,181 +2,203 @@ package pagination
 
 import (
 	"encoding/base64"
-	"github.com/pkg/errors"
+	"encoding/json"
+	"testing"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"strconv"
-	"testing"
 )
 
-func TestComputeOffset(t *testing.T) {
+func TestDecodeKeysetCursor(t *testing.T) {
 	//GIVEN
-	offset := 2000
 	testCases := []struct {
-		Name            string
-		InputCursor     string
-		ExptectedOffset int
-		ExpectedErr     error
+		Name           string
+		InputCursor    string
+		InputOrderBy   string
+		ExpectedCursor *KeysetCursor
+		ExpectedErr    string
 	}{
 		{
-			Name:            "Success",
-			InputCursor:     convertIntToBase64String(offset),
-			ExptectedOffset: offset,
-			ExpectedErr:     nil,
+			Name:           "Success",
+			InputCursor:    toBase64String(`{"orderBy":"name","value":"foo","id":"1"}`),
+			InputOrderBy:   "name",
+			ExpectedCursor: &KeysetCursor{OrderBy: "name", Value: "foo", ID: "1"},
 		},
 		{
-			Name:            "Success with easter egg",
-			InputCursor:     string(base64.StdEncoding.EncodeToString([]byte("DpKtJ4j9jDq" + strconv.Itoa(offset)))),
-			ExptectedOffset: offset,
-			ExpectedErr:     nil,
+			Name:           "Success with number value",
+			InputCursor:    toBase64String(`{"orderBy":"age","value":9007199254740993,"id":"1"}`),
+			InputOrderBy:   "age",
+			ExpectedCursor: &KeysetCursor{OrderBy: "age", Value: json.Number("9007199254740993"), ID: "1"},
 		},
 		{
-			Name:            "Success when cursor is empty",
-			InputCursor:     "",
-			ExptectedOffset: 0,
-			ExpectedErr:     nil,
+			Name:           "Success when cursor is empty",
+			InputCursor:    "",
+			InputOrderBy:   "name",
+			ExpectedCursor: nil,
 		},
 		{
-			Name:            "Return error when cursor is negative",
-			InputCursor:     convertIntToBase64String(-offset),
-			ExptectedOffset: 0,
-			ExpectedErr:     errors.New("cursor is not correct"),
+			Name:         "Return error when cursor was created for other order",
+			InputCursor:  toBase64String(`{"orderBy":"id","value":"1","id":"1"}`),
+			InputOrderBy: "name",
+			ExpectedErr:  "cursor is not correct: it was created for order by id",
 		},
 		{
-			Name:            "Return error when input is not integer",
-			InputCursor:     string(base64.StdEncoding.EncodeToString([]byte("foo-bar"))),
-			ExptectedOffset: 0,
-			ExpectedErr:     errors.New("cursor is not correct"),
+			Name:         "Return error when cursor does not point to object",
+			InputCursor:  toBase64String(`{"orderBy":"name","value":"foo"}`),
+			InputOrderBy: "name",
+			ExpectedErr:  "cursor is not correct",
 		},
 		{
-			Name:            "Return error when input is not valid BASE64 string",
-			InputCursor:     "Zm9vLWJh-1cg==",
-			ExptectedOffset: 0,
-			ExpectedErr:     errors.New("cursor is not correct"),
+			Name:         "Return error when input is not JSON",
+			InputCursor:  toBase64String("foo-bar"),
+			InputOrderBy: "name",
+			ExpectedErr:  "cursor is not correct",
+		},
+		{
+			Name:         "Return error when input is not valid BASE64 string",
+			InputCursor:  "Zm9vLWJh-1cg==",
+			InputOrderBy: "name",
+			ExpectedErr:  "cursor is not correct",
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			//WHEN
-			offset, err := DecodeOffsetCursor(testCase.InputCursor)
+			cursor, err := DecodeKeysetCursor(testCase.InputCursor, testCase.InputOrderBy)
 
 			//THEN
-			if testCase.ExpectedErr != nil {
+			if testCase.ExpectedErr != "" {
 				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
 			} else {
 				require.NoError(t, err)
-				assert.Equal(t, testCase.ExptectedOffset, offset)
+				assert.Equal(t, testCase.ExpectedCursor, cursor)
 			}
 		})
 	}
 }
 
-func TestConvertOffsetToPageCursor(t *testing.T) {
-	// GIVEN
-	pageSize := 50
-	offset := 50
+func TestEncodeKeysetCursor(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		cursor, err := EncodeKeysetCursor(KeysetCursor{OrderBy: "name", Value: "foo", ID: "1"})
 
-	// WHEN
-	nextPageCursor := EncodeNextOffsetCursor(pageSize, offset)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, toBase64String(`{"orderBy":"name","value":"foo","id":"1"}`), cursor)
+	})
+
+	t.Run("Return error when order by value is empty", func(t *testing.T) {
+		// WHEN
+		_, err := EncodeKeysetCursor(KeysetCursor{OrderBy: "name", ID: "1"})
 
-	// THEN
-	require.Equal(t, "RHBLdEo0ajlqRHExMDA=", nextPageCursor)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cursor cannot point to object with empty order by value or ID")
+	})
 }
 
-func TestConvertOffsetLimitAndOrderedColumnToSQL(t *testing.T) {
-	t.Run("Success converting Offset and Limit to SQL ", func(t *testing.T) {
+func TestConvertKeysetAndLimitToSQL(t *testing.T) {
+	t.Run("Success converting limit to SQL for the first page", func(t *testing.T) {
 		// WHEN
-		sql, err := ConvertOffsetLimitAndOrderedColumnToSQL(5, 5, "id")
+		condition, args, sql, err := ConvertKeysetAndLimitToSQL(5, nil, NewAscOrderBy("name"), "id", 2)
 
 		//THEN
 		require.NoError(t, err)
-		assert.Equal(t, sql, ` ORDER BY id LIMIT 5 OFFSET 5`)
+		assert.Empty(t, condition)
+		assert.Empty(t, args)
+		assert.Equal(t, `ORDER BY name, id LIMIT 6`, sql)
 	})
 
-	t.Run("Return error when column to order by is empty", func(t *testing.T) {
+	t.Run("Success converting keyset and limit to SQL", func(t *testing.T) {
 		// WHEN
-		_, err := ConvertOffsetLimitAndOrderedColumnToSQL(5, 10, "")
+		condition, args, sql, err := ConvertKeysetAndLimitToSQL(5, &KeysetCursor{OrderBy: "name", Value: "foo", ID: "1"}, NewAscOrderBy("name"), "id", 2)
 
 		//THEN
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), `to use pagination you must provide column to order by`)
+		require.NoError(t, err)
+		assert.Equal(t, `(name, id) > ($2, $3)`, condition)
+		assert.Equal(t, []interface{}{"foo", "1"}, args)
+		assert.Equal(t, `ORDER BY name, id LIMIT 6`, sql)
 	})
 
-	t.Run("Return error when page size is smaller than 1", func(t *testing.T) {
+	t.Run("Success converting keyset and limit to SQL when ordered by ID", func(t *testing.T) {
 		// WHEN
-		_, err := ConvertOffsetLimitAndOrderedColumnToSQL(-1, 5, "id")
+		condition, args, sql, err := ConvertKeysetAndLimitToSQL(5, &KeysetCursor{OrderBy: "id", Value: "1", ID: "1"}, OrderBy{Column: "id"}, "id", 4)
 
 		//THEN
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), `page size cannot be smaller than 1`)
+		require.NoError(t, err)
+		assert.Equal(t, `id > $4`, condition)
+		assert.Equal(t, []interface{}{"1"}, args)
+		assert.Equal(t, `ORDER BY id LIMIT 6`, sql)
 	})
 
-	t.Run("Return error when offset is smaller than 0", func(t *testing.T) {
+	t.Run("Success converting keyset and limit to SQL in descending order", func(t *testing.T) {
 		// WHEN
-		_, err := ConvertOffsetLimitAndOrderedColumnToSQL(5, -1, "id")
+		condition, args, sql, err := ConvertKeysetAndLimitToSQL(5, &KeysetCursor{OrderBy: "name DESC", Value: "foo", ID: "1"}, NewDescOrderBy("name"), "id", 2)
 
 		//THEN
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), `offset cannot be smaller than 0`)
+		require.NoError(t, err)
+		assert.Equal(t, `(name, id) < ($2, $3)`, condition)
+		assert.Equal(t, []interface{}{"foo", "1"}, args)
+		assert.Equal(t, `ORDER BY name DESC, id DESC LIMIT 6`, sql)
 	})
-}
-
-func TestDecodeAndEncodeCursorTogether(t *testing.T) {
-	t.Run("Success encoding and then decoding cursor", func(t *testing.T) {
-		//GIVEN
-		offset := 4
 
-		//WHEN
-		cursor := EncodeNextOffsetCursor(offset, 0)
-		decodedOffset, err := DecodeOffsetCursor(cursor)
+	t.Run("Success converting keyset and limit to SQL when ordered by ID in descending order", func(t *testing.T) {
+		// WHEN
+		condition, args, sql, err := ConvertKeysetAndLimitToSQL(5, &KeysetCursor{OrderBy: "id DESC", Value: "1", ID: "1"}, NewDescOrderBy("id"), "id", 4)
 
 		//THEN
 		require.NoError(t, err)
-		assert.Equal(t, offset, decodedOffset)
+		assert.Equal(t, `id < $4`, condition)
+		assert.Equal(t, []interface{}{"1"}, args)
+		assert.Equal(t, `ORDER BY id DESC LIMIT 6`, sql)
 	})
 
-	t.Run("Success encoding and then decoding next page cursor", func(t *testing.T) {
-		//GIVEN
-		offset := 4
-		pageSize := 5
+	t.Run("Return error when column to order by is empty", func(t *testing.T) {
+		// WHEN
+		_, _, _, err := ConvertKeysetAndLimitToSQL(5, nil, OrderBy{}, "id", 2)
 
-		//WHEN
-		cursor := EncodeNextOffsetCursor(offset, pageSize)
-		decodedOffset, err := DecodeOffsetCursor(cursor)
 		//THEN
-		require.NoError(t, err)
-		assert.Equal(t, offset+pageSize, decodedOffset)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `to use pagination you must provide column to order by`)
 	})
 
-	t.Run("Success decoding and then encoding cursor", func(t *testing.T) {
-		//GIVEN
-		cursor := "RHBLdEo0ajlqRHExMDA="
+	t.Run("Return error when order direction is unknown", func(t *testing.T) {
+		// WHEN
+		_, _, _, err := ConvertKeysetAndLimitToSQL(5, nil, OrderBy{Column: "name", Direction: "UP"}, "id", 2)
 
-		//WHEN
-		offset, err := DecodeOffsetCursor(cursor)
-		encodedCusor := EncodeNextOffsetCursor(offset, 0)
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown order direction UP`)
+	})
+
+	t.Run("Return error when page size is smaller than 1", func(t *testing.T) {
+		// WHEN
+		_, _, _, err := ConvertKeysetAndLimitToSQL(-1, nil, NewAscOrderBy("id"), "id", 2)
 
 		//THEN
-		require.NoError(t, err)
-		assert.Equal(t, cursor, encodedCusor)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `page size cannot be smaller than 1`)
 	})
+}
 
-	t.Run("Success decoding and then encoding incremented cursor", func(t *testing.T) {
+func TestDecodeAndEncodeCursorTogether(t *testing.T) {
+	t.Run("Success encoding and then decoding cursor", func(t *testing.T) {
 		//GIVEN
-		cursor := "RHBLdEo0ajlqRHExMDA="
-		nextCursor := "RHBLdEo0ajlqRHExMDU="
+		keysetCursor := KeysetCursor{OrderBy: "name", Value: "foo", ID: "1"}
 
 		//WHEN
-		offset, err := DecodeOffsetCursor(cursor)
-		encodedCusor := EncodeNextOffsetCursor(offset, 5)
+		cursor, err := EncodeKeysetCursor(keysetCursor)
+		require.NoError(t, err)
+		decodedCursor, err := DecodeKeysetCursor(cursor, "name")
 
 		//THEN
 		require.NoError(t, err)
-		assert.Equal(t, nextCursor, encodedCusor)
+		assert.Equal(t, &keysetCursor, decodedCursor)
 	})
 }
 
-func convertIntToBase64String(number int) string {
-	return string(base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(number))))
+func toBase64String(value string) string {
+	return base64.StdEncoding.EncodeToString([]byte(value))
 }
@@ -0,0 +1,56 @@
+package pagination
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type key int
+
+const TotalCountContextKey key = iota
+
+const (
+	totalCountField = "totalCount"
+	pageTypeSuffix  = "Page"
+)
+
+// SaveTotalCountRequestedToContext stores if the total count of the listed objects has to be computed
+func SaveTotalCountRequestedToContext(ctx context.Context, requested bool) context.Context {
+	return context.WithValue(ctx, TotalCountContextKey, requested)
+}
+
+// IsTotalCountRequested returns false only if it is known that the total count is not needed, so it is computed by default
+func IsTotalCountRequested(ctx context.Context) bool {
+	requested, ok := ctx.Value(TotalCountContextKey).(bool)
+	if !ok {
+		return true
+	}
+
+	return requested
+}
+
+// TotalCountMiddleware is the GraphQL resolver middleware storing in the context if the total count of the page returned by the field is selected.
+// Fields returning other types reset it, so that their resolvers always compute it.
+func TotalCountMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	resolverCtx := graphql.GetResolverContext(ctx)
+	if resolverCtx == nil || resolverCtx.Field.Field == nil || resolverCtx.Field.Definition == nil {
+		return next(ctx)
+	}
+
+	requested := true
+	typeName := resolverCtx.Field.Definition.Type.Name()
+	if strings.HasSuffix(typeName, pageTypeSuffix) {
+		requested = false
+		fields := graphql.CollectFields(graphql.GetRequestContext(ctx), resolverCtx.Field.Selections, []string{typeName, "Pageable"})
+		for _, field := range fields {
+			if field.Name == totalCountField {
+				requested = true
+				break
+			}
+		}
+	}
+
+	return next(SaveTotalCountRequestedToContext(ctx, requested))
+}
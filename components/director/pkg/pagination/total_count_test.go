@@ -0,0 +1,81 @@
+package pagination_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+)
+
+func TestIsTotalCountRequested(t *testing.T) {
+	t.Run("Returns true by default", func(t *testing.T) {
+		assert.True(t, pagination.IsTotalCountRequested(context.TODO()))
+	})
+
+	t.Run("Returns value saved in context", func(t *testing.T) {
+		ctx := pagination.SaveTotalCountRequestedToContext(context.TODO(), false)
+
+		assert.False(t, pagination.IsTotalCountRequested(ctx))
+	})
+}
+
+func TestTotalCountMiddleware(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		FieldType         string
+		Selections        ast.SelectionSet
+		ExpectedRequested bool
+	}{
+		{
+			Name:              "Requested when page with total count is selected",
+			FieldType:         "RuntimePage",
+			Selections:        ast.SelectionSet{&ast.Field{Name: "data"}, &ast.Field{Name: "totalCount"}},
+			ExpectedRequested: true,
+		},
+		{
+			Name:              "Not requested when page without total count is selected",
+			FieldType:         "RuntimePage",
+			Selections:        ast.SelectionSet{&ast.Field{Name: "data"}, &ast.Field{Name: "pageInfo"}},
+			ExpectedRequested: false,
+		},
+		{
+			Name:              "Requested for other types",
+			FieldType:         "Runtime",
+			Selections:        ast.SelectionSet{&ast.Field{Name: "id"}},
+			ExpectedRequested: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			field := &ast.Field{
+				Name:         "field",
+				SelectionSet: testCase.Selections,
+				Definition:   &ast.FieldDefinition{Name: "field", Type: ast.NonNullNamedType(testCase.FieldType, nil)},
+			}
+			ctx := graphql.WithRequestContext(context.TODO(), &graphql.RequestContext{})
+			ctx = pagination.SaveTotalCountRequestedToContext(ctx, !testCase.ExpectedRequested)
+			ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+				Field: graphql.CollectedField{Field: field, Selections: field.SelectionSet},
+			})
+
+			var actualRequested bool
+			next := func(ctx context.Context) (interface{}, error) {
+				actualRequested = pagination.IsTotalCountRequested(ctx)
+				return nil, nil
+			}
+
+			// WHEN
+			_, err := pagination.TotalCountMiddleware(ctx, next)
+
+			// THEN
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedRequested, actualRequested)
+		})
+	}
+}
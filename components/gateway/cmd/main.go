@@ -3,7 +3,10 @@ package main
 import (
 	"log"
 	"net/http"
+	"time"
 
+	"github.com/kyma-incubator/compass/components/gateway/internal/health"
+	"github.com/kyma-incubator/compass/components/gateway/internal/revocation"
 	"github.com/kyma-incubator/compass/components/gateway/internal/tenant"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
 	"github.com/pkg/errors"
@@ -17,19 +20,48 @@ type config struct {
 
 	DirectorOrigin  string `envconfig:"default=http://127.0.0.1:3000"`
 	ConnectorOrigin string `envconfig:"default=http://127.0.0.1:3000"`
+
+	Readiness struct {
+		Timeout           time.Duration `envconfig:"default=2s"`
+		CacheTTL          time.Duration `envconfig:"default=10s"`
+		FailureThreshold  int           `envconfig:"default=3"`
+		DirectorEndpoint  string        `envconfig:"default=/"`
+		ConnectorEndpoint string        `envconfig:"default=/"`
+	}
+
+	Revocation struct {
+		// ConnectorInternalOrigin is the origin of the Connector internal API which serves the revocation check
+		ConnectorInternalOrigin string        `envconfig:"default=http://127.0.0.1:3001"`
+		CheckEndpoint           string        `envconfig:"default=/revocation/check"`
+		Timeout                 time.Duration `envconfig:"default=2s"`
+		CacheTTL                time.Duration `envconfig:"default=30s"`
+	}
 }
 
+const (
+	directorUpstream  = "director"
+	connectorUpstream = "connector"
+)
+
 func main() {
 	cfg := config{}
 	err := envconfig.InitWithPrefix(&cfg, "APP")
 	exitOnError(err, "Error while loading app config")
 
+	checker := health.NewChecker(cfg.Readiness.Timeout, cfg.Readiness.CacheTTL, cfg.Readiness.FailureThreshold,
+		health.Upstream{Name: directorUpstream, URL: cfg.DirectorOrigin + cfg.Readiness.DirectorEndpoint},
+		health.Upstream{Name: connectorUpstream, URL: cfg.ConnectorOrigin + cfg.Readiness.ConnectorEndpoint},
+	)
+
+	revocationChecker := revocation.NewChecker(cfg.Revocation.ConnectorInternalOrigin+cfg.Revocation.CheckEndpoint, cfg.Revocation.Timeout, cfg.Revocation.CacheTTL)
+	rejectRevokedCertificates := revocation.RejectRevokedCertificates(revocationChecker)
+
 	router := mux.NewRouter()
 
-	err = proxyRequestsForComponent(router, "/connector", cfg.ConnectorOrigin)
+	err = proxyRequestsForComponent(router, "/connector", cfg.ConnectorOrigin, checker, connectorUpstream, rejectRevokedCertificates)
 	exitOnError(err, "Error while initializing proxy for Connector")
 
-	err = proxyRequestsForComponent(router, "/director", cfg.DirectorOrigin, tenant.RequireTenantHeader("GET"))
+	err = proxyRequestsForComponent(router, "/director", cfg.DirectorOrigin, checker, directorUpstream, rejectRevokedCertificates, tenant.RequireTenantHeader("GET"))
 	exitOnError(err, "Error while initializing proxy for Director")
 
 	router.HandleFunc("/healthz", func(writer http.ResponseWriter, request *http.Request) {
@@ -40,6 +72,8 @@ func main() {
 		}
 	})
 
+	router.HandleFunc("/readyz", health.NewReadinessHandler(checker))
+
 	http.Handle("/", router)
 
 	log.Printf("Listening on %s", cfg.Address)
@@ -48,17 +82,20 @@ func main() {
 	}
 }
 
-func proxyRequestsForComponent(router *mux.Router, path string, targetOrigin string, middleware ...mux.MiddlewareFunc) error {
+func proxyRequestsForComponent(router *mux.Router, path string, targetOrigin string, checker health.Checker, upstreamName string, middleware ...mux.MiddlewareFunc) error {
 	log.Printf("Proxying requests on path `%s` to `%s`\n", path, targetOrigin)
 
 	componentProxy, err := proxy.New(targetOrigin, path)
 	if err != nil {
 		return errors.Wrapf(err, "while initializing proxy for component")
 	}
+	componentProxy.ErrorHandler = health.NewProxyErrorHandler(checker, upstreamName)
+	componentProxy.ModifyResponse = health.NewProxyResponseHandler(checker, upstreamName)
 
 	connector := router.PathPrefix(path).Subrouter()
 	connector.PathPrefix("").HandlerFunc(componentProxy.ServeHTTP)
 	connector.Use(middleware...)
+	connector.Use(health.RequireHealthyUpstream(checker, upstreamName))
 
 	return nil
 }
@@ -0,0 +1,210 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+type Upstream struct {
+	Name string
+	URL  string
+}
+
+type Status struct {
+	Healthy   bool      `json:"healthy"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+type Checker interface {
+	Check(ctx context.Context, name string) Status
+	CheckAll(ctx context.Context) map[string]Status
+	ReportFailure(name string, err error)
+	ReportSuccess(name string)
+}
+
+type upstreamState struct {
+	mu       sync.Mutex
+	upstream Upstream
+	status   Status
+	checked  bool
+	// probing is closed when the probe in progress finishes, it is nil when the upstream is not being probed
+	probing  chan struct{}
+	failures int
+}
+
+type checker struct {
+	client           *http.Client
+	timeout          time.Duration
+	cacheTTL         time.Duration
+	failureThreshold int
+	now              func() time.Time
+	states           map[string]*upstreamState
+}
+
+// NewChecker returns the checker of the upstreams, which marks an upstream as unhealthy when the probe fails
+// or when the failureThreshold consecutive failures of the proxied requests are reported
+func NewChecker(timeout, cacheTTL time.Duration, failureThreshold int, upstreams ...Upstream) Checker {
+	states := make(map[string]*upstreamState, len(upstreams))
+	for _, upstream := range upstreams {
+		states[upstream.Name] = &upstreamState{upstream: upstream}
+	}
+
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+
+	return &checker{
+		client:           &http.Client{Timeout: timeout},
+		timeout:          timeout,
+		cacheTTL:         cacheTTL,
+		failureThreshold: failureThreshold,
+		now:              time.Now,
+		states:           states,
+	}
+}
+
+// Check returns cached status of the upstream or probes it when the cached status expired.
+// Only one probe of the upstream runs at a time, the requests checking the upstream meanwhile get the expired status
+// or wait for the first probe until their context is done. The probe does not depend on the context of the request,
+// so that a client which disconnects does not affect the status of the upstream.
+func (c *checker) Check(ctx context.Context, name string) Status {
+	state, ok := c.states[name]
+	if !ok {
+		return Status{Healthy: false, Error: fmt.Sprintf("unknown upstream `%s`", name), CheckedAt: c.now()}
+	}
+
+	state.mu.Lock()
+	if state.checked && c.now().Sub(state.status.CheckedAt) < c.cacheTTL {
+		status := state.status
+		state.mu.Unlock()
+		return status
+	}
+
+	if state.probing == nil {
+		probing := make(chan struct{})
+		state.probing = probing
+		state.mu.Unlock()
+
+		status := c.probe(state.upstream)
+		if !status.Healthy {
+			log.Warnf("Upstream `%s` is unhealthy: %s", name, status.Error)
+		}
+
+		state.mu.Lock()
+		state.status = status
+		state.checked = true
+		state.probing = nil
+		state.mu.Unlock()
+		close(probing)
+
+		return status
+	}
+
+	if state.checked {
+		status := state.status
+		state.mu.Unlock()
+		return status
+	}
+
+	probing := state.probing
+	state.mu.Unlock()
+
+	select {
+	case <-probing:
+	case <-ctx.Done():
+		return Status{Healthy: false, Error: errors.Wrap(ctx.Err(), "while waiting for upstream check").Error(), CheckedAt: c.now()}
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	return state.status
+}
+
+func (c *checker) CheckAll(ctx context.Context) map[string]Status {
+	result := make(map[string]Status, len(c.states))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name := range c.states {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			status := c.Check(ctx, name)
+
+			mu.Lock()
+			result[name] = status
+			mu.Unlock()
+		}(name)
+	}
+	wg.Wait()
+
+	return result
+}
+
+// ReportFailure marks the upstream as unhealthy until the cached status expires, once the failureThreshold consecutive failures are reported
+func (c *checker) ReportFailure(name string, err error) {
+	state, ok := c.states[name]
+	if !ok {
+		return
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.failures++
+	if state.failures < c.failureThreshold {
+		return
+	}
+
+	state.failures = 0
+	state.status = Status{Healthy: false, Error: err.Error(), CheckedAt: c.now()}
+	state.checked = true
+}
+
+// ReportSuccess resets the consecutive failures of the upstream
+func (c *checker) ReportSuccess(name string) {
+	state, ok := c.states[name]
+	if !ok {
+		return
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.failures = 0
+}
+
+func (c *checker) probe(upstream Upstream) Status {
+	checkedAt := c.now()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, upstream.URL, nil)
+	if err != nil {
+		return Status{Healthy: false, Error: errors.Wrap(err, "while creating request").Error(), CheckedAt: checkedAt}
+	}
+
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return Status{Healthy: false, Error: errors.Wrapf(err, "while calling %s", upstream.URL).Error(), CheckedAt: checkedAt}
+	}
+	defer func() {
+		err := resp.Body.Close()
+		if err != nil {
+			log.Error(errors.Wrap(err, "while closing response body"))
+		}
+	}()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return Status{Healthy: false, Error: fmt.Sprintf("unexpected status code %d", resp.StatusCode), CheckedAt: checkedAt}
+	}
+
+	return Status{Healthy: true, CheckedAt: checkedAt}
+}
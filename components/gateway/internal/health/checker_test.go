@@ -0,0 +1,245 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecker_Check(t *testing.T) {
+	t.Run("should report healthy upstream", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "director", URL: server.URL})
+
+		// when
+		status := checker.Check(context.TODO(), "director")
+
+		// then
+		assert.True(t, status.Healthy)
+		assert.Empty(t, status.Error)
+	})
+
+	t.Run("should report unhealthy upstream when it responds with server error", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "director", URL: server.URL})
+
+		// when
+		status := checker.Check(context.TODO(), "director")
+
+		// then
+		assert.False(t, status.Healthy)
+		assert.Equal(t, "unexpected status code 502", status.Error)
+	})
+
+	t.Run("should report unhealthy upstream when it does not respond in time", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+		}))
+		defer server.Close()
+
+		checker := health.NewChecker(10*time.Millisecond, time.Minute, 1, health.Upstream{Name: "director", URL: server.URL})
+
+		// when
+		status := checker.Check(context.TODO(), "director")
+
+		// then
+		assert.False(t, status.Healthy)
+		assert.NotEmpty(t, status.Error)
+	})
+
+	t.Run("should report unknown upstream as unhealthy", func(t *testing.T) {
+		// given
+		checker := health.NewChecker(time.Second, time.Minute, 1)
+
+		// when
+		status := checker.Check(context.TODO(), "foo")
+
+		// then
+		assert.False(t, status.Healthy)
+		assert.Equal(t, "unknown upstream `foo`", status.Error)
+	})
+
+	t.Run("should cache status", func(t *testing.T) {
+		// given
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		}))
+		defer server.Close()
+
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "director", URL: server.URL})
+
+		// when
+		checker.Check(context.TODO(), "director")
+		checker.Check(context.TODO(), "director")
+
+		// then
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("should probe upstream again when cached status expired", func(t *testing.T) {
+		// given
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		}))
+		defer server.Close()
+
+		checker := health.NewChecker(time.Second, 0, 1, health.Upstream{Name: "director", URL: server.URL})
+
+		// when
+		checker.Check(context.TODO(), "director")
+		checker.Check(context.TODO(), "director")
+
+		// then
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("should not be affected by cancelled context of the request", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "director", URL: server.URL})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// when
+		status := checker.Check(ctx, "director")
+
+		// then
+		assert.True(t, status.Healthy)
+	})
+
+	t.Run("should return expired status without waiting while upstream is probed", func(t *testing.T) {
+		// given
+		release := make(chan struct{})
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) > 1 {
+				<-release
+			}
+		}))
+		defer server.Close()
+
+		checker := health.NewChecker(time.Second, 0, 1, health.Upstream{Name: "director", URL: server.URL})
+		assert.True(t, checker.Check(context.TODO(), "director").Healthy)
+
+		probed := make(chan health.Status)
+		go func() {
+			probed <- checker.Check(context.TODO(), "director")
+		}()
+		for atomic.LoadInt32(&calls) < 2 {
+			time.Sleep(time.Millisecond)
+		}
+
+		// when
+		status := checker.Check(context.TODO(), "director")
+
+		// then
+		assert.True(t, status.Healthy)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+		close(release)
+		assert.True(t, (<-probed).Healthy)
+	})
+}
+
+func TestChecker_ReportFailure(t *testing.T) {
+	t.Run("should mark upstream unhealthy until cached status expires", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "director", URL: server.URL})
+		assert.True(t, checker.Check(context.TODO(), "director").Healthy)
+
+		// when
+		checker.ReportFailure("director", errors.New("connection refused"))
+
+		// then
+		status := checker.Check(context.TODO(), "director")
+		assert.False(t, status.Healthy)
+		assert.Equal(t, "connection refused", status.Error)
+	})
+
+	t.Run("should mark upstream unhealthy only after consecutive failures reach threshold", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+
+		checker := health.NewChecker(time.Second, time.Minute, 3, health.Upstream{Name: "director", URL: server.URL})
+		assert.True(t, checker.Check(context.TODO(), "director").Healthy)
+
+		// when
+		checker.ReportFailure("director", errors.New("connection refused"))
+		checker.ReportFailure("director", errors.New("connection refused"))
+
+		// then
+		assert.True(t, checker.Check(context.TODO(), "director").Healthy)
+
+		// when
+		checker.ReportFailure("director", errors.New("connection refused"))
+
+		// then
+		assert.False(t, checker.Check(context.TODO(), "director").Healthy)
+	})
+
+	t.Run("should not mark upstream unhealthy when failures are not consecutive", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+
+		checker := health.NewChecker(time.Second, time.Minute, 2, health.Upstream{Name: "director", URL: server.URL})
+		assert.True(t, checker.Check(context.TODO(), "director").Healthy)
+
+		// when
+		checker.ReportFailure("director", errors.New("connection refused"))
+		checker.ReportSuccess("director")
+		checker.ReportFailure("director", errors.New("connection refused"))
+
+		// then
+		assert.True(t, checker.Check(context.TODO(), "director").Healthy)
+	})
+}
+
+func TestChecker_CheckAll(t *testing.T) {
+	// given
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer unhealthy.Close()
+
+	checker := health.NewChecker(time.Second, time.Minute, 1,
+		health.Upstream{Name: "director", URL: healthy.URL},
+		health.Upstream{Name: "connector", URL: unhealthy.URL},
+	)
+
+	// when
+	statuses := checker.CheckAll(context.TODO())
+
+	// then
+	assert.Len(t, statuses, 2)
+	assert.True(t, statuses["director"].Healthy)
+	assert.False(t, statuses["connector"].Healthy)
+}
@@ -0,0 +1,122 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	statusOK          = "ok"
+	statusUnavailable = "unavailable"
+)
+
+type readinessResponse struct {
+	Status    string            `json:"status"`
+	Upstreams map[string]Status `json:"upstreams"`
+}
+
+func NewReadinessHandler(checker Checker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		statuses := checker.CheckAll(r.Context())
+
+		response := readinessResponse{
+			Status:    statusOK,
+			Upstreams: statuses,
+		}
+		statusCode := http.StatusOK
+		for _, status := range statuses {
+			if !status.Healthy {
+				response.Status = statusUnavailable
+				statusCode = http.StatusServiceUnavailable
+				break
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		err := json.NewEncoder(w).Encode(response)
+		if err != nil {
+			log.Error(errors.Wrap(err, "while writing readiness response"))
+		}
+	}
+}
+
+// RequireHealthyUpstream fails fast with GraphQL error while the upstream is unhealthy
+func RequireHealthyUpstream(checker Checker, upstreamName string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			status := checker.Check(r.Context(), upstreamName)
+			if !status.Healthy {
+				writeUnavailableError(w, upstreamName)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// NewProxyErrorHandler reports the failure of the upstream when proxying the request fails because of the upstream.
+// Errors caused by the client, such as a cancelled request, do not affect the status of the upstream.
+func NewProxyErrorHandler(checker Checker, upstreamName string) func(http.ResponseWriter, *http.Request, error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		if r.Context().Err() == context.Canceled || errors.Cause(err) == context.Canceled {
+			log.Infof("Request to upstream `%s` cancelled by the client", upstreamName)
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		log.Error(errors.Wrapf(err, "while proxying request to upstream `%s`", upstreamName))
+		if isUpstreamFailure(err) {
+			checker.ReportFailure(upstreamName, err)
+		}
+		writeUnavailableError(w, upstreamName)
+	}
+}
+
+// NewProxyResponseHandler reports the failure of the upstream when it responds that it is unavailable, and its success otherwise,
+// so that the circuit is opened after the configured number of consecutive failures
+func NewProxyResponseHandler(checker Checker, upstreamName string) func(*http.Response) error {
+	return func(resp *http.Response) error {
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			checker.ReportFailure(upstreamName, fmt.Errorf("unexpected status code %d", resp.StatusCode))
+		default:
+			checker.ReportSuccess(upstreamName)
+		}
+		return nil
+	}
+}
+
+func isUpstreamFailure(err error) bool {
+	cause := errors.Cause(err)
+	if cause == io.EOF || cause == io.ErrUnexpectedEOF || cause == context.DeadlineExceeded {
+		return true
+	}
+
+	_, ok := cause.(net.Error)
+	return ok
+}
+
+func writeUnavailableError(w http.ResponseWriter, upstreamName string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": nil,
+		"errors": []map[string]interface{}{
+			{
+				"message": fmt.Sprintf("Upstream `%s` is unavailable", upstreamName),
+			},
+		},
+	})
+	if err != nil {
+		log.Error(errors.Wrap(err, "while writing JSON error"))
+	}
+}
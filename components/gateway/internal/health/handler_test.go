@@ -0,0 +1,244 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadinessHandler(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	testCases := []struct {
+		Name               string
+		Upstreams          []health.Upstream
+		ExpectedStatusCode int
+		ExpectedStatus     string
+	}{
+		{
+			Name: "All upstreams healthy",
+			Upstreams: []health.Upstream{
+				{Name: "director", URL: healthy.URL},
+				{Name: "connector", URL: healthy.URL},
+			},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedStatus:     "ok",
+		},
+		{
+			Name: "One upstream unhealthy",
+			Upstreams: []health.Upstream{
+				{Name: "director", URL: healthy.URL},
+				{Name: "connector", URL: unhealthy.URL},
+			},
+			ExpectedStatusCode: http.StatusServiceUnavailable,
+			ExpectedStatus:     "unavailable",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			checker := health.NewChecker(time.Second, time.Minute, 1, testCase.Upstreams...)
+			handler := health.NewReadinessHandler(checker)
+			recorder := httptest.NewRecorder()
+
+			// when
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			// then
+			assert.Equal(t, testCase.ExpectedStatusCode, recorder.Code)
+			var body struct {
+				Status    string                   `json:"status"`
+				Upstreams map[string]health.Status `json:"upstreams"`
+			}
+			err := json.NewDecoder(recorder.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedStatus, body.Status)
+			assert.Len(t, body.Upstreams, len(testCase.Upstreams))
+		})
+	}
+}
+
+func TestRequireHealthyUpstream(t *testing.T) {
+	t.Run("should pass request when upstream is healthy", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "director", URL: server.URL})
+
+		called := false
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		})
+		recorder := httptest.NewRecorder()
+
+		// when
+		health.RequireHealthyUpstream(checker, "director")(next).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/director/graphql", nil))
+
+		// then
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("should fail fast with GraphQL error when upstream is unhealthy", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "director", URL: server.URL})
+		checker.ReportFailure("director", errors.New("connection refused"))
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("It shouldn't occur")
+		})
+		recorder := httptest.NewRecorder()
+
+		// when
+		health.RequireHealthyUpstream(checker, "director")(next).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/director/graphql", nil))
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+		assertGraphQLError(t, recorder, "Upstream `director` is unavailable")
+	})
+}
+
+func TestNewProxyErrorHandler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	t.Run("should open circuit when connecting to upstream failed", func(t *testing.T) {
+		// given
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "connector", URL: server.URL})
+		recorder := httptest.NewRecorder()
+		err := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+		// when
+		health.NewProxyErrorHandler(checker, "connector")(recorder, httptest.NewRequest(http.MethodPost, "/connector/graphql", nil), err)
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+		assertGraphQLError(t, recorder, "Upstream `connector` is unavailable")
+		assert.False(t, checker.Check(context.TODO(), "connector").Healthy)
+	})
+
+	t.Run("should open circuit when upstream closed connection", func(t *testing.T) {
+		// given
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "connector", URL: server.URL})
+		recorder := httptest.NewRecorder()
+
+		// when
+		health.NewProxyErrorHandler(checker, "connector")(recorder, httptest.NewRequest(http.MethodPost, "/connector/graphql", nil), io.EOF)
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+		assert.False(t, checker.Check(context.TODO(), "connector").Healthy)
+	})
+
+	t.Run("should not open circuit when request was cancelled by client", func(t *testing.T) {
+		// given
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "connector", URL: server.URL})
+		recorder := httptest.NewRecorder()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req := httptest.NewRequest(http.MethodPost, "/connector/graphql", nil).WithContext(ctx)
+
+		// when
+		health.NewProxyErrorHandler(checker, "connector")(recorder, req, context.Canceled)
+
+		// then
+		assert.Equal(t, http.StatusBadGateway, recorder.Code)
+		assert.True(t, checker.Check(context.TODO(), "connector").Healthy)
+	})
+
+	t.Run("should not open circuit on error not caused by upstream", func(t *testing.T) {
+		// given
+		checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "connector", URL: server.URL})
+		recorder := httptest.NewRecorder()
+
+		// when
+		health.NewProxyErrorHandler(checker, "connector")(recorder, httptest.NewRequest(http.MethodPost, "/connector/graphql", nil), errors.New("http: request body too large"))
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+		assert.True(t, checker.Check(context.TODO(), "connector").Healthy)
+	})
+}
+
+func TestNewProxyResponseHandler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	testCases := []struct {
+		Name            string
+		StatusCode      int
+		ExpectedHealthy bool
+	}{
+		{Name: "OK", StatusCode: http.StatusOK, ExpectedHealthy: true},
+		{Name: "Internal server error", StatusCode: http.StatusInternalServerError, ExpectedHealthy: true},
+		{Name: "Bad gateway", StatusCode: http.StatusBadGateway, ExpectedHealthy: false},
+		{Name: "Service unavailable", StatusCode: http.StatusServiceUnavailable, ExpectedHealthy: false},
+		{Name: "Gateway timeout", StatusCode: http.StatusGatewayTimeout, ExpectedHealthy: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			checker := health.NewChecker(time.Second, time.Minute, 1, health.Upstream{Name: "director", URL: server.URL})
+
+			// when
+			err := health.NewProxyResponseHandler(checker, "director")(&http.Response{StatusCode: testCase.StatusCode})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedHealthy, checker.Check(context.TODO(), "director").Healthy)
+		})
+	}
+}
+
+func TestNewProxyResponseHandler_ConsecutiveFailures(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	checker := health.NewChecker(time.Second, time.Minute, 2, health.Upstream{Name: "director", URL: server.URL})
+	handler := health.NewProxyResponseHandler(checker, "director")
+
+	// when
+	require.NoError(t, handler(&http.Response{StatusCode: http.StatusBadGateway}))
+	require.NoError(t, handler(&http.Response{StatusCode: http.StatusOK}))
+	require.NoError(t, handler(&http.Response{StatusCode: http.StatusBadGateway}))
+
+	// then
+	assert.True(t, checker.Check(context.TODO(), "director").Healthy)
+
+	// when
+	require.NoError(t, handler(&http.Response{StatusCode: http.StatusServiceUnavailable}))
+
+	// then
+	assert.False(t, checker.Check(context.TODO(), "director").Healthy)
+}
+
+func assertGraphQLError(t *testing.T, recorder *httptest.ResponseRecorder, expectedMessage string) {
+	var body map[string]interface{}
+	err := json.NewDecoder(recorder.Body).Decode(&body)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"data": nil,
+		"errors": []interface{}{
+			map[string]interface{}{"message": expectedMessage},
+		},
+	}, body)
+}
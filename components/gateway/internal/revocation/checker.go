@@ -0,0 +1,136 @@
+package revocation
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Checker interface {
+	IsRevoked(ctx context.Context, certificate string) (bool, error)
+}
+
+type checkRequest struct {
+	Certificate string `json:"certificate"`
+}
+
+type checkResponse struct {
+	Revoked bool `json:"revoked"`
+}
+
+type cachedResult struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+type checker struct {
+	checkURL string
+	client   *http.Client
+	cacheTTL time.Duration
+
+	mutex sync.Mutex
+	cache map[string]cachedResult
+}
+
+// NewChecker creates Checker calling the Connector revocation check endpoint. Results are cached for the given time.
+func NewChecker(checkURL string, timeout, cacheTTL time.Duration) Checker {
+	return &checker{
+		checkURL: checkURL,
+		client:   &http.Client{Timeout: timeout},
+		cacheTTL: cacheTTL,
+		cache:    map[string]cachedResult{},
+	}
+}
+
+func (c *checker) IsRevoked(ctx context.Context, certificate string) (bool, error) {
+	key := fingerprint(certificate)
+
+	if revoked, found := c.cached(key); found {
+		return revoked, nil
+	}
+
+	revoked, err := c.check(ctx, certificate)
+	if err != nil {
+		return false, err
+	}
+
+	c.store(key, revoked)
+	return revoked, nil
+}
+
+func (c *checker) check(ctx context.Context, certificate string) (bool, error) {
+	body, err := json.Marshal(checkRequest{Certificate: certificate})
+	if err != nil {
+		return false, errors.Wrap(err, "while marshalling revocation check request")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.checkURL, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrap(err, "while creating revocation check request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return false, errors.Wrap(err, "while calling revocation check endpoint")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("revocation check endpoint responded with status code %d", res.StatusCode)
+	}
+
+	var response checkResponse
+	err = json.NewDecoder(res.Body).Decode(&response)
+	if err != nil {
+		return false, errors.Wrap(err, "while decoding revocation check response")
+	}
+
+	return response.Revoked, nil
+}
+
+func (c *checker) cached(key string) (bool, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	result, found := c.cache[key]
+	if !found {
+		return false, false
+	}
+
+	if time.Now().After(result.expiresAt) {
+		delete(c.cache, key)
+		return false, false
+	}
+
+	return result.revoked, true
+}
+
+func (c *checker) store(key string, revoked bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	for k, result := range c.cache {
+		if now.After(result.expiresAt) {
+			delete(c.cache, k)
+		}
+	}
+
+	c.cache[key] = cachedResult{revoked: revoked, expiresAt: now.Add(c.cacheTTL)}
+}
+
+func fingerprint(certificate string) string {
+	sum := sha256.Sum256([]byte(certificate))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,82 @@
+package revocation_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/revocation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const certificate = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+func TestChecker_IsRevoked(t *testing.T) {
+	t.Run("should return revocation status reported by Connector", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]string
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Equal(t, certificate, body["certificate"])
+
+			_, err = w.Write([]byte(`{"revoked":true}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		checker := revocation.NewChecker(server.URL, time.Second, time.Minute)
+
+		// when
+		revoked, err := checker.IsRevoked(context.TODO(), certificate)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should cache revocation status", func(t *testing.T) {
+		// given
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			_, err := w.Write([]byte(`{"revoked":false}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		checker := revocation.NewChecker(server.URL, time.Second, time.Minute)
+
+		// when
+		_, err := checker.IsRevoked(context.TODO(), certificate)
+		require.NoError(t, err)
+		revoked, err := checker.IsRevoked(context.TODO(), certificate)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, revoked)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("should return error when Connector responds with error", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		checker := revocation.NewChecker(server.URL, time.Second, time.Minute)
+
+		// when
+		_, err := checker.IsRevoked(context.TODO(), certificate)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "status code 500")
+	})
+}
@@ -0,0 +1,59 @@
+package revocation
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// RejectRevokedCertificates rejects requests authenticated with a revoked client certificate.
+// Requests without client certificate are passed through. If the revocation status cannot be verified the request is rejected.
+func RejectRevokedCertificates(checker Checker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			certificate, err := certificateFromHeader(r.Header.Get(ClientCertificateHeader))
+			if err != nil {
+				log.Error(errors.Wrap(err, "while reading client certificate"))
+				writeJSONError(w, http.StatusBadRequest, "Invalid client certificate")
+				return
+			}
+
+			if certificate == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			revoked, err := checker.IsRevoked(r.Context(), certificate)
+			if err != nil {
+				log.Error(errors.Wrap(err, "while checking client certificate revocation"))
+				writeJSONError(w, http.StatusServiceUnavailable, "Unable to verify client certificate revocation status")
+				return
+			}
+
+			if revoked {
+				writeJSONError(w, http.StatusForbidden, "Client certificate has been revoked")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeJSONError(w http.ResponseWriter, statusCode int, errMessage string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": nil,
+		"errors": []map[string]interface{}{
+			{
+				"message": errMessage,
+			},
+		},
+	})
+	if err != nil {
+		log.Error(errors.Wrap(err, "while writing JSON error"))
+	}
+}
@@ -0,0 +1,105 @@
+package revocation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/revocation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRejectRevokedCertificates(t *testing.T) {
+	header := fmt.Sprintf(`By=spiffe://cluster.local/ns/compass-system/sa/gateway;Hash=abc;Cert="%s";Subject="CN=app-id,O=Org"`, url.PathEscape(certificate))
+
+	t.Run("should pass request with valid certificate", func(t *testing.T) {
+		// given
+		checker := &fakeChecker{revoked: false}
+		rr := httptest.NewRecorder()
+
+		// when
+		serve(checker, rr, header)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, certificate, checker.checked)
+	})
+
+	t.Run("should check certificate of original client when header has multiple elements", func(t *testing.T) {
+		// given
+		checker := &fakeChecker{revoked: false}
+		rr := httptest.NewRecorder()
+
+		// when
+		serve(checker, rr, header+`,By=spiffe://cluster.local/ns/compass-system/sa/director;Hash=def;Subject=""`)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, certificate, checker.checked)
+	})
+
+	t.Run("should pass request without client certificate", func(t *testing.T) {
+		// given
+		checker := &fakeChecker{}
+		rr := httptest.NewRecorder()
+
+		// when
+		serve(checker, rr, `By=spiffe://cluster.local/ns/compass-system/sa/gateway;Hash=abc;Subject=""`)
+
+		// then
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, checker.checked)
+	})
+
+	t.Run("should reject request with revoked certificate", func(t *testing.T) {
+		// given
+		checker := &fakeChecker{revoked: true}
+		rr := httptest.NewRecorder()
+
+		// when
+		serve(checker, rr, header)
+
+		// then
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		assert.JSONEq(t, `{"data":null,"errors":[{"message":"Client certificate has been revoked"}]}`, rr.Body.String())
+	})
+
+	t.Run("should reject request when revocation status cannot be verified", func(t *testing.T) {
+		// given
+		checker := &fakeChecker{err: errors.New("connection refused")}
+		rr := httptest.NewRecorder()
+
+		// when
+		serve(checker, rr, header)
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	})
+}
+
+func serve(checker revocation.Checker, rr *httptest.ResponseRecorder, header string) {
+	handler := revocation.RejectRevokedCertificates(checker)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/director/graphql", nil)
+	req.Header.Set(revocation.ClientCertificateHeader, header)
+
+	handler.ServeHTTP(rr, req)
+}
+
+type fakeChecker struct {
+	revoked bool
+	err     error
+	checked string
+}
+
+func (c *fakeChecker) IsRevoked(ctx context.Context, certificate string) (bool, error) {
+	c.checked = certificate
+	return c.revoked, c.err
+}
+
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/handler"
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/api"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/connector"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/director"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/persistence"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/provider"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/provisioning"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/tenant"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/uid"
+	"github.com/kyma-incubator/compass/components/provisioner/pkg/gqlschema"
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/vrischmann/envconfig"
+)
+
+const (
+	memoryBackend   = "memory"
+	postgresBackend = "postgres"
+
+	fakeProvider = "fake"
+
+	connStringf string = "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s"
+)
+
+type config struct {
+	Address               string `envconfig:"default=127.0.0.1:3000"`
+	APIEndpoint           string `envconfig:"default=/graphql"`
+	PlaygroundAPIEndpoint string `envconfig:"default=/graphql"`
+
+	// StorageBackend selects the storage of the operations and Runtimes: postgres or memory
+	StorageBackend string `envconfig:"default=postgres"`
+	// OperationLease is the time after which the operation is failed unless the Provisioner instance executing it renews the lease
+	OperationLease time.Duration `envconfig:"default=1m"`
+
+	// Provider selects the infrastructure provider creating the clusters, only the fake provider is available
+	Provider     string `envconfig:"default=fake"`
+	FakeProvider struct {
+		Delay           time.Duration `envconfig:"default=10s"`
+		FailingRuntimes []string      `envconfig:"optional"`
+	}
+
+	DirectorClient struct {
+		URL     string        `envconfig:"default=http://127.0.0.1:3000/graphql"`
+		Timeout time.Duration `envconfig:"default=10s"`
+	}
+
+	ConnectorClient struct {
+		URL     string        `envconfig:"default=http://127.0.0.1:3001/graphql"`
+		Timeout time.Duration `envconfig:"default=10s"`
+	}
+	// ConnectorURL is passed to the Runtime Agents, defaults to the URL of the Connector client
+	ConnectorURL string `envconfig:"optional"`
+
+	Database struct {
+		User     string `envconfig:"default=postgres,APP_DB_USER"`
+		Password string `envconfig:"default=pgsql@12345,APP_DB_PASSWORD"`
+		Host     string `envconfig:"default=localhost,APP_DB_HOST"`
+		Port     string `envconfig:"default=5432,APP_DB_PORT"`
+		Name     string `envconfig:"default=postgres,APP_DB_NAME"`
+		SSLMode  string `envconfig:"default=disable,APP_DB_SSL"`
+	}
+}
+
+func (c *config) String() string {
+	return fmt.Sprintf("Address: %s, APIEndpoint: %s, StorageBackend: %s, OperationLease: %s, "+
+		"Provider: %s, FakeProviderDelay: %s, FakeProviderFailingRuntimes: %v, "+
+		"DirectorClientURL: %s, DirectorClientTimeout: %s, ConnectorClientURL: %s, ConnectorClientTimeout: %s, ConnectorURL: %s, "+
+		"DatabaseUser: %s, DatabaseHost: %s, DatabasePort: %s, DatabaseName: %s, DatabaseSSLMode: %s",
+		c.Address, c.APIEndpoint, c.StorageBackend, c.OperationLease,
+		c.Provider, c.FakeProvider.Delay, c.FakeProvider.FailingRuntimes,
+		c.DirectorClient.URL, c.DirectorClient.Timeout, c.ConnectorClient.URL, c.ConnectorClient.Timeout, c.ConnectorURL,
+		c.Database.User, c.Database.Host, c.Database.Port, c.Database.Name, c.Database.SSLMode)
+}
+
+func main() {
+	cfg := config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	exitOnError(err, "Error while loading app config")
+
+	log.Println("Starting Provisioner")
+	log.Printf("Config: %s", cfg.String())
+
+	repository, err := newRepository(cfg)
+	exitOnError(err, "Failed to initialize repository")
+
+	infrastructureProvider, err := newInfrastructureProvider(cfg)
+	exitOnError(err, "Failed to initialize infrastructure provider")
+
+	directorClient := director.NewClient(cfg.DirectorClient.URL, cfg.DirectorClient.Timeout)
+	connectorClient := connector.NewClient(cfg.ConnectorClient.URL, cfg.ConnectorClient.Timeout)
+
+	connectorURL := cfg.ConnectorURL
+	if connectorURL == "" {
+		connectorURL = cfg.ConnectorClient.URL
+	}
+
+	uidService := uid.NewService()
+	lease := provisioning.LeaseConfig{
+		Owner:    uidService.Generate(),
+		Duration: cfg.OperationLease,
+	}
+	log.Printf("Provisioner instance %s executes operations", lease.Owner)
+
+	provisioningService := provisioning.NewProvisioningService(repository, infrastructureProvider, directorClient, connectorClient, connectorURL, uidService, lease)
+
+	err = provisioningService.FailInterruptedOperations()
+	exitOnError(err, "Failed to recover operations")
+
+	go failInterruptedOperations(provisioningService, cfg.OperationLease)
+
+	resolver := api.NewResolver(provisioningService)
+
+	server := prepareServer(cfg, resolver)
+
+	log.Printf("API listening on %s...", cfg.Address)
+	if err := server.ListenAndServe(); err != nil {
+		panic(err)
+	}
+}
+
+// failInterruptedOperations periodically fails the operations of the Provisioner instances which stopped without finishing them
+func failInterruptedOperations(provisioningService provisioning.Service, interval time.Duration) {
+	for range time.Tick(interval) {
+		err := provisioningService.FailInterruptedOperations()
+		if err != nil {
+			logrus.Errorf("Failed to fail interrupted operations: %s", err.Error())
+		}
+	}
+}
+
+func newRepository(cfg config) (persistence.Repository, error) {
+	switch cfg.StorageBackend {
+	case postgresBackend:
+		db, err := openDatabase(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		return persistence.NewPostgresRepository(db), nil
+	case memoryBackend:
+		logrus.Warn("Using in-memory storage, operations and Runtimes are lost after restart")
+		return persistence.NewInMemoryRepository(), nil
+	default:
+		return nil, errors.Errorf("Invalid storage backend: %s", cfg.StorageBackend)
+	}
+}
+
+func newInfrastructureProvider(cfg config) (provider.InfrastructureProvider, error) {
+	switch cfg.Provider {
+	case fakeProvider:
+		logrus.Warn("Using fake infrastructure provider, no clusters are created")
+		return provider.NewFakeProvider(provider.FakeConfig{
+			Delay:           cfg.FakeProvider.Delay,
+			FailingRuntimes: cfg.FakeProvider.FailingRuntimes,
+		}), nil
+	default:
+		return nil, errors.Errorf("Invalid infrastructure provider: %s", cfg.Provider)
+	}
+}
+
+func openDatabase(cfg config) (*sql.DB, error) {
+	connString := fmt.Sprintf(connStringf, cfg.Database.Host, cfg.Database.Port, cfg.Database.User,
+		cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode)
+
+	db, err := sql.Open("postgres", connString)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to open database connection")
+	}
+
+	err = db.Ping()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to connect to database")
+	}
+
+	return db, nil
+}
+
+func prepareServer(cfg config, resolver *api.Resolver) *http.Server {
+	executableSchema := gqlschema.NewExecutableSchema(gqlschema.Config{
+		Resolvers: resolver,
+	})
+
+	router := mux.NewRouter()
+	router.HandleFunc("/", handler.Playground("Provisioner", cfg.PlaygroundAPIEndpoint))
+	router.HandleFunc(cfg.APIEndpoint, handler.GraphQL(executableSchema))
+
+	router.Use(tenant.NewMiddleware().PropagateTenant)
+
+	return &http.Server{
+		Addr:    cfg.Address,
+		Handler: router,
+	}
+}
+
+func exitOnError(err error, context string) {
+	if err != nil {
+		wrappedError := errors.Wrap(err, context)
+		log.Fatal(wrappedError)
+	}
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+	"github.com/kyma-incubator/compass/components/provisioner/pkg/gqlschema"
+)
+
+var (
+	operationTypes = map[model.OperationType]gqlschema.OperationType{
+		model.Provision:        gqlschema.OperationTypeProvision,
+		model.Upgrade:          gqlschema.OperationTypeUpgrade,
+		model.Deprovision:      gqlschema.OperationTypeDeprovision,
+		model.ReconnectRuntime: gqlschema.OperationTypeReconnectRuntime,
+	}
+
+	operationStates = map[model.OperationState]gqlschema.OperationState{
+		model.Pending:    gqlschema.OperationStatePending,
+		model.InProgress: gqlschema.OperationStateInProgress,
+		model.Succeeded:  gqlschema.OperationStateSucceeded,
+		model.Failed:     gqlschema.OperationStateFailed,
+	}
+
+	connectionStatuses = map[model.ConnectionStatus]gqlschema.RuntimeAgentConnectionStatus{
+		model.ConnectionPending:      gqlschema.RuntimeAgentConnectionStatusPending,
+		model.ConnectionConnected:    gqlschema.RuntimeAgentConnectionStatusConnected,
+		model.ConnectionDisconnected: gqlschema.RuntimeAgentConnectionStatusDisconnected,
+	}
+)
+
+func clusterConfigFromInput(in gqlschema.ClusterConfigInput) model.ClusterConfig {
+	return model.ClusterConfig{
+		Name:                   in.Name,
+		Size:                   stringValue(in.Size),
+		Memory:                 stringValue(in.Memory),
+		ComputeZone:            in.ComputeZone,
+		Version:                stringValue(in.Version),
+		Credentials:            in.Credentials,
+		InfrastructureProvider: string(in.InfrastructureProvider),
+	}
+}
+
+func kymaConfigFromInput(in gqlschema.KymaConfigInput) model.KymaConfig {
+	var modules []string
+	for _, module := range in.Modules {
+		modules = append(modules, string(module))
+	}
+
+	return model.KymaConfig{
+		Version: in.Version,
+		Modules: modules,
+	}
+}
+
+func upgradeConfigFromInput(in gqlschema.UpgradeRuntimeInput) model.UpgradeConfig {
+	var config model.UpgradeConfig
+
+	if in.ClusterConfig != nil {
+		config.ClusterVersion = in.ClusterConfig.Version
+	}
+
+	if in.KymaConfig != nil {
+		kymaConfig := kymaConfigFromInput(*in.KymaConfig)
+		config.KymaConfig = &kymaConfig
+	}
+
+	return config
+}
+
+func toOperationStatus(operation model.Operation) *gqlschema.OperationStatus {
+	status := &gqlschema.OperationStatus{
+		Operation: operationTypes[operation.Type],
+		State:     operationStates[operation.State],
+		Message:   operation.Message,
+	}
+
+	if operation.State == model.Failed {
+		message := operation.Message
+		status.Errors = []*gqlschema.Error{{Message: &message}}
+	}
+
+	return status
+}
+
+func toRuntimeStatus(status model.RuntimeStatus) *gqlschema.RuntimeStatus {
+	runtimeStatus := &gqlschema.RuntimeStatus{
+		LastOperationStatus: toOperationStatus(status.LastOperation),
+	}
+
+	if status.Runtime == nil {
+		return runtimeStatus
+	}
+
+	runtimeStatus.RuntimeConfiguration = toRuntimeConfig(*status.Runtime)
+
+	if status.Runtime.Kubeconfig != "" {
+		runtimeStatus.RuntimeConnectionConfig = &gqlschema.RuntimeConnectionConfig{Kubeconfig: status.Runtime.Kubeconfig}
+	}
+
+	if status.ConnectionStatus != nil {
+		runtimeStatus.RuntimeConnectionStatus = toRuntimeConnectionStatus(*status.ConnectionStatus)
+	}
+
+	return runtimeStatus
+}
+
+func toRuntimeConnectionStatus(status model.RuntimeConnectionStatus) *gqlschema.RuntimeConnectionStatus {
+	var errors []*gqlschema.Error
+	for _, message := range status.Errors {
+		message := message
+		errors = append(errors, &gqlschema.Error{Message: &message})
+	}
+
+	return &gqlschema.RuntimeConnectionStatus{
+		Status: connectionStatuses[status.Status],
+		Errors: errors,
+	}
+}
+
+func toRuntimeConfig(runtime model.Runtime) *gqlschema.RuntimeConfig {
+	provider := gqlschema.InfrastructureProvider(runtime.ClusterConfig.InfrastructureProvider)
+
+	var modules []*gqlschema.KymaModule
+	for _, module := range runtime.KymaConfig.Modules {
+		kymaModule := gqlschema.KymaModule(module)
+		modules = append(modules, &kymaModule)
+	}
+
+	return &gqlschema.RuntimeConfig{
+		ClusterConfig: &gqlschema.ClusterConfig{
+			Name:                   stringPtr(runtime.ClusterConfig.Name),
+			Size:                   stringPtr(runtime.ClusterConfig.Size),
+			Memory:                 stringPtr(runtime.ClusterConfig.Memory),
+			ComputeZone:            stringPtr(runtime.ClusterConfig.ComputeZone),
+			Version:                stringPtr(runtime.ClusterConfig.Version),
+			InfrastructureProvider: &provider,
+		},
+		KymaConfig: &gqlschema.KymaConfig{
+			Version: stringPtr(runtime.KymaConfig.Version),
+			Modules: modules,
+		},
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func stringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
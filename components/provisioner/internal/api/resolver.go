@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/provisioning"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/tenant"
+	"github.com/kyma-incubator/compass/components/provisioner/pkg/gqlschema"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type Resolver struct {
+	provisioningService provisioning.Service
+	log                 *logrus.Entry
+}
+
+func NewResolver(provisioningService provisioning.Service) *Resolver {
+	return &Resolver{
+		provisioningService: provisioningService,
+		log:                 logrus.WithField("Resolver", "Provisioner"),
+	}
+}
+
+func (r *Resolver) Mutation() gqlschema.MutationResolver {
+	return r
+}
+
+func (r *Resolver) Query() gqlschema.QueryResolver {
+	return r
+}
+
+func (r *Resolver) ProvisionRuntime(ctx context.Context, id *gqlschema.RuntimeID, config *gqlschema.ProvisionRuntimeInput) (*gqlschema.AsyncOperationID, error) {
+	runtimeID, err := runtimeIDFromInput(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil || config.ClusterConfig == nil || config.KymaConfig == nil {
+		return nil, apperrors.WrongInput("Cluster and Kyma configuration is required")
+	}
+
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.log.Infof("Provisioning Runtime %s in %s tenant...", runtimeID, tenantID)
+
+	operationID, err := r.provisioningService.ProvisionRuntime(runtimeID, tenantID, clusterConfigFromInput(*config.ClusterConfig), kymaConfigFromInput(*config.KymaConfig))
+	if err != nil {
+		r.log.Error(err.Error())
+		return nil, errors.Wrapf(err, "Failed to provision Runtime %s", runtimeID)
+	}
+
+	return &gqlschema.AsyncOperationID{ID: operationID}, nil
+}
+
+func (r *Resolver) UpgradeRuntime(ctx context.Context, id *gqlschema.RuntimeID, config *gqlschema.UpgradeRuntimeInput) (*gqlschema.AsyncOperationID, error) {
+	runtimeID, err := runtimeIDFromInput(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil || (config.ClusterConfig == nil && config.KymaConfig == nil) {
+		return nil, apperrors.WrongInput("Cluster or Kyma configuration is required")
+	}
+
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.log.Infof("Upgrading Runtime %s in %s tenant...", runtimeID, tenantID)
+
+	operationID, err := r.provisioningService.UpgradeRuntime(runtimeID, tenantID, upgradeConfigFromInput(*config))
+	if err != nil {
+		r.log.Error(err.Error())
+		return nil, errors.Wrapf(err, "Failed to upgrade Runtime %s", runtimeID)
+	}
+
+	return &gqlschema.AsyncOperationID{ID: operationID}, nil
+}
+
+func (r *Resolver) DeprovisionRuntime(ctx context.Context, id *gqlschema.RuntimeID) (*gqlschema.AsyncOperationID, error) {
+	runtimeID, err := runtimeIDFromInput(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.log.Infof("Deprovisioning Runtime %s in %s tenant...", runtimeID, tenantID)
+
+	operationID, err := r.provisioningService.DeprovisionRuntime(runtimeID, tenantID)
+	if err != nil {
+		r.log.Error(err.Error())
+		return nil, errors.Wrapf(err, "Failed to deprovision Runtime %s", runtimeID)
+	}
+
+	return &gqlschema.AsyncOperationID{ID: operationID}, nil
+}
+
+func (r *Resolver) ReconnectRuntimeAgent(ctx context.Context, id *gqlschema.RuntimeID) (*gqlschema.AsyncOperationID, error) {
+	runtimeID, err := runtimeIDFromInput(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.log.Infof("Reconnecting Runtime Agent of Runtime %s in %s tenant...", runtimeID, tenantID)
+
+	operationID, err := r.provisioningService.ReconnectRuntimeAgent(runtimeID, tenantID)
+	if err != nil {
+		r.log.Error(err.Error())
+		return nil, errors.Wrapf(err, "Failed to reconnect Runtime Agent of Runtime %s", runtimeID)
+	}
+
+	return &gqlschema.AsyncOperationID{ID: operationID}, nil
+}
+
+func (r *Resolver) RuntimeStatus(ctx context.Context, id *gqlschema.RuntimeID) (*gqlschema.RuntimeStatus, error) {
+	runtimeID, err := runtimeIDFromInput(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := r.provisioningService.RuntimeStatus(runtimeID, tenantID)
+	if err != nil {
+		r.log.Error(err.Error())
+		return nil, errors.Wrapf(err, "Failed to get status of Runtime %s", runtimeID)
+	}
+
+	return toRuntimeStatus(status), nil
+}
+
+func (r *Resolver) RuntimeOperationStatus(ctx context.Context, id *gqlschema.AsyncOperationIDInput) (*gqlschema.OperationStatus, error) {
+	if id == nil || id.ID == "" {
+		return nil, apperrors.WrongInput("Operation ID is required")
+	}
+
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err := r.provisioningService.RuntimeOperationStatus(id.ID, tenantID)
+	if err != nil {
+		r.log.Error(err.Error())
+		return nil, errors.Wrapf(err, "Failed to get status of operation %s", id.ID)
+	}
+
+	return toOperationStatus(operation), nil
+}
+
+func runtimeIDFromInput(id *gqlschema.RuntimeID) (string, apperrors.AppError) {
+	if id == nil || id.ID == "" {
+		return "", apperrors.WrongInput("Runtime ID is required")
+	}
+
+	return id.ID, nil
+}
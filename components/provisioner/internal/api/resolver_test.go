@@ -0,0 +1,236 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/provisioning/mocks"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/tenant"
+	"github.com/kyma-incubator/compass/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	runtimeID   = "runtime-id"
+	tenantID    = "tenant"
+	operationID = "operation-id"
+)
+
+func TestResolver_ProvisionRuntime(t *testing.T) {
+
+	size := "n1-standard-4"
+
+	t.Run("should start provisioning", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		provisioningService.On("ProvisionRuntime", runtimeID, tenantID,
+			model.ClusterConfig{Name: "cluster", Size: "n1-standard-4", ComputeZone: "europe-west1-b", Credentials: "credentials", InfrastructureProvider: "GKE"},
+			model.KymaConfig{Version: "1.6", Modules: []string{"Backup"}},
+		).Return(operationID, nil)
+
+		resolver := NewResolver(provisioningService)
+
+		// when
+		result, err := resolver.ProvisionRuntime(tenant.SaveToContext(context.TODO(), tenantID), &gqlschema.RuntimeID{ID: runtimeID}, &gqlschema.ProvisionRuntimeInput{
+			ClusterConfig: &gqlschema.ClusterConfigInput{
+				Name:                   "cluster",
+				Size:                   &size,
+				ComputeZone:            "europe-west1-b",
+				Credentials:            "credentials",
+				InfrastructureProvider: gqlschema.InfrastructureProviderGke,
+			},
+			KymaConfig: &gqlschema.KymaConfigInput{
+				Version: "1.6",
+				Modules: []gqlschema.KymaModule{gqlschema.KymaModuleBackup},
+			},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gqlschema.AsyncOperationID{ID: operationID}, result)
+		provisioningService.AssertExpectations(t)
+	})
+
+	t.Run("should return error when tenant is missing", func(t *testing.T) {
+		// given
+		resolver := NewResolver(&mocks.Service{})
+
+		// when
+		_, err := resolver.ProvisionRuntime(context.TODO(), &gqlschema.RuntimeID{ID: runtimeID}, &gqlschema.ProvisionRuntimeInput{
+			ClusterConfig: &gqlschema.ClusterConfigInput{Name: "cluster"},
+			KymaConfig:    &gqlschema.KymaConfigInput{Version: "1.6"},
+		})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Tenant not provided")
+	})
+
+	t.Run("should return error when configuration is missing", func(t *testing.T) {
+		// given
+		resolver := NewResolver(&mocks.Service{})
+
+		// when
+		_, err := resolver.ProvisionRuntime(context.TODO(), &gqlschema.RuntimeID{ID: runtimeID}, &gqlschema.ProvisionRuntimeInput{})
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should return error when Runtime ID is missing", func(t *testing.T) {
+		// given
+		resolver := NewResolver(&mocks.Service{})
+
+		// when
+		_, err := resolver.ProvisionRuntime(context.TODO(), nil, &gqlschema.ProvisionRuntimeInput{})
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestResolver_UpgradeRuntime(t *testing.T) {
+
+	t.Run("should start upgrade of Kyma", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		provisioningService.On("UpgradeRuntime", runtimeID, tenantID, model.UpgradeConfig{
+			KymaConfig: &model.KymaConfig{Version: "1.7"},
+		}).Return(operationID, nil)
+
+		resolver := NewResolver(provisioningService)
+
+		// when
+		result, err := resolver.UpgradeRuntime(tenant.SaveToContext(context.TODO(), tenantID), &gqlschema.RuntimeID{ID: runtimeID}, &gqlschema.UpgradeRuntimeInput{
+			KymaConfig: &gqlschema.KymaConfigInput{Version: "1.7"},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, result.ID)
+		provisioningService.AssertExpectations(t)
+	})
+}
+
+func TestResolver_DeprovisionRuntime(t *testing.T) {
+
+	t.Run("should return error when Runtime does not exist", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		provisioningService.On("DeprovisionRuntime", runtimeID, tenantID).Return("", apperrors.NotFound("Runtime not found"))
+
+		resolver := NewResolver(provisioningService)
+
+		// when
+		_, err := resolver.DeprovisionRuntime(tenant.SaveToContext(context.TODO(), tenantID), &gqlschema.RuntimeID{ID: runtimeID})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Runtime not found")
+	})
+
+	t.Run("should return error when tenant is missing", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+
+		resolver := NewResolver(provisioningService)
+
+		// when
+		_, err := resolver.DeprovisionRuntime(context.TODO(), &gqlschema.RuntimeID{ID: runtimeID})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Tenant not provided")
+		provisioningService.AssertNotCalled(t, "DeprovisionRuntime", mock.Anything, mock.Anything)
+	})
+}
+
+func TestResolver_RuntimeStatus(t *testing.T) {
+
+	finishedAt := time.Date(2019, 10, 7, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should return status of provisioned Runtime", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		provisioningService.On("RuntimeStatus", runtimeID, tenantID).Return(model.RuntimeStatus{
+			LastOperation: model.Operation{
+				ID:         operationID,
+				Type:       model.Provision,
+				State:      model.Succeeded,
+				Message:    "Runtime provisioned",
+				FinishedAt: &finishedAt,
+			},
+			Runtime: &model.Runtime{
+				ID:            runtimeID,
+				ClusterConfig: model.ClusterConfig{Name: "cluster", InfrastructureProvider: "GKE"},
+				KymaConfig:    model.KymaConfig{Version: "1.6"},
+				Kubeconfig:    "kubeconfig",
+			},
+			ConnectionStatus: &model.RuntimeConnectionStatus{
+				Status: model.ConnectionDisconnected,
+				Errors: []string{"Client certificate expired"},
+			},
+		}, nil)
+
+		resolver := NewResolver(provisioningService)
+
+		// when
+		status, err := resolver.RuntimeStatus(tenant.SaveToContext(context.TODO(), tenantID), &gqlschema.RuntimeID{ID: runtimeID})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationTypeProvision, status.LastOperationStatus.Operation)
+		assert.Equal(t, gqlschema.OperationStateSucceeded, status.LastOperationStatus.State)
+		assert.Empty(t, status.LastOperationStatus.Errors)
+		require.NotNil(t, status.RuntimeConnectionConfig)
+		assert.Equal(t, "kubeconfig", status.RuntimeConnectionConfig.Kubeconfig)
+		require.NotNil(t, status.RuntimeConnectionStatus)
+		assert.Equal(t, gqlschema.RuntimeAgentConnectionStatusDisconnected, status.RuntimeConnectionStatus.Status)
+		require.Len(t, status.RuntimeConnectionStatus.Errors, 1)
+		assert.Equal(t, "Client certificate expired", *status.RuntimeConnectionStatus.Errors[0].Message)
+		assert.Equal(t, "cluster", *status.RuntimeConfiguration.ClusterConfig.Name)
+		assert.Nil(t, status.RuntimeConfiguration.ClusterConfig.Size)
+		assert.Equal(t, "1.6", *status.RuntimeConfiguration.KymaConfig.Version)
+	})
+}
+
+func TestResolver_RuntimeOperationStatus(t *testing.T) {
+
+	t.Run("should return errors of failed operation", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		provisioningService.On("RuntimeOperationStatus", operationID, tenantID).Return(model.Operation{
+			ID:      operationID,
+			Type:    model.Deprovision,
+			State:   model.Failed,
+			Message: "Failed to deprovision cluster",
+		}, nil)
+
+		resolver := NewResolver(provisioningService)
+
+		// when
+		status, err := resolver.RuntimeOperationStatus(tenant.SaveToContext(context.TODO(), tenantID), &gqlschema.AsyncOperationIDInput{ID: operationID})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationStateFailed, status.State)
+		require.Len(t, status.Errors, 1)
+		assert.Equal(t, "Failed to deprovision cluster", *status.Errors[0].Message)
+	})
+
+	t.Run("should return error when operation ID is missing", func(t *testing.T) {
+		// given
+		resolver := NewResolver(&mocks.Service{})
+
+		// when
+		_, err := resolver.RuntimeOperationStatus(context.TODO(), nil)
+
+		// then
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,44 @@
+package connector
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/graphql"
+)
+
+const generateRuntimeTokenMutation = `mutation($runtimeID: ID!) { result: generateRuntimeToken(runtimeID: $runtimeID) { token } }`
+
+//go:generate mockery -name=Client
+type Client interface {
+	// GenerateRuntimeToken returns the one-time token the Runtime Agent uses to pair the Runtime with the Connector
+	GenerateRuntimeToken(tenant, runtimeID string) (string, apperrors.AppError)
+}
+
+type client struct {
+	graphQLClient *graphql.Client
+}
+
+// NewClient creates client of the Connector GraphQL API available under the given URL
+func NewClient(url string, timeout time.Duration) Client {
+	return &client{
+		graphQLClient: graphql.NewClient("Connector", url, timeout),
+	}
+}
+
+func (c *client) GenerateRuntimeToken(tenant, runtimeID string) (string, apperrors.AppError) {
+	var token struct {
+		Token string `json:"token"`
+	}
+
+	found, err := c.graphQLClient.Do(generateRuntimeTokenMutation, tenant, map[string]interface{}{"runtimeID": runtimeID}, &token)
+	if err != nil {
+		return "", err.Append("Failed to generate token for Runtime %s", runtimeID)
+	}
+
+	if !found || token.Token == "" {
+		return "", apperrors.UpstreamServerCallFailed("Connector returned empty token for Runtime %s", runtimeID)
+	}
+
+	return token.Token, nil
+}
@@ -0,0 +1,85 @@
+package connector_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/connector"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tenant    = "tenant"
+	runtimeID = "runtime-id"
+)
+
+func TestClient_GenerateRuntimeToken(t *testing.T) {
+
+	t.Run("should return token", func(t *testing.T) {
+		// given
+		server := newConnectorServer(t, `{"data":{"result":{"token":"token"}}}`, http.StatusOK)
+		defer server.Close()
+
+		client := connector.NewClient(server.URL, time.Second)
+
+		// when
+		token, err := client.GenerateRuntimeToken(tenant, runtimeID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "token", token)
+	})
+
+	t.Run("should return error when Connector returned errors", func(t *testing.T) {
+		// given
+		server := newConnectorServer(t, `{"data":null,"errors":[{"message":"Runtime runtime-id not found in tenant tenant"}]}`, http.StatusOK)
+		defer server.Close()
+
+		client := connector.NewClient(server.URL, time.Second)
+
+		// when
+		_, err := client.GenerateRuntimeToken(tenant, runtimeID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeUpstreamServerCallFailed, err.Code())
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	t.Run("should return error when Connector returned empty token", func(t *testing.T) {
+		// given
+		server := newConnectorServer(t, `{"data":{"result":{"token":""}}}`, http.StatusOK)
+		defer server.Close()
+
+		client := connector.NewClient(server.URL, time.Second)
+
+		// when
+		_, err := client.GenerateRuntimeToken(tenant, runtimeID)
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func newConnectorServer(t *testing.T, response string, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, tenant, r.Header.Get(graphql.TenantHeader))
+
+		var request struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+		assert.Equal(t, runtimeID, request.Variables["runtimeID"])
+
+		w.WriteHeader(status)
+		_, err = w.Write([]byte(response))
+		require.NoError(t, err)
+	}))
+}
@@ -0,0 +1,93 @@
+package director
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/graphql"
+)
+
+const (
+	createRuntimeMutation = `mutation($in: RuntimeInput!) { result: createRuntime(in: $in) { id } }`
+	deleteRuntimeMutation = `mutation($id: ID!) { result: deleteRuntime(id: $id) { id } }`
+	runtimeQuery          = `query($id: ID!) { result: runtime(id: $id) { id status { condition } certificate { serialNumber expiresAt } } }`
+)
+
+const (
+	RuntimeConditionInitial = "INITIAL"
+	RuntimeConditionReady   = "READY"
+	RuntimeConditionFailed  = "FAILED"
+)
+
+// Runtime contains the pairing state of the Runtime registered in the Director
+type Runtime struct {
+	ID     string `json:"id"`
+	Status struct {
+		Condition string `json:"condition"`
+	} `json:"status"`
+	// Certificate is set when the Runtime Agent is paired with the Connector
+	Certificate *ClientCertificate `json:"certificate"`
+}
+
+type ClientCertificate struct {
+	SerialNumber string    `json:"serialNumber"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+//go:generate mockery -name=Client
+type Client interface {
+	// CreateRuntime registers the Runtime in the Director and returns its ID
+	CreateRuntime(tenant, name string) (string, apperrors.AppError)
+	DeleteRuntime(tenant, id string) apperrors.AppError
+	// GetRuntime returns NotFound error if the Runtime is not registered in the Director
+	GetRuntime(tenant, id string) (Runtime, apperrors.AppError)
+}
+
+type client struct {
+	graphQLClient *graphql.Client
+}
+
+// NewClient creates client of the Director GraphQL API available under the given URL
+func NewClient(url string, timeout time.Duration) Client {
+	return &client{
+		graphQLClient: graphql.NewClient("Director", url, timeout),
+	}
+}
+
+func (c *client) CreateRuntime(tenant, name string) (string, apperrors.AppError) {
+	var runtime Runtime
+	found, err := c.graphQLClient.Do(createRuntimeMutation, tenant, map[string]interface{}{"in": map[string]interface{}{"name": name}}, &runtime)
+	if err != nil {
+		return "", err.Append("Failed to create Runtime in Director")
+	}
+
+	if !found {
+		return "", apperrors.UpstreamServerCallFailed("Director returned empty result for created Runtime")
+	}
+
+	return runtime.ID, nil
+}
+
+func (c *client) DeleteRuntime(tenant, id string) apperrors.AppError {
+	var runtime Runtime
+	_, err := c.graphQLClient.Do(deleteRuntimeMutation, tenant, map[string]interface{}{"id": id}, &runtime)
+	if err != nil {
+		return err.Append("Failed to delete Runtime %s from Director", id)
+	}
+
+	return nil
+}
+
+func (c *client) GetRuntime(tenant, id string) (Runtime, apperrors.AppError) {
+	var runtime Runtime
+	found, err := c.graphQLClient.Do(runtimeQuery, tenant, map[string]interface{}{"id": id}, &runtime)
+	if err != nil {
+		return Runtime{}, err.Append("Failed to get Runtime %s from Director", id)
+	}
+
+	if !found {
+		return Runtime{}, apperrors.NotFound("Runtime %s not found in Director", id)
+	}
+
+	return runtime, nil
+}
@@ -0,0 +1,152 @@
+package director_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/director"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tenant = "tenant"
+	id     = "id"
+)
+
+func TestClient_CreateRuntime(t *testing.T) {
+
+	t.Run("should return ID of created Runtime", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, `{"data":{"result":{"id":"id"}}}`, http.StatusOK, func(variables map[string]interface{}) {
+			assert.Equal(t, map[string]interface{}{"name": "cluster"}, variables["in"])
+		})
+		defer server.Close()
+
+		client := director.NewClient(server.URL, time.Second)
+
+		// when
+		runtimeID, err := client.CreateRuntime(tenant, "cluster")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, id, runtimeID)
+	})
+
+	t.Run("should return error when Director returned errors", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, `{"data":{"result":null},"errors":[{"message":"invalid name"}]}`, http.StatusOK, nil)
+		defer server.Close()
+
+		client := director.NewClient(server.URL, time.Second)
+
+		// when
+		_, err := client.CreateRuntime(tenant, "cluster")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeUpstreamServerCallFailed, err.Code())
+		assert.Contains(t, err.Error(), "invalid name")
+	})
+}
+
+func TestClient_GetRuntime(t *testing.T) {
+
+	t.Run("should return pairing state of Runtime", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, `{"data":{"result":{"id":"id","status":{"condition":"READY"},"certificate":{"serialNumber":"4d2","expiresAt":"2019-12-30T12:00:00Z"}}}}`,
+			http.StatusOK, expectID(t))
+		defer server.Close()
+
+		client := director.NewClient(server.URL, time.Second)
+
+		// when
+		runtime, err := client.GetRuntime(tenant, id)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, id, runtime.ID)
+		assert.Equal(t, director.RuntimeConditionReady, runtime.Status.Condition)
+		require.NotNil(t, runtime.Certificate)
+		assert.Equal(t, "4d2", runtime.Certificate.SerialNumber)
+		assert.Equal(t, time.Date(2019, 12, 30, 12, 0, 0, 0, time.UTC), runtime.Certificate.ExpiresAt.UTC())
+	})
+
+	t.Run("should return NotFound error when Runtime does not exist", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, `{"data":{"result":null}}`, http.StatusOK, expectID(t))
+		defer server.Close()
+
+		client := director.NewClient(server.URL, time.Second)
+
+		// when
+		_, err := client.GetRuntime(tenant, id)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should return error when Director responded with unexpected status", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, ``, http.StatusInternalServerError, nil)
+		defer server.Close()
+
+		client := director.NewClient(server.URL, time.Second)
+
+		// when
+		_, err := client.GetRuntime(tenant, id)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeUpstreamServerCallFailed, err.Code())
+	})
+}
+
+func TestClient_DeleteRuntime(t *testing.T) {
+
+	t.Run("should delete Runtime", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, `{"data":{"result":{"id":"id"}}}`, http.StatusOK, expectID(t))
+		defer server.Close()
+
+		client := director.NewClient(server.URL, time.Second)
+
+		// when
+		err := client.DeleteRuntime(tenant, id)
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func expectID(t *testing.T) func(map[string]interface{}) {
+	return func(variables map[string]interface{}) {
+		assert.Equal(t, id, variables["id"])
+	}
+}
+
+func newDirectorServer(t *testing.T, response string, status int, checkVariables func(map[string]interface{})) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, tenant, r.Header.Get(graphql.TenantHeader))
+
+		var request struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+
+		if checkVariables != nil {
+			checkVariables(request.Variables)
+		}
+
+		w.WriteHeader(status)
+		_, err = w.Write([]byte(response))
+		require.NoError(t, err)
+	}))
+}
@@ -0,0 +1,97 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+)
+
+const TenantHeader = "Tenant"
+
+// Client calls GraphQL API of other Compass components on behalf of the tenant
+type Client struct {
+	name       string
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient creates client of the GraphQL API available under the given URL, the name identifies the called component in errors
+func NewClient(name, url string, timeout time.Duration) *Client {
+	return &Client{
+		name: name,
+		url:  url,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type request struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type response struct {
+	Data struct {
+		Result json.RawMessage `json:"result"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// Do executes the query and decodes the field aliased as result into the result argument
+// it returns false if the result is null
+func (c *Client) Do(query, tenant string, variables map[string]interface{}, result interface{}) (bool, apperrors.AppError) {
+	body, err := json.Marshal(request{Query: query, Variables: variables})
+	if err != nil {
+		return false, apperrors.Internal("Failed to marshal %s request: %s", c.name, err.Error())
+	}
+
+	httpRequest, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return false, apperrors.Internal("Failed to create %s request: %s", c.name, err.Error())
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set(TenantHeader, tenant)
+
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		return false, apperrors.UpstreamServerCallFailed("Failed to call %s: %s", c.name, err.Error())
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return false, apperrors.UpstreamServerCallFailed("%s responded with unexpected status %d", c.name, httpResponse.StatusCode)
+	}
+
+	var gqlResponse response
+	err = json.NewDecoder(httpResponse.Body).Decode(&gqlResponse)
+	if err != nil {
+		return false, apperrors.UpstreamServerCallFailed("Failed to decode %s response: %s", c.name, err.Error())
+	}
+
+	if len(gqlResponse.Errors) > 0 {
+		messages := make([]string, 0, len(gqlResponse.Errors))
+		for _, e := range gqlResponse.Errors {
+			messages = append(messages, e.Message)
+		}
+
+		return false, apperrors.UpstreamServerCallFailed("%s returned errors: %s", c.name, strings.Join(messages, "; "))
+	}
+
+	if len(gqlResponse.Data.Result) == 0 || string(gqlResponse.Data.Result) == "null" {
+		return false, nil
+	}
+
+	err = json.Unmarshal(gqlResponse.Data.Result, result)
+	if err != nil {
+		return false, apperrors.UpstreamServerCallFailed("Failed to decode %s result: %s", c.name, err.Error())
+	}
+
+	return true, nil
+}
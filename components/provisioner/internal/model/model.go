@@ -0,0 +1,119 @@
+package model
+
+import "time"
+
+type OperationType string
+
+const (
+	Provision        OperationType = "PROVISION"
+	Upgrade          OperationType = "UPGRADE"
+	Deprovision      OperationType = "DEPROVISION"
+	ReconnectRuntime OperationType = "RECONNECT_RUNTIME"
+)
+
+type OperationState string
+
+const (
+	Pending    OperationState = "PENDING"
+	InProgress OperationState = "IN_PROGRESS"
+	Succeeded  OperationState = "SUCCEEDED"
+	Failed     OperationState = "FAILED"
+)
+
+// Operation is an asynchronous operation executed on the Runtime
+type Operation struct {
+	ID         string
+	RuntimeID  string
+	Tenant     string
+	Type       OperationType
+	State      OperationState
+	Message    string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	// Owner is the Provisioner instance executing the operation, it renews the lease until the operation is finished
+	Owner          string
+	LeaseExpiresAt time.Time
+}
+
+// Finished returns true if the operation succeeded or failed
+func (o Operation) Finished() bool {
+	return o.State == Succeeded || o.State == Failed
+}
+
+type ClusterConfig struct {
+	Name                   string
+	Size                   string
+	Memory                 string
+	ComputeZone            string
+	Version                string
+	Credentials            string
+	InfrastructureProvider string
+}
+
+type KymaConfig struct {
+	Version string
+	Modules []string
+}
+
+// Runtime is the provisioned cluster with its configuration
+type Runtime struct {
+	ID            string
+	Tenant        string
+	ClusterConfig ClusterConfig
+	KymaConfig    KymaConfig
+	// Kubeconfig is set when the cluster has been provisioned
+	Kubeconfig string
+	// DirectorRuntimeID is the ID of the Runtime registered in the Director, it is set when the cluster has been provisioned
+	DirectorRuntimeID string
+}
+
+// RuntimeAgentConfig is the configuration the Runtime Agent uses to pair the Runtime with the Connector
+type RuntimeAgentConfig struct {
+	ConnectorURL string
+	Token        string
+	// RuntimeID is the ID of the Runtime in the Director
+	RuntimeID string
+	Tenant    string
+}
+
+type ConnectionStatus string
+
+const (
+	ConnectionPending      ConnectionStatus = "PENDING"
+	ConnectionConnected    ConnectionStatus = "CONNECTED"
+	ConnectionDisconnected ConnectionStatus = "DISCONNECTED"
+)
+
+// RuntimeConnectionStatus describes the pairing of the Runtime Agent with the Connector
+type RuntimeConnectionStatus struct {
+	Status ConnectionStatus
+	Errors []string
+}
+
+type UpgradeConfig struct {
+	// ClusterVersion is the desired Kubernetes version, left unchanged if empty
+	ClusterVersion string
+	// KymaConfig is the desired Kyma configuration, left unchanged if nil
+	KymaConfig *KymaConfig
+}
+
+// Apply returns the Runtime configuration after the upgrade
+func (c UpgradeConfig) Apply(runtime Runtime) Runtime {
+	if c.ClusterVersion != "" {
+		runtime.ClusterConfig.Version = c.ClusterVersion
+	}
+	if c.KymaConfig != nil {
+		runtime.KymaConfig = *c.KymaConfig
+	}
+
+	return runtime
+}
+
+// RuntimeStatus describes the last operation executed on the Runtime and its current configuration
+type RuntimeStatus struct {
+	LastOperation Operation
+	// Runtime is not set when the Runtime has been deprovisioned or its provisioning failed
+	Runtime *Runtime
+	// ConnectionStatus is not set when the Runtime is not registered in the Director or the Director is not available
+	ConnectionStatus *RuntimeConnectionStatus
+}
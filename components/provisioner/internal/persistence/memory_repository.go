@@ -0,0 +1,182 @@
+package persistence
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+)
+
+type inMemoryRepository struct {
+	mutex      sync.RWMutex
+	operations map[string]model.Operation
+	runtimes   map[string]model.Runtime
+}
+
+// NewInMemoryRepository creates a new repository keeping operations and Runtimes in memory
+// the state is lost on restart, so it is meant for local development and tests only
+func NewInMemoryRepository() Repository {
+	return &inMemoryRepository{
+		operations: map[string]model.Operation{},
+		runtimes:   map[string]model.Runtime{},
+	}
+}
+
+func (r *inMemoryRepository) InsertOperation(operation model.Operation) apperrors.AppError {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.operations[operation.ID]; exists {
+		return apperrors.AlreadyExists("Operation %s already exists", operation.ID)
+	}
+
+	for _, existing := range r.operations {
+		if existing.RuntimeID == operation.RuntimeID && !existing.Finished() && !operation.Finished() {
+			return apperrors.AlreadyExists("Another operation is in progress for Runtime %s", operation.RuntimeID)
+		}
+	}
+
+	r.operations[operation.ID] = operation
+
+	return nil
+}
+
+func (r *inMemoryRepository) UpdateOperation(operation model.Operation) apperrors.AppError {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	existing, found := r.operations[operation.ID]
+	if !found {
+		return apperrors.NotFound("Operation %s not found", operation.ID)
+	}
+
+	existing.State = operation.State
+	existing.Message = operation.Message
+	existing.FinishedAt = operation.FinishedAt
+	r.operations[operation.ID] = existing
+
+	return nil
+}
+
+func (r *inMemoryRepository) GetOperation(operationID string) (model.Operation, apperrors.AppError) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	operation, found := r.operations[operationID]
+	if !found {
+		return model.Operation{}, apperrors.NotFound("Operation %s not found", operationID)
+	}
+
+	return operation, nil
+}
+
+func (r *inMemoryRepository) GetLastOperation(runtimeID string) (model.Operation, apperrors.AppError) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var last *model.Operation
+	for _, operation := range r.operations {
+		if operation.RuntimeID != runtimeID {
+			continue
+		}
+
+		if last == nil || operation.StartedAt.After(last.StartedAt) {
+			operation := operation
+			last = &operation
+		}
+	}
+
+	if last == nil {
+		return model.Operation{}, apperrors.NotFound("No operations found for Runtime %s", runtimeID)
+	}
+
+	return *last, nil
+}
+
+func (r *inMemoryRepository) RenewLease(operationID, owner string, expiresAt time.Time) apperrors.AppError {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	operation, found := r.operations[operationID]
+	if !found || operation.Owner != owner || operation.Finished() {
+		return apperrors.NotFound("Unfinished operation %s of this instance not found", operationID)
+	}
+
+	operation.LeaseExpiresAt = expiresAt
+	r.operations[operationID] = operation
+
+	return nil
+}
+
+func (r *inMemoryRepository) FailExpiredOperations(message string, now time.Time) (int, apperrors.AppError) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	failed := 0
+	for id, operation := range r.operations {
+		if operation.Finished() || !operation.LeaseExpiresAt.Before(now) {
+			continue
+		}
+
+		finishedAt := now
+		operation.State = model.Failed
+		operation.Message = message
+		operation.FinishedAt = &finishedAt
+		r.operations[id] = operation
+		failed++
+	}
+
+	return failed, nil
+}
+
+func (r *inMemoryRepository) InsertRuntime(runtime model.Runtime) apperrors.AppError {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.runtimes[runtime.ID]; exists {
+		return apperrors.AlreadyExists("Runtime %s already exists", runtime.ID)
+	}
+
+	r.runtimes[runtime.ID] = runtime
+
+	return nil
+}
+
+func (r *inMemoryRepository) UpdateRuntime(runtime model.Runtime) apperrors.AppError {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.runtimes[runtime.ID]; !exists {
+		return apperrors.NotFound("Runtime %s not found", runtime.ID)
+	}
+
+	r.runtimes[runtime.ID] = runtime
+
+	return nil
+}
+
+func (r *inMemoryRepository) GetRuntime(runtimeID string) (model.Runtime, apperrors.AppError) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	runtime, found := r.runtimes[runtimeID]
+	if !found {
+		return model.Runtime{}, apperrors.NotFound("Runtime %s not found", runtimeID)
+	}
+
+	return runtime, nil
+}
+
+func (r *inMemoryRepository) DeleteRuntime(runtimeID string) apperrors.AppError {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.runtimes[runtimeID]; !exists {
+		return apperrors.NotFound("Runtime %s not found", runtimeID)
+	}
+
+	delete(r.runtimes, runtimeID)
+
+	return nil
+}
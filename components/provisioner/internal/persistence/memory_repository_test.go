@@ -0,0 +1,149 @@
+package persistence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var startedAt = time.Date(2019, 10, 7, 12, 0, 0, 0, time.UTC)
+
+func TestInMemoryRepository_Operations(t *testing.T) {
+
+	t.Run("should reject second unfinished operation for the same Runtime", func(t *testing.T) {
+		// given
+		repository := persistence.NewInMemoryRepository()
+
+		err := repository.InsertOperation(model.Operation{ID: "1", RuntimeID: "runtime-id", State: model.InProgress, StartedAt: startedAt})
+		require.NoError(t, err)
+
+		// when
+		err = repository.InsertOperation(model.Operation{ID: "2", RuntimeID: "runtime-id", State: model.Pending, StartedAt: startedAt})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeAlreadyExists, err.Code())
+	})
+
+	t.Run("should accept operation when previous operation is finished", func(t *testing.T) {
+		// given
+		repository := persistence.NewInMemoryRepository()
+		finishedAt := startedAt.Add(time.Minute)
+
+		err := repository.InsertOperation(model.Operation{ID: "1", RuntimeID: "runtime-id", State: model.Pending, StartedAt: startedAt})
+		require.NoError(t, err)
+		err = repository.UpdateOperation(model.Operation{ID: "1", State: model.Succeeded, FinishedAt: &finishedAt})
+		require.NoError(t, err)
+
+		// when
+		err = repository.InsertOperation(model.Operation{ID: "2", RuntimeID: "runtime-id", State: model.Pending, StartedAt: finishedAt})
+
+		// then
+		require.NoError(t, err)
+		last, err := repository.GetLastOperation("runtime-id")
+		require.NoError(t, err)
+		assert.Equal(t, "2", last.ID)
+	})
+
+	t.Run("should renew lease of own unfinished operation", func(t *testing.T) {
+		// given
+		repository := persistence.NewInMemoryRepository()
+		leaseEnd := startedAt.Add(time.Minute)
+
+		err := repository.InsertOperation(model.Operation{ID: "1", RuntimeID: "runtime-id", State: model.InProgress, StartedAt: startedAt, Owner: "owner"})
+		require.NoError(t, err)
+
+		// when
+		err = repository.RenewLease("1", "owner", leaseEnd)
+
+		// then
+		require.NoError(t, err)
+		operation, err := repository.GetOperation("1")
+		require.NoError(t, err)
+		assert.Equal(t, leaseEnd, operation.LeaseExpiresAt)
+
+		err = repository.RenewLease("1", "other", leaseEnd)
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should fail only unfinished operations with expired lease", func(t *testing.T) {
+		// given
+		repository := persistence.NewInMemoryRepository()
+		now := startedAt.Add(time.Minute)
+
+		err := repository.InsertOperation(model.Operation{ID: "1", RuntimeID: "runtime-1", State: model.InProgress, StartedAt: startedAt, LeaseExpiresAt: now.Add(-time.Second)})
+		require.NoError(t, err)
+		err = repository.InsertOperation(model.Operation{ID: "2", RuntimeID: "runtime-2", State: model.InProgress, StartedAt: startedAt, LeaseExpiresAt: now.Add(time.Second)})
+		require.NoError(t, err)
+		err = repository.InsertOperation(model.Operation{ID: "3", RuntimeID: "runtime-3", State: model.Succeeded, StartedAt: startedAt, FinishedAt: &startedAt})
+		require.NoError(t, err)
+
+		// when
+		failed, err := repository.FailExpiredOperations("interrupted", now)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 1, failed)
+
+		operation, err := repository.GetOperation("1")
+		require.NoError(t, err)
+		assert.Equal(t, model.Failed, operation.State)
+		assert.Equal(t, "interrupted", operation.Message)
+		assert.Equal(t, &now, operation.FinishedAt)
+
+		operation, err = repository.GetOperation("2")
+		require.NoError(t, err)
+		assert.Equal(t, model.InProgress, operation.State)
+	})
+}
+
+func TestInMemoryRepository_Runtimes(t *testing.T) {
+
+	t.Run("should store, update and delete Runtime", func(t *testing.T) {
+		// given
+		repository := persistence.NewInMemoryRepository()
+		runtime := model.Runtime{ID: "runtime-id", KymaConfig: model.KymaConfig{Version: "1.6"}}
+
+		// when
+		err := repository.InsertRuntime(runtime)
+		require.NoError(t, err)
+
+		runtime.Kubeconfig = "kubeconfig"
+		err = repository.UpdateRuntime(runtime)
+		require.NoError(t, err)
+
+		stored, err := repository.GetRuntime("runtime-id")
+		require.NoError(t, err)
+
+		err = repository.DeleteRuntime("runtime-id")
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, runtime, stored)
+
+		_, err = repository.GetRuntime("runtime-id")
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should return AlreadyExists error when Runtime exists", func(t *testing.T) {
+		// given
+		repository := persistence.NewInMemoryRepository()
+
+		err := repository.InsertRuntime(model.Runtime{ID: "runtime-id"})
+		require.NoError(t, err)
+
+		// when
+		err = repository.InsertRuntime(model.Runtime{ID: "runtime-id"})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeAlreadyExists, err.Code())
+	})
+}
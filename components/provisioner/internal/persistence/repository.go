@@ -0,0 +1,251 @@
+package persistence
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the error code returned by Postgres when the unique constraint is violated
+const uniqueViolation pq.ErrorCode = "23505"
+
+const (
+	insertOperationQuery     = `INSERT INTO provisioner_operations (id, runtime_id, tenant, type, state, message, started_at, finished_at, owner, lease_expires_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	updateOperationQuery     = `UPDATE provisioner_operations SET state = $2, message = $3, finished_at = $4 WHERE id = $1`
+	selectOperationQuery     = `SELECT id, runtime_id, tenant, type, state, message, started_at, finished_at, owner, lease_expires_at FROM provisioner_operations WHERE id = $1`
+	selectLastOperationQuery = `SELECT id, runtime_id, tenant, type, state, message, started_at, finished_at, owner, lease_expires_at FROM provisioner_operations WHERE runtime_id = $1 ORDER BY started_at DESC LIMIT 1`
+	renewLeaseQuery          = `UPDATE provisioner_operations SET lease_expires_at = $3 WHERE id = $1 AND owner = $2 AND state IN ($4, $5)`
+	failExpiredQuery         = `UPDATE provisioner_operations SET state = $1, message = $2, finished_at = $3 WHERE state IN ($4, $5) AND lease_expires_at < $3`
+
+	insertRuntimeQuery = `INSERT INTO provisioner_runtimes (id, tenant, cluster_config, kyma_config, kubeconfig, director_runtime_id) VALUES ($1, $2, $3, $4, $5, $6)`
+	updateRuntimeQuery = `UPDATE provisioner_runtimes SET cluster_config = $2, kyma_config = $3, kubeconfig = $4, director_runtime_id = $5 WHERE id = $1`
+	selectRuntimeQuery = `SELECT id, tenant, cluster_config, kyma_config, kubeconfig, director_runtime_id FROM provisioner_runtimes WHERE id = $1`
+	deleteRuntimeQuery = `DELETE FROM provisioner_runtimes WHERE id = $1`
+)
+
+//go:generate mockery -name=Repository
+type Repository interface {
+	// InsertOperation stores the operation, it fails with AlreadyExists error if another operation for the same Runtime is pending or in progress
+	InsertOperation(operation model.Operation) apperrors.AppError
+	// UpdateOperation updates the state, message and finish time of the operation
+	UpdateOperation(operation model.Operation) apperrors.AppError
+	GetOperation(operationID string) (model.Operation, apperrors.AppError)
+	// GetLastOperation returns the most recently started operation of the Runtime
+	GetLastOperation(runtimeID string) (model.Operation, apperrors.AppError)
+	// RenewLease extends the lease the owner holds on the pending or in progress operation
+	RenewLease(operationID, owner string, expiresAt time.Time) apperrors.AppError
+	// FailExpiredOperations marks the pending and in progress operations with the lease expired before now as failed and returns their number
+	FailExpiredOperations(message string, now time.Time) (int, apperrors.AppError)
+
+	// InsertRuntime stores the Runtime configuration, it fails with AlreadyExists error if the Runtime is already stored
+	InsertRuntime(runtime model.Runtime) apperrors.AppError
+	UpdateRuntime(runtime model.Runtime) apperrors.AppError
+	GetRuntime(runtimeID string) (model.Runtime, apperrors.AppError)
+	DeleteRuntime(runtimeID string) apperrors.AppError
+}
+
+type postgresRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresRepository creates a new repository storing operations in the provisioner_operations table and Runtimes in the provisioner_runtimes table
+// The provisioner_operations table has a unique index on the runtime_id of pending and in progress operations, which guarantees only one
+// asynchronous operation per Runtime across the Provisioner replicas
+func NewPostgresRepository(db *sql.DB) Repository {
+	return &postgresRepository{db: db}
+}
+
+func (r *postgresRepository) InsertOperation(operation model.Operation) apperrors.AppError {
+	_, err := r.db.Exec(insertOperationQuery, operation.ID, operation.RuntimeID, operation.Tenant, operation.Type, operation.State, operation.Message,
+		operation.StartedAt.UTC(), utcOrNil(operation.FinishedAt), operation.Owner, operation.LeaseExpiresAt.UTC())
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.AlreadyExists("Another operation is in progress for Runtime %s", operation.RuntimeID)
+		}
+		return apperrors.Internal("Failed to store operation: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) UpdateOperation(operation model.Operation) apperrors.AppError {
+	result, err := r.db.Exec(updateOperationQuery, operation.ID, operation.State, operation.Message, utcOrNil(operation.FinishedAt))
+	if err != nil {
+		return apperrors.Internal("Failed to update operation %s: %s", operation.ID, err.Error())
+	}
+
+	return checkAffected(result, "Operation %s not found", operation.ID)
+}
+
+func (r *postgresRepository) GetOperation(operationID string) (model.Operation, apperrors.AppError) {
+	operation, err := scanOperation(r.db.QueryRow(selectOperationQuery, operationID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return model.Operation{}, apperrors.NotFound("Operation %s not found", operationID)
+		}
+		return model.Operation{}, apperrors.Internal("Failed to get operation %s: %s", operationID, err.Error())
+	}
+
+	return operation, nil
+}
+
+func (r *postgresRepository) GetLastOperation(runtimeID string) (model.Operation, apperrors.AppError) {
+	operation, err := scanOperation(r.db.QueryRow(selectLastOperationQuery, runtimeID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return model.Operation{}, apperrors.NotFound("No operations found for Runtime %s", runtimeID)
+		}
+		return model.Operation{}, apperrors.Internal("Failed to get last operation of Runtime %s: %s", runtimeID, err.Error())
+	}
+
+	return operation, nil
+}
+
+func (r *postgresRepository) RenewLease(operationID, owner string, expiresAt time.Time) apperrors.AppError {
+	result, err := r.db.Exec(renewLeaseQuery, operationID, owner, expiresAt.UTC(), model.Pending, model.InProgress)
+	if err != nil {
+		return apperrors.Internal("Failed to renew lease of operation %s: %s", operationID, err.Error())
+	}
+
+	return checkAffected(result, "Unfinished operation %s of this instance not found", operationID)
+}
+
+func (r *postgresRepository) FailExpiredOperations(message string, now time.Time) (int, apperrors.AppError) {
+	result, err := r.db.Exec(failExpiredQuery, model.Failed, message, now.UTC(), model.Pending, model.InProgress)
+	if err != nil {
+		return 0, apperrors.Internal("Failed to fail operations with expired lease: %s", err.Error())
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, apperrors.Internal("Failed to check affected rows: %s", err.Error())
+	}
+
+	return int(affected), nil
+}
+
+func (r *postgresRepository) InsertRuntime(runtime model.Runtime) apperrors.AppError {
+	clusterConfig, kymaConfig, appErr := marshalRuntimeConfig(runtime)
+	if appErr != nil {
+		return appErr
+	}
+
+	_, err := r.db.Exec(insertRuntimeQuery, runtime.ID, runtime.Tenant, clusterConfig, kymaConfig, runtime.Kubeconfig, runtime.DirectorRuntimeID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.AlreadyExists("Runtime %s already exists", runtime.ID)
+		}
+		return apperrors.Internal("Failed to store Runtime %s: %s", runtime.ID, err.Error())
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) UpdateRuntime(runtime model.Runtime) apperrors.AppError {
+	clusterConfig, kymaConfig, appErr := marshalRuntimeConfig(runtime)
+	if appErr != nil {
+		return appErr
+	}
+
+	result, err := r.db.Exec(updateRuntimeQuery, runtime.ID, clusterConfig, kymaConfig, runtime.Kubeconfig, runtime.DirectorRuntimeID)
+	if err != nil {
+		return apperrors.Internal("Failed to update Runtime %s: %s", runtime.ID, err.Error())
+	}
+
+	return checkAffected(result, "Runtime %s not found", runtime.ID)
+}
+
+func (r *postgresRepository) GetRuntime(runtimeID string) (model.Runtime, apperrors.AppError) {
+	var runtime model.Runtime
+	var clusterConfig, kymaConfig []byte
+
+	err := r.db.QueryRow(selectRuntimeQuery, runtimeID).Scan(&runtime.ID, &runtime.Tenant, &clusterConfig, &kymaConfig, &runtime.Kubeconfig, &runtime.DirectorRuntimeID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return model.Runtime{}, apperrors.NotFound("Runtime %s not found", runtimeID)
+		}
+		return model.Runtime{}, apperrors.Internal("Failed to get Runtime %s: %s", runtimeID, err.Error())
+	}
+
+	err = json.Unmarshal(clusterConfig, &runtime.ClusterConfig)
+	if err != nil {
+		return model.Runtime{}, apperrors.Internal("Failed to read cluster configuration of Runtime %s: %s", runtimeID, err.Error())
+	}
+
+	err = json.Unmarshal(kymaConfig, &runtime.KymaConfig)
+	if err != nil {
+		return model.Runtime{}, apperrors.Internal("Failed to read Kyma configuration of Runtime %s: %s", runtimeID, err.Error())
+	}
+
+	return runtime, nil
+}
+
+func (r *postgresRepository) DeleteRuntime(runtimeID string) apperrors.AppError {
+	result, err := r.db.Exec(deleteRuntimeQuery, runtimeID)
+	if err != nil {
+		return apperrors.Internal("Failed to delete Runtime %s: %s", runtimeID, err.Error())
+	}
+
+	return checkAffected(result, "Runtime %s not found", runtimeID)
+}
+
+func scanOperation(row *sql.Row) (model.Operation, error) {
+	var operation model.Operation
+	var finishedAt pq.NullTime
+
+	err := row.Scan(&operation.ID, &operation.RuntimeID, &operation.Tenant, &operation.Type, &operation.State, &operation.Message, &operation.StartedAt, &finishedAt,
+		&operation.Owner, &operation.LeaseExpiresAt)
+	if err != nil {
+		return model.Operation{}, err
+	}
+
+	if finishedAt.Valid {
+		operation.FinishedAt = &finishedAt.Time
+	}
+
+	return operation, nil
+}
+
+func marshalRuntimeConfig(runtime model.Runtime) ([]byte, []byte, apperrors.AppError) {
+	clusterConfig, err := json.Marshal(runtime.ClusterConfig)
+	if err != nil {
+		return nil, nil, apperrors.Internal("Failed to marshal cluster configuration of Runtime %s: %s", runtime.ID, err.Error())
+	}
+
+	kymaConfig, err := json.Marshal(runtime.KymaConfig)
+	if err != nil {
+		return nil, nil, apperrors.Internal("Failed to marshal Kyma configuration of Runtime %s: %s", runtime.ID, err.Error())
+	}
+
+	return clusterConfig, kymaConfig, nil
+}
+
+func checkAffected(result sql.Result, notFoundFormat string, id string) apperrors.AppError {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return apperrors.Internal("Failed to check affected rows: %s", err.Error())
+	}
+
+	if affected == 0 {
+		return apperrors.NotFound(notFoundFormat, id)
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == uniqueViolation
+}
+
+func utcOrNil(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+
+	return t.UTC()
+}
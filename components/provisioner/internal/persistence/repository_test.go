@@ -0,0 +1,358 @@
+package persistence
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testStartedAt  = time.Date(2019, 10, 7, 12, 0, 0, 0, time.UTC)
+	testFinishedAt = testStartedAt.Add(time.Minute)
+	testLeaseEnd   = testStartedAt.Add(time.Minute)
+
+	operationColumns = []string{"id", "runtime_id", "tenant", "type", "state", "message", "started_at", "finished_at", "owner", "lease_expires_at"}
+	runtimeColumns   = []string{"id", "tenant", "cluster_config", "kyma_config", "kubeconfig", "director_runtime_id"}
+)
+
+func TestPostgresRepository_InsertOperation(t *testing.T) {
+
+	operation := model.Operation{
+		ID:             "operation-id",
+		RuntimeID:      "runtime-id",
+		Tenant:         "tenant",
+		Type:           model.Provision,
+		State:          model.Pending,
+		Message:        "Operation scheduled",
+		StartedAt:      testStartedAt,
+		Owner:          "owner",
+		LeaseExpiresAt: testLeaseEnd,
+	}
+
+	t.Run("should store operation", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(insertOperationQuery)).
+			WithArgs("operation-id", "runtime-id", "tenant", model.Provision, model.Pending, "Operation scheduled", testStartedAt, nil, "owner", testLeaseEnd).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.InsertOperation(operation)
+
+		// then
+		require.NoError(t, err)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("should return AlreadyExists error when another operation is in progress", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(insertOperationQuery)).
+			WillReturnError(&pq.Error{Code: uniqueViolation})
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.InsertOperation(operation)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeAlreadyExists, err.Code())
+	})
+
+	t.Run("should return Internal error when failed to store operation", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(insertOperationQuery)).
+			WillReturnError(errors.New("error"))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.InsertOperation(operation)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
+
+func TestPostgresRepository_UpdateOperation(t *testing.T) {
+
+	t.Run("should return NotFound error when operation does not exist", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(updateOperationQuery)).
+			WithArgs("operation-id", model.Succeeded, "Runtime provisioned", testFinishedAt).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.UpdateOperation(model.Operation{
+			ID:         "operation-id",
+			State:      model.Succeeded,
+			Message:    "Runtime provisioned",
+			FinishedAt: &testFinishedAt,
+		})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresRepository_GetLastOperation(t *testing.T) {
+
+	t.Run("should return last operation", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows(operationColumns).
+			AddRow("operation-id", "runtime-id", "tenant", "PROVISION", "SUCCEEDED", "Runtime provisioned", testStartedAt, testFinishedAt, "owner", testLeaseEnd)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(selectLastOperationQuery)).
+			WithArgs("runtime-id").
+			WillReturnRows(rows)
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		operation, err := repository.GetLastOperation("runtime-id")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, model.Operation{
+			ID:             "operation-id",
+			RuntimeID:      "runtime-id",
+			Tenant:         "tenant",
+			Type:           model.Provision,
+			State:          model.Succeeded,
+			Message:        "Runtime provisioned",
+			StartedAt:      testStartedAt,
+			FinishedAt:     &testFinishedAt,
+			Owner:          "owner",
+			LeaseExpiresAt: testLeaseEnd,
+		}, operation)
+	})
+
+	t.Run("should return NotFound error when there are no operations", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(selectLastOperationQuery)).
+			WithArgs("runtime-id").
+			WillReturnRows(sqlmock.NewRows(operationColumns))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		_, err := repository.GetLastOperation("runtime-id")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+}
+
+func TestPostgresRepository_RenewLease(t *testing.T) {
+
+	t.Run("should renew lease of unfinished operation", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(renewLeaseQuery)).
+			WithArgs("operation-id", "owner", testLeaseEnd, model.Pending, model.InProgress).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.RenewLease("operation-id", "owner", testLeaseEnd)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should return NotFound error when operation is finished or owned by another instance", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(renewLeaseQuery)).
+			WithArgs("operation-id", "owner", testLeaseEnd, model.Pending, model.InProgress).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.RenewLease("operation-id", "owner", testLeaseEnd)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+}
+
+func TestPostgresRepository_FailExpiredOperations(t *testing.T) {
+
+	t.Run("should fail pending and in progress operations with expired lease", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(failExpiredQuery)).
+			WithArgs(model.Failed, "interrupted", testFinishedAt, model.Pending, model.InProgress).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		failed, err := repository.FailExpiredOperations("interrupted", testFinishedAt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, failed)
+	})
+}
+
+func TestPostgresRepository_Runtime(t *testing.T) {
+
+	runtime := model.Runtime{
+		ID:                "runtime-id",
+		Tenant:            "tenant",
+		ClusterConfig:     model.ClusterConfig{Name: "cluster", InfrastructureProvider: "GKE"},
+		KymaConfig:        model.KymaConfig{Version: "1.6", Modules: []string{"Backup"}},
+		Kubeconfig:        "kubeconfig",
+		DirectorRuntimeID: "director-runtime-id",
+	}
+	clusterConfig := `{"Name":"cluster","Size":"","Memory":"","ComputeZone":"","Version":"","Credentials":"","InfrastructureProvider":"GKE"}`
+	kymaConfig := `{"Version":"1.6","Modules":["Backup"]}`
+
+	t.Run("should store Runtime", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(insertRuntimeQuery)).
+			WithArgs("runtime-id", "tenant", []byte(clusterConfig), []byte(kymaConfig), "kubeconfig", "director-runtime-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.InsertRuntime(runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("should return AlreadyExists error when Runtime exists", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(insertRuntimeQuery)).
+			WillReturnError(&pq.Error{Code: uniqueViolation})
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.InsertRuntime(runtime)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeAlreadyExists, err.Code())
+	})
+
+	t.Run("should get Runtime", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows(runtimeColumns).
+			AddRow("runtime-id", "tenant", []byte(clusterConfig), []byte(kymaConfig), "kubeconfig", "director-runtime-id")
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(selectRuntimeQuery)).
+			WithArgs("runtime-id").
+			WillReturnRows(rows)
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		result, err := repository.GetRuntime("runtime-id")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, runtime, result)
+	})
+
+	t.Run("should return NotFound error when Runtime does not exist", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(selectRuntimeQuery)).
+			WithArgs("runtime-id").
+			WillReturnRows(sqlmock.NewRows(runtimeColumns))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		_, err := repository.GetRuntime("runtime-id")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should delete Runtime", func(t *testing.T) {
+		// given
+		db, dbMock := newDBMock(t)
+		defer db.Close()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(deleteRuntimeQuery)).
+			WithArgs("runtime-id").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		repository := NewPostgresRepository(db)
+
+		// when
+		err := repository.DeleteRuntime("runtime-id")
+
+		// then
+		require.NoError(t, err)
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+}
+
+func newDBMock(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	return db, dbMock
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+	"github.com/pkg/errors"
+)
+
+const fakeKubeconfigTemplate = `apiVersion: v1
+kind: Config
+current-context: %[1]s
+clusters:
+- name: %[1]s
+  cluster:
+    server: https://api.%[1]s.fake.local
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s-admin
+users:
+- name: %[1]s-admin
+  user:
+    token: fake-token
+`
+
+// FakeConfig configures the behaviour of the fake provider
+type FakeConfig struct {
+	// Delay is the time each call takes
+	Delay time.Duration
+	// FailingRuntimes contains IDs of the Runtimes for which all calls fail
+	FailingRuntimes []string
+}
+
+type fakeProvider struct {
+	delay        time.Duration
+	mutex        sync.RWMutex
+	failing      map[string]bool
+	clusters     map[string]model.Runtime
+	agentConfigs map[string]model.RuntimeAgentConfig
+}
+
+// NewFakeProvider creates provider which does not create any infrastructure, it only keeps the provisioned Runtimes in memory
+// and returns fake kubeconfigs, so that the Provisioner can be run and tested locally
+func NewFakeProvider(config FakeConfig) *fakeProvider {
+	failing := map[string]bool{}
+	for _, runtimeID := range config.FailingRuntimes {
+		failing[runtimeID] = true
+	}
+
+	return &fakeProvider{
+		delay:        config.Delay,
+		failing:      failing,
+		clusters:     map[string]model.Runtime{},
+		agentConfigs: map[string]model.RuntimeAgentConfig{},
+	}
+}
+
+func (p *fakeProvider) Provision(runtime model.Runtime) (string, error) {
+	err := p.call(runtime.ID)
+	if err != nil {
+		return "", err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, exists := p.clusters[runtime.ID]; exists {
+		return "", errors.Errorf("Cluster for Runtime %s already exists", runtime.ID)
+	}
+	p.clusters[runtime.ID] = runtime
+
+	return fmt.Sprintf(fakeKubeconfigTemplate, runtime.ID), nil
+}
+
+func (p *fakeProvider) Upgrade(runtime model.Runtime, desired model.Runtime) error {
+	err := p.call(runtime.ID)
+	if err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, exists := p.clusters[runtime.ID]; !exists {
+		return errors.Errorf("Cluster for Runtime %s does not exist", runtime.ID)
+	}
+	p.clusters[runtime.ID] = desired
+
+	return nil
+}
+
+func (p *fakeProvider) Deprovision(runtime model.Runtime) error {
+	err := p.call(runtime.ID)
+	if err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, exists := p.clusters[runtime.ID]; !exists {
+		return errors.Errorf("Cluster for Runtime %s does not exist", runtime.ID)
+	}
+	delete(p.clusters, runtime.ID)
+	delete(p.agentConfigs, runtime.ID)
+
+	return nil
+}
+
+func (p *fakeProvider) ConfigureRuntimeAgent(runtime model.Runtime, config model.RuntimeAgentConfig) error {
+	err := p.call(runtime.ID)
+	if err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, exists := p.clusters[runtime.ID]; !exists {
+		return errors.Errorf("Cluster for Runtime %s does not exist", runtime.ID)
+	}
+	p.agentConfigs[runtime.ID] = config
+
+	return nil
+}
+
+// Cluster returns the configuration of the cluster provisioned for the Runtime
+func (p *fakeProvider) Cluster(runtimeID string) (model.Runtime, bool) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	runtime, exists := p.clusters[runtimeID]
+	return runtime, exists
+}
+
+// RuntimeAgentConfig returns the configuration passed to the Runtime Agent of the Runtime
+func (p *fakeProvider) RuntimeAgentConfig(runtimeID string) (model.RuntimeAgentConfig, bool) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	config, exists := p.agentConfigs[runtimeID]
+	return config, exists
+}
+
+func (p *fakeProvider) call(runtimeID string) error {
+	time.Sleep(p.delay)
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	if p.failing[runtimeID] {
+		return errors.Errorf("Fake failure for Runtime %s", runtimeID)
+	}
+
+	return nil
+}
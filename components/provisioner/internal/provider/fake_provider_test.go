@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeProvider(t *testing.T) {
+
+	t.Run("should provision, upgrade and deprovision cluster", func(t *testing.T) {
+		// given
+		provider := NewFakeProvider(FakeConfig{})
+		runtime := model.Runtime{ID: "runtime-id", KymaConfig: model.KymaConfig{Version: "1.6"}}
+		desired := model.Runtime{ID: "runtime-id", KymaConfig: model.KymaConfig{Version: "1.7"}}
+
+		// when
+		kubeconfig, err := provider.Provision(runtime)
+		require.NoError(t, err)
+
+		err = provider.Upgrade(runtime, desired)
+		require.NoError(t, err)
+
+		upgraded, exists := provider.Cluster("runtime-id")
+
+		err = provider.ConfigureRuntimeAgent(desired, model.RuntimeAgentConfig{ConnectorURL: "https://connector", Token: "token"})
+		require.NoError(t, err)
+
+		agentConfig, configured := provider.RuntimeAgentConfig("runtime-id")
+
+		err = provider.Deprovision(desired)
+		require.NoError(t, err)
+
+		// then
+		assert.Contains(t, kubeconfig, "server: https://api.runtime-id.fake.local")
+		assert.True(t, exists)
+		assert.Equal(t, desired, upgraded)
+		assert.True(t, configured)
+		assert.Equal(t, "token", agentConfig.Token)
+
+		_, exists = provider.Cluster("runtime-id")
+		assert.False(t, exists)
+		_, configured = provider.RuntimeAgentConfig("runtime-id")
+		assert.False(t, configured)
+	})
+
+	t.Run("should fail for configured Runtime", func(t *testing.T) {
+		// given
+		provider := NewFakeProvider(FakeConfig{FailingRuntimes: []string{"failing-id"}})
+
+		// when
+		_, err := provider.Provision(model.Runtime{ID: "failing-id"})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Fake failure for Runtime failing-id")
+	})
+
+	t.Run("should return error when cluster does not exist", func(t *testing.T) {
+		// given
+		provider := NewFakeProvider(FakeConfig{})
+
+		// when
+		err := provider.Deprovision(model.Runtime{ID: "runtime-id"})
+
+		// then
+		require.Error(t, err)
+	})
+}
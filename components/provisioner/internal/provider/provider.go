@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+)
+
+//go:generate mockery -name=InfrastructureProvider
+
+// InfrastructureProvider creates, upgrades and deletes clusters with Kyma installed
+// the calls are blocking, the Provisioner executes them in the background and tracks them as asynchronous operations
+type InfrastructureProvider interface {
+	// Provision creates the cluster with Kyma installed and returns its kubeconfig
+	Provision(runtime model.Runtime) (string, error)
+	// Upgrade changes the cluster and Kyma of the provisioned Runtime to the desired configuration
+	Upgrade(runtime model.Runtime, desired model.Runtime) error
+	// Deprovision deletes the cluster
+	Deprovision(runtime model.Runtime) error
+	// ConfigureRuntimeAgent passes the configuration to the Runtime Agent running in the provisioned cluster
+	// the Runtime Agent pairs the Runtime with the Connector using the configuration
+	ConfigureRuntimeAgent(runtime model.Runtime, config model.RuntimeAgentConfig) error
+}
@@ -0,0 +1,454 @@
+package provisioning
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/connector"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/director"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/persistence"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/provider"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const interruptedOperationMessage = "Operation interrupted, the Provisioner instance executing it stopped"
+
+//go:generate mockery -name=Service
+type Service interface {
+	// ProvisionRuntime starts provisioning of the Runtime in the tenant and returns the ID of the operation
+	ProvisionRuntime(runtimeID, tenant string, clusterConfig model.ClusterConfig, kymaConfig model.KymaConfig) (string, apperrors.AppError)
+	// UpgradeRuntime starts upgrade of the provisioned Runtime of the tenant and returns the ID of the operation
+	UpgradeRuntime(runtimeID, tenant string, config model.UpgradeConfig) (string, apperrors.AppError)
+	// DeprovisionRuntime starts deprovisioning of the Runtime of the tenant and returns the ID of the operation
+	DeprovisionRuntime(runtimeID, tenant string) (string, apperrors.AppError)
+	// ReconnectRuntimeAgent starts reconnection of the Runtime Agent of the tenant and returns the ID of the operation
+	ReconnectRuntimeAgent(runtimeID, tenant string) (string, apperrors.AppError)
+	// RuntimeStatus and RuntimeOperationStatus return NotFound error for the Runtimes and operations of other tenants
+	RuntimeStatus(runtimeID, tenant string) (model.RuntimeStatus, apperrors.AppError)
+	RuntimeOperationStatus(operationID, tenant string) (model.Operation, apperrors.AppError)
+	// FailInterruptedOperations marks the operations with expired lease as failed, as the Provisioner instance executing them stopped
+	FailInterruptedOperations() apperrors.AppError
+}
+
+//go:generate mockery -name=UIDService
+type UIDService interface {
+	Generate() string
+}
+
+// LeaseConfig identifies the Provisioner instance executing the operations and configures the lease it holds on them
+type LeaseConfig struct {
+	Owner string
+	// Duration is the time after which the operation is failed unless its owner renews the lease
+	Duration time.Duration
+}
+
+// operationFunc executes the operation and returns the message describing its result
+type operationFunc func() (string, error)
+
+type service struct {
+	repository      persistence.Repository
+	provider        provider.InfrastructureProvider
+	directorClient  director.Client
+	connectorClient connector.Client
+	// connectorURL is passed to the Runtime Agent, which pairs the Runtime with the Connector
+	connectorURL string
+	uidService   UIDService
+	lease        LeaseConfig
+	now          func() time.Time
+	// run starts the operation in the background
+	run func(func())
+	log *logrus.Entry
+}
+
+func NewProvisioningService(repository persistence.Repository, provider provider.InfrastructureProvider, directorClient director.Client,
+	connectorClient connector.Client, connectorURL string, uidService UIDService, lease LeaseConfig) Service {
+	return &service{
+		repository:      repository,
+		provider:        provider,
+		directorClient:  directorClient,
+		connectorClient: connectorClient,
+		connectorURL:    connectorURL,
+		uidService:      uidService,
+		lease:           lease,
+		now:             time.Now,
+		run: func(f func()) {
+			go f()
+		},
+		log: logrus.WithField("Service", "Provisioning"),
+	}
+}
+
+func (s *service) ProvisionRuntime(runtimeID, tenant string, clusterConfig model.ClusterConfig, kymaConfig model.KymaConfig) (string, apperrors.AppError) {
+	runtime := model.Runtime{
+		ID:            runtimeID,
+		Tenant:        tenant,
+		ClusterConfig: clusterConfig,
+		KymaConfig:    kymaConfig,
+	}
+
+	err := s.repository.InsertRuntime(runtime)
+	if err != nil {
+		return "", err.Append("Failed to provision Runtime")
+	}
+
+	operationID, err := s.startOperation(runtime, model.Provision, func() (string, error) {
+		return s.provision(runtime)
+	})
+	if err != nil {
+		s.deleteRuntime(runtimeID)
+		return "", err
+	}
+
+	return operationID, nil
+}
+
+func (s *service) UpgradeRuntime(runtimeID, tenant string, config model.UpgradeConfig) (string, apperrors.AppError) {
+	runtime, err := s.getRuntime(runtimeID, tenant)
+	if err != nil {
+		return "", err.Append("Failed to upgrade Runtime")
+	}
+
+	desired := config.Apply(runtime)
+
+	return s.startOperation(runtime, model.Upgrade, func() (string, error) {
+		err := s.provider.Upgrade(runtime, desired)
+		if err != nil {
+			return "", errors.Wrap(err, "Failed to upgrade cluster")
+		}
+
+		appErr := s.repository.UpdateRuntime(desired)
+		if appErr != nil {
+			return "", errors.Wrap(appErr, "Cluster upgraded but failed to store Runtime configuration")
+		}
+
+		return "Runtime upgraded", nil
+	})
+}
+
+func (s *service) DeprovisionRuntime(runtimeID, tenant string) (string, apperrors.AppError) {
+	runtime, err := s.getRuntime(runtimeID, tenant)
+	if err != nil {
+		return "", err.Append("Failed to deprovision Runtime")
+	}
+
+	return s.startOperation(runtime, model.Deprovision, func() (string, error) {
+		err := s.unregisterRuntime(runtime)
+		if err != nil {
+			return "", err
+		}
+		runtime.DirectorRuntimeID = ""
+
+		err = s.provider.Deprovision(runtime)
+		if err != nil {
+			return "", errors.Wrap(err, "Failed to deprovision cluster")
+		}
+
+		appErr := s.repository.DeleteRuntime(runtimeID)
+		if appErr != nil {
+			return "", errors.Wrap(appErr, "Cluster deprovisioned but failed to delete Runtime configuration")
+		}
+
+		return "Runtime deprovisioned", nil
+	})
+}
+
+func (s *service) ReconnectRuntimeAgent(runtimeID, tenant string) (string, apperrors.AppError) {
+	runtime, err := s.getRuntime(runtimeID, tenant)
+	if err != nil {
+		return "", err.Append("Failed to reconnect Runtime Agent")
+	}
+
+	if runtime.DirectorRuntimeID == "" {
+		return "", apperrors.WrongInput("Runtime %s is not registered in Director", runtimeID)
+	}
+
+	return s.startOperation(runtime, model.ReconnectRuntime, func() (string, error) {
+		err := s.configureRuntimeAgent(runtime)
+		if err != nil {
+			return "", err
+		}
+
+		return "Runtime Agent reconnected", nil
+	})
+}
+
+func (s *service) RuntimeStatus(runtimeID, tenant string) (model.RuntimeStatus, apperrors.AppError) {
+	lastOperation, err := s.repository.GetLastOperation(runtimeID)
+	if err != nil {
+		return model.RuntimeStatus{}, err.Append("Failed to get Runtime status")
+	}
+
+	if lastOperation.Tenant != tenant {
+		return model.RuntimeStatus{}, apperrors.NotFound("No operations found for Runtime %s", runtimeID).Append("Failed to get Runtime status")
+	}
+
+	status := model.RuntimeStatus{LastOperation: lastOperation}
+
+	runtime, err := s.getRuntime(runtimeID, tenant)
+	if err != nil {
+		if err.Code() != apperrors.CodeNotFound {
+			return model.RuntimeStatus{}, err.Append("Failed to get Runtime status")
+		}
+		return status, nil
+	}
+
+	status.Runtime = &runtime
+
+	if runtime.DirectorRuntimeID != "" {
+		status.ConnectionStatus = s.connectionStatus(runtime)
+	}
+
+	return status, nil
+}
+
+func (s *service) RuntimeOperationStatus(operationID, tenant string) (model.Operation, apperrors.AppError) {
+	operation, err := s.repository.GetOperation(operationID)
+	if err != nil {
+		return model.Operation{}, err.Append("Failed to get operation status")
+	}
+
+	if operation.Tenant != tenant {
+		return model.Operation{}, apperrors.NotFound("Operation %s not found", operationID).Append("Failed to get operation status")
+	}
+
+	return operation, nil
+}
+
+func (s *service) FailInterruptedOperations() apperrors.AppError {
+	failed, err := s.repository.FailExpiredOperations(interruptedOperationMessage, s.now())
+	if err != nil {
+		return err.Append("Failed to fail interrupted operations")
+	}
+
+	if failed > 0 {
+		s.log.Warnf("%d operations with expired lease marked as failed.", failed)
+	}
+
+	return nil
+}
+
+// getRuntime returns NotFound error if the Runtime belongs to another tenant
+func (s *service) getRuntime(runtimeID, tenant string) (model.Runtime, apperrors.AppError) {
+	runtime, err := s.repository.GetRuntime(runtimeID)
+	if err != nil {
+		return model.Runtime{}, err
+	}
+
+	if runtime.Tenant != tenant {
+		return model.Runtime{}, apperrors.NotFound("Runtime %s not found", runtimeID)
+	}
+
+	return runtime, nil
+}
+
+func (s *service) provision(runtime model.Runtime) (string, error) {
+	directorRuntimeID, appErr := s.directorClient.CreateRuntime(runtime.Tenant, runtime.ClusterConfig.Name)
+	if appErr != nil {
+		s.deleteRuntime(runtime.ID)
+		return "", errors.Wrap(appErr, "Failed to register Runtime in Director")
+	}
+
+	runtime.DirectorRuntimeID = directorRuntimeID
+
+	kubeconfig, err := s.provider.Provision(runtime)
+	if err != nil {
+		s.deleteDirectorRuntime(runtime)
+		s.deleteRuntime(runtime.ID)
+		return "", errors.Wrap(err, "Failed to provision cluster")
+	}
+
+	runtime.Kubeconfig = kubeconfig
+
+	appErr = s.repository.UpdateRuntime(runtime)
+	if appErr != nil {
+		return "", errors.Wrap(appErr, "Cluster provisioned but failed to store kubeconfig")
+	}
+
+	err = s.configureRuntimeAgent(runtime)
+	if err != nil {
+		return "", errors.Wrap(err, "Cluster provisioned but failed to configure Runtime Agent, reconnect the Runtime Agent to retry")
+	}
+
+	return "Runtime provisioned", nil
+}
+
+// configureRuntimeAgent passes a new one-time token to the Runtime Agent, so that it can pair the Runtime with the Connector
+func (s *service) configureRuntimeAgent(runtime model.Runtime) error {
+	token, appErr := s.connectorClient.GenerateRuntimeToken(runtime.Tenant, runtime.DirectorRuntimeID)
+	if appErr != nil {
+		return errors.Wrap(appErr, "Failed to generate Runtime token")
+	}
+
+	err := s.provider.ConfigureRuntimeAgent(runtime, model.RuntimeAgentConfig{
+		ConnectorURL: s.connectorURL,
+		Token:        token,
+		RuntimeID:    runtime.DirectorRuntimeID,
+		Tenant:       runtime.Tenant,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Failed to configure Runtime Agent")
+	}
+
+	return nil
+}
+
+// unregisterRuntime deletes the Runtime from the Director and clears the stored Director Runtime ID, so that retries do not delete it again
+func (s *service) unregisterRuntime(runtime model.Runtime) error {
+	if runtime.DirectorRuntimeID == "" {
+		return nil
+	}
+
+	appErr := s.directorClient.DeleteRuntime(runtime.Tenant, runtime.DirectorRuntimeID)
+	if appErr != nil {
+		return errors.Wrap(appErr, "Failed to delete Runtime from Director")
+	}
+
+	runtime.DirectorRuntimeID = ""
+
+	appErr = s.repository.UpdateRuntime(runtime)
+	if appErr != nil {
+		return errors.Wrap(appErr, "Runtime deleted from Director but failed to store Runtime configuration")
+	}
+
+	return nil
+}
+
+// connectionStatus derives the connection status of the Runtime Agent from the pairing state of the Runtime in the Director
+func (s *service) connectionStatus(runtime model.Runtime) *model.RuntimeConnectionStatus {
+	directorRuntime, err := s.directorClient.GetRuntime(runtime.Tenant, runtime.DirectorRuntimeID)
+	if err != nil {
+		if err.Code() == apperrors.CodeNotFound {
+			return &model.RuntimeConnectionStatus{
+				Status: model.ConnectionDisconnected,
+				Errors: []string{err.Error()},
+			}
+		}
+
+		s.log.Warnf("Failed to get pairing state of Runtime %s: %s", runtime.ID, err.Error())
+		return nil
+	}
+
+	if directorRuntime.Status.Condition == director.RuntimeConditionFailed {
+		return &model.RuntimeConnectionStatus{
+			Status: model.ConnectionDisconnected,
+			Errors: []string{"Runtime is in FAILED condition in Director"},
+		}
+	}
+
+	if directorRuntime.Certificate == nil {
+		return &model.RuntimeConnectionStatus{Status: model.ConnectionPending}
+	}
+
+	if !directorRuntime.Certificate.ExpiresAt.After(s.now()) {
+		return &model.RuntimeConnectionStatus{
+			Status: model.ConnectionDisconnected,
+			Errors: []string{fmt.Sprintf("Client certificate %s expired at %s", directorRuntime.Certificate.SerialNumber,
+				directorRuntime.Certificate.ExpiresAt.UTC().Format(time.RFC3339))},
+		}
+	}
+
+	return &model.RuntimeConnectionStatus{Status: model.ConnectionConnected}
+}
+
+// startOperation stores the pending operation owned by this instance and executes it in the background
+func (s *service) startOperation(runtime model.Runtime, operationType model.OperationType, execute operationFunc) (string, apperrors.AppError) {
+	now := s.now()
+	operation := model.Operation{
+		ID:             s.uidService.Generate(),
+		RuntimeID:      runtime.ID,
+		Tenant:         runtime.Tenant,
+		Type:           operationType,
+		State:          model.Pending,
+		Message:        "Operation scheduled",
+		StartedAt:      now,
+		Owner:          s.lease.Owner,
+		LeaseExpiresAt: now.Add(s.lease.Duration),
+	}
+
+	err := s.repository.InsertOperation(operation)
+	if err != nil {
+		return "", err.Append("Failed to start %s operation", operationType)
+	}
+
+	s.log.Infof("%s operation %s for Runtime %s started.", operationType, operation.ID, runtime.ID)
+
+	s.run(func() {
+		s.execute(operation, execute)
+	})
+
+	return operation.ID, nil
+}
+
+func (s *service) execute(operation model.Operation, execute operationFunc) {
+	stopRenewingLease := s.renewLease(operation)
+	defer stopRenewingLease()
+
+	operation.State = model.InProgress
+	operation.Message = "Operation in progress"
+	s.updateOperation(operation)
+
+	message, err := execute()
+
+	finishedAt := s.now()
+	operation.FinishedAt = &finishedAt
+	if err != nil {
+		s.log.Errorf("%s operation %s for Runtime %s failed: %s", operation.Type, operation.ID, operation.RuntimeID, err.Error())
+		operation.State = model.Failed
+		operation.Message = err.Error()
+	} else {
+		s.log.Infof("%s operation %s for Runtime %s succeeded.", operation.Type, operation.ID, operation.RuntimeID)
+		operation.State = model.Succeeded
+		operation.Message = message
+	}
+
+	s.updateOperation(operation)
+}
+
+// renewLease extends the lease of the operation in the background until the returned function is called
+func (s *service) renewLease(operation model.Operation) func() {
+	done := make(chan struct{})
+	ticker := time.NewTicker(s.lease.Duration / 3)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := s.repository.RenewLease(operation.ID, s.lease.Owner, s.now().Add(s.lease.Duration))
+				if err != nil {
+					s.log.Errorf("Failed to renew lease of %s operation %s: %s", operation.Type, operation.ID, err.Error())
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+	}
+}
+
+func (s *service) updateOperation(operation model.Operation) {
+	err := s.repository.UpdateOperation(operation)
+	if err != nil {
+		s.log.Errorf("Failed to update %s operation %s to %s state: %s", operation.Type, operation.ID, operation.State, err.Error())
+	}
+}
+
+func (s *service) deleteDirectorRuntime(runtime model.Runtime) {
+	err := s.directorClient.DeleteRuntime(runtime.Tenant, runtime.DirectorRuntimeID)
+	if err != nil {
+		s.log.Errorf("Failed to delete Runtime %s from Director: %s", runtime.DirectorRuntimeID, err.Error())
+	}
+}
+
+func (s *service) deleteRuntime(runtimeID string) {
+	err := s.repository.DeleteRuntime(runtimeID)
+	if err != nil {
+		s.log.Errorf("Failed to delete configuration of Runtime %s: %s", runtimeID, err.Error())
+	}
+}
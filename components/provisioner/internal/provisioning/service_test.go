@@ -0,0 +1,667 @@
+package provisioning
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+	connectorMocks "github.com/kyma-incubator/compass/components/provisioner/internal/connector/mocks"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/director"
+	directorMocks "github.com/kyma-incubator/compass/components/provisioner/internal/director/mocks"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/model"
+	persistenceMocks "github.com/kyma-incubator/compass/components/provisioner/internal/persistence/mocks"
+	providerMocks "github.com/kyma-incubator/compass/components/provisioner/internal/provider/mocks"
+	"github.com/kyma-incubator/compass/components/provisioner/internal/provisioning/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	runtimeID         = "runtime-id"
+	tenant            = "tenant"
+	directorRuntimeID = "director-runtime-id"
+	operationID       = "operation-id"
+	kubeconfig        = "kubeconfig"
+	connectorURL      = "https://connector.kyma.local/graphql"
+	token             = "token"
+	owner             = "owner"
+	leaseDuration     = time.Minute
+)
+
+var (
+	startedAt = time.Date(2019, 10, 7, 12, 0, 0, 0, time.UTC)
+
+	clusterConfig = model.ClusterConfig{
+		Name:                   "cluster",
+		ComputeZone:            "europe-west1-b",
+		Version:                "1.13",
+		Credentials:            "credentials",
+		InfrastructureProvider: "GKE",
+	}
+	kymaConfig = model.KymaConfig{
+		Version: "1.6",
+		Modules: []string{"Backup"},
+	}
+	runtime = model.Runtime{
+		ID:            runtimeID,
+		Tenant:        tenant,
+		ClusterConfig: clusterConfig,
+		KymaConfig:    kymaConfig,
+	}
+	registeredRuntime = model.Runtime{
+		ID:                runtimeID,
+		Tenant:            tenant,
+		ClusterConfig:     clusterConfig,
+		KymaConfig:        kymaConfig,
+		DirectorRuntimeID: directorRuntimeID,
+	}
+	provisionedRuntime = model.Runtime{
+		ID:                runtimeID,
+		Tenant:            tenant,
+		ClusterConfig:     clusterConfig,
+		KymaConfig:        kymaConfig,
+		Kubeconfig:        kubeconfig,
+		DirectorRuntimeID: directorRuntimeID,
+	}
+	agentConfig = model.RuntimeAgentConfig{
+		ConnectorURL: connectorURL,
+		Token:        token,
+		RuntimeID:    directorRuntimeID,
+		Tenant:       tenant,
+	}
+)
+
+func TestService_ProvisionRuntime(t *testing.T) {
+
+	t.Run("should provision Runtime and configure Runtime Agent", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("InsertRuntime", runtime).Return(nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.Provision)).Return(nil)
+		deps.repository.On("UpdateOperation", inProgressOperation(model.Provision)).Return(nil)
+		deps.directorClient.On("CreateRuntime", tenant, "cluster").Return(directorRuntimeID, nil)
+		deps.provider.On("Provision", registeredRuntime).Return(kubeconfig, nil)
+		deps.repository.On("UpdateRuntime", provisionedRuntime).Return(nil)
+		deps.connectorClient.On("GenerateRuntimeToken", tenant, directorRuntimeID).Return(token, nil)
+		deps.provider.On("ConfigureRuntimeAgent", provisionedRuntime, agentConfig).Return(nil)
+		deps.repository.On("UpdateOperation", finishedOperation(model.Provision, model.Succeeded, "Runtime provisioned")).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		id, err := svc.ProvisionRuntime(runtimeID, tenant, clusterConfig, kymaConfig)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, id)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should fail operation and delete Runtime when registration in Director failed", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("InsertRuntime", runtime).Return(nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.Provision)).Return(nil)
+		deps.repository.On("UpdateOperation", inProgressOperation(model.Provision)).Return(nil)
+		deps.directorClient.On("CreateRuntime", tenant, "cluster").Return("", apperrors.UpstreamServerCallFailed("timeout"))
+		deps.repository.On("DeleteRuntime", runtimeID).Return(nil)
+		deps.repository.On("UpdateOperation", finishedOperation(model.Provision, model.Failed, "Failed to register Runtime in Director: timeout")).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.ProvisionRuntime(runtimeID, tenant, clusterConfig, kymaConfig)
+
+		// then
+		require.NoError(t, err)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should fail operation and clean up when provider failed", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("InsertRuntime", runtime).Return(nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.Provision)).Return(nil)
+		deps.repository.On("UpdateOperation", inProgressOperation(model.Provision)).Return(nil)
+		deps.directorClient.On("CreateRuntime", tenant, "cluster").Return(directorRuntimeID, nil)
+		deps.provider.On("Provision", registeredRuntime).Return("", errors.New("quota exceeded"))
+		deps.directorClient.On("DeleteRuntime", tenant, directorRuntimeID).Return(nil)
+		deps.repository.On("DeleteRuntime", runtimeID).Return(nil)
+		deps.repository.On("UpdateOperation", finishedOperation(model.Provision, model.Failed, "Failed to provision cluster: quota exceeded")).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		id, err := svc.ProvisionRuntime(runtimeID, tenant, clusterConfig, kymaConfig)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, id)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should keep provisioned Runtime when failed to generate token", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("InsertRuntime", runtime).Return(nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.Provision)).Return(nil)
+		deps.repository.On("UpdateOperation", inProgressOperation(model.Provision)).Return(nil)
+		deps.directorClient.On("CreateRuntime", tenant, "cluster").Return(directorRuntimeID, nil)
+		deps.provider.On("Provision", registeredRuntime).Return(kubeconfig, nil)
+		deps.repository.On("UpdateRuntime", provisionedRuntime).Return(nil)
+		deps.connectorClient.On("GenerateRuntimeToken", tenant, directorRuntimeID).Return("", apperrors.UpstreamServerCallFailed("timeout"))
+		deps.repository.On("UpdateOperation", finishedOperation(model.Provision, model.Failed,
+			"Cluster provisioned but failed to configure Runtime Agent, reconnect the Runtime Agent to retry: Failed to generate Runtime token: timeout")).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.ProvisionRuntime(runtimeID, tenant, clusterConfig, kymaConfig)
+
+		// then
+		require.NoError(t, err)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should return error when Runtime already exists", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("InsertRuntime", runtime).Return(apperrors.AlreadyExists("Runtime already exists"))
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.ProvisionRuntime(runtimeID, tenant, clusterConfig, kymaConfig)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeAlreadyExists, err.Code())
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should delete Runtime when another operation is in progress", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("InsertRuntime", runtime).Return(nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.Provision)).Return(apperrors.AlreadyExists("Another operation is in progress"))
+		deps.repository.On("DeleteRuntime", runtimeID).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.ProvisionRuntime(runtimeID, tenant, clusterConfig, kymaConfig)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeAlreadyExists, err.Code())
+		deps.assertExpectations(t)
+	})
+}
+
+func TestService_UpgradeRuntime(t *testing.T) {
+
+	t.Run("should upgrade Runtime", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		upgradedKymaConfig := model.KymaConfig{Version: "1.7"}
+		upgradedRuntime := provisionedRuntime
+		upgradedRuntime.ClusterConfig.Version = "1.14"
+		upgradedRuntime.KymaConfig = upgradedKymaConfig
+
+		deps.repository.On("GetRuntime", runtimeID).Return(provisionedRuntime, nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.Upgrade)).Return(nil)
+		deps.repository.On("UpdateOperation", inProgressOperation(model.Upgrade)).Return(nil)
+		deps.provider.On("Upgrade", provisionedRuntime, upgradedRuntime).Return(nil)
+		deps.repository.On("UpdateRuntime", upgradedRuntime).Return(nil)
+		deps.repository.On("UpdateOperation", finishedOperation(model.Upgrade, model.Succeeded, "Runtime upgraded")).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		id, err := svc.UpgradeRuntime(runtimeID, tenant, model.UpgradeConfig{ClusterVersion: "1.14", KymaConfig: &upgradedKymaConfig})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, id)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should return error when Runtime does not exist", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetRuntime", runtimeID).Return(model.Runtime{}, apperrors.NotFound("Runtime not found"))
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.UpgradeRuntime(runtimeID, tenant, model.UpgradeConfig{ClusterVersion: "1.14"})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should return NotFound error when Runtime belongs to another tenant", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetRuntime", runtimeID).Return(provisionedRuntime, nil)
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.UpgradeRuntime(runtimeID, "other-tenant", model.UpgradeConfig{ClusterVersion: "1.14"})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		deps.assertExpectations(t)
+	})
+}
+
+func TestService_DeprovisionRuntime(t *testing.T) {
+
+	unregisteredRuntime := provisionedRuntime
+	unregisteredRuntime.DirectorRuntimeID = ""
+
+	t.Run("should delete Runtime from Director and deprovision cluster", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetRuntime", runtimeID).Return(provisionedRuntime, nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.Deprovision)).Return(nil)
+		deps.repository.On("UpdateOperation", inProgressOperation(model.Deprovision)).Return(nil)
+		deps.directorClient.On("DeleteRuntime", tenant, directorRuntimeID).Return(nil)
+		deps.repository.On("UpdateRuntime", unregisteredRuntime).Return(nil)
+		deps.provider.On("Deprovision", unregisteredRuntime).Return(nil)
+		deps.repository.On("DeleteRuntime", runtimeID).Return(nil)
+		deps.repository.On("UpdateOperation", finishedOperation(model.Deprovision, model.Succeeded, "Runtime deprovisioned")).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		id, err := svc.DeprovisionRuntime(runtimeID, tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, id)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should not delete Runtime from Director again when retrying", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetRuntime", runtimeID).Return(unregisteredRuntime, nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.Deprovision)).Return(nil)
+		deps.repository.On("UpdateOperation", inProgressOperation(model.Deprovision)).Return(nil)
+		deps.provider.On("Deprovision", unregisteredRuntime).Return(errors.New("timeout"))
+		deps.repository.On("UpdateOperation", finishedOperation(model.Deprovision, model.Failed, "Failed to deprovision cluster: timeout")).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		id, err := svc.DeprovisionRuntime(runtimeID, tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, id)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should keep Runtime when failed to delete it from Director", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetRuntime", runtimeID).Return(provisionedRuntime, nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.Deprovision)).Return(nil)
+		deps.repository.On("UpdateOperation", inProgressOperation(model.Deprovision)).Return(nil)
+		deps.directorClient.On("DeleteRuntime", tenant, directorRuntimeID).Return(apperrors.UpstreamServerCallFailed("timeout"))
+		deps.repository.On("UpdateOperation", finishedOperation(model.Deprovision, model.Failed, "Failed to delete Runtime from Director: timeout")).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.DeprovisionRuntime(runtimeID, tenant)
+
+		// then
+		require.NoError(t, err)
+		deps.assertExpectations(t)
+	})
+}
+
+func TestService_ReconnectRuntimeAgent(t *testing.T) {
+
+	t.Run("should pass new token to Runtime Agent", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetRuntime", runtimeID).Return(provisionedRuntime, nil)
+		deps.repository.On("InsertOperation", pendingOperation(model.ReconnectRuntime)).Return(nil)
+		deps.repository.On("UpdateOperation", inProgressOperation(model.ReconnectRuntime)).Return(nil)
+		deps.connectorClient.On("GenerateRuntimeToken", tenant, directorRuntimeID).Return(token, nil)
+		deps.provider.On("ConfigureRuntimeAgent", provisionedRuntime, agentConfig).Return(nil)
+		deps.repository.On("UpdateOperation", finishedOperation(model.ReconnectRuntime, model.Succeeded, "Runtime Agent reconnected")).Return(nil)
+
+		svc := deps.newService()
+
+		// when
+		id, err := svc.ReconnectRuntimeAgent(runtimeID, tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, id)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should return error when Runtime is not registered in Director", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetRuntime", runtimeID).Return(runtime, nil)
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.ReconnectRuntimeAgent(runtimeID, tenant)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+	})
+}
+
+func TestService_RuntimeStatus(t *testing.T) {
+
+	lastOperation := finishedOperation(model.Provision, model.Succeeded, "Runtime provisioned")
+
+	for _, testCase := range []struct {
+		description      string
+		directorRuntime  director.Runtime
+		directorErr      apperrors.AppError
+		connectionStatus *model.RuntimeConnectionStatus
+	}{
+		{
+			description:      "should return Pending status when Runtime Agent is not paired",
+			directorRuntime:  directorRuntime(director.RuntimeConditionInitial, nil),
+			connectionStatus: &model.RuntimeConnectionStatus{Status: model.ConnectionPending},
+		},
+		{
+			description:      "should return Connected status when Runtime Agent has valid certificate",
+			directorRuntime:  directorRuntime(director.RuntimeConditionReady, &director.ClientCertificate{SerialNumber: "4d2", ExpiresAt: startedAt.Add(time.Hour)}),
+			connectionStatus: &model.RuntimeConnectionStatus{Status: model.ConnectionConnected},
+		},
+		{
+			description:     "should return Disconnected status when certificate expired",
+			directorRuntime: directorRuntime(director.RuntimeConditionReady, &director.ClientCertificate{SerialNumber: "4d2", ExpiresAt: startedAt.Add(-time.Hour)}),
+			connectionStatus: &model.RuntimeConnectionStatus{
+				Status: model.ConnectionDisconnected,
+				Errors: []string{"Client certificate 4d2 expired at 2019-10-07T11:00:00Z"},
+			},
+		},
+		{
+			description:     "should return Disconnected status when Runtime failed",
+			directorRuntime: directorRuntime(director.RuntimeConditionFailed, nil),
+			connectionStatus: &model.RuntimeConnectionStatus{
+				Status: model.ConnectionDisconnected,
+				Errors: []string{"Runtime is in FAILED condition in Director"},
+			},
+		},
+		{
+			description: "should return Disconnected status when Runtime is not registered in Director",
+			directorErr: apperrors.NotFound("Runtime not found"),
+			connectionStatus: &model.RuntimeConnectionStatus{
+				Status: model.ConnectionDisconnected,
+				Errors: []string{"Runtime not found"},
+			},
+		},
+		{
+			description: "should not return connection status when Director is not available",
+			directorErr: apperrors.UpstreamServerCallFailed("timeout"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			deps := newTestDependencies()
+
+			deps.repository.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+			deps.repository.On("GetRuntime", runtimeID).Return(provisionedRuntime, nil)
+			deps.directorClient.On("GetRuntime", tenant, directorRuntimeID).Return(testCase.directorRuntime, testCase.directorErr)
+
+			svc := deps.newService()
+
+			// when
+			status, err := svc.RuntimeStatus(runtimeID, tenant)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, lastOperation, status.LastOperation)
+			assert.Equal(t, &provisionedRuntime, status.Runtime)
+			assert.Equal(t, testCase.connectionStatus, status.ConnectionStatus)
+		})
+	}
+
+	t.Run("should return last operation of deprovisioned Runtime", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+		lastOperation := finishedOperation(model.Deprovision, model.Succeeded, "Runtime deprovisioned")
+
+		deps.repository.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		deps.repository.On("GetRuntime", runtimeID).Return(model.Runtime{}, apperrors.NotFound("Runtime not found"))
+
+		svc := deps.newService()
+
+		// when
+		status, err := svc.RuntimeStatus(runtimeID, tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, lastOperation, status.LastOperation)
+		assert.Nil(t, status.Runtime)
+		assert.Nil(t, status.ConnectionStatus)
+	})
+
+	t.Run("should return error when there are no operations for Runtime", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetLastOperation", runtimeID).Return(model.Operation{}, apperrors.NotFound("No operations found"))
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.RuntimeStatus(runtimeID, tenant)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should return NotFound error when Runtime belongs to another tenant", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.RuntimeStatus(runtimeID, "other-tenant")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		deps.assertExpectations(t)
+	})
+}
+
+func TestService_RuntimeOperationStatus(t *testing.T) {
+
+	operation := finishedOperation(model.Provision, model.Succeeded, "Runtime provisioned")
+
+	t.Run("should return operation", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetOperation", operationID).Return(operation, nil)
+
+		svc := deps.newService()
+
+		// when
+		result, err := svc.RuntimeOperationStatus(operationID, tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operation, result)
+	})
+
+	t.Run("should return NotFound error when operation belongs to another tenant", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("GetOperation", operationID).Return(operation, nil)
+
+		svc := deps.newService()
+
+		// when
+		_, err := svc.RuntimeOperationStatus(operationID, "other-tenant")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+}
+
+func TestService_FailInterruptedOperations(t *testing.T) {
+
+	t.Run("should fail operations with expired lease", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+
+		deps.repository.On("FailExpiredOperations", interruptedOperationMessage, startedAt).Return(2, nil)
+
+		svc := deps.newService()
+
+		// when
+		err := svc.FailInterruptedOperations()
+
+		// then
+		require.NoError(t, err)
+		deps.assertExpectations(t)
+	})
+}
+
+func TestService_RenewLease(t *testing.T) {
+
+	t.Run("should renew lease while operation is executed", func(t *testing.T) {
+		// given
+		deps := newTestDependencies()
+		renewed := make(chan struct{}, 1)
+		shortLease := 3 * time.Millisecond
+
+		deps.repository.On("RenewLease", operationID, owner, startedAt.Add(shortLease)).Return(nil).Run(func(mock.Arguments) {
+			select {
+			case renewed <- struct{}{}:
+			default:
+			}
+		})
+
+		svc := deps.newService().(*service)
+		svc.lease.Duration = shortLease
+
+		// when
+		stop := svc.renewLease(pendingOperation(model.Provision))
+		<-renewed
+		stop()
+
+		// then
+		deps.assertExpectations(t)
+	})
+}
+
+type testDependencies struct {
+	repository      *persistenceMocks.Repository
+	provider        *providerMocks.InfrastructureProvider
+	directorClient  *directorMocks.Client
+	connectorClient *connectorMocks.Client
+}
+
+func newTestDependencies() testDependencies {
+	return testDependencies{
+		repository:      &persistenceMocks.Repository{},
+		provider:        &providerMocks.InfrastructureProvider{},
+		directorClient:  &directorMocks.Client{},
+		connectorClient: &connectorMocks.Client{},
+	}
+}
+
+// newService creates the service executing operations synchronously at the fixed time
+func (d testDependencies) newService() Service {
+	uidService := &mocks.UIDService{}
+	uidService.On("Generate").Return(operationID)
+
+	svc := NewProvisioningService(d.repository, d.provider, d.directorClient, d.connectorClient, connectorURL, uidService, LeaseConfig{Owner: owner, Duration: leaseDuration}).(*service)
+	svc.now = func() time.Time {
+		return startedAt
+	}
+	svc.run = func(f func()) {
+		f()
+	}
+
+	return svc
+}
+
+func (d testDependencies) assertExpectations(t *testing.T) {
+	mock.AssertExpectationsForObjects(t, d.repository, d.provider, d.directorClient, d.connectorClient)
+}
+
+func directorRuntime(condition string, certificate *director.ClientCertificate) director.Runtime {
+	runtime := director.Runtime{ID: directorRuntimeID, Certificate: certificate}
+	runtime.Status.Condition = condition
+
+	return runtime
+}
+
+func pendingOperation(operationType model.OperationType) model.Operation {
+	return model.Operation{
+		ID:             operationID,
+		RuntimeID:      runtimeID,
+		Tenant:         tenant,
+		Type:           operationType,
+		State:          model.Pending,
+		Message:        "Operation scheduled",
+		StartedAt:      startedAt,
+		Owner:          owner,
+		LeaseExpiresAt: startedAt.Add(leaseDuration),
+	}
+}
+
+func inProgressOperation(operationType model.OperationType) model.Operation {
+	operation := pendingOperation(operationType)
+	operation.State = model.InProgress
+	operation.Message = "Operation in progress"
+
+	return operation
+}
+
+func finishedOperation(operationType model.OperationType, state model.OperationState, message string) model.Operation {
+	finishedAt := startedAt
+
+	operation := pendingOperation(operationType)
+	operation.State = state
+	operation.Message = message
+	operation.FinishedAt = &finishedAt
+
+	return operation
+}
@@ -0,0 +1,44 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/provisioner/internal/apperrors"
+)
+
+const Header = "Tenant"
+
+type contextKey string
+
+const tenantKey contextKey = "Tenant"
+
+type middleware struct {
+}
+
+func NewMiddleware() *middleware {
+	return &middleware{}
+}
+
+// PropagateTenant puts the value of the Tenant header in the request context
+func (m *middleware) PropagateTenant(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := SaveToContext(r.Context(), r.Header.Get(Header))
+
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func SaveToContext(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey, tenant)
+}
+
+// LoadFromContext returns BadRequest error if the tenant is not provided
+func LoadFromContext(ctx context.Context) (string, apperrors.AppError) {
+	tenant, ok := ctx.Value(tenantKey).(string)
+	if !ok || tenant == "" {
+		return "", apperrors.BadRequest("Tenant not provided")
+	}
+
+	return tenant, nil
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/agent"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/applier"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/config"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connection"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connector"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/director"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/vrischmann/envconfig"
+)
+
+const fakeApplier = "fake"
+
+type appConfig struct {
+	// ConfigFile is the path to the JSON connection configuration set up by the Runtime Provisioner,
+	// if it is not set, the connection configuration is read from the environment
+	ConfigFile   string `envconfig:"optional"`
+	ConnectorURL string `envconfig:"default=http://127.0.0.1:3001/graphql"`
+	Token        string `envconfig:"optional"`
+	RuntimeID    string `envconfig:"optional"`
+	Tenant       string `envconfig:"optional"`
+
+	// DirectorURL overrides the Director URL returned by the Connector
+	DirectorURL string `envconfig:"optional"`
+
+	// ConnectionFile is the path to the file storing the established connection, if it is not set the connection is kept in memory
+	ConnectionFile string        `envconfig:"optional"`
+	SyncPeriod     time.Duration `envconfig:"default=15s"`
+	RenewalWindow  time.Duration `envconfig:"default=168h"`
+	ClientTimeout  time.Duration `envconfig:"default=10s"`
+	// SkipVerify disables verification of the Management Plane server certificates
+	SkipVerify bool `envconfig:"default=false"`
+	// ForwardClientCertificate sends the client certificate to the Connector in the X-Forwarded-Client-Cert header,
+	// use it when there is no gateway terminating TLS in front of the Connector, eg.: in local setups
+	ForwardClientCertificate bool `envconfig:"default=false"`
+
+	EventsGatewayURL string `envconfig:"optional"`
+	ConsoleURL       string `envconfig:"optional"`
+
+	// Applier selects the applier configuring the Runtime, only the fake applier is available
+	Applier string `envconfig:"default=fake"`
+}
+
+func (c *appConfig) String() string {
+	return fmt.Sprintf("ConfigFile: %s, ConnectorURL: %s, RuntimeID: %s, Tenant: %s, DirectorURL: %s, "+
+		"ConnectionFile: %s, SyncPeriod: %s, RenewalWindow: %s, ClientTimeout: %s, SkipVerify: %v, ForwardClientCertificate: %v, "+
+		"EventsGatewayURL: %s, ConsoleURL: %s, Applier: %s",
+		c.ConfigFile, c.ConnectorURL, c.RuntimeID, c.Tenant, c.DirectorURL,
+		c.ConnectionFile, c.SyncPeriod, c.RenewalWindow, c.ClientTimeout, c.SkipVerify, c.ForwardClientCertificate,
+		c.EventsGatewayURL, c.ConsoleURL, c.Applier)
+}
+
+func main() {
+	cfg := appConfig{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	exitOnError(err, "Error while loading app config")
+
+	log.Println("Starting Runtime Agent")
+	log.Printf("Config: %s", cfg.String())
+
+	runtimeApplier, err := newApplier(cfg)
+	exitOnError(err, "Failed to initialize applier")
+
+	store := newStore(cfg)
+	httpClient := connection.NewHTTPClient(store, cfg.ClientTimeout, cfg.SkipVerify)
+
+	runtimeAgent := agent.NewAgent(
+		agent.Config{
+			RenewalWindow: cfg.RenewalWindow,
+			DirectorURL:   cfg.DirectorURL,
+			Labels:        labels(cfg),
+		},
+		newConfigProvider(cfg),
+		store,
+		func(url string) connector.Client {
+			return connector.NewClient(url, httpClient, store, cfg.ForwardClientCertificate)
+		},
+		func(url, tenant string) director.Client {
+			return director.NewClient(url, tenant, httpClient)
+		},
+		runtimeApplier,
+	)
+
+	runtimeAgent.Run(cfg.SyncPeriod, stopOnSignal())
+}
+
+func newApplier(cfg appConfig) (applier.Applier, error) {
+	switch cfg.Applier {
+	case fakeApplier:
+		logrus.Warn("Using fake applier, the Runtime is not configured")
+		return applier.NewFakeApplier(), nil
+	default:
+		return nil, errors.Errorf("Invalid applier: %s", cfg.Applier)
+	}
+}
+
+func newStore(cfg appConfig) connection.Store {
+	if cfg.ConnectionFile == "" {
+		logrus.Warn("Using in-memory connection storage, a new token is required after restart")
+		return connection.NewInMemoryStore()
+	}
+
+	return connection.NewFileStore(cfg.ConnectionFile)
+}
+
+func newConfigProvider(cfg appConfig) config.Provider {
+	if cfg.ConfigFile != "" {
+		return config.NewFileProvider(cfg.ConfigFile)
+	}
+
+	return config.NewStaticProvider(config.ConnectionConfig{
+		ConnectorURL: cfg.ConnectorURL,
+		Token:        cfg.Token,
+		RuntimeID:    cfg.RuntimeID,
+		Tenant:       cfg.Tenant,
+	})
+}
+
+func labels(cfg appConfig) map[string]string {
+	runtimeLabels := map[string]string{}
+	if cfg.EventsGatewayURL != "" {
+		runtimeLabels[agent.EventsGatewayURLLabel] = cfg.EventsGatewayURL
+	}
+	if cfg.ConsoleURL != "" {
+		runtimeLabels[agent.ConsoleURLLabel] = cfg.ConsoleURL
+	}
+
+	return runtimeLabels
+}
+
+func stopOnSignal() <-chan struct{} {
+	stop := make(chan struct{})
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-signals
+		close(stop)
+	}()
+
+	return stop
+}
+
+func exitOnError(err error, context string) {
+	if err != nil {
+		wrappedError := errors.Wrap(err, context)
+		log.Fatal(wrappedError)
+	}
+}
@@ -0,0 +1,284 @@
+package agent
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/applier"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/certificates"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/config"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connection"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connector"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/director"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	EventsGatewayURLLabel = "events_gateway_url"
+	ConsoleURLLabel       = "console_url"
+)
+
+// ConnectorClientConstructor creates client of the Connector available under the URL
+type ConnectorClientConstructor func(url string) connector.Client
+
+// DirectorClientConstructor creates client of the Director available under the URL, which calls it on behalf of the tenant
+type DirectorClientConstructor func(url, tenant string) director.Client
+
+type Config struct {
+	// RenewalWindow is the time before the certificate expiration in which the certificate is renewed
+	RenewalWindow time.Duration
+	// DirectorURL overrides the Director URL returned by the Connector
+	DirectorURL string
+	// Labels are the Runtime specific labels reported to the Director, eg.: Events Gateway URL and Runtime Console URL
+	Labels map[string]string
+}
+
+// Agent establishes and renews the trusted connection with the Management Plane and applies the configuration of the Runtime
+type Agent struct {
+	config             Config
+	configProvider     config.Provider
+	store              connection.Store
+	newConnectorClient ConnectorClientConstructor
+	newDirectorClient  DirectorClientConstructor
+	applier            applier.Applier
+	now                func() time.Time
+	log                *logrus.Entry
+
+	// reportedLabels contains the labels reported for the reportedRuntimeID, so that they are reported only when they change
+	reportedRuntimeID string
+	reportedLabels    map[string]string
+}
+
+func NewAgent(
+	config Config,
+	configProvider config.Provider,
+	store connection.Store,
+	newConnectorClient ConnectorClientConstructor,
+	newDirectorClient DirectorClientConstructor,
+	applier applier.Applier) *Agent {
+	return &Agent{
+		config:             config,
+		configProvider:     configProvider,
+		store:              store,
+		newConnectorClient: newConnectorClient,
+		newDirectorClient:  newDirectorClient,
+		applier:            applier,
+		now:                time.Now,
+		log:                logrus.WithField("Component", "Agent"),
+		reportedLabels:     map[string]string{},
+	}
+}
+
+// Run synchronizes the Runtime with the given period until the stop channel is closed
+func (a *Agent) Run(period time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		err := a.Sync()
+		if err != nil {
+			a.log.Errorf("Failed to synchronize Runtime: %s", err.Error())
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync establishes or renews the connection if needed, applies the Runtime configuration fetched from the Director and reports the Runtime labels
+func (a *Agent) Sync() error {
+	connectionConfig, err := a.configProvider.Load()
+	if err != nil {
+		return errors.Wrap(err, "Failed to load connection configuration")
+	}
+
+	conn, runtimeID, err := a.ensureConnection(connectionConfig)
+	if err != nil {
+		return err
+	}
+
+	directorURL := a.config.DirectorURL
+	if directorURL == "" {
+		directorURL = conn.DirectorURL
+	}
+	if directorURL == "" {
+		return errors.New("Director URL not configured and not returned by Connector")
+	}
+
+	directorClient := a.newDirectorClient(directorURL, connectionConfig.Tenant)
+
+	applications, err := directorClient.ApplicationsForRuntime(runtimeID)
+	if err != nil {
+		return errors.Wrap(err, "Failed to fetch Runtime configuration")
+	}
+
+	err = a.applier.Apply(applications)
+	if err != nil {
+		return errors.Wrap(err, "Failed to apply Runtime configuration")
+	}
+
+	return a.reportLabels(directorClient, runtimeID)
+}
+
+// ensureConnection establishes the connection if it does not exist or a new token was provided and renews the certificate in the renewal window,
+// it returns the connection and the ID of the Runtime, which defaults to the common name of the certificate
+func (a *Agent) ensureConnection(connectionConfig config.ConnectionConfig) (connection.Connection, string, error) {
+	conn, found, err := a.store.Load()
+	if err != nil {
+		return connection.Connection{}, "", errors.Wrap(err, "Failed to load connection")
+	}
+
+	if !found || (connectionConfig.Token != "" && connectionConfig.Token != conn.Token) {
+		conn, err = a.establishConnection(connectionConfig)
+		if err != nil {
+			return connection.Connection{}, "", err
+		}
+	}
+
+	certificate, err := conn.Credentials.ParseClientCertificate()
+	if err != nil {
+		return connection.Connection{}, "", err
+	}
+
+	validFor := certificate.NotAfter.Sub(a.now())
+	if validFor <= 0 {
+		return connection.Connection{}, "", errors.Errorf("Client certificate expired at %s, reconnect the Runtime Agent to provide a new token", certificate.NotAfter.Format(time.RFC3339))
+	}
+
+	if validFor <= a.config.RenewalWindow {
+		renewed, err := a.renewCertificate(connectionConfig, conn)
+		if err != nil {
+			a.log.Warnf("Failed to renew client certificate, it is valid until %s: %s", certificate.NotAfter.Format(time.RFC3339), err.Error())
+		} else {
+			conn = renewed
+		}
+	}
+
+	runtimeID := connectionConfig.RuntimeID
+	if runtimeID == "" {
+		runtimeID = certificate.Subject.CommonName
+	}
+
+	return conn, runtimeID, nil
+}
+
+func (a *Agent) establishConnection(connectionConfig config.ConnectionConfig) (connection.Connection, error) {
+	if connectionConfig.Token == "" {
+		return connection.Connection{}, errors.New("Connection not established and token not provided")
+	}
+
+	a.log.Infof("Establishing connection with Connector %s", connectionConfig.ConnectorURL)
+
+	credentials, directorURL, err := a.requestCertificate(connectionConfig.ConnectorURL, connectionConfig.Token)
+	if err != nil {
+		return connection.Connection{}, errors.Wrap(err, "Failed to establish connection")
+	}
+
+	conn := connection.Connection{
+		Token:       connectionConfig.Token,
+		DirectorURL: directorURL,
+		Credentials: credentials,
+	}
+
+	err = a.store.Save(conn)
+	if err != nil {
+		return connection.Connection{}, errors.Wrap(err, "Failed to save connection")
+	}
+
+	a.log.Info("Connection established")
+	return conn, nil
+}
+
+func (a *Agent) renewCertificate(connectionConfig config.ConnectionConfig, conn connection.Connection) (connection.Connection, error) {
+	a.log.Info("Renewing client certificate")
+
+	credentials, directorURL, err := a.requestCertificate(connectionConfig.ConnectorURL, "")
+	if err != nil {
+		return connection.Connection{}, err
+	}
+
+	conn.Credentials = credentials
+	if directorURL != "" {
+		conn.DirectorURL = directorURL
+	}
+
+	err = a.store.Save(conn)
+	if err != nil {
+		return connection.Connection{}, errors.Wrap(err, "Failed to save renewed certificate")
+	}
+
+	a.log.Info("Client certificate renewed")
+	return conn, nil
+}
+
+// requestCertificate requests the certificate with the one-time token or, if the token is empty, with the current client certificate
+func (a *Agent) requestCertificate(connectorURL, token string) (certificates.Credentials, string, error) {
+	connectorClient := a.newConnectorClient(connectorURL)
+
+	configuration, err := connectorClient.Configuration(token)
+	if err != nil {
+		return certificates.Credentials{}, "", err
+	}
+
+	keyAlgorithm, err := certificates.SelectKeyAlgorithm(configuration.KeyAlgorithm, configuration.AllowedKeyAlgorithms)
+	if err != nil {
+		return certificates.Credentials{}, "", err
+	}
+
+	subject, err := certificates.ParseSubject(configuration.Subject)
+	if err != nil {
+		return certificates.Credentials{}, "", err
+	}
+
+	key, err := certificates.GenerateKey(keyAlgorithm)
+	if err != nil {
+		return certificates.Credentials{}, "", errors.Wrapf(err, "Failed to generate %s key", keyAlgorithm)
+	}
+
+	csr, err := certificates.CreateCSR(subject, key)
+	if err != nil {
+		return certificates.Credentials{}, "", err
+	}
+
+	result, err := connectorClient.SignCSR(csr, configuration.Token)
+	if err != nil {
+		return certificates.Credentials{}, "", err
+	}
+
+	encodedKey, err := certificates.EncodePrivateKey(key)
+	if err != nil {
+		return certificates.Credentials{}, "", err
+	}
+
+	return certificates.Credentials{
+		PrivateKey:        encodedKey,
+		ClientCertificate: result.ClientCertificate,
+		CertificateChain:  result.CertificateChain,
+		CACertificate:     result.CACertificate,
+	}, configuration.DirectorURL, nil
+}
+
+func (a *Agent) reportLabels(directorClient director.Client, runtimeID string) error {
+	if runtimeID != a.reportedRuntimeID {
+		a.reportedRuntimeID = runtimeID
+		a.reportedLabels = map[string]string{}
+	}
+
+	for key, value := range a.config.Labels {
+		if reported, ok := a.reportedLabels[key]; ok && reported == value {
+			continue
+		}
+
+		err := directorClient.SetRuntimeLabel(runtimeID, key, value)
+		if err != nil {
+			return errors.Wrap(err, "Failed to report Runtime labels")
+		}
+
+		a.reportedLabels[key] = value
+	}
+
+	return nil
+}
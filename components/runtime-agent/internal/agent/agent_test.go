@@ -0,0 +1,374 @@
+package agent
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	applierMocks "github.com/kyma-incubator/compass/components/runtime-agent/internal/applier/mocks"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/config"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connection"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connector"
+	connectorMocks "github.com/kyma-incubator/compass/components/runtime-agent/internal/connector/mocks"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/director"
+	directorMocks "github.com/kyma-incubator/compass/components/runtime-agent/internal/director/mocks"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	connectorURL = "https://connector/graphql"
+	directorURL  = "https://director/graphql"
+	tenant       = "tenant"
+	runtimeID    = "runtime-id"
+	token        = "token"
+	csrToken     = "csr-token"
+
+	subject       = "O=Org,OU=OrgUnit,L=Locality,ST=State,C=PL,CN=runtime-id"
+	validity      = 90 * 24 * time.Hour
+	renewalWindow = 7 * 24 * time.Hour
+)
+
+var (
+	now          = time.Date(2019, 10, 10, 12, 0, 0, 0, time.UTC)
+	applications = []model.Application{{ID: "app-id", Name: "app"}}
+)
+
+func TestAgent_Sync(t *testing.T) {
+
+	t.Run("should establish connection, apply configuration and report labels", func(t *testing.T) {
+		// given
+		deps := newTestDependencies(t)
+		deps.expectPairing(token, now)
+		deps.expectConfiguration()
+		deps.directorClient.On("SetRuntimeLabel", runtimeID, EventsGatewayURLLabel, "https://gateway").Return(nil).Once()
+
+		agent := deps.newAgent(config.ConnectionConfig{ConnectorURL: connectorURL, Token: token, Tenant: tenant}, now)
+
+		// when
+		err := agent.Sync()
+
+		// then
+		require.NoError(t, err)
+		conn, found, err := deps.store.Load()
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, token, conn.Token)
+		assert.Equal(t, directorURL, conn.DirectorURL)
+
+		certificate, err := conn.Credentials.ParseClientCertificate()
+		require.NoError(t, err)
+		assert.Equal(t, runtimeID, certificate.Subject.CommonName)
+		_, err = conn.Credentials.TLSCertificate()
+		assert.NoError(t, err)
+
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should use existing connection and report labels once", func(t *testing.T) {
+		// given
+		deps := newTestDependencies(t)
+		deps.saveConnection(token, now)
+		deps.expectConfiguration()
+		deps.directorClient.On("SetRuntimeLabel", runtimeID, EventsGatewayURLLabel, "https://gateway").Return(nil).Once()
+
+		agent := deps.newAgent(config.ConnectionConfig{ConnectorURL: connectorURL, Token: token, Tenant: tenant}, now)
+
+		// when
+		err := agent.Sync()
+		require.NoError(t, err)
+
+		err = agent.Sync()
+		require.NoError(t, err)
+
+		// then
+		deps.connectorClient.AssertNotCalled(t, "Configuration", mock.Anything)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should establish connection again when new token is provided", func(t *testing.T) {
+		// given
+		deps := newTestDependencies(t)
+		deps.saveConnection(token, now)
+		deps.expectPairing("new-token", now)
+		deps.expectConfiguration()
+		deps.directorClient.On("SetRuntimeLabel", runtimeID, EventsGatewayURLLabel, "https://gateway").Return(nil)
+
+		agent := deps.newAgent(config.ConnectionConfig{ConnectorURL: connectorURL, Token: "new-token", Tenant: tenant}, now)
+
+		// when
+		err := agent.Sync()
+
+		// then
+		require.NoError(t, err)
+		conn, _, err := deps.store.Load()
+		require.NoError(t, err)
+		assert.Equal(t, "new-token", conn.Token)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should renew certificate in renewal window", func(t *testing.T) {
+		// given
+		deps := newTestDependencies(t)
+		issuedAt := now.Add(-validity + renewalWindow/2)
+		deps.saveConnection(token, issuedAt)
+		deps.expectPairing("", now)
+		deps.expectConfiguration()
+		deps.directorClient.On("SetRuntimeLabel", runtimeID, EventsGatewayURLLabel, "https://gateway").Return(nil)
+
+		agent := deps.newAgent(config.ConnectionConfig{ConnectorURL: connectorURL, Token: token, Tenant: tenant}, now)
+
+		// when
+		err := agent.Sync()
+
+		// then
+		require.NoError(t, err)
+		conn, _, err := deps.store.Load()
+		require.NoError(t, err)
+		assert.Equal(t, token, conn.Token)
+
+		certificate, err := conn.Credentials.ParseClientCertificate()
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(validity), certificate.NotAfter)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should keep current certificate when renewal fails", func(t *testing.T) {
+		// given
+		deps := newTestDependencies(t)
+		issuedAt := now.Add(-validity + renewalWindow/2)
+		deps.saveConnection(token, issuedAt)
+		deps.connectorClient.On("Configuration", "").Return(connector.Configuration{}, errors.New("renewal not allowed"))
+		deps.expectConfiguration()
+		deps.directorClient.On("SetRuntimeLabel", runtimeID, EventsGatewayURLLabel, "https://gateway").Return(nil)
+
+		agent := deps.newAgent(config.ConnectionConfig{ConnectorURL: connectorURL, Token: token, Tenant: tenant}, now)
+
+		// when
+		err := agent.Sync()
+
+		// then
+		require.NoError(t, err)
+		conn, _, err := deps.store.Load()
+		require.NoError(t, err)
+
+		certificate, err := conn.Credentials.ParseClientCertificate()
+		require.NoError(t, err)
+		assert.Equal(t, issuedAt.Add(validity), certificate.NotAfter)
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should return error when certificate expired", func(t *testing.T) {
+		// given
+		deps := newTestDependencies(t)
+		deps.saveConnection(token, now.Add(-validity-time.Hour))
+
+		agent := deps.newAgent(config.ConnectionConfig{ConnectorURL: connectorURL, Token: token, Tenant: tenant}, now)
+
+		// when
+		err := agent.Sync()
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expired")
+		deps.assertExpectations(t)
+	})
+
+	t.Run("should return error when connection is not established and token is not provided", func(t *testing.T) {
+		// given
+		deps := newTestDependencies(t)
+
+		agent := deps.newAgent(config.ConnectionConfig{ConnectorURL: connectorURL, Tenant: tenant}, now)
+
+		// when
+		err := agent.Sync()
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "token not provided")
+	})
+
+	t.Run("should return error when failed to apply configuration", func(t *testing.T) {
+		// given
+		deps := newTestDependencies(t)
+		deps.saveConnection(token, now)
+		deps.directorClient.On("ApplicationsForRuntime", runtimeID).Return(applications, nil)
+		deps.applier.On("Apply", applications).Return(errors.New("error"))
+
+		agent := deps.newAgent(config.ConnectionConfig{ConnectorURL: connectorURL, Token: token, Tenant: tenant}, now)
+
+		// when
+		err := agent.Sync()
+
+		// then
+		require.Error(t, err)
+		deps.directorClient.AssertNotCalled(t, "SetRuntimeLabel", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type testDependencies struct {
+	t               *testing.T
+	ca              *testCA
+	store           connection.Store
+	connectorClient *connectorMocks.Client
+	directorClient  *directorMocks.Client
+	applier         *applierMocks.Applier
+}
+
+func newTestDependencies(t *testing.T) *testDependencies {
+	return &testDependencies{
+		t:               t,
+		ca:              newTestCA(t),
+		store:           connection.NewInMemoryStore(),
+		connectorClient: &connectorMocks.Client{},
+		directorClient:  &directorMocks.Client{},
+		applier:         &applierMocks.Applier{},
+	}
+}
+
+func (d *testDependencies) newAgent(connectionConfig config.ConnectionConfig, now time.Time) *Agent {
+	agent := NewAgent(
+		Config{RenewalWindow: renewalWindow, Labels: map[string]string{EventsGatewayURLLabel: "https://gateway"}},
+		config.NewStaticProvider(connectionConfig),
+		d.store,
+		func(url string) connector.Client {
+			assert.Equal(d.t, connectorURL, url)
+			return d.connectorClient
+		},
+		func(url, tenantID string) director.Client {
+			assert.Equal(d.t, directorURL, url)
+			assert.Equal(d.t, tenant, tenantID)
+			return d.directorClient
+		},
+		d.applier,
+	)
+	agent.now = func() time.Time { return now }
+
+	return agent
+}
+
+// expectPairing sets up the Connector to issue certificates valid from the given time for the token or, if the token is empty, for the renewal
+func (d *testDependencies) expectPairing(token string, issuedAt time.Time) {
+	configuration := connector.Configuration{
+		Subject:              subject,
+		KeyAlgorithm:         "ecdsa-p256",
+		AllowedKeyAlgorithms: []string{"rsa2048", "ecdsa-p256"},
+		DirectorURL:          directorURL,
+	}
+
+	signToken := ""
+	if token != "" {
+		configuration.Token = csrToken
+		signToken = csrToken
+	}
+
+	d.connectorClient.On("Configuration", token).Return(configuration, nil).Once()
+	d.connectorClient.On("SignCSR", mock.Anything, signToken).Return(func(csr []byte, _ string) connector.CertificationResult {
+		return d.ca.sign(csr, issuedAt)
+	}, nil).Once()
+}
+
+func (d *testDependencies) expectConfiguration() {
+	d.directorClient.On("ApplicationsForRuntime", runtimeID).Return(applications, nil)
+	d.applier.On("Apply", applications).Return(nil)
+}
+
+// saveConnection saves connection established with the token and certificate issued at the given time
+func (d *testDependencies) saveConnection(token string, issuedAt time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(d.t, err)
+
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: runtimeID}}, key)
+	require.NoError(d.t, err)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(d.t, err)
+
+	result := d.ca.sign(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}), issuedAt)
+
+	conn := connection.Connection{Token: token, DirectorURL: directorURL}
+	conn.Credentials.PrivateKey = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	conn.Credentials.ClientCertificate = result.ClientCertificate
+	conn.Credentials.CertificateChain = result.CertificateChain
+	conn.Credentials.CACertificate = result.CACertificate
+
+	require.NoError(d.t, d.store.Save(conn))
+}
+
+func (d *testDependencies) assertExpectations(t *testing.T) {
+	d.connectorClient.AssertExpectations(t)
+	d.directorClient.AssertExpectations(t)
+	d.applier.AssertExpectations(t)
+}
+
+type testCA struct {
+	t           *testing.T
+	key         *ecdsa.PrivateKey
+	certificate *x509.Certificate
+	pem         []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             now.Add(-2 * validity),
+		NotAfter:              now.Add(2 * validity),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{
+		t:           t,
+		key:         key,
+		certificate: certificate,
+		pem:         pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (ca *testCA) sign(pemCSR []byte, issuedAt time.Time) connector.CertificationResult {
+	pemBlock, _ := pem.Decode(pemCSR)
+	require.NotNil(ca.t, pemBlock)
+
+	csr, err := x509.ParseCertificateRequest(pemBlock.Bytes)
+	require.NoError(ca.t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(issuedAt.Unix()),
+		Subject:      csr.Subject,
+		NotBefore:    issuedAt,
+		NotAfter:     issuedAt.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, csr.PublicKey, ca.key)
+	require.NoError(ca.t, err)
+
+	clientCertificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	return connector.CertificationResult{
+		ClientCertificate: clientCertificate,
+		CertificateChain:  append(append([]byte{}, clientCertificate...), ca.pem...),
+		CACertificate:     ca.pem,
+	}
+}
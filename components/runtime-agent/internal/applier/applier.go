@@ -0,0 +1,10 @@
+package applier
+
+import "github.com/kyma-incubator/compass/components/runtime-agent/internal/model"
+
+//go:generate mockery -name=Applier
+type Applier interface {
+	// Apply configures the Runtime so that it matches the desired configuration, Applications not present in the list should be removed
+	// it is called after each configuration poll, so it needs to be idempotent
+	Apply(applications []model.Application) error
+}
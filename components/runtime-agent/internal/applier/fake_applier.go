@@ -0,0 +1,65 @@
+package applier
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/model"
+	"github.com/sirupsen/logrus"
+)
+
+type fakeApplier struct {
+	mutex        sync.RWMutex
+	applications map[string]model.Application
+	log          *logrus.Entry
+}
+
+// NewFakeApplier creates applier which does not configure the Runtime, it only keeps the applied Applications in memory
+// and logs the changes, so that the Runtime Agent can be run and tested locally
+func NewFakeApplier() *fakeApplier {
+	return &fakeApplier{
+		applications: map[string]model.Application{},
+		log:          logrus.WithField("Applier", "Fake"),
+	}
+}
+
+func (a *fakeApplier) Apply(applications []model.Application) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	desired := make(map[string]model.Application, len(applications))
+	for _, application := range applications {
+		desired[application.ID] = application
+
+		current, exists := a.applications[application.ID]
+		switch {
+		case !exists:
+			a.log.Infof("Application %s (%s) created", application.Name, application.ID)
+		case !reflect.DeepEqual(current, application):
+			a.log.Infof("Application %s (%s) updated", application.Name, application.ID)
+		}
+	}
+
+	for id, application := range a.applications {
+		if _, exists := desired[id]; !exists {
+			a.log.Infof("Application %s (%s) deleted", application.Name, id)
+		}
+	}
+
+	a.applications = desired
+
+	return nil
+}
+
+// Applications returns the last applied Applications
+func (a *fakeApplier) Applications() []model.Application {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	applications := make([]model.Application, 0, len(a.applications))
+	for _, application := range a.applications {
+		applications = append(applications, application)
+	}
+
+	return applications
+}
@@ -0,0 +1,31 @@
+package applier
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeApplier(t *testing.T) {
+
+	t.Run("should replace applied Applications", func(t *testing.T) {
+		// given
+		applier := NewFakeApplier()
+
+		first := model.Application{ID: "app-1", Name: "first"}
+		second := model.Application{ID: "app-2", Name: "second"}
+		updated := model.Application{ID: "app-2", Name: "second", APIs: []model.APIDefinition{{ID: "api", TargetURL: "https://api"}}}
+
+		// when
+		err := applier.Apply([]model.Application{first, second})
+		require.NoError(t, err)
+
+		err = applier.Apply([]model.Application{updated})
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, []model.Application{updated}, applier.Applications())
+	})
+}
@@ -0,0 +1,42 @@
+package certificates
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+)
+
+// Credentials contain the PEM encoded private key and the certificates issued by the Connector
+type Credentials struct {
+	PrivateKey        []byte
+	ClientCertificate []byte
+	CertificateChain  []byte
+	CACertificate     []byte
+}
+
+// TLSCertificate returns the certificate chain with the private key used to authenticate in the Management Plane
+func (c Credentials) TLSCertificate() (tls.Certificate, error) {
+	certificate, err := tls.X509KeyPair(c.CertificateChain, c.PrivateKey)
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "Failed to load client certificate")
+	}
+
+	return certificate, nil
+}
+
+// ParseClientCertificate decodes the client certificate
+func (c Credentials) ParseClientCertificate() (*x509.Certificate, error) {
+	pemBlock, _ := pem.Decode(c.ClientCertificate)
+	if pemBlock == nil {
+		return nil, errors.New("Failed to decode client certificate PEM block")
+	}
+
+	certificate, err := x509.ParseCertificate(pemBlock.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to parse client certificate")
+	}
+
+	return certificate, nil
+}
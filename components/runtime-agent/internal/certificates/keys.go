@@ -0,0 +1,123 @@
+package certificates
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	rsaKeyAlgorithmFormat = "rsa%d"
+	ecdsaP256KeyAlgorithm = "ecdsa-p256"
+
+	minRSAKeySize = 2048
+)
+
+// SelectKeyAlgorithm returns the preferred key algorithm if the agent supports it, otherwise the first supported of the allowed algorithms
+func SelectKeyAlgorithm(preferred string, allowed []string) (string, error) {
+	for _, algorithm := range append([]string{preferred}, allowed...) {
+		if isSupported(algorithm) {
+			return algorithm, nil
+		}
+	}
+
+	return "", errors.Errorf("None of the allowed key algorithms is supported: %v", allowed)
+}
+
+// GenerateKey generates private key for the key algorithm, eg.: rsa2048, ecdsa-p256
+func GenerateKey(algorithm string) (crypto.Signer, error) {
+	if algorithm == ecdsaP256KeyAlgorithm {
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+
+	keySize, ok := rsaKeySize(algorithm)
+	if !ok {
+		return nil, errors.Errorf("Unsupported key algorithm %s", algorithm)
+	}
+
+	return rsa.GenerateKey(rand.Reader, keySize)
+}
+
+// ParseSubject parses the subject returned by the Connector, eg.: O=Org,OU=OrgUnit,L=Locality,ST=State,C=PL,CN=runtime-id
+func ParseSubject(subject string) (pkix.Name, error) {
+	var name pkix.Name
+
+	for _, attribute := range strings.Split(subject, ",") {
+		keyValue := strings.SplitN(attribute, "=", 2)
+		if len(keyValue) != 2 {
+			return pkix.Name{}, errors.Errorf("Invalid subject attribute %s", attribute)
+		}
+
+		value := keyValue[1]
+		switch strings.TrimSpace(keyValue[0]) {
+		case "CN":
+			name.CommonName = value
+		case "O":
+			name.Organization = []string{value}
+		case "OU":
+			name.OrganizationalUnit = []string{value}
+		case "L":
+			name.Locality = []string{value}
+		case "ST":
+			name.Province = []string{value}
+		case "C":
+			name.Country = []string{value}
+		default:
+			return pkix.Name{}, errors.Errorf("Unsupported subject attribute %s", attribute)
+		}
+	}
+
+	if name.CommonName == "" {
+		return pkix.Name{}, errors.Errorf("Common name not found in subject %s", subject)
+	}
+
+	return name, nil
+}
+
+// CreateCSR creates PEM encoded Certificate Signing Request signed with the key
+func CreateCSR(subject pkix.Name, key crypto.Signer) ([]byte, error) {
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: subject}, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to create Certificate Signing Request")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), nil
+}
+
+// EncodePrivateKey encodes the key in the PEM encoded PKCS #8 form
+func EncodePrivateKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to marshal private key")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func isSupported(algorithm string) bool {
+	if algorithm == ecdsaP256KeyAlgorithm {
+		return true
+	}
+
+	_, ok := rsaKeySize(algorithm)
+	return ok
+}
+
+func rsaKeySize(algorithm string) (int, bool) {
+	var keySize int
+	_, err := fmt.Sscanf(algorithm, rsaKeyAlgorithmFormat, &keySize)
+	if err != nil || fmt.Sprintf(rsaKeyAlgorithmFormat, keySize) != algorithm || keySize < minRSAKeySize {
+		return 0, false
+	}
+
+	return keySize, true
+}
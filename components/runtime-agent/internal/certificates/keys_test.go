@@ -0,0 +1,141 @@
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const subject = "O=Org,OU=OrgUnit,L=Locality,ST=State,C=PL,CN=runtime-id"
+
+func TestSelectKeyAlgorithm(t *testing.T) {
+
+	testCases := []struct {
+		description string
+		preferred   string
+		allowed     []string
+		expected    string
+	}{
+		{description: "should select preferred algorithm", preferred: "ecdsa-p256", allowed: []string{"rsa2048", "ecdsa-p256"}, expected: "ecdsa-p256"},
+		{description: "should select RSA with larger key", preferred: "rsa4096", allowed: []string{"rsa4096"}, expected: "rsa4096"},
+		{description: "should select first supported allowed algorithm", preferred: "ed25519", allowed: []string{"ed25519", "rsa2048"}, expected: "rsa2048"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			algorithm, err := SelectKeyAlgorithm(testCase.preferred, testCase.allowed)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, algorithm)
+		})
+	}
+
+	t.Run("should return error when no algorithm is supported", func(t *testing.T) {
+		// when
+		_, err := SelectKeyAlgorithm("rsa1024", []string{"rsa1024", "ed25519"})
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestCreateCSR(t *testing.T) {
+
+	t.Run("should create CSR with RSA key", func(t *testing.T) {
+		// given
+		name, err := ParseSubject(subject)
+		require.NoError(t, err)
+
+		key, err := GenerateKey("rsa2048")
+		require.NoError(t, err)
+
+		// when
+		pemCSR, err := CreateCSR(name, key)
+		require.NoError(t, err)
+
+		// then
+		csr := parseCSR(t, pemCSR)
+		assert.Equal(t, "runtime-id", csr.Subject.CommonName)
+		assert.Equal(t, []string{"Org"}, csr.Subject.Organization)
+		assert.Equal(t, []string{"OrgUnit"}, csr.Subject.OrganizationalUnit)
+		assert.Equal(t, []string{"Locality"}, csr.Subject.Locality)
+		assert.Equal(t, []string{"State"}, csr.Subject.Province)
+		assert.Equal(t, []string{"PL"}, csr.Subject.Country)
+		require.IsType(t, &rsa.PublicKey{}, csr.PublicKey)
+		assert.Equal(t, 2048, csr.PublicKey.(*rsa.PublicKey).N.BitLen())
+	})
+
+	t.Run("should create CSR with ECDSA key", func(t *testing.T) {
+		// given
+		name, err := ParseSubject(subject)
+		require.NoError(t, err)
+
+		key, err := GenerateKey("ecdsa-p256")
+		require.NoError(t, err)
+
+		// when
+		pemCSR, err := CreateCSR(name, key)
+		require.NoError(t, err)
+
+		// then
+		csr := parseCSR(t, pemCSR)
+		assert.IsType(t, &ecdsa.PublicKey{}, csr.PublicKey)
+	})
+}
+
+func TestParseSubject(t *testing.T) {
+
+	t.Run("should return error when common name is missing", func(t *testing.T) {
+		// when
+		_, err := ParseSubject("O=Org,OU=OrgUnit,L=Locality,ST=State,C=PL")
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should return error for unsupported attribute", func(t *testing.T) {
+		// when
+		_, err := ParseSubject("O=Org,STREET=Street,CN=runtime-id")
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestEncodePrivateKey(t *testing.T) {
+
+	t.Run("should encode key in PKCS #8 form", func(t *testing.T) {
+		// given
+		key, err := GenerateKey("ecdsa-p256")
+		require.NoError(t, err)
+
+		// when
+		encoded, err := EncodePrivateKey(key)
+		require.NoError(t, err)
+
+		// then
+		pemBlock, _ := pem.Decode(encoded)
+		require.NotNil(t, pemBlock)
+		decoded, err := x509.ParsePKCS8PrivateKey(pemBlock.Bytes)
+		require.NoError(t, err)
+		assert.Equal(t, key, decoded)
+	})
+}
+
+func parseCSR(t *testing.T, pemCSR []byte) *x509.CertificateRequest {
+	pemBlock, _ := pem.Decode(pemCSR)
+	require.NotNil(t, pemBlock)
+
+	csr, err := x509.ParseCertificateRequest(pemBlock.Bytes)
+	require.NoError(t, err)
+	require.NoError(t, csr.CheckSignature())
+
+	return csr
+}
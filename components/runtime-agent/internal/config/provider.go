@@ -0,0 +1,60 @@
+package config
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+// ConnectionConfig contains the parameters required to establish the trusted connection, they are set up by the Runtime Provisioner
+type ConnectionConfig struct {
+	ConnectorURL string
+	// Token is the one-time token used to pair the Runtime with the Connector
+	Token     string
+	RuntimeID string
+	Tenant    string
+}
+
+//go:generate mockery -name=Provider
+type Provider interface {
+	Load() (ConnectionConfig, error)
+}
+
+type staticProvider struct {
+	config ConnectionConfig
+}
+
+// NewStaticProvider creates provider returning the configuration passed at startup
+func NewStaticProvider(config ConnectionConfig) Provider {
+	return &staticProvider{config: config}
+}
+
+func (p *staticProvider) Load() (ConnectionConfig, error) {
+	return p.config, nil
+}
+
+type fileProvider struct {
+	path string
+}
+
+// NewFileProvider creates provider reading the JSON configuration from the file on each call,
+// so that the Runtime Agent picks up a new token when the file is updated, eg.: when mounted from a Secret
+func NewFileProvider(path string) Provider {
+	return &fileProvider{path: path}
+}
+
+func (p *fileProvider) Load() (ConnectionConfig, error) {
+	content, err := ioutil.ReadFile(p.path)
+	if err != nil {
+		return ConnectionConfig{}, errors.Wrapf(err, "Failed to read connection configuration from %s", p.path)
+	}
+
+	var config ConnectionConfig
+	err = json.Unmarshal(content, &config)
+	if err != nil {
+		return ConnectionConfig{}, errors.Wrapf(err, "Failed to decode connection configuration from %s", p.path)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileProvider_Load(t *testing.T) {
+
+	t.Run("should read configuration set up by the Runtime Provisioner", func(t *testing.T) {
+		// given
+		file, err := ioutil.TempFile("", "runtime-agent-config")
+		require.NoError(t, err)
+		defer os.Remove(file.Name())
+
+		_, err = file.WriteString(`{"ConnectorURL":"https://connector/graphql","Token":"token","RuntimeID":"runtime-id","Tenant":"tenant"}`)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		// when
+		config, err := NewFileProvider(file.Name()).Load()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ConnectionConfig{
+			ConnectorURL: "https://connector/graphql",
+			Token:        "token",
+			RuntimeID:    "runtime-id",
+			Tenant:       "tenant",
+		}, config)
+	})
+
+	t.Run("should return error when file does not exist", func(t *testing.T) {
+		// when
+		_, err := NewFileProvider("/non-existing/config.json").Load()
+
+		// then
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,39 @@
+package connection
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// NewHTTPClient creates client presenting the client certificate of the stored connection, if it is established,
+// the certificate is loaded on each handshake so that the renewed certificate is used without recreating the client
+func NewHTTPClient(store Store, timeout time.Duration, skipVerify bool) *http.Client {
+	getClientCertificate := func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		connection, found, err := store.Load()
+		if err != nil || !found {
+			return &tls.Certificate{}, err
+		}
+
+		certificate, err := connection.Credentials.TLSCertificate()
+		if err != nil {
+			logrus.Errorf("Failed to load client certificate: %s", err.Error())
+			return &tls.Certificate{}, nil
+		}
+
+		return &certificate, nil
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				GetClientCertificate: getClientCertificate,
+				InsecureSkipVerify:   skipVerify,
+			},
+		},
+	}
+}
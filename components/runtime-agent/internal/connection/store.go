@@ -0,0 +1,113 @@
+package connection
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/certificates"
+	"github.com/pkg/errors"
+)
+
+// Connection is the trusted connection between the Runtime and the Management Plane
+type Connection struct {
+	// Token is the one-time token used to establish the connection, a new token triggers establishing the connection again
+	Token string
+	// DirectorURL is the URL of the Director returned by the Connector
+	DirectorURL string
+	Credentials certificates.Credentials
+}
+
+//go:generate mockery -name=Store
+type Store interface {
+	// Load returns false if the connection has not been established yet
+	Load() (Connection, bool, error)
+	Save(conn Connection) error
+}
+
+type fileStore struct {
+	path string
+}
+
+// NewFileStore creates store keeping the connection in the file, so that the Runtime Agent does not need a new token after restart
+func NewFileStore(path string) Store {
+	return &fileStore{path: path}
+}
+
+func (s *fileStore) Load() (Connection, bool, error) {
+	content, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Connection{}, false, nil
+		}
+		return Connection{}, false, errors.Wrapf(err, "Failed to read connection from %s", s.path)
+	}
+
+	var connection Connection
+	err = json.Unmarshal(content, &connection)
+	if err != nil {
+		return Connection{}, false, errors.Wrapf(err, "Failed to decode connection from %s", s.path)
+	}
+
+	return connection, true, nil
+}
+
+// Save writes the connection to a temporary file which replaces the stored one, so that the stored connection is never partially written
+func (s *fileStore) Save(connection Connection) error {
+	content, err := json.Marshal(connection)
+	if err != nil {
+		return errors.Wrap(err, "Failed to encode connection")
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path))
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create temporary file for %s", s.path)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write(content)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrapf(err, "Failed to write connection to %s", tmpFile.Name())
+	}
+
+	err = os.Rename(tmpFile.Name(), s.path)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to save connection to %s", s.path)
+	}
+
+	return nil
+}
+
+type inMemoryStore struct {
+	mutex      sync.RWMutex
+	connection *Connection
+}
+
+// NewInMemoryStore creates store keeping the connection in memory, the connection is lost after restart
+func NewInMemoryStore() Store {
+	return &inMemoryStore{}
+}
+
+func (s *inMemoryStore) Load() (Connection, bool, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.connection == nil {
+		return Connection{}, false, nil
+	}
+
+	return *s.connection, true, nil
+}
+
+func (s *inMemoryStore) Save(connection Connection) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.connection = &connection
+	return nil
+}
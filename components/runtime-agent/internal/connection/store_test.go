@@ -0,0 +1,106 @@
+package connection_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/certificates"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConnection = connection.Connection{
+	Token:       "token",
+	DirectorURL: "https://director/graphql",
+	Credentials: certificates.Credentials{
+		PrivateKey:        []byte("key"),
+		ClientCertificate: []byte("certificate"),
+		CertificateChain:  []byte("chain"),
+		CACertificate:     []byte("ca"),
+	},
+}
+
+func TestFileStore(t *testing.T) {
+
+	t.Run("should save and load connection", func(t *testing.T) {
+		// given
+		dir := tempDir(t)
+		defer os.RemoveAll(dir)
+
+		store := connection.NewFileStore(filepath.Join(dir, "connection.json"))
+
+		// when
+		err := store.Save(testConnection)
+		require.NoError(t, err)
+
+		loaded, found, err := connection.NewFileStore(filepath.Join(dir, "connection.json")).Load()
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, testConnection, loaded)
+	})
+
+	t.Run("should return false when connection is not saved", func(t *testing.T) {
+		// given
+		dir := tempDir(t)
+		defer os.RemoveAll(dir)
+
+		store := connection.NewFileStore(filepath.Join(dir, "connection.json"))
+
+		// when
+		_, found, err := store.Load()
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("should return error when file is invalid", func(t *testing.T) {
+		// given
+		dir := tempDir(t)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "connection.json")
+		require.NoError(t, ioutil.WriteFile(path, []byte("invalid"), 0600))
+
+		// when
+		_, _, err := connection.NewFileStore(path).Load()
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestInMemoryStore(t *testing.T) {
+
+	t.Run("should save and load connection", func(t *testing.T) {
+		// given
+		store := connection.NewInMemoryStore()
+
+		_, found, err := store.Load()
+		require.NoError(t, err)
+		require.False(t, found)
+
+		// when
+		err = store.Save(testConnection)
+		require.NoError(t, err)
+
+		loaded, found, err := store.Load()
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, testConnection, loaded)
+	})
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "runtime-agent")
+	require.NoError(t, err)
+
+	return dir
+}
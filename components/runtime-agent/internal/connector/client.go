@@ -0,0 +1,172 @@
+package connector
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connection"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/graphql"
+	"github.com/pkg/errors"
+)
+
+const (
+	TokenHeader             = "Connector-Token"
+	ClientCertificateHeader = "X-Forwarded-Client-Cert"
+)
+
+const (
+	configurationQuery = `query { result: configuration { token { token } certificateSigningRequestInfo { subject keyAlgorithm allowedKeyAlgorithms } managementPlaneInfo { directorURL } } }`
+	signCSRMutation    = `mutation($csr: String!) { result: signCertificateSigningRequest(csr: $csr) { certificateChain caCertificate clientCertificate } }`
+)
+
+// Configuration contains the information required to request the certificate
+type Configuration struct {
+	// Token authenticates the Certificate Signing Request, it is returned only if the configuration was requested with the one-time token
+	Token                string
+	Subject              string
+	KeyAlgorithm         string
+	AllowedKeyAlgorithms []string
+	DirectorURL          string
+}
+
+// CertificationResult contains the PEM encoded certificates issued by the Connector
+type CertificationResult struct {
+	CertificateChain  []byte
+	ClientCertificate []byte
+	CACertificate     []byte
+}
+
+//go:generate mockery -name=Client
+type Client interface {
+	// Configuration returns the configuration required to request the certificate,
+	// if the token is empty the client certificate of the stored connection is used to renew the certificate
+	Configuration(token string) (Configuration, error)
+	// SignCSR signs the PEM encoded Certificate Signing Request,
+	// if the token is empty the client certificate of the stored connection is used to renew the certificate
+	SignCSR(csr []byte, token string) (CertificationResult, error)
+}
+
+type client struct {
+	graphQLClient            *graphql.Client
+	store                    connection.Store
+	forwardClientCertificate bool
+}
+
+// NewClient creates client of the Connector GraphQL API available under the given URL
+// if forwardClientCertificate is set, the client certificate is also sent in the X-Forwarded-Client-Cert header,
+// which is required when there is no gateway terminating TLS in front of the Connector, eg.: in local setups
+func NewClient(url string, httpClient *http.Client, store connection.Store, forwardClientCertificate bool) Client {
+	return &client{
+		graphQLClient:            graphql.NewClient("Connector", url, httpClient),
+		store:                    store,
+		forwardClientCertificate: forwardClientCertificate,
+	}
+}
+
+func (c *client) Configuration(token string) (Configuration, error) {
+	headers, err := c.authenticationHeaders(token)
+	if err != nil {
+		return Configuration{}, err
+	}
+
+	var configuration struct {
+		Token *struct {
+			Token string `json:"token"`
+		} `json:"token"`
+		CertificateSigningRequestInfo *struct {
+			Subject              string   `json:"subject"`
+			KeyAlgorithm         string   `json:"keyAlgorithm"`
+			AllowedKeyAlgorithms []string `json:"allowedKeyAlgorithms"`
+		} `json:"certificateSigningRequestInfo"`
+		ManagementPlaneInfo *struct {
+			DirectorURL string `json:"directorURL"`
+		} `json:"managementPlaneInfo"`
+	}
+
+	found, err := c.graphQLClient.Do(configurationQuery, headers, nil, &configuration)
+	if err != nil {
+		return Configuration{}, errors.Wrap(err, "Failed to fetch configuration from Connector")
+	}
+
+	if !found || configuration.CertificateSigningRequestInfo == nil {
+		return Configuration{}, errors.New("Connector returned empty Certificate Signing Request information")
+	}
+
+	result := Configuration{
+		Subject:              configuration.CertificateSigningRequestInfo.Subject,
+		KeyAlgorithm:         configuration.CertificateSigningRequestInfo.KeyAlgorithm,
+		AllowedKeyAlgorithms: configuration.CertificateSigningRequestInfo.AllowedKeyAlgorithms,
+	}
+	if configuration.Token != nil {
+		result.Token = configuration.Token.Token
+	}
+	if configuration.ManagementPlaneInfo != nil {
+		result.DirectorURL = configuration.ManagementPlaneInfo.DirectorURL
+	}
+
+	return result, nil
+}
+
+func (c *client) SignCSR(csr []byte, token string) (CertificationResult, error) {
+	headers, err := c.authenticationHeaders(token)
+	if err != nil {
+		return CertificationResult{}, err
+	}
+
+	var encodedResult struct {
+		CertificateChain  string `json:"certificateChain"`
+		CACertificate     string `json:"caCertificate"`
+		ClientCertificate string `json:"clientCertificate"`
+	}
+
+	variables := map[string]interface{}{"csr": base64.StdEncoding.EncodeToString(csr)}
+
+	found, err := c.graphQLClient.Do(signCSRMutation, headers, variables, &encodedResult)
+	if err != nil {
+		return CertificationResult{}, errors.Wrap(err, "Failed to sign Certificate Signing Request")
+	}
+
+	if !found {
+		return CertificationResult{}, errors.New("Connector returned empty certification result")
+	}
+
+	var result CertificationResult
+	for _, certificate := range []struct {
+		name    string
+		encoded string
+		decoded *[]byte
+	}{
+		{name: "certificate chain", encoded: encodedResult.CertificateChain, decoded: &result.CertificateChain},
+		{name: "client certificate", encoded: encodedResult.ClientCertificate, decoded: &result.ClientCertificate},
+		{name: "CA certificate", encoded: encodedResult.CACertificate, decoded: &result.CACertificate},
+	} {
+		*certificate.decoded, err = base64.StdEncoding.DecodeString(certificate.encoded)
+		if err != nil {
+			return CertificationResult{}, errors.Wrapf(err, "Failed to decode %s", certificate.name)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *client) authenticationHeaders(token string) (map[string]string, error) {
+	if token != "" {
+		return map[string]string{TokenHeader: token}, nil
+	}
+
+	if !c.forwardClientCertificate {
+		return nil, nil
+	}
+
+	conn, found, err := c.store.Load()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to load client certificate")
+	}
+
+	if !found {
+		return nil, errors.New("Connection not established, client certificate not available")
+	}
+
+	return map[string]string{ClientCertificateHeader: "Cert=" + url.PathEscape(string(conn.Credentials.ClientCertificate))}, nil
+}
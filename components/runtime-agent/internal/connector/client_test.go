@@ -0,0 +1,143 @@
+package connector_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/certificates"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connection"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/connector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const clientCertificate = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+func TestClient_Configuration(t *testing.T) {
+
+	t.Run("should return configuration for token", func(t *testing.T) {
+		// given
+		server := newConnectorServer(t, func(r *http.Request) {
+			assert.Equal(t, "token", r.Header.Get(connector.TokenHeader))
+			assert.Empty(t, r.Header.Get(connector.ClientCertificateHeader))
+		}, `{"data":{"result":{
+			"token":{"token":"csr-token"},
+			"certificateSigningRequestInfo":{"subject":"O=Org,CN=runtime-id","keyAlgorithm":"rsa2048","allowedKeyAlgorithms":["rsa2048","ecdsa-p256"]},
+			"managementPlaneInfo":{"directorURL":"https://director/graphql"}}}}`)
+		defer server.Close()
+
+		client := connector.NewClient(server.URL, http.DefaultClient, connection.NewInMemoryStore(), true)
+
+		// when
+		configuration, err := client.Configuration("token")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, connector.Configuration{
+			Token:                "csr-token",
+			Subject:              "O=Org,CN=runtime-id",
+			KeyAlgorithm:         "rsa2048",
+			AllowedKeyAlgorithms: []string{"rsa2048", "ecdsa-p256"},
+			DirectorURL:          "https://director/graphql",
+		}, configuration)
+	})
+
+	t.Run("should forward client certificate when token is empty", func(t *testing.T) {
+		// given
+		store := connection.NewInMemoryStore()
+		require.NoError(t, store.Save(connection.Connection{Credentials: certificates.Credentials{ClientCertificate: []byte(clientCertificate)}}))
+
+		server := newConnectorServer(t, func(r *http.Request) {
+			assert.Empty(t, r.Header.Get(connector.TokenHeader))
+			assert.Equal(t, "Cert="+url.PathEscape(clientCertificate), r.Header.Get(connector.ClientCertificateHeader))
+		}, `{"data":{"result":{"token":null,"certificateSigningRequestInfo":{"subject":"O=Org,CN=runtime-id","keyAlgorithm":"rsa2048","allowedKeyAlgorithms":["rsa2048"]}}}}`)
+		defer server.Close()
+
+		client := connector.NewClient(server.URL, http.DefaultClient, store, true)
+
+		// when
+		configuration, err := client.Configuration("")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, configuration.Token)
+		assert.Empty(t, configuration.DirectorURL)
+	})
+
+	t.Run("should return error when Connector returned errors", func(t *testing.T) {
+		// given
+		server := newConnectorServer(t, func(r *http.Request) {}, `{"data":null,"errors":[{"message":"Invalid token"}]}`)
+		defer server.Close()
+
+		client := connector.NewClient(server.URL, http.DefaultClient, connection.NewInMemoryStore(), false)
+
+		// when
+		_, err := client.Configuration("token")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid token")
+	})
+}
+
+func TestClient_SignCSR(t *testing.T) {
+
+	t.Run("should return decoded certificates", func(t *testing.T) {
+		// given
+		encode := base64.StdEncoding.EncodeToString
+
+		server := newConnectorServer(t, func(r *http.Request) {
+			assert.Equal(t, "csr-token", r.Header.Get(connector.TokenHeader))
+
+			var request struct {
+				Variables struct {
+					CSR string `json:"csr"`
+				} `json:"variables"`
+			}
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(body, &request))
+			assert.Equal(t, encode([]byte("csr")), request.Variables.CSR)
+		}, `{"data":{"result":{"certificateChain":"`+encode([]byte("chain"))+`","caCertificate":"`+encode([]byte("ca"))+`","clientCertificate":"`+encode([]byte("certificate"))+`"}}}`)
+		defer server.Close()
+
+		client := connector.NewClient(server.URL, http.DefaultClient, connection.NewInMemoryStore(), false)
+
+		// when
+		result, err := client.SignCSR([]byte("csr"), "csr-token")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, connector.CertificationResult{
+			CertificateChain:  []byte("chain"),
+			ClientCertificate: []byte("certificate"),
+			CACertificate:     []byte("ca"),
+		}, result)
+	})
+
+	t.Run("should return error when client certificate is not available", func(t *testing.T) {
+		// given
+		client := connector.NewClient("http://127.0.0.1", http.DefaultClient, connection.NewInMemoryStore(), true)
+
+		// when
+		_, err := client.SignCSR([]byte("csr"), "")
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func newConnectorServer(t *testing.T, assertRequest func(r *http.Request), response string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(r)
+
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(response))
+		require.NoError(t, err)
+	}))
+}
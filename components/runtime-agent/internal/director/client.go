@@ -0,0 +1,188 @@
+package director
+
+import (
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/graphql"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/model"
+	"github.com/pkg/errors"
+)
+
+const TenantHeader = "Tenant"
+
+// pageSize is the maximum page size allowed by the Director, the APIs and Event APIs of each Application are limited to the first page
+const pageSize = 100
+
+const (
+	applicationsForRuntimeQuery = `query($runtimeID: ID!, $first: Int, $after: PageCursor) {
+	result: applicationsForRuntime(runtimeID: $runtimeID, first: $first, after: $after) {
+		data {
+			id name description labels
+			apis(first: 100) { data { id name description targetURL auth(runtimeID: $runtimeID) { auth { credential {
+				... on BasicCredentialData { username password }
+				... on OAuthCredentialData { clientId clientSecret url }
+			} } } } }
+			eventAPIs(first: 100) { data { id name description } }
+		}
+		pageInfo { endCursor hasNextPage }
+	}
+}`
+	setRuntimeLabelMutation = `mutation($runtimeID: ID!, $key: String!, $value: Any!) { result: setRuntimeLabel(runtimeID: $runtimeID, key: $key, value: $value) { key } }`
+)
+
+//go:generate mockery -name=Client
+type Client interface {
+	// ApplicationsForRuntime returns all Applications assigned to the Runtime with the credentials valid for the Runtime
+	ApplicationsForRuntime(runtimeID string) ([]model.Application, error)
+	SetRuntimeLabel(runtimeID, key string, value interface{}) error
+}
+
+type client struct {
+	graphQLClient *graphql.Client
+	tenant        string
+}
+
+// NewClient creates client of the Director GraphQL API available under the given URL, which calls it on behalf of the tenant
+func NewClient(url, tenant string, httpClient *http.Client) Client {
+	return &client{
+		graphQLClient: graphql.NewClient("Director", url, httpClient),
+		tenant:        tenant,
+	}
+}
+
+type applicationPage struct {
+	Data     []application `json:"data"`
+	PageInfo struct {
+		EndCursor   string `json:"endCursor"`
+		HasNextPage bool   `json:"hasNextPage"`
+	} `json:"pageInfo"`
+}
+
+type application struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description *string                `json:"description"`
+	Labels      map[string]interface{} `json:"labels"`
+	APIs        struct {
+		Data []apiDefinition `json:"data"`
+	} `json:"apis"`
+	EventAPIs struct {
+		Data []eventAPIDefinition `json:"data"`
+	} `json:"eventAPIs"`
+}
+
+type apiDefinition struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	TargetURL   string  `json:"targetURL"`
+	Auth        struct {
+		Auth *struct {
+			Credential credentialData `json:"credential"`
+		} `json:"auth"`
+	} `json:"auth"`
+}
+
+// credentialData contains fields of all members of the CredentialData union
+type credentialData struct {
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+	URL          string `json:"url"`
+}
+
+type eventAPIDefinition struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+func (c *client) ApplicationsForRuntime(runtimeID string) ([]model.Application, error) {
+	applications := []model.Application{}
+
+	variables := map[string]interface{}{"runtimeID": runtimeID, "first": pageSize}
+	for {
+		var page applicationPage
+		_, err := c.graphQLClient.Do(applicationsForRuntimeQuery, c.headers(), variables, &page)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to fetch Applications for Runtime %s", runtimeID)
+		}
+
+		for _, app := range page.Data {
+			applications = append(applications, app.toModel())
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return applications, nil
+		}
+		variables["after"] = page.PageInfo.EndCursor
+	}
+}
+
+func (c *client) SetRuntimeLabel(runtimeID, key string, value interface{}) error {
+	var label struct {
+		Key string `json:"key"`
+	}
+
+	variables := map[string]interface{}{"runtimeID": runtimeID, "key": key, "value": value}
+
+	_, err := c.graphQLClient.Do(setRuntimeLabelMutation, c.headers(), variables, &label)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to set label %s of Runtime %s", key, runtimeID)
+	}
+
+	return nil
+}
+
+func (c *client) headers() map[string]string {
+	return map[string]string{TenantHeader: c.tenant}
+}
+
+func (a application) toModel() model.Application {
+	apis := make([]model.APIDefinition, 0, len(a.APIs.Data))
+	for _, api := range a.APIs.Data {
+		apis = append(apis, model.APIDefinition{
+			ID:          api.ID,
+			Name:        api.Name,
+			Description: api.Description,
+			TargetURL:   api.TargetURL,
+			Credentials: api.credentials(),
+		})
+	}
+
+	eventAPIs := make([]model.EventAPIDefinition, 0, len(a.EventAPIs.Data))
+	for _, eventAPI := range a.EventAPIs.Data {
+		eventAPIs = append(eventAPIs, model.EventAPIDefinition{
+			ID:          eventAPI.ID,
+			Name:        eventAPI.Name,
+			Description: eventAPI.Description,
+		})
+	}
+
+	return model.Application{
+		ID:          a.ID,
+		Name:        a.Name,
+		Description: a.Description,
+		Labels:      a.Labels,
+		APIs:        apis,
+		EventAPIs:   eventAPIs,
+	}
+}
+
+func (a apiDefinition) credentials() *model.Credentials {
+	if a.Auth.Auth == nil {
+		return nil
+	}
+
+	credential := a.Auth.Auth.Credential
+	if credential.ClientID != "" {
+		return &model.Credentials{
+			OAuth: &model.OAuthCredentials{ClientID: credential.ClientID, ClientSecret: credential.ClientSecret, URL: credential.URL},
+		}
+	}
+
+	return &model.Credentials{
+		Basic: &model.BasicCredentials{Username: credential.Username, Password: credential.Password},
+	}
+}
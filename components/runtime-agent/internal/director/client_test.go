@@ -0,0 +1,114 @@
+package director_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/director"
+	"github.com/kyma-incubator/compass/components/runtime-agent/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tenant    = "tenant"
+	runtimeID = "runtime-id"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+func TestClient_ApplicationsForRuntime(t *testing.T) {
+
+	t.Run("should fetch all pages of Applications", func(t *testing.T) {
+		// given
+		responses := map[string]string{
+			"": `{"data":{"result":{"data":[{"id":"app-1","name":"first","labels":{"scenarios":["DEFAULT"]},
+				"apis":{"data":[{"id":"api-1","name":"basic","targetURL":"https://basic","auth":{"auth":{"credential":{"username":"user","password":"pass"}}}},
+					{"id":"api-2","name":"oauth","targetURL":"https://oauth","auth":{"auth":{"credential":{"clientId":"client","clientSecret":"secret","url":"https://token"}}}},
+					{"id":"api-3","name":"public","targetURL":"https://public","auth":{"auth":null}}]},
+				"eventAPIs":{"data":[{"id":"event-api-1","name":"events"}]}}],
+				"pageInfo":{"endCursor":"cursor","hasNextPage":true}}}}`,
+			"cursor": `{"data":{"result":{"data":[{"id":"app-2","name":"second","apis":{"data":[]},"eventAPIs":{"data":[]}}],"pageInfo":{"endCursor":"","hasNextPage":false}}}}`,
+		}
+
+		server := newDirectorServer(t, func(request graphQLRequest) string {
+			assert.Equal(t, runtimeID, request.Variables["runtimeID"])
+			after, _ := request.Variables["after"].(string)
+			return responses[after]
+		})
+		defer server.Close()
+
+		client := director.NewClient(server.URL, tenant, http.DefaultClient)
+
+		// when
+		applications, err := client.ApplicationsForRuntime(runtimeID)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, applications, 2)
+		assert.Equal(t, "app-1", applications[0].ID)
+		assert.Equal(t, map[string]interface{}{"scenarios": []interface{}{"DEFAULT"}}, applications[0].Labels)
+		assert.Equal(t, []model.APIDefinition{
+			{ID: "api-1", Name: "basic", TargetURL: "https://basic", Credentials: &model.Credentials{Basic: &model.BasicCredentials{Username: "user", Password: "pass"}}},
+			{ID: "api-2", Name: "oauth", TargetURL: "https://oauth", Credentials: &model.Credentials{OAuth: &model.OAuthCredentials{ClientID: "client", ClientSecret: "secret", URL: "https://token"}}},
+			{ID: "api-3", Name: "public", TargetURL: "https://public"},
+		}, applications[0].APIs)
+		assert.Equal(t, []model.EventAPIDefinition{{ID: "event-api-1", Name: "events"}}, applications[0].EventAPIs)
+		assert.Equal(t, "app-2", applications[1].ID)
+	})
+
+	t.Run("should return error when Director returned errors", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, func(graphQLRequest) string {
+			return `{"data":null,"errors":[{"message":"Runtime not found"}]}`
+		})
+		defer server.Close()
+
+		client := director.NewClient(server.URL, tenant, http.DefaultClient)
+
+		// when
+		_, err := client.ApplicationsForRuntime(runtimeID)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Runtime not found")
+	})
+}
+
+func TestClient_SetRuntimeLabel(t *testing.T) {
+
+	t.Run("should set label", func(t *testing.T) {
+		// given
+		server := newDirectorServer(t, func(request graphQLRequest) string {
+			assert.Equal(t, map[string]interface{}{"runtimeID": runtimeID, "key": "console_url", "value": "https://console"}, request.Variables)
+			return `{"data":{"result":{"key":"console_url"}}}`
+		})
+		defer server.Close()
+
+		client := director.NewClient(server.URL, tenant, http.DefaultClient)
+
+		// when
+		err := client.SetRuntimeLabel(runtimeID, "console_url", "https://console")
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func newDirectorServer(t *testing.T, respond func(request graphQLRequest) string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, tenant, r.Header.Get(director.TenantHeader))
+
+		var request graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(respond(request)))
+		require.NoError(t, err)
+	}))
+}
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Client calls GraphQL API of the Management Plane components
+type Client struct {
+	name       string
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient creates client of the GraphQL API available under the given URL, the name identifies the called component in errors
+func NewClient(name, url string, httpClient *http.Client) *Client {
+	return &Client{
+		name:       name,
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+type request struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type response struct {
+	Data struct {
+		Result json.RawMessage `json:"result"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// Do executes the query with the given headers and decodes the field aliased as result into the result argument
+// it returns false if the result is null
+func (c *Client) Do(query string, headers map[string]string, variables map[string]interface{}, result interface{}) (bool, error) {
+	body, err := json.Marshal(request{Query: query, Variables: variables})
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to marshal %s request", c.name)
+	}
+
+	httpRequest, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to create %s request", c.name)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		httpRequest.Header.Set(key, value)
+	}
+
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to call %s", c.name)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return false, errors.Errorf("%s responded with unexpected status %d", c.name, httpResponse.StatusCode)
+	}
+
+	var gqlResponse response
+	err = json.NewDecoder(httpResponse.Body).Decode(&gqlResponse)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to decode %s response", c.name)
+	}
+
+	if len(gqlResponse.Errors) > 0 {
+		messages := make([]string, 0, len(gqlResponse.Errors))
+		for _, e := range gqlResponse.Errors {
+			messages = append(messages, e.Message)
+		}
+
+		return false, errors.Errorf("%s returned errors: %s", c.name, strings.Join(messages, "; "))
+	}
+
+	if len(gqlResponse.Data.Result) == 0 || string(gqlResponse.Data.Result) == "null" {
+		return false, nil
+	}
+
+	err = json.Unmarshal(gqlResponse.Data.Result, result)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to decode %s result", c.name)
+	}
+
+	return true, nil
+}
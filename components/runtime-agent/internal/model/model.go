@@ -0,0 +1,43 @@
+package model
+
+// Application is the configuration of the Application assigned to the Runtime
+type Application struct {
+	ID          string
+	Name        string
+	Description *string
+	Labels      map[string]interface{}
+	APIs        []APIDefinition
+	EventAPIs   []EventAPIDefinition
+}
+
+type APIDefinition struct {
+	ID          string
+	Name        string
+	Description *string
+	TargetURL   string
+	// Credentials are set if the Application defined credentials valid for the Runtime
+	Credentials *Credentials
+}
+
+type EventAPIDefinition struct {
+	ID          string
+	Name        string
+	Description *string
+}
+
+// Credentials contain either basic or OAuth credentials
+type Credentials struct {
+	Basic *BasicCredentials
+	OAuth *OAuthCredentials
+}
+
+type BasicCredentials struct {
+	Username string
+	Password string
+}
+
+type OAuthCredentials struct {
+	ClientID     string
+	ClientSecret string
+	URL          string
+}
@@ -0,0 +1,10 @@
+-- Connector tokens
+
+CREATE TABLE connector_tokens (
+    token_hash varchar(64) PRIMARY KEY,
+    client_id varchar(256) NOT NULL,
+    token_type varchar(32) NOT NULL,
+    expires_at timestamp NOT NULL
+);
+
+CREATE INDEX ON connector_tokens (expires_at);
@@ -0,0 +1,2 @@
+ALTER TABLE connector_tokens
+    ADD COLUMN tenant varchar(256) NOT NULL DEFAULT '';
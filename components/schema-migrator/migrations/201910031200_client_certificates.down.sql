@@ -0,0 +1,3 @@
+ALTER TABLE runtimes
+    DROP COLUMN certificate_serial_number,
+    DROP COLUMN certificate_expires_at;
@@ -0,0 +1,3 @@
+ALTER TABLE runtimes
+    ADD COLUMN certificate_serial_number varchar(256),
+    ADD COLUMN certificate_expires_at timestamp;
@@ -0,0 +1,2 @@
+ALTER TABLE connector_tokens
+    ADD COLUMN client_type varchar(256) NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+DROP TABLE connector_issued_certificates;
@@ -0,0 +1,14 @@
+-- Certificates issued by the Connector
+
+CREATE TABLE connector_issued_certificates (
+    serial_number varchar(64) PRIMARY KEY,
+    subject varchar(1024) NOT NULL,
+    client_id varchar(256) NOT NULL,
+    client_type varchar(32) NOT NULL,
+    tenant varchar(256) NOT NULL,
+    not_before timestamp NOT NULL,
+    not_after timestamp NOT NULL
+);
+
+CREATE INDEX ON connector_issued_certificates (client_id);
+CREATE INDEX ON connector_issued_certificates (not_after);
@@ -0,0 +1 @@
+DROP TABLE connector_used_tokens;
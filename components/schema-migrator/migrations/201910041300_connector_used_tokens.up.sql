@@ -0,0 +1,8 @@
+-- IDs of the signed one-time tokens which have already been used
+
+CREATE TABLE connector_used_tokens (
+    token_id varchar(64) PRIMARY KEY,
+    expires_at timestamp NOT NULL
+);
+
+CREATE INDEX ON connector_used_tokens (expires_at);
@@ -0,0 +1,2 @@
+DROP TABLE provisioner_operations;
+DROP TABLE provisioner_runtimes;
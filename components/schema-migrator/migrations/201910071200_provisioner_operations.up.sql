@@ -0,0 +1,22 @@
+-- Runtimes and asynchronous operations managed by the Provisioner
+
+CREATE TABLE provisioner_runtimes (
+    id varchar(256) PRIMARY KEY,
+    cluster_config jsonb NOT NULL,
+    kyma_config jsonb NOT NULL,
+    kubeconfig text NOT NULL DEFAULT ''
+);
+
+CREATE TABLE provisioner_operations (
+    id uuid PRIMARY KEY,
+    runtime_id varchar(256) NOT NULL,
+    type varchar(32) NOT NULL,
+    state varchar(32) NOT NULL,
+    message text NOT NULL,
+    started_at timestamp NOT NULL,
+    finished_at timestamp
+);
+
+-- Only one operation can be pending or in progress for a given Runtime
+CREATE UNIQUE INDEX provisioner_operations_runtime_unfinished_idx ON provisioner_operations (runtime_id) WHERE state IN ('PENDING', 'IN_PROGRESS');
+CREATE INDEX ON provisioner_operations (runtime_id, started_at);
@@ -0,0 +1,2 @@
+ALTER TABLE provisioner_runtimes DROP COLUMN director_runtime_id;
+ALTER TABLE provisioner_runtimes DROP COLUMN tenant;
@@ -0,0 +1,2 @@
+ALTER TABLE provisioner_runtimes ADD COLUMN tenant varchar(256) NOT NULL DEFAULT '';
+ALTER TABLE provisioner_runtimes ADD COLUMN director_runtime_id varchar(256) NOT NULL DEFAULT '';
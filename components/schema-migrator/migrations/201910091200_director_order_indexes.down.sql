@@ -0,0 +1,3 @@
+DROP INDEX documents_tenant_id_app_id_display_name_id_idx;
+DROP INDEX documents_tenant_id_app_id_title_id_idx;
+DROP INDEX runtimes_tenant_id_name_id_idx;
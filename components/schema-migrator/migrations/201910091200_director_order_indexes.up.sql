@@ -0,0 +1,3 @@
+CREATE INDEX runtimes_tenant_id_name_id_idx ON runtimes (tenant_id, name, id);
+CREATE INDEX documents_tenant_id_app_id_title_id_idx ON documents (tenant_id, app_id, title, id);
+CREATE INDEX documents_tenant_id_app_id_display_name_id_idx ON documents (tenant_id, app_id, display_name, id);
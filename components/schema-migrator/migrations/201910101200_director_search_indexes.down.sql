@@ -0,0 +1,4 @@
+DROP INDEX runtimes_description_trgm_idx;
+DROP INDEX runtimes_name_trgm_idx;
+
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,4 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX runtimes_name_trgm_idx ON runtimes USING gin (name gin_trgm_ops);
+CREATE INDEX runtimes_description_trgm_idx ON runtimes USING gin (description gin_trgm_ops);
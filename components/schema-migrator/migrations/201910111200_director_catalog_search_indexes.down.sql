@@ -0,0 +1 @@
+DROP INDEX documents_search_idx;
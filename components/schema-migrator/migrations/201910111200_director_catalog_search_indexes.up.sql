@@ -0,0 +1 @@
+CREATE INDEX documents_search_idx ON documents USING gin (to_tsvector('english', title || ' ' || display_name || ' ' || description || ' ' || coalesce(data, '')));
@@ -0,0 +1,2 @@
+DROP TABLE runtime_scenario_assignments;
+DROP TABLE scenario_assignment_rules;
@@ -0,0 +1,20 @@
+CREATE TABLE scenario_assignment_rules (
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id uuid NOT NULL,
+    scenario varchar(256) NOT NULL,
+    selector_key varchar(256) NOT NULL,
+    selector_query text
+);
+
+CREATE INDEX ON scenario_assignment_rules (tenant_id);
+CREATE UNIQUE INDEX ON scenario_assignment_rules (tenant_id, id);
+
+-- Scenarios added to the scenarios label of the Runtime by the assignment rules. The other values of the label were set manually.
+CREATE TABLE runtime_scenario_assignments (
+    tenant_id uuid NOT NULL,
+    runtime_id uuid NOT NULL,
+    foreign key (tenant_id, runtime_id) references runtimes (tenant_id, id) ON DELETE CASCADE,
+    scenario varchar(256) NOT NULL
+);
+
+CREATE UNIQUE INDEX ON runtime_scenario_assignments (tenant_id, runtime_id, scenario);
@@ -0,0 +1,5 @@
+DROP INDEX provisioner_operations_unfinished_lease_idx;
+
+ALTER TABLE provisioner_operations DROP COLUMN lease_expires_at;
+ALTER TABLE provisioner_operations DROP COLUMN owner;
+ALTER TABLE provisioner_operations DROP COLUMN tenant;
@@ -0,0 +1,10 @@
+-- Operations are executed by the Provisioner instance which started them, the instance renews the lease of the operation
+-- until it is finished, so that the other replicas fail only the operations of the instances which stopped
+
+ALTER TABLE provisioner_operations ADD COLUMN tenant varchar(256) NOT NULL DEFAULT '';
+ALTER TABLE provisioner_operations ADD COLUMN owner varchar(256) NOT NULL DEFAULT '';
+ALTER TABLE provisioner_operations ADD COLUMN lease_expires_at timestamp NOT NULL DEFAULT now();
+
+UPDATE provisioner_operations o SET tenant = r.tenant FROM provisioner_runtimes r WHERE o.runtime_id = r.id;
+
+CREATE INDEX provisioner_operations_unfinished_lease_idx ON provisioner_operations (lease_expires_at) WHERE state IN ('PENDING', 'IN_PROGRESS');
@@ -11,8 +11,15 @@ func TestTokens(t *testing.T) {
 	config, err := testkit.ReadConfig()
 	require.NoError(t, err)
 
-	appID := "54f83a73-b340-418d-b653-d25b5ed47d75"
-	client := testkit.NewConnectorClient(config.InternalConnectorUrl)
+	directorClient := testkit.NewDirectorClient(config.DirectorUrl, config.Tenant)
+	appID, err := directorClient.CreateApplication("connector-tests-tokens")
+	require.NoError(t, err)
+	defer func() {
+		err := directorClient.DeleteApplication(appID)
+		require.NoError(t, err)
+	}()
+
+	client := testkit.NewConnectorClient(config.InternalConnectorUrl, config.Tenant)
 
 	t.Run("should return valid response on Configuration query", func(t *testing.T) {
 		//when
@@ -67,9 +74,16 @@ func TestCertificateGeneration(t *testing.T) {
 	config, err := testkit.ReadConfig()
 	require.NoError(t, err)
 
-	appID := "54f83a73-b340-418d-b653-d95b5e347d74"
+	directorClient := testkit.NewDirectorClient(config.DirectorUrl, config.Tenant)
+	appID, err := directorClient.CreateApplication("connector-tests-certificates")
+	require.NoError(t, err)
+	defer func() {
+		err := directorClient.DeleteApplication(appID)
+		require.NoError(t, err)
+	}()
+
 	clientKey := testkit.CreateKey(t)
-	client := testkit.NewConnectorClient(config.InternalConnectorUrl)
+	client := testkit.NewConnectorClient(config.InternalConnectorUrl, config.Tenant)
 
 	t.Run("should return client certificate with valid subject and signed with CA certificate", func(t *testing.T) {
 		//when
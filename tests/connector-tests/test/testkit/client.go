@@ -11,7 +11,8 @@ import (
 )
 
 const (
-	TokenHeader = "Connector-Token"
+	TokenHeader  = "Connector-Token"
+	TenantHeader = "Tenant"
 )
 
 type ConnectorClient interface {
@@ -23,9 +24,10 @@ type ConnectorClient interface {
 type client struct {
 	graphQlClient *gcli.Client
 	queryProvider queryProvider
+	tenant        string
 }
 
-func NewConnectorClient(endpoint string) ConnectorClient {
+func NewConnectorClient(endpoint, tenant string) ConnectorClient {
 	httpClient := &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{
@@ -39,12 +41,14 @@ func NewConnectorClient(endpoint string) ConnectorClient {
 	return &client{
 		graphQlClient: graphQlClient,
 		queryProvider: queryProvider{},
+		tenant:        tenant,
 	}
 }
 
 func (c client) GenerateToken(appID string) (schema.Token, error) {
 	query := c.queryProvider.generateToken(appID)
 	req := gcli.NewRequest(query)
+	req.Header.Add(TenantHeader, c.tenant)
 
 	var response TokenResponse
 
@@ -9,6 +9,8 @@ import (
 
 type TestConfig struct {
 	InternalConnectorUrl string `envconfig:"default=http://compass-connector:3000/graphql"`
+	DirectorUrl          string `envconfig:"default=http://compass-director:3000/graphql"`
+	Tenant               string `envconfig:"default=3e64ebae-38b5-46a0-b1ed-9ccee153a0ae"`
 }
 
 func ReadConfig() (TestConfig, error) {
@@ -0,0 +1,72 @@
+package testkit
+
+import (
+	"context"
+	"fmt"
+
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
+)
+
+// DirectorClient creates Applications in Director, so that the Connector issues tokens for them
+type DirectorClient interface {
+	CreateApplication(name string) (string, error)
+	DeleteApplication(id string) error
+}
+
+type directorClient struct {
+	graphQlClient *gcli.Client
+	tenant        string
+}
+
+func NewDirectorClient(endpoint, tenant string) DirectorClient {
+	return &directorClient{
+		graphQlClient: gcli.NewClient(endpoint),
+		tenant:        tenant,
+	}
+}
+
+func (c *directorClient) CreateApplication(name string) (string, error) {
+	query := fmt.Sprintf(`mutation {
+	result: createApplication(in: {name: "%s"}) {
+		id
+	}
+}`, name)
+
+	var response ApplicationResponse
+
+	err := c.run(query, &response)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to create Application")
+	}
+	return response.Result.ID, nil
+}
+
+func (c *directorClient) DeleteApplication(id string) error {
+	query := fmt.Sprintf(`mutation {
+	result: deleteApplication(id: "%s") {
+		id
+	}
+}`, id)
+
+	var response ApplicationResponse
+
+	err := c.run(query, &response)
+	if err != nil {
+		return errors.Wrap(err, "Failed to delete Application")
+	}
+	return nil
+}
+
+func (c *directorClient) run(query string, response interface{}) error {
+	req := gcli.NewRequest(query)
+	req.Header.Add(TenantHeader, c.tenant)
+
+	return c.graphQlClient.Run(context.Background(), req, response)
+}
+
+type ApplicationResponse struct {
+	Result struct {
+		ID string `json:"id"`
+	} `json:"result"`
+}
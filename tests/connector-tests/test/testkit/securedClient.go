@@ -23,7 +23,7 @@ type securedClient struct {
 	queryProvider queryProvider
 }
 
-func NewSecuredConnectorClient(endpoint string, key *rsa.PrivateKey, certificate ...[]byte) ConnectorClient {
+func NewSecuredConnectorClient(endpoint string, key *rsa.PrivateKey, certificate ...[]byte) SecuredConnectorClient {
 	tlsCert := tls.Certificate{
 		Certificate: certificate,
 		PrivateKey:  key,
@@ -43,7 +43,7 @@ func NewSecuredConnectorClient(endpoint string, key *rsa.PrivateKey, certificate
 
 	graphQlClient := gcli.NewClient(endpoint, gcli.WithHTTPClient(httpClient))
 
-	return &client{
+	return &securedClient{
 		graphQlClient: graphQlClient,
 		queryProvider: queryProvider{},
 	}
@@ -62,7 +62,7 @@ func (c securedClient) Configuration() (schema.Configuration, error) {
 	return response.Result, nil
 }
 
-func (c securedClient) RenewCert(csr string, token string) (schema.CertificationResult, error) {
+func (c securedClient) RenewCertificate(csr string) (schema.CertificationResult, error) {
 	query := c.queryProvider.generateCert(csr)
 	req := gcli.NewRequest(query)
 
@@ -70,7 +70,7 @@ func (c securedClient) RenewCert(csr string, token string) (schema.Certification
 
 	err := c.graphQlClient.Run(context.Background(), req, &response)
 	if err != nil {
-		return schema.CertificationResult{}, errors.Wrap(err, "Failed to generate certificate")
+		return schema.CertificationResult{}, errors.Wrap(err, "Failed to renew certificate")
 	}
 	return response.Result, nil
 }
@@ -285,7 +285,9 @@ func (g *graphqlizer) LabelDefinitionInputToGQL(in graphql.LabelDefinitionInput)
 
 func (g *graphqlizer) LabelFilterToGQL(in graphql.LabelFilter) (string, error) {
 	return g.genericToGQL(in, `{
+		{{- if .Key }}
 		key: "{{.Key}}",
+		{{- end }}
 		{{- if .Query }}
 		query: "{{.Query}}",
 		{{- end }}
@@ -645,7 +645,7 @@ func TestSearchApplicationsByLabels(t *testing.T) {
 
 	// Query for application with LabelFilter "foo"
 	labelFilter := graphql.LabelFilter{
-		Key: labelKeyFoo,
+		Key: &labelKeyFoo,
 	}
 
 	//WHEN
@@ -667,7 +667,7 @@ func TestSearchApplicationsByLabels(t *testing.T) {
 
 	// Query for application with LabelFilter "bar"
 	labelFilter = graphql.LabelFilter{
-		Key: labelKeyBar,
+		Key: &labelKeyBar,
 	}
 
 	// WHEN
@@ -724,7 +724,7 @@ func TestSearchRuntimesByLabels(t *testing.T) {
 
 	// Query for runtime with LabelFilter "foo"
 	labelFilter := graphql.LabelFilter{
-		Key: labelKeyFoo,
+		Key: &labelKeyFoo,
 	}
 
 	//WHEN
@@ -746,7 +746,7 @@ func TestSearchRuntimesByLabels(t *testing.T) {
 
 	// Query for runtime with LabelFilter "bar"
 	labelFilter = graphql.LabelFilter{
-		Key: labelKeyBar,
+		Key: &labelKeyBar,
 	}
 
 	// WHEN